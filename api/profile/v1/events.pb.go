@@ -25,14 +25,16 @@ const (
 
 // EngagementAddedEvent 对应 profile.engagement.added。
 type EngagementAddedEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	VideoId       string                 `protobuf:"bytes,3,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
-	FavoriteType  FavoriteType           `protobuf:"varint,4,opt,name=favorite_type,json=favoriteType,proto3,enum=profile.v1.FavoriteType" json:"favorite_type,omitempty"`
-	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
-	Source        string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
-	Stats         *VideoStats            `protobuf:"bytes,7,opt,name=stats,proto3" json:"stats,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	EventId      string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId       string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VideoId      string                 `protobuf:"bytes,3,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	FavoriteType FavoriteType           `protobuf:"varint,4,opt,name=favorite_type,json=favoriteType,proto3,enum=profile.v1.FavoriteType" json:"favorite_type,omitempty"`
+	OccurredAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// source 为 manual/recommendation/system。
+	Source        string             `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	Stats         *VideoStats        `protobuf:"bytes,7,opt,name=stats,proto3" json:"stats,omitempty"`
+	Context       *EngagementContext `protobuf:"bytes,8,opt,name=context,proto3" json:"context,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -116,6 +118,13 @@ func (x *EngagementAddedEvent) GetStats() *VideoStats {
 	return nil
 }
 
+func (x *EngagementAddedEvent) GetContext() *EngagementContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
 // EngagementRemovedEvent 对应 profile.engagement.removed。
 type EngagementRemovedEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -294,12 +303,184 @@ func (x *WatchProgressedEvent) GetContext() *structpb.Struct {
 	return nil
 }
 
+// CollectionItemAddedEvent 对应 profile.collection.item_added。
+type CollectionItemAddedEvent struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	EventId      string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId       string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId string                 `protobuf:"bytes,3,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	VideoId      string                 `protobuf:"bytes,4,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	// position 为加入后在收藏夹中的排序位置（从 1 开始）。
+	Position      int32                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionItemAddedEvent) Reset() {
+	*x = CollectionItemAddedEvent{}
+	mi := &file_api_profile_v1_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionItemAddedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionItemAddedEvent) ProtoMessage() {}
+
+func (x *CollectionItemAddedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionItemAddedEvent.ProtoReflect.Descriptor instead.
+func (*CollectionItemAddedEvent) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CollectionItemAddedEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CollectionItemAddedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CollectionItemAddedEvent) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *CollectionItemAddedEvent) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *CollectionItemAddedEvent) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *CollectionItemAddedEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// CollectionItemRemovedEvent 对应 profile.collection.item_removed。
+type CollectionItemRemovedEvent struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	EventId      string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId       string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId string                 `protobuf:"bytes,3,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	VideoId      string                 `protobuf:"bytes,4,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	// reason 为 removed（主动移除）/moved（移动到其他收藏夹）/collection_deleted（收藏夹被删除）。
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionItemRemovedEvent) Reset() {
+	*x = CollectionItemRemovedEvent{}
+	mi := &file_api_profile_v1_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionItemRemovedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionItemRemovedEvent) ProtoMessage() {}
+
+func (x *CollectionItemRemovedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionItemRemovedEvent.ProtoReflect.Descriptor instead.
+func (*CollectionItemRemovedEvent) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CollectionItemRemovedEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CollectionItemRemovedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CollectionItemRemovedEvent) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *CollectionItemRemovedEvent) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *CollectionItemRemovedEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CollectionItemRemovedEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
 var File_api_profile_v1_events_proto protoreflect.FileDescriptor
 
 const file_api_profile_v1_events_proto_rawDesc = "" +
 	"\n" +
 	"\x1bapi/profile/v1/events.proto\x12\n" +
-	"profile.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1capi/profile/v1/profile.proto\"\xa7\x02\n" +
+	"profile.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1capi/profile/v1/profile.proto\"\xe0\x02\n" +
 	"\x14EngagementAddedEvent\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
@@ -308,7 +489,8 @@ const file_api_profile_v1_events_proto_rawDesc = "" +
 	"\voccurred_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"occurredAt\x12\x16\n" +
 	"\x06source\x18\x06 \x01(\tR\x06source\x12,\n" +
-	"\x05stats\x18\a \x01(\v2\x16.profile.v1.VideoStatsR\x05stats\"\xe4\x02\n" +
+	"\x05stats\x18\a \x01(\v2\x16.profile.v1.VideoStatsR\x05stats\x127\n" +
+	"\acontext\x18\b \x01(\v2\x1d.profile.v1.EngagementContextR\acontext\"\xe4\x02\n" +
 	"\x16EngagementRemovedEvent\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
@@ -325,7 +507,23 @@ const file_api_profile_v1_events_proto_rawDesc = "" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
 	"\bvideo_id\x18\x03 \x01(\tR\avideoId\x125\n" +
 	"\bprogress\x18\x04 \x01(\v2\x19.profile.v1.WatchProgressR\bprogress\x121\n" +
-	"\acontext\x18\x05 \x01(\v2\x17.google.protobuf.StructR\acontextBHZFgithub.com/bionicotaku/lingo-services-profile/api/profile/v1;profilev1b\x06proto3"
+	"\acontext\x18\x05 \x01(\v2\x17.google.protobuf.StructR\acontext\"\xe7\x01\n" +
+	"\x18CollectionItemAddedEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12#\n" +
+	"\rcollection_id\x18\x03 \x01(\tR\fcollectionId\x12\x19\n" +
+	"\bvideo_id\x18\x04 \x01(\tR\avideoId\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\x05R\bposition\x12;\n" +
+	"\voccurred_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\xe5\x01\n" +
+	"\x1aCollectionItemRemovedEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12#\n" +
+	"\rcollection_id\x18\x03 \x01(\tR\fcollectionId\x12\x19\n" +
+	"\bvideo_id\x18\x04 \x01(\tR\avideoId\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12;\n" +
+	"\voccurred_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAtBHZFgithub.com/bionicotaku/lingo-services-profile/api/profile/v1;profilev1b\x06proto3"
 
 var (
 	file_api_profile_v1_events_proto_rawDescOnce sync.Once
@@ -339,32 +537,38 @@ func file_api_profile_v1_events_proto_rawDescGZIP() []byte {
 	return file_api_profile_v1_events_proto_rawDescData
 }
 
-var file_api_profile_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_profile_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_api_profile_v1_events_proto_goTypes = []any{
-	(*EngagementAddedEvent)(nil),   // 0: profile.v1.EngagementAddedEvent
-	(*EngagementRemovedEvent)(nil), // 1: profile.v1.EngagementRemovedEvent
-	(*WatchProgressedEvent)(nil),   // 2: profile.v1.WatchProgressedEvent
-	(FavoriteType)(0),              // 3: profile.v1.FavoriteType
-	(*timestamppb.Timestamp)(nil),  // 4: google.protobuf.Timestamp
-	(*VideoStats)(nil),             // 5: profile.v1.VideoStats
-	(*WatchProgress)(nil),          // 6: profile.v1.WatchProgress
-	(*structpb.Struct)(nil),        // 7: google.protobuf.Struct
+	(*EngagementAddedEvent)(nil),       // 0: profile.v1.EngagementAddedEvent
+	(*EngagementRemovedEvent)(nil),     // 1: profile.v1.EngagementRemovedEvent
+	(*WatchProgressedEvent)(nil),       // 2: profile.v1.WatchProgressedEvent
+	(*CollectionItemAddedEvent)(nil),   // 3: profile.v1.CollectionItemAddedEvent
+	(*CollectionItemRemovedEvent)(nil), // 4: profile.v1.CollectionItemRemovedEvent
+	(FavoriteType)(0),                  // 5: profile.v1.FavoriteType
+	(*timestamppb.Timestamp)(nil),      // 6: google.protobuf.Timestamp
+	(*VideoStats)(nil),                 // 7: profile.v1.VideoStats
+	(*EngagementContext)(nil),          // 8: profile.v1.EngagementContext
+	(*WatchProgress)(nil),              // 9: profile.v1.WatchProgress
+	(*structpb.Struct)(nil),            // 10: google.protobuf.Struct
 }
 var file_api_profile_v1_events_proto_depIdxs = []int32{
-	3, // 0: profile.v1.EngagementAddedEvent.favorite_type:type_name -> profile.v1.FavoriteType
-	4, // 1: profile.v1.EngagementAddedEvent.occurred_at:type_name -> google.protobuf.Timestamp
-	5, // 2: profile.v1.EngagementAddedEvent.stats:type_name -> profile.v1.VideoStats
-	3, // 3: profile.v1.EngagementRemovedEvent.favorite_type:type_name -> profile.v1.FavoriteType
-	4, // 4: profile.v1.EngagementRemovedEvent.occurred_at:type_name -> google.protobuf.Timestamp
-	4, // 5: profile.v1.EngagementRemovedEvent.deleted_at:type_name -> google.protobuf.Timestamp
-	5, // 6: profile.v1.EngagementRemovedEvent.stats:type_name -> profile.v1.VideoStats
-	6, // 7: profile.v1.WatchProgressedEvent.progress:type_name -> profile.v1.WatchProgress
-	7, // 8: profile.v1.WatchProgressedEvent.context:type_name -> google.protobuf.Struct
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	5,  // 0: profile.v1.EngagementAddedEvent.favorite_type:type_name -> profile.v1.FavoriteType
+	6,  // 1: profile.v1.EngagementAddedEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	7,  // 2: profile.v1.EngagementAddedEvent.stats:type_name -> profile.v1.VideoStats
+	8,  // 3: profile.v1.EngagementAddedEvent.context:type_name -> profile.v1.EngagementContext
+	5,  // 4: profile.v1.EngagementRemovedEvent.favorite_type:type_name -> profile.v1.FavoriteType
+	6,  // 5: profile.v1.EngagementRemovedEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	6,  // 6: profile.v1.EngagementRemovedEvent.deleted_at:type_name -> google.protobuf.Timestamp
+	7,  // 7: profile.v1.EngagementRemovedEvent.stats:type_name -> profile.v1.VideoStats
+	9,  // 8: profile.v1.WatchProgressedEvent.progress:type_name -> profile.v1.WatchProgress
+	10, // 9: profile.v1.WatchProgressedEvent.context:type_name -> google.protobuf.Struct
+	6,  // 10: profile.v1.CollectionItemAddedEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	6,  // 11: profile.v1.CollectionItemRemovedEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_api_profile_v1_events_proto_init() }
@@ -379,7 +583,7 @@ func file_api_profile_v1_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_profile_v1_events_proto_rawDesc), len(file_api_profile_v1_events_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -7,6 +7,8 @@
 package profilev1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
@@ -75,13 +77,19 @@ func (FavoriteAction) EnumDescriptor() ([]byte, []int) {
 	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{0}
 }
 
-// FavoriteType 表示互动类别。
+// FavoriteType 表示互动类别，取值与服务端互动类型注册表一一对应。
 type FavoriteType int32
 
 const (
 	FavoriteType_FAVORITE_TYPE_UNSPECIFIED FavoriteType = 0
 	FavoriteType_FAVORITE_TYPE_LIKE        FavoriteType = 1
 	FavoriteType_FAVORITE_TYPE_BOOKMARK    FavoriteType = 2
+	// DISLIKE 表示点踩，与 LIKE 互斥。
+	FavoriteType_FAVORITE_TYPE_DISLIKE FavoriteType = 3
+	// NOT_INTERESTED 表示不感兴趣，仅作为推荐负反馈信号，不计入统计。
+	FavoriteType_FAVORITE_TYPE_NOT_INTERESTED FavoriteType = 4
+	// SHARE 表示分享，仅支持新增。
+	FavoriteType_FAVORITE_TYPE_SHARE FavoriteType = 5
 )
 
 // Enum value maps for FavoriteType.
@@ -90,11 +98,17 @@ var (
 		0: "FAVORITE_TYPE_UNSPECIFIED",
 		1: "FAVORITE_TYPE_LIKE",
 		2: "FAVORITE_TYPE_BOOKMARK",
+		3: "FAVORITE_TYPE_DISLIKE",
+		4: "FAVORITE_TYPE_NOT_INTERESTED",
+		5: "FAVORITE_TYPE_SHARE",
 	}
 	FavoriteType_value = map[string]int32{
-		"FAVORITE_TYPE_UNSPECIFIED": 0,
-		"FAVORITE_TYPE_LIKE":        1,
-		"FAVORITE_TYPE_BOOKMARK":    2,
+		"FAVORITE_TYPE_UNSPECIFIED":    0,
+		"FAVORITE_TYPE_LIKE":           1,
+		"FAVORITE_TYPE_BOOKMARK":       2,
+		"FAVORITE_TYPE_DISLIKE":        3,
+		"FAVORITE_TYPE_NOT_INTERESTED": 4,
+		"FAVORITE_TYPE_SHARE":          5,
 	}
 )
 
@@ -125,6 +139,118 @@ func (FavoriteType) EnumDescriptor() ([]byte, []int) {
 	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{1}
 }
 
+// EngagementSource 表示互动来源，未指定时按 MANUAL 处理。
+type EngagementSource int32
+
+const (
+	EngagementSource_ENGAGEMENT_SOURCE_UNSPECIFIED EngagementSource = 0
+	// MANUAL 表示用户主动操作。
+	EngagementSource_ENGAGEMENT_SOURCE_MANUAL EngagementSource = 1
+	// RECOMMENDATION 表示来自推荐位的操作。
+	EngagementSource_ENGAGEMENT_SOURCE_RECOMMENDATION EngagementSource = 2
+	// SYSTEM 表示系统代用户执行（如导入、迁移）。
+	EngagementSource_ENGAGEMENT_SOURCE_SYSTEM EngagementSource = 3
+)
+
+// Enum value maps for EngagementSource.
+var (
+	EngagementSource_name = map[int32]string{
+		0: "ENGAGEMENT_SOURCE_UNSPECIFIED",
+		1: "ENGAGEMENT_SOURCE_MANUAL",
+		2: "ENGAGEMENT_SOURCE_RECOMMENDATION",
+		3: "ENGAGEMENT_SOURCE_SYSTEM",
+	}
+	EngagementSource_value = map[string]int32{
+		"ENGAGEMENT_SOURCE_UNSPECIFIED":    0,
+		"ENGAGEMENT_SOURCE_MANUAL":         1,
+		"ENGAGEMENT_SOURCE_RECOMMENDATION": 2,
+		"ENGAGEMENT_SOURCE_SYSTEM":         3,
+	}
+)
+
+func (x EngagementSource) Enum() *EngagementSource {
+	p := new(EngagementSource)
+	*p = x
+	return p
+}
+
+func (x EngagementSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EngagementSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_profile_v1_profile_proto_enumTypes[2].Descriptor()
+}
+
+func (EngagementSource) Type() protoreflect.EnumType {
+	return &file_api_profile_v1_profile_proto_enumTypes[2]
+}
+
+func (x EngagementSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EngagementSource.Descriptor instead.
+func (EngagementSource) EnumDescriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{2}
+}
+
+// AccountStatus 表示账户生命周期状态。
+type AccountStatus int32
+
+const (
+	AccountStatus_ACCOUNT_STATUS_UNSPECIFIED      AccountStatus = 0
+	AccountStatus_ACCOUNT_STATUS_ACTIVE           AccountStatus = 1
+	AccountStatus_ACCOUNT_STATUS_SUSPENDED        AccountStatus = 2
+	AccountStatus_ACCOUNT_STATUS_PENDING_DELETION AccountStatus = 3
+	AccountStatus_ACCOUNT_STATUS_DELETED          AccountStatus = 4
+)
+
+// Enum value maps for AccountStatus.
+var (
+	AccountStatus_name = map[int32]string{
+		0: "ACCOUNT_STATUS_UNSPECIFIED",
+		1: "ACCOUNT_STATUS_ACTIVE",
+		2: "ACCOUNT_STATUS_SUSPENDED",
+		3: "ACCOUNT_STATUS_PENDING_DELETION",
+		4: "ACCOUNT_STATUS_DELETED",
+	}
+	AccountStatus_value = map[string]int32{
+		"ACCOUNT_STATUS_UNSPECIFIED":      0,
+		"ACCOUNT_STATUS_ACTIVE":           1,
+		"ACCOUNT_STATUS_SUSPENDED":        2,
+		"ACCOUNT_STATUS_PENDING_DELETION": 3,
+		"ACCOUNT_STATUS_DELETED":          4,
+	}
+)
+
+func (x AccountStatus) Enum() *AccountStatus {
+	p := new(AccountStatus)
+	*p = x
+	return p
+}
+
+func (x AccountStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_profile_v1_profile_proto_enumTypes[3].Descriptor()
+}
+
+func (AccountStatus) Type() protoreflect.EnumType {
+	return &file_api_profile_v1_profile_proto_enumTypes[3]
+}
+
+func (x AccountStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountStatus.Descriptor instead.
+func (AccountStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{3}
+}
+
 // GetProfileRequest 描述档案查询条件。
 type GetProfileRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -220,7 +346,8 @@ type UpdateProfileRequest struct {
 	state   protoimpl.MessageState `protogen:"open.v1"`
 	UserId  string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Profile *Profile               `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
-	// update_mask 指定需要更新的字段，例如 display_name, avatar_url。
+	// update_mask 指定需要更新的字段，例如 profile.display_name、profile.avatar_url、
+	// profile.preferred_locale、profile.preferred_timezone；显式指定但值为空表示清空。
 	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	// 期望的 profile_version，用于乐观锁控制。
 	ExpectedProfileVersion *wrapperspb.Int64Value `protobuf:"bytes,4,opt,name=expected_profile_version,json=expectedProfileVersion,proto3" json:"expected_profile_version,omitempty"`
@@ -460,6 +587,61 @@ func (x *UpdatePreferencesResponse) GetProfile() *Profile {
 	return nil
 }
 
+// EngagementContext 描述互动发生时的上下文，落库于 profile.engagements.metadata。
+type EngagementContext struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// entry_point 为触发互动的入口，如 feed、video_detail、search。
+	EntryPoint string `protobuf:"bytes,1,opt,name=entry_point,json=entryPoint,proto3" json:"entry_point,omitempty"`
+	// device 为终端类型，如 ios、android、web。
+	Device        string `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EngagementContext) Reset() {
+	*x = EngagementContext{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EngagementContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngagementContext) ProtoMessage() {}
+
+func (x *EngagementContext) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngagementContext.ProtoReflect.Descriptor instead.
+func (*EngagementContext) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EngagementContext) GetEntryPoint() string {
+	if x != nil {
+		return x.EntryPoint
+	}
+	return ""
+}
+
+func (x *EngagementContext) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
 // MutateFavoriteRequest 执行收藏/点赞写操作。
 type MutateFavoriteRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -469,13 +651,15 @@ type MutateFavoriteRequest struct {
 	Action         FavoriteAction         `protobuf:"varint,4,opt,name=action,proto3,enum=profile.v1.FavoriteAction" json:"action,omitempty"`
 	IdempotencyKey string                 `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 	OccurredAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Source         EngagementSource       `protobuf:"varint,7,opt,name=source,proto3,enum=profile.v1.EngagementSource" json:"source,omitempty"`
+	Context        *EngagementContext     `protobuf:"bytes,8,opt,name=context,proto3" json:"context,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *MutateFavoriteRequest) Reset() {
 	*x = MutateFavoriteRequest{}
-	mi := &file_api_profile_v1_profile_proto_msgTypes[6]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -487,7 +671,7 @@ func (x *MutateFavoriteRequest) String() string {
 func (*MutateFavoriteRequest) ProtoMessage() {}
 
 func (x *MutateFavoriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_profile_v1_profile_proto_msgTypes[6]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -500,7 +684,7 @@ func (x *MutateFavoriteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MutateFavoriteRequest.ProtoReflect.Descriptor instead.
 func (*MutateFavoriteRequest) Descriptor() ([]byte, []int) {
-	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{6}
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *MutateFavoriteRequest) GetUserId() string {
@@ -545,6 +729,20 @@ func (x *MutateFavoriteRequest) GetOccurredAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *MutateFavoriteRequest) GetSource() EngagementSource {
+	if x != nil {
+		return x.Source
+	}
+	return EngagementSource_ENGAGEMENT_SOURCE_UNSPECIFIED
+}
+
+func (x *MutateFavoriteRequest) GetContext() *EngagementContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
 type MutateFavoriteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	State         *FavoriteState         `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
@@ -555,7 +753,7 @@ type MutateFavoriteResponse struct {
 
 func (x *MutateFavoriteResponse) Reset() {
 	*x = MutateFavoriteResponse{}
-	mi := &file_api_profile_v1_profile_proto_msgTypes[7]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -567,7 +765,7 @@ func (x *MutateFavoriteResponse) String() string {
 func (*MutateFavoriteResponse) ProtoMessage() {}
 
 func (x *MutateFavoriteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_profile_v1_profile_proto_msgTypes[7]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -580,7 +778,7 @@ func (x *MutateFavoriteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MutateFavoriteResponse.ProtoReflect.Descriptor instead.
 func (*MutateFavoriteResponse) Descriptor() ([]byte, []int) {
-	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{7}
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *MutateFavoriteResponse) GetState() *FavoriteState {
@@ -599,17 +797,18 @@ func (x *MutateFavoriteResponse) GetStats() *VideoStats {
 
 // BatchQueryFavoriteRequest 批量查询收藏/点赞状态。
 type BatchQueryFavoriteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	VideoIds      []string               `protobuf:"bytes,2,rep,name=video_ids,json=videoIds,proto3" json:"video_ids,omitempty"`
-	IncludeStats  bool                   `protobuf:"varint,3,opt,name=include_stats,json=includeStats,proto3" json:"include_stats,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// video_ids 单次最多 100 个，每项须为 UUID。
+	VideoIds      []string `protobuf:"bytes,2,rep,name=video_ids,json=videoIds,proto3" json:"video_ids,omitempty"`
+	IncludeStats  bool     `protobuf:"varint,3,opt,name=include_stats,json=includeStats,proto3" json:"include_stats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BatchQueryFavoriteRequest) Reset() {
 	*x = BatchQueryFavoriteRequest{}
-	mi := &file_api_profile_v1_profile_proto_msgTypes[8]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -621,7 +820,7 @@ func (x *BatchQueryFavoriteRequest) String() string {
 func (*BatchQueryFavoriteRequest) ProtoMessage() {}
 
 func (x *BatchQueryFavoriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_profile_v1_profile_proto_msgTypes[8]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -634,7 +833,7 @@ func (x *BatchQueryFavoriteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchQueryFavoriteRequest.ProtoReflect.Descriptor instead.
 func (*BatchQueryFavoriteRequest) Descriptor() ([]byte, []int) {
-	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{8}
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *BatchQueryFavoriteRequest) GetUserId() string {
@@ -667,7 +866,7 @@ type BatchQueryFavoriteResponse struct {
 
 func (x *BatchQueryFavoriteResponse) Reset() {
 	*x = BatchQueryFavoriteResponse{}
-	mi := &file_api_profile_v1_profile_proto_msgTypes[9]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -679,7 +878,7 @@ func (x *BatchQueryFavoriteResponse) String() string {
 func (*BatchQueryFavoriteResponse) ProtoMessage() {}
 
 func (x *BatchQueryFavoriteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_profile_v1_profile_proto_msgTypes[9]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -692,7 +891,7 @@ func (x *BatchQueryFavoriteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchQueryFavoriteResponse.ProtoReflect.Descriptor instead.
 func (*BatchQueryFavoriteResponse) Descriptor() ([]byte, []int) {
-	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{9}
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *BatchQueryFavoriteResponse) GetSummaries() []*FavoriteSummary {
@@ -704,17 +903,19 @@ func (x *BatchQueryFavoriteResponse) GetSummaries() []*FavoriteSummary {
 
 // ListFavoritesRequest 游标分页收藏列表。
 type ListFavoritesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// page_size 为 0 时使用默认值 20，上限 100。
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token 为上一页返回的偏移量游标。
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListFavoritesRequest) Reset() {
 	*x = ListFavoritesRequest{}
-	mi := &file_api_profile_v1_profile_proto_msgTypes[10]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -726,7 +927,7 @@ func (x *ListFavoritesRequest) String() string {
 func (*ListFavoritesRequest) ProtoMessage() {}
 
 func (x *ListFavoritesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_profile_v1_profile_proto_msgTypes[10]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -739,7 +940,7 @@ func (x *ListFavoritesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFavoritesRequest.ProtoReflect.Descriptor instead.
 func (*ListFavoritesRequest) Descriptor() ([]byte, []int) {
-	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{10}
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListFavoritesRequest) GetUserId() string {
@@ -773,7 +974,7 @@ type ListFavoritesResponse struct {
 
 func (x *ListFavoritesResponse) Reset() {
 	*x = ListFavoritesResponse{}
-	mi := &file_api_profile_v1_profile_proto_msgTypes[11]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -785,7 +986,7 @@ func (x *ListFavoritesResponse) String() string {
 func (*ListFavoritesResponse) ProtoMessage() {}
 
 func (x *ListFavoritesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_profile_v1_profile_proto_msgTypes[11]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -798,7 +999,7 @@ func (x *ListFavoritesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFavoritesResponse.ProtoReflect.Descriptor instead.
 func (*ListFavoritesResponse) Descriptor() ([]byte, []int) {
-	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{11}
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListFavoritesResponse) GetFavorites() []*FavoriteItem {
@@ -828,7 +1029,7 @@ type UpsertWatchProgressRequest struct {
 
 func (x *UpsertWatchProgressRequest) Reset() {
 	*x = UpsertWatchProgressRequest{}
-	mi := &file_api_profile_v1_profile_proto_msgTypes[12]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -840,7 +1041,7 @@ func (x *UpsertWatchProgressRequest) String() string {
 func (*UpsertWatchProgressRequest) ProtoMessage() {}
 
 func (x *UpsertWatchProgressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_profile_v1_profile_proto_msgTypes[12]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -853,7 +1054,7 @@ func (x *UpsertWatchProgressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertWatchProgressRequest.ProtoReflect.Descriptor instead.
 func (*UpsertWatchProgressRequest) Descriptor() ([]byte, []int) {
-	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{12}
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpsertWatchProgressRequest) GetUserId() string {
@@ -894,7 +1095,7 @@ type UpsertWatchProgressResponse struct {
 
 func (x *UpsertWatchProgressResponse) Reset() {
 	*x = UpsertWatchProgressResponse{}
-	mi := &file_api_profile_v1_profile_proto_msgTypes[13]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -906,7 +1107,7 @@ func (x *UpsertWatchProgressResponse) String() string {
 func (*UpsertWatchProgressResponse) ProtoMessage() {}
 
 func (x *UpsertWatchProgressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_profile_v1_profile_proto_msgTypes[13]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -919,7 +1120,7 @@ func (x *UpsertWatchProgressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertWatchProgressResponse.ProtoReflect.Descriptor instead.
 func (*UpsertWatchProgressResponse) Descriptor() ([]byte, []int) {
-	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{13}
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *UpsertWatchProgressResponse) GetProgress() *WatchProgress {
@@ -938,17 +1139,19 @@ func (x *UpsertWatchProgressResponse) GetStats() *VideoStats {
 
 // ListWatchHistoryRequest 返回观看历史。
 type ListWatchHistoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// page_size 为 0 时使用默认值 20，上限 100。
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token 为上一页返回的偏移量游标。
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListWatchHistoryRequest) Reset() {
 	*x = ListWatchHistoryRequest{}
-	mi := &file_api_profile_v1_profile_proto_msgTypes[14]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -960,7 +1163,7 @@ func (x *ListWatchHistoryRequest) String() string {
 func (*ListWatchHistoryRequest) ProtoMessage() {}
 
 func (x *ListWatchHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_profile_v1_profile_proto_msgTypes[14]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -973,7 +1176,7 @@ func (x *ListWatchHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListWatchHistoryRequest.ProtoReflect.Descriptor instead.
 func (*ListWatchHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{14}
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListWatchHistoryRequest) GetUserId() string {
@@ -1007,7 +1210,7 @@ type ListWatchHistoryResponse struct {
 
 func (x *ListWatchHistoryResponse) Reset() {
 	*x = ListWatchHistoryResponse{}
-	mi := &file_api_profile_v1_profile_proto_msgTypes[15]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1019,7 +1222,7 @@ func (x *ListWatchHistoryResponse) String() string {
 func (*ListWatchHistoryResponse) ProtoMessage() {}
 
 func (x *ListWatchHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_profile_v1_profile_proto_msgTypes[15]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1032,7 +1235,7 @@ func (x *ListWatchHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListWatchHistoryResponse.ProtoReflect.Descriptor instead.
 func (*ListWatchHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{15}
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListWatchHistoryResponse) GetItems() []*WatchHistoryEntry {
@@ -1058,7 +1261,7 @@ type PurgeUserDataRequest struct {
 
 func (x *PurgeUserDataRequest) Reset() {
 	*x = PurgeUserDataRequest{}
-	mi := &file_api_profile_v1_profile_proto_msgTypes[16]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1070,7 +1273,7 @@ func (x *PurgeUserDataRequest) String() string {
 func (*PurgeUserDataRequest) ProtoMessage() {}
 
 func (x *PurgeUserDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_profile_v1_profile_proto_msgTypes[16]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,7 +1286,7 @@ func (x *PurgeUserDataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PurgeUserDataRequest.ProtoReflect.Descriptor instead.
 func (*PurgeUserDataRequest) Descriptor() ([]byte, []int) {
-	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{16}
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *PurgeUserDataRequest) GetUserId() string {
@@ -1103,7 +1306,7 @@ type PurgeUserDataResponse struct {
 
 func (x *PurgeUserDataResponse) Reset() {
 	*x = PurgeUserDataResponse{}
-	mi := &file_api_profile_v1_profile_proto_msgTypes[17]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1115,7 +1318,7 @@ func (x *PurgeUserDataResponse) String() string {
 func (*PurgeUserDataResponse) ProtoMessage() {}
 
 func (x *PurgeUserDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_profile_v1_profile_proto_msgTypes[17]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1128,7 +1331,7 @@ func (x *PurgeUserDataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PurgeUserDataResponse.ProtoReflect.Descriptor instead.
 func (*PurgeUserDataResponse) Descriptor() ([]byte, []int) {
-	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{17}
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *PurgeUserDataResponse) GetPurgeTaskId() string {
@@ -1138,35 +1341,33 @@ func (x *PurgeUserDataResponse) GetPurgeTaskId() string {
 	return ""
 }
 
-// Profile 表示用户档案。
-type Profile struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	DisplayName    string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	AvatarUrl      string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
-	ProfileVersion int64                  `protobuf:"varint,4,opt,name=profile_version,json=profileVersion,proto3" json:"profile_version,omitempty"`
-	Preferences    *Preferences           `protobuf:"bytes,5,opt,name=preferences,proto3" json:"preferences,omitempty"`
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// SuspendAccountRequest 冻结指定账户。
+type SuspendAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id 为目标账户，必填。
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// reason 记录冻结原因，便于运营追溯。
+	Reason                 string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpectedProfileVersion *wrapperspb.Int64Value `protobuf:"bytes,3,opt,name=expected_profile_version,json=expectedProfileVersion,proto3" json:"expected_profile_version,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *Profile) Reset() {
-	*x = Profile{}
-	mi := &file_api_profile_v1_profile_proto_msgTypes[18]
+func (x *SuspendAccountRequest) Reset() {
+	*x = SuspendAccountRequest{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Profile) String() string {
+func (x *SuspendAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Profile) ProtoMessage() {}
+func (*SuspendAccountRequest) ProtoMessage() {}
 
-func (x *Profile) ProtoReflect() protoreflect.Message {
-	mi := &file_api_profile_v1_profile_proto_msgTypes[18]
+func (x *SuspendAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1177,86 +1378,54 @@ func (x *Profile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
-func (*Profile) Descriptor() ([]byte, []int) {
-	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SuspendAccountRequest.ProtoReflect.Descriptor instead.
+func (*SuspendAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Profile) GetUserId() string {
+func (x *SuspendAccountRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *Profile) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
-	}
-	return ""
-}
-
-func (x *Profile) GetAvatarUrl() string {
+func (x *SuspendAccountRequest) GetReason() string {
 	if x != nil {
-		return x.AvatarUrl
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *Profile) GetProfileVersion() int64 {
-	if x != nil {
-		return x.ProfileVersion
-	}
-	return 0
-}
-
-func (x *Profile) GetPreferences() *Preferences {
-	if x != nil {
-		return x.Preferences
-	}
-	return nil
-}
-
-func (x *Profile) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *Profile) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *SuspendAccountRequest) GetExpectedProfileVersion() *wrapperspb.Int64Value {
 	if x != nil {
-		return x.UpdatedAt
+		return x.ExpectedProfileVersion
 	}
 	return nil
 }
 
-// Preferences 表示学习/通知偏好。
-type Preferences struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	LearningGoal      string                 `protobuf:"bytes,1,opt,name=learning_goal,json=learningGoal,proto3" json:"learning_goal,omitempty"`
-	DailyQuotaMinutes *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=daily_quota_minutes,json=dailyQuotaMinutes,proto3" json:"daily_quota_minutes,omitempty"`
-	// extra 用于保留未结构化的偏好字段（Post-MVP 扩展）。
-	Extra         *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+type SuspendAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Preferences) Reset() {
-	*x = Preferences{}
-	mi := &file_api_profile_v1_profile_proto_msgTypes[19]
+func (x *SuspendAccountResponse) Reset() {
+	*x = SuspendAccountResponse{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Preferences) String() string {
+func (x *SuspendAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Preferences) ProtoMessage() {}
+func (*SuspendAccountResponse) ProtoMessage() {}
 
-func (x *Preferences) ProtoReflect() protoreflect.Message {
-	mi := &file_api_profile_v1_profile_proto_msgTypes[19]
+func (x *SuspendAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1267,12 +1436,2990 @@ func (x *Preferences) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Preferences.ProtoReflect.Descriptor instead.
-func (*Preferences) Descriptor() ([]byte, []int) {
-	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use SuspendAccountResponse.ProtoReflect.Descriptor instead.
+func (*SuspendAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Preferences) GetLearningGoal() string {
+func (x *SuspendAccountResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// ReactivateAccountRequest 恢复指定账户。
+type ReactivateAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id 为目标账户，必填。
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// reason 记录恢复原因，便于运营追溯。
+	Reason                 string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpectedProfileVersion *wrapperspb.Int64Value `protobuf:"bytes,3,opt,name=expected_profile_version,json=expectedProfileVersion,proto3" json:"expected_profile_version,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ReactivateAccountRequest) Reset() {
+	*x = ReactivateAccountRequest{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateAccountRequest) ProtoMessage() {}
+
+func (x *ReactivateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateAccountRequest.ProtoReflect.Descriptor instead.
+func (*ReactivateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReactivateAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReactivateAccountRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReactivateAccountRequest) GetExpectedProfileVersion() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.ExpectedProfileVersion
+	}
+	return nil
+}
+
+type ReactivateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateAccountResponse) Reset() {
+	*x = ReactivateAccountResponse{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateAccountResponse) ProtoMessage() {}
+
+func (x *ReactivateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateAccountResponse.ProtoReflect.Descriptor instead.
+func (*ReactivateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReactivateAccountResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// ListAuditEntriesRequest 描述审计记录过滤条件，未填写的字段不参与过滤。
+type ListAuditEntriesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	UserId  string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ActorId string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Action  string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	TraceId string                 `protobuf:"bytes,4,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	// since/until 限定 created_at 的半开区间 [since, until)。
+	Since *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`
+	Until *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=until,proto3" json:"until,omitempty"`
+	// page_size 为 0 时使用默认值 20，上限 100。
+	PageSize int32 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token 为上一页返回的偏移量游标。
+	PageToken     string `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEntriesRequest) Reset() {
+	*x = ListAuditEntriesRequest{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEntriesRequest) ProtoMessage() {}
+
+func (x *ListAuditEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListAuditEntriesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListAuditEntriesRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditEntriesRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditEntriesRequest) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *ListAuditEntriesRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListAuditEntriesRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *ListAuditEntriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEntriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListAuditEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEntriesResponse) Reset() {
+	*x = ListAuditEntriesResponse{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEntriesResponse) ProtoMessage() {}
+
+func (x *ListAuditEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListAuditEntriesResponse) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListAuditEntriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// AuditEntry 表示一次写操作的审计记录，before/after 仅包含发生变化的字段。
+type AuditEntry struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	AuditId string                 `protobuf:"bytes,1,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`
+	UserId  string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// actor_type 为 user 或 service。
+	ActorType     string                 `protobuf:"bytes,3,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"`
+	ActorId       string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Action        string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	ResourceType  string                 `protobuf:"bytes,6,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceId    string                 `protobuf:"bytes,7,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Before        *structpb.Struct       `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
+	After         *structpb.Struct       `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	TraceId       string                 `protobuf:"bytes,10,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AuditEntry) GetAuditId() string {
+	if x != nil {
+		return x.AuditId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetActorType() string {
+	if x != nil {
+		return x.ActorType
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetBefore() *structpb.Struct {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetAfter() *structpb.Struct {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// UpdateVisibilityRequest 更新字段可见性。
+type UpdateVisibilityRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Visibility *ProfileVisibility     `protobuf:"bytes,2,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	// update_mask 指定需要更新的开关，例如 visibility.public_bookmarks；为空表示整体覆盖。
+	UpdateMask             *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	ExpectedProfileVersion *wrapperspb.Int64Value `protobuf:"bytes,4,opt,name=expected_profile_version,json=expectedProfileVersion,proto3" json:"expected_profile_version,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateVisibilityRequest) Reset() {
+	*x = UpdateVisibilityRequest{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVisibilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVisibilityRequest) ProtoMessage() {}
+
+func (x *UpdateVisibilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVisibilityRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVisibilityRequest) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateVisibilityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateVisibilityRequest) GetVisibility() *ProfileVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return nil
+}
+
+func (x *UpdateVisibilityRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateVisibilityRequest) GetExpectedProfileVersion() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.ExpectedProfileVersion
+	}
+	return nil
+}
+
+type UpdateVisibilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVisibilityResponse) Reset() {
+	*x = UpdateVisibilityResponse{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVisibilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVisibilityResponse) ProtoMessage() {}
+
+func (x *UpdateVisibilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVisibilityResponse.ProtoReflect.Descriptor instead.
+func (*UpdateVisibilityResponse) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateVisibilityResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// GetPublicProfileRequest 查询公开主页。
+type GetPublicProfileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id 为目标用户；为空时回落到当前调用方。
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicProfileRequest) Reset() {
+	*x = GetPublicProfileRequest{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicProfileRequest) ProtoMessage() {}
+
+func (x *GetPublicProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicProfileRequest) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetPublicProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetPublicProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *PublicProfile         `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicProfileResponse) Reset() {
+	*x = GetPublicProfileResponse{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicProfileResponse) ProtoMessage() {}
+
+func (x *GetPublicProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicProfileResponse) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetPublicProfileResponse) GetProfile() *PublicProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// ListPublicBookmarksRequest 游标分页公开收藏列表。
+type ListPublicBookmarksRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// page_size 为 0 时使用默认值 20，上限 100。
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token 为上一页返回的偏移量游标。
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPublicBookmarksRequest) Reset() {
+	*x = ListPublicBookmarksRequest{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPublicBookmarksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPublicBookmarksRequest) ProtoMessage() {}
+
+func (x *ListPublicBookmarksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPublicBookmarksRequest.ProtoReflect.Descriptor instead.
+func (*ListPublicBookmarksRequest) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListPublicBookmarksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListPublicBookmarksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPublicBookmarksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListPublicBookmarksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bookmarks     []*PublicBookmark      `protobuf:"bytes,1,rep,name=bookmarks,proto3" json:"bookmarks,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPublicBookmarksResponse) Reset() {
+	*x = ListPublicBookmarksResponse{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPublicBookmarksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPublicBookmarksResponse) ProtoMessage() {}
+
+func (x *ListPublicBookmarksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPublicBookmarksResponse.ProtoReflect.Descriptor instead.
+func (*ListPublicBookmarksResponse) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListPublicBookmarksResponse) GetBookmarks() []*PublicBookmark {
+	if x != nil {
+		return x.Bookmarks
+	}
+	return nil
+}
+
+func (x *ListPublicBookmarksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// CreateAvatarUploadRequest 申请头像上传地址。
+type CreateAvatarUploadRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// content_type 为待上传文件的 MIME 类型，需在服务端白名单内。
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// content_length 为客户端声明的文件大小（字节），用于提前拒绝超限文件。
+	ContentLength int64 `protobuf:"varint,3,opt,name=content_length,json=contentLength,proto3" json:"content_length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAvatarUploadRequest) Reset() {
+	*x = CreateAvatarUploadRequest{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAvatarUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAvatarUploadRequest) ProtoMessage() {}
+
+func (x *CreateAvatarUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAvatarUploadRequest.ProtoReflect.Descriptor instead.
+func (*CreateAvatarUploadRequest) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateAvatarUploadRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateAvatarUploadRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *CreateAvatarUploadRequest) GetContentLength() int64 {
+	if x != nil {
+		return x.ContentLength
+	}
+	return 0
+}
+
+type CreateAvatarUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Upload        *AvatarUploadTarget    `protobuf:"bytes,1,opt,name=upload,proto3" json:"upload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAvatarUploadResponse) Reset() {
+	*x = CreateAvatarUploadResponse{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAvatarUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAvatarUploadResponse) ProtoMessage() {}
+
+func (x *CreateAvatarUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAvatarUploadResponse.ProtoReflect.Descriptor instead.
+func (*CreateAvatarUploadResponse) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateAvatarUploadResponse) GetUpload() *AvatarUploadTarget {
+	if x != nil {
+		return x.Upload
+	}
+	return nil
+}
+
+// ConfirmAvatarUploadRequest 确认头像上传完成。
+type ConfirmAvatarUploadRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// upload_key 为 CreateAvatarUpload 返回的对象键。
+	UploadKey              string                 `protobuf:"bytes,2,opt,name=upload_key,json=uploadKey,proto3" json:"upload_key,omitempty"`
+	ExpectedProfileVersion *wrapperspb.Int64Value `protobuf:"bytes,3,opt,name=expected_profile_version,json=expectedProfileVersion,proto3" json:"expected_profile_version,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ConfirmAvatarUploadRequest) Reset() {
+	*x = ConfirmAvatarUploadRequest{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmAvatarUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmAvatarUploadRequest) ProtoMessage() {}
+
+func (x *ConfirmAvatarUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmAvatarUploadRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmAvatarUploadRequest) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ConfirmAvatarUploadRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ConfirmAvatarUploadRequest) GetUploadKey() string {
+	if x != nil {
+		return x.UploadKey
+	}
+	return ""
+}
+
+func (x *ConfirmAvatarUploadRequest) GetExpectedProfileVersion() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.ExpectedProfileVersion
+	}
+	return nil
+}
+
+type ConfirmAvatarUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmAvatarUploadResponse) Reset() {
+	*x = ConfirmAvatarUploadResponse{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmAvatarUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmAvatarUploadResponse) ProtoMessage() {}
+
+func (x *ConfirmAvatarUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmAvatarUploadResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmAvatarUploadResponse) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ConfirmAvatarUploadResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// AvatarUploadTarget 描述客户端直传头像所需的信息。
+type AvatarUploadTarget struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UploadKey string                 `protobuf:"bytes,1,opt,name=upload_key,json=uploadKey,proto3" json:"upload_key,omitempty"`
+	UploadUrl string                 `protobuf:"bytes,2,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
+	// method 为上传使用的 HTTP 方法，通常为 PUT。
+	Method string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	// headers 为上传时必须携带的请求头（如 Content-Type）。
+	Headers       map[string]string      `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	MaxBytes      int64                  `protobuf:"varint,6,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvatarUploadTarget) Reset() {
+	*x = AvatarUploadTarget{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvatarUploadTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvatarUploadTarget) ProtoMessage() {}
+
+func (x *AvatarUploadTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvatarUploadTarget.ProtoReflect.Descriptor instead.
+func (*AvatarUploadTarget) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AvatarUploadTarget) GetUploadKey() string {
+	if x != nil {
+		return x.UploadKey
+	}
+	return ""
+}
+
+func (x *AvatarUploadTarget) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+func (x *AvatarUploadTarget) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AvatarUploadTarget) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *AvatarUploadTarget) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *AvatarUploadTarget) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+// CreateCollectionRequest 新建收藏夹。
+type CreateCollectionRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// name 去除首尾空白后为 1-64 个字符。
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionRequest) Reset() {
+	*x = CreateCollectionRequest{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionRequest) ProtoMessage() {}
+
+func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateCollectionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionResponse) Reset() {
+	*x = CreateCollectionResponse{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionResponse) ProtoMessage() {}
+
+func (x *CreateCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionResponse.ProtoReflect.Descriptor instead.
+func (*CreateCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateCollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+// ListCollectionsRequest 分页查询收藏夹。
+type ListCollectionsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// page_size 为 0 时使用默认值 20，上限 100。
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsRequest) Reset() {
+	*x = ListCollectionsRequest{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsRequest) ProtoMessage() {}
+
+func (x *ListCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListCollectionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListCollectionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCollectionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListCollectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collections   []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsResponse) Reset() {
+	*x = ListCollectionsResponse{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsResponse) ProtoMessage() {}
+
+func (x *ListCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListCollectionsResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *ListCollectionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// RenameCollectionRequest 重命名收藏夹。
+type RenameCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId  string                 `protobuf:"bytes,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameCollectionRequest) Reset() {
+	*x = RenameCollectionRequest{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameCollectionRequest) ProtoMessage() {}
+
+func (x *RenameCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameCollectionRequest.ProtoReflect.Descriptor instead.
+func (*RenameCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RenameCollectionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RenameCollectionRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *RenameCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameCollectionResponse) Reset() {
+	*x = RenameCollectionResponse{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameCollectionResponse) ProtoMessage() {}
+
+func (x *RenameCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameCollectionResponse.ProtoReflect.Descriptor instead.
+func (*RenameCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RenameCollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+// DeleteCollectionRequest 删除收藏夹。
+type DeleteCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId  string                 `protobuf:"bytes,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionRequest) Reset() {
+	*x = DeleteCollectionRequest{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionRequest) ProtoMessage() {}
+
+func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DeleteCollectionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteCollectionRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+type DeleteCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionResponse) Reset() {
+	*x = DeleteCollectionResponse{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionResponse) ProtoMessage() {}
+
+func (x *DeleteCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{44}
+}
+
+// AddCollectionItemRequest 将视频加入收藏夹。
+type AddCollectionItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId  string                 `protobuf:"bytes,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,3,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCollectionItemRequest) Reset() {
+	*x = AddCollectionItemRequest{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCollectionItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCollectionItemRequest) ProtoMessage() {}
+
+func (x *AddCollectionItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCollectionItemRequest.ProtoReflect.Descriptor instead.
+func (*AddCollectionItemRequest) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AddCollectionItemRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddCollectionItemRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *AddCollectionItemRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+type AddCollectionItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *CollectionItem        `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCollectionItemResponse) Reset() {
+	*x = AddCollectionItemResponse{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCollectionItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCollectionItemResponse) ProtoMessage() {}
+
+func (x *AddCollectionItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCollectionItemResponse.ProtoReflect.Descriptor instead.
+func (*AddCollectionItemResponse) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AddCollectionItemResponse) GetItem() *CollectionItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// RemoveCollectionItemRequest 将视频移出收藏夹。
+type RemoveCollectionItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId  string                 `protobuf:"bytes,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,3,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCollectionItemRequest) Reset() {
+	*x = RemoveCollectionItemRequest{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCollectionItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCollectionItemRequest) ProtoMessage() {}
+
+func (x *RemoveCollectionItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCollectionItemRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCollectionItemRequest) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RemoveCollectionItemRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveCollectionItemRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *RemoveCollectionItemRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+type RemoveCollectionItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCollectionItemResponse) Reset() {
+	*x = RemoveCollectionItemResponse{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCollectionItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCollectionItemResponse) ProtoMessage() {}
+
+func (x *RemoveCollectionItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCollectionItemResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCollectionItemResponse) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{48}
+}
+
+// ReorderCollectionItemsRequest 重排收藏夹成员。
+type ReorderCollectionItemsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	UserId       string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId string                 `protobuf:"bytes,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	// video_ids 为期望的完整顺序，须包含且仅包含收藏夹当前全部成员。
+	VideoIds      []string `protobuf:"bytes,3,rep,name=video_ids,json=videoIds,proto3" json:"video_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderCollectionItemsRequest) Reset() {
+	*x = ReorderCollectionItemsRequest{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCollectionItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCollectionItemsRequest) ProtoMessage() {}
+
+func (x *ReorderCollectionItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCollectionItemsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderCollectionItemsRequest) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ReorderCollectionItemsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReorderCollectionItemsRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *ReorderCollectionItemsRequest) GetVideoIds() []string {
+	if x != nil {
+		return x.VideoIds
+	}
+	return nil
+}
+
+type ReorderCollectionItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderCollectionItemsResponse) Reset() {
+	*x = ReorderCollectionItemsResponse{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCollectionItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCollectionItemsResponse) ProtoMessage() {}
+
+func (x *ReorderCollectionItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCollectionItemsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderCollectionItemsResponse) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{50}
+}
+
+// MoveCollectionItemRequest 在收藏夹之间移动视频。
+type MoveCollectionItemRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// collection_id 为视频当前所在的收藏夹。
+	CollectionId       string `protobuf:"bytes,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	VideoId            string `protobuf:"bytes,3,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	TargetCollectionId string `protobuf:"bytes,4,opt,name=target_collection_id,json=targetCollectionId,proto3" json:"target_collection_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MoveCollectionItemRequest) Reset() {
+	*x = MoveCollectionItemRequest{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCollectionItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCollectionItemRequest) ProtoMessage() {}
+
+func (x *MoveCollectionItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCollectionItemRequest.ProtoReflect.Descriptor instead.
+func (*MoveCollectionItemRequest) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MoveCollectionItemRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MoveCollectionItemRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *MoveCollectionItemRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *MoveCollectionItemRequest) GetTargetCollectionId() string {
+	if x != nil {
+		return x.TargetCollectionId
+	}
+	return ""
+}
+
+type MoveCollectionItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *CollectionItem        `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCollectionItemResponse) Reset() {
+	*x = MoveCollectionItemResponse{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCollectionItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCollectionItemResponse) ProtoMessage() {}
+
+func (x *MoveCollectionItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCollectionItemResponse.ProtoReflect.Descriptor instead.
+func (*MoveCollectionItemResponse) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *MoveCollectionItemResponse) GetItem() *CollectionItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// ListCollectionItemsRequest 分页查询收藏夹成员。
+type ListCollectionItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId  string                 `protobuf:"bytes,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionItemsRequest) Reset() {
+	*x = ListCollectionItemsRequest{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionItemsRequest) ProtoMessage() {}
+
+func (x *ListCollectionItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionItemsRequest) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListCollectionItemsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListCollectionItemsRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *ListCollectionItemsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCollectionItemsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListCollectionItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Items         []*CollectionItem      `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionItemsResponse) Reset() {
+	*x = ListCollectionItemsResponse{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionItemsResponse) ProtoMessage() {}
+
+func (x *ListCollectionItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionItemsResponse) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListCollectionItemsResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+func (x *ListCollectionItemsResponse) GetItems() []*CollectionItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListCollectionItemsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// AddEngagementNoteRequest 为收藏添加笔记。
+type AddEngagementNoteRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	UserId  string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VideoId string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	// body 去除首尾空白后为 1-2000 个字符。
+	Body string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// position_seconds 为笔记对应的视频时间点（秒），缺省表示不关联时间点。
+	PositionSeconds *wrapperspb.Int32Value `protobuf:"bytes,4,opt,name=position_seconds,json=positionSeconds,proto3" json:"position_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AddEngagementNoteRequest) Reset() {
+	*x = AddEngagementNoteRequest{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddEngagementNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEngagementNoteRequest) ProtoMessage() {}
+
+func (x *AddEngagementNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEngagementNoteRequest.ProtoReflect.Descriptor instead.
+func (*AddEngagementNoteRequest) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AddEngagementNoteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddEngagementNoteRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *AddEngagementNoteRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *AddEngagementNoteRequest) GetPositionSeconds() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.PositionSeconds
+	}
+	return nil
+}
+
+type AddEngagementNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          *EngagementNote        `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddEngagementNoteResponse) Reset() {
+	*x = AddEngagementNoteResponse{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddEngagementNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEngagementNoteResponse) ProtoMessage() {}
+
+func (x *AddEngagementNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEngagementNoteResponse.ProtoReflect.Descriptor instead.
+func (*AddEngagementNoteResponse) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AddEngagementNoteResponse) GetNote() *EngagementNote {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+// UpdateEngagementNoteRequest 修改笔记；position_seconds 缺省时清空时间点。
+type UpdateEngagementNoteRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NoteId          string                 `protobuf:"bytes,2,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	Body            string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	PositionSeconds *wrapperspb.Int32Value `protobuf:"bytes,4,opt,name=position_seconds,json=positionSeconds,proto3" json:"position_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateEngagementNoteRequest) Reset() {
+	*x = UpdateEngagementNoteRequest{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEngagementNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEngagementNoteRequest) ProtoMessage() {}
+
+func (x *UpdateEngagementNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEngagementNoteRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEngagementNoteRequest) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UpdateEngagementNoteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateEngagementNoteRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *UpdateEngagementNoteRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *UpdateEngagementNoteRequest) GetPositionSeconds() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.PositionSeconds
+	}
+	return nil
+}
+
+type UpdateEngagementNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          *EngagementNote        `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEngagementNoteResponse) Reset() {
+	*x = UpdateEngagementNoteResponse{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEngagementNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEngagementNoteResponse) ProtoMessage() {}
+
+func (x *UpdateEngagementNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEngagementNoteResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEngagementNoteResponse) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpdateEngagementNoteResponse) GetNote() *EngagementNote {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+// DeleteEngagementNoteRequest 删除笔记。
+type DeleteEngagementNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NoteId        string                 `protobuf:"bytes,2,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEngagementNoteRequest) Reset() {
+	*x = DeleteEngagementNoteRequest{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEngagementNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEngagementNoteRequest) ProtoMessage() {}
+
+func (x *DeleteEngagementNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEngagementNoteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEngagementNoteRequest) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeleteEngagementNoteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteEngagementNoteRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+type DeleteEngagementNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEngagementNoteResponse) Reset() {
+	*x = DeleteEngagementNoteResponse{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEngagementNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEngagementNoteResponse) ProtoMessage() {}
+
+func (x *DeleteEngagementNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEngagementNoteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEngagementNoteResponse) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{60}
+}
+
+// EngagementNote 表示收藏上的私有笔记，仅本人可见。
+type EngagementNote struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	NoteId  string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	VideoId string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Body    string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// position_seconds 为空表示笔记未关联视频时间点。
+	PositionSeconds *wrapperspb.Int32Value `protobuf:"bytes,4,opt,name=position_seconds,json=positionSeconds,proto3" json:"position_seconds,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EngagementNote) Reset() {
+	*x = EngagementNote{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EngagementNote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngagementNote) ProtoMessage() {}
+
+func (x *EngagementNote) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngagementNote.ProtoReflect.Descriptor instead.
+func (*EngagementNote) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *EngagementNote) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *EngagementNote) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *EngagementNote) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *EngagementNote) GetPositionSeconds() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.PositionSeconds
+	}
+	return nil
+}
+
+func (x *EngagementNote) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *EngagementNote) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Collection 表示用户收藏夹。
+type Collection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionId  string                 `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ItemCount     int64                  `protobuf:"varint,3,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collection) Reset() {
+	*x = Collection{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection) ProtoMessage() {}
+
+func (x *Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Collection) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *Collection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Collection) GetItemCount() int64 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *Collection) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Collection) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CollectionItem 表示收藏夹成员，video 由 videos_projection 补水，投影缺失时为空。
+type CollectionItem struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	VideoId string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	// position 为排序位置，从 1 开始。
+	Position      int32                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	AddedAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	Video         *VideoMetadata         `protobuf:"bytes,4,opt,name=video,proto3" json:"video,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionItem) Reset() {
+	*x = CollectionItem{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionItem) ProtoMessage() {}
+
+func (x *CollectionItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionItem.ProtoReflect.Descriptor instead.
+func (*CollectionItem) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CollectionItem) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *CollectionItem) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *CollectionItem) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+func (x *CollectionItem) GetVideo() *VideoMetadata {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+// Profile 表示用户档案。
+type Profile struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DisplayName       string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	AvatarUrl         string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	ProfileVersion    int64                  `protobuf:"varint,4,opt,name=profile_version,json=profileVersion,proto3" json:"profile_version,omitempty"`
+	Preferences       *Preferences           `protobuf:"bytes,5,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	AccountStatus     AccountStatus          `protobuf:"varint,8,opt,name=account_status,json=accountStatus,proto3,enum=profile.v1.AccountStatus" json:"account_status,omitempty"`
+	PendingDeletionAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=pending_deletion_at,json=pendingDeletionAt,proto3" json:"pending_deletion_at,omitempty"`
+	// deleted_at 非空表示账户已删除，此时仅返回公开字段。
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// visibility 为公开主页的字段可见性设置，仅对本人返回。
+	Visibility *ProfileVisibility `protobuf:"bytes,11,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	// preferred_locale 为 BCP47 语言标签，未设置时回落到网关 userinfo 的 locale。
+	PreferredLocale string `protobuf:"bytes,12,opt,name=preferred_locale,json=preferredLocale,proto3" json:"preferred_locale,omitempty"`
+	// preferred_timezone 为 IANA 时区名称，未设置时回落到网关 userinfo 的 zoneinfo。
+	PreferredTimezone string `protobuf:"bytes,13,opt,name=preferred_timezone,json=preferredTimezone,proto3" json:"preferred_timezone,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Profile) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Profile) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Profile) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *Profile) GetProfileVersion() int64 {
+	if x != nil {
+		return x.ProfileVersion
+	}
+	return 0
+}
+
+func (x *Profile) GetPreferences() *Preferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *Profile) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Profile) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Profile) GetAccountStatus() AccountStatus {
+	if x != nil {
+		return x.AccountStatus
+	}
+	return AccountStatus_ACCOUNT_STATUS_UNSPECIFIED
+}
+
+func (x *Profile) GetPendingDeletionAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PendingDeletionAt
+	}
+	return nil
+}
+
+func (x *Profile) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Profile) GetVisibility() *ProfileVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return nil
+}
+
+func (x *Profile) GetPreferredLocale() string {
+	if x != nil {
+		return x.PreferredLocale
+	}
+	return ""
+}
+
+func (x *Profile) GetPreferredTimezone() string {
+	if x != nil {
+		return x.PreferredTimezone
+	}
+	return ""
+}
+
+// ProfileVisibility 表示公开主页的字段可见性开关。
+type ProfileVisibility struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PublicDisplayName bool                   `protobuf:"varint,1,opt,name=public_display_name,json=publicDisplayName,proto3" json:"public_display_name,omitempty"`
+	PublicAvatar      bool                   `protobuf:"varint,2,opt,name=public_avatar,json=publicAvatar,proto3" json:"public_avatar,omitempty"`
+	PublicBookmarks   bool                   `protobuf:"varint,3,opt,name=public_bookmarks,json=publicBookmarks,proto3" json:"public_bookmarks,omitempty"`
+	PublicStats       bool                   `protobuf:"varint,4,opt,name=public_stats,json=publicStats,proto3" json:"public_stats,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ProfileVisibility) Reset() {
+	*x = ProfileVisibility{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileVisibility) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileVisibility) ProtoMessage() {}
+
+func (x *ProfileVisibility) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileVisibility.ProtoReflect.Descriptor instead.
+func (*ProfileVisibility) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ProfileVisibility) GetPublicDisplayName() bool {
+	if x != nil {
+		return x.PublicDisplayName
+	}
+	return false
+}
+
+func (x *ProfileVisibility) GetPublicAvatar() bool {
+	if x != nil {
+		return x.PublicAvatar
+	}
+	return false
+}
+
+func (x *ProfileVisibility) GetPublicBookmarks() bool {
+	if x != nil {
+		return x.PublicBookmarks
+	}
+	return false
+}
+
+func (x *ProfileVisibility) GetPublicStats() bool {
+	if x != nil {
+		return x.PublicStats
+	}
+	return false
+}
+
+// PublicProfile 表示公开主页视图，未公开的字段留空。
+type PublicProfile struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserId      string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DisplayName string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	AvatarUrl   string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	// bookmarks_public 为 true 时可调用 ListPublicBookmarks。
+	BookmarksPublic bool `protobuf:"varint,4,opt,name=bookmarks_public,json=bookmarksPublic,proto3" json:"bookmarks_public,omitempty"`
+	// stats 仅在用户公开统计时返回。
+	Stats         *PublicProfileStats `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublicProfile) Reset() {
+	*x = PublicProfile{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicProfile) ProtoMessage() {}
+
+func (x *PublicProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicProfile.ProtoReflect.Descriptor instead.
+func (*PublicProfile) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *PublicProfile) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PublicProfile) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *PublicProfile) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *PublicProfile) GetBookmarksPublic() bool {
+	if x != nil {
+		return x.BookmarksPublic
+	}
+	return false
+}
+
+func (x *PublicProfile) GetStats() *PublicProfileStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// PublicProfileStats 表示公开主页展示的聚合统计。
+type PublicProfileStats struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	LikeCount         int64                  `protobuf:"varint,1,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	BookmarkCount     int64                  `protobuf:"varint,2,opt,name=bookmark_count,json=bookmarkCount,proto3" json:"bookmark_count,omitempty"`
+	WatchedVideoCount int64                  `protobuf:"varint,3,opt,name=watched_video_count,json=watchedVideoCount,proto3" json:"watched_video_count,omitempty"`
+	TotalWatchSeconds int64                  `protobuf:"varint,4,opt,name=total_watch_seconds,json=totalWatchSeconds,proto3" json:"total_watch_seconds,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PublicProfileStats) Reset() {
+	*x = PublicProfileStats{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicProfileStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicProfileStats) ProtoMessage() {}
+
+func (x *PublicProfileStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicProfileStats.ProtoReflect.Descriptor instead.
+func (*PublicProfileStats) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *PublicProfileStats) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *PublicProfileStats) GetBookmarkCount() int64 {
+	if x != nil {
+		return x.BookmarkCount
+	}
+	return 0
+}
+
+func (x *PublicProfileStats) GetWatchedVideoCount() int64 {
+	if x != nil {
+		return x.WatchedVideoCount
+	}
+	return 0
+}
+
+func (x *PublicProfileStats) GetTotalWatchSeconds() int64 {
+	if x != nil {
+		return x.TotalWatchSeconds
+	}
+	return 0
+}
+
+// PublicBookmark 表示公开收藏列表项，不包含点赞等私有状态。
+type PublicBookmark struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Video         *VideoMetadata         `protobuf:"bytes,2,opt,name=video,proto3" json:"video,omitempty"`
+	BookmarkedAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=bookmarked_at,json=bookmarkedAt,proto3" json:"bookmarked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublicBookmark) Reset() {
+	*x = PublicBookmark{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicBookmark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicBookmark) ProtoMessage() {}
+
+func (x *PublicBookmark) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicBookmark.ProtoReflect.Descriptor instead.
+func (*PublicBookmark) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *PublicBookmark) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *PublicBookmark) GetVideo() *VideoMetadata {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+func (x *PublicBookmark) GetBookmarkedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BookmarkedAt
+	}
+	return nil
+}
+
+// Preferences 表示学习/通知偏好。
+type Preferences struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	LearningGoal      string                 `protobuf:"bytes,1,opt,name=learning_goal,json=learningGoal,proto3" json:"learning_goal,omitempty"`
+	DailyQuotaMinutes *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=daily_quota_minutes,json=dailyQuotaMinutes,proto3" json:"daily_quota_minutes,omitempty"`
+	// extra 用于保留未结构化的偏好字段（Post-MVP 扩展）。
+	Extra         *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Preferences) Reset() {
+	*x = Preferences{}
+	mi := &file_api_profile_v1_profile_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Preferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Preferences) ProtoMessage() {}
+
+func (x *Preferences) ProtoReflect() protoreflect.Message {
+	mi := &file_api_profile_v1_profile_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Preferences.ProtoReflect.Descriptor instead.
+func (*Preferences) Descriptor() ([]byte, []int) {
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *Preferences) GetLearningGoal() string {
 	if x != nil {
 		return x.LearningGoal
 	}
@@ -1300,13 +4447,16 @@ type FavoriteState struct {
 	HasBookmarked bool                   `protobuf:"varint,2,opt,name=has_bookmarked,json=hasBookmarked,proto3" json:"has_bookmarked,omitempty"`
 	LikedAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=liked_at,json=likedAt,proto3" json:"liked_at,omitempty"`
 	BookmarkedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=bookmarked_at,json=bookmarkedAt,proto3" json:"bookmarked_at,omitempty"`
+	HasDisliked   bool                   `protobuf:"varint,5,opt,name=has_disliked,json=hasDisliked,proto3" json:"has_disliked,omitempty"`
+	HasShared     bool                   `protobuf:"varint,6,opt,name=has_shared,json=hasShared,proto3" json:"has_shared,omitempty"`
+	NotInterested bool                   `protobuf:"varint,7,opt,name=not_interested,json=notInterested,proto3" json:"not_interested,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FavoriteState) Reset() {
 	*x = FavoriteState{}
-	mi := &file_api_profile_v1_profile_proto_msgTypes[20]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1318,7 +4468,7 @@ func (x *FavoriteState) String() string {
 func (*FavoriteState) ProtoMessage() {}
 
 func (x *FavoriteState) ProtoReflect() protoreflect.Message {
-	mi := &file_api_profile_v1_profile_proto_msgTypes[20]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1331,7 +4481,7 @@ func (x *FavoriteState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FavoriteState.ProtoReflect.Descriptor instead.
 func (*FavoriteState) Descriptor() ([]byte, []int) {
-	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{20}
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *FavoriteState) GetHasLiked() bool {
@@ -1362,22 +4512,47 @@ func (x *FavoriteState) GetBookmarkedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *FavoriteState) GetHasDisliked() bool {
+	if x != nil {
+		return x.HasDisliked
+	}
+	return false
+}
+
+func (x *FavoriteState) GetHasShared() bool {
+	if x != nil {
+		return x.HasShared
+	}
+	return false
+}
+
+func (x *FavoriteState) GetNotInterested() bool {
+	if x != nil {
+		return x.NotInterested
+	}
+	return false
+}
+
 // FavoriteItem 表示收藏列表项。
 type FavoriteItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
-	FavoriteType  FavoriteType           `protobuf:"varint,2,opt,name=favorite_type,json=favoriteType,proto3,enum=profile.v1.FavoriteType" json:"favorite_type,omitempty"`
-	State         *FavoriteState         `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
-	Video         *VideoMetadata         `protobuf:"bytes,4,opt,name=video,proto3" json:"video,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	VideoId      string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	FavoriteType FavoriteType           `protobuf:"varint,2,opt,name=favorite_type,json=favoriteType,proto3,enum=profile.v1.FavoriteType" json:"favorite_type,omitempty"`
+	State        *FavoriteState         `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Video        *VideoMetadata         `protobuf:"bytes,4,opt,name=video,proto3" json:"video,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Source       EngagementSource       `protobuf:"varint,7,opt,name=source,proto3,enum=profile.v1.EngagementSource" json:"source,omitempty"`
+	Context      *EngagementContext     `protobuf:"bytes,8,opt,name=context,proto3" json:"context,omitempty"`
+	// notes 为本人在该收藏上的笔记，按时间点排序；仅 BOOKMARK 条目携带。
+	Notes         []*EngagementNote `protobuf:"bytes,9,rep,name=notes,proto3" json:"notes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FavoriteItem) Reset() {
 	*x = FavoriteItem{}
-	mi := &file_api_profile_v1_profile_proto_msgTypes[21]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1389,7 +4564,7 @@ func (x *FavoriteItem) String() string {
 func (*FavoriteItem) ProtoMessage() {}
 
 func (x *FavoriteItem) ProtoReflect() protoreflect.Message {
-	mi := &file_api_profile_v1_profile_proto_msgTypes[21]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1402,7 +4577,7 @@ func (x *FavoriteItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FavoriteItem.ProtoReflect.Descriptor instead.
 func (*FavoriteItem) Descriptor() ([]byte, []int) {
-	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{21}
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *FavoriteItem) GetVideoId() string {
@@ -1447,6 +4622,27 @@ func (x *FavoriteItem) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *FavoriteItem) GetSource() EngagementSource {
+	if x != nil {
+		return x.Source
+	}
+	return EngagementSource_ENGAGEMENT_SOURCE_UNSPECIFIED
+}
+
+func (x *FavoriteItem) GetContext() *EngagementContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *FavoriteItem) GetNotes() []*EngagementNote {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
 // FavoriteSummary 表示批量查询结果。
 type FavoriteSummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1459,7 +4655,7 @@ type FavoriteSummary struct {
 
 func (x *FavoriteSummary) Reset() {
 	*x = FavoriteSummary{}
-	mi := &file_api_profile_v1_profile_proto_msgTypes[22]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1471,7 +4667,7 @@ func (x *FavoriteSummary) String() string {
 func (*FavoriteSummary) ProtoMessage() {}
 
 func (x *FavoriteSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_api_profile_v1_profile_proto_msgTypes[22]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1484,7 +4680,7 @@ func (x *FavoriteSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FavoriteSummary.ProtoReflect.Descriptor instead.
 func (*FavoriteSummary) Descriptor() ([]byte, []int) {
-	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{22}
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *FavoriteSummary) GetVideoId() string {
@@ -1524,7 +4720,7 @@ type WatchProgress struct {
 
 func (x *WatchProgress) Reset() {
 	*x = WatchProgress{}
-	mi := &file_api_profile_v1_profile_proto_msgTypes[23]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1536,7 +4732,7 @@ func (x *WatchProgress) String() string {
 func (*WatchProgress) ProtoMessage() {}
 
 func (x *WatchProgress) ProtoReflect() protoreflect.Message {
-	mi := &file_api_profile_v1_profile_proto_msgTypes[23]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1549,7 +4745,7 @@ func (x *WatchProgress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchProgress.ProtoReflect.Descriptor instead.
 func (*WatchProgress) Descriptor() ([]byte, []int) {
-	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{23}
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *WatchProgress) GetPositionSeconds() int64 {
@@ -1613,7 +4809,7 @@ type WatchHistoryEntry struct {
 
 func (x *WatchHistoryEntry) Reset() {
 	*x = WatchHistoryEntry{}
-	mi := &file_api_profile_v1_profile_proto_msgTypes[24]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1625,7 +4821,7 @@ func (x *WatchHistoryEntry) String() string {
 func (*WatchHistoryEntry) ProtoMessage() {}
 
 func (x *WatchHistoryEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_api_profile_v1_profile_proto_msgTypes[24]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1638,7 +4834,7 @@ func (x *WatchHistoryEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchHistoryEntry.ProtoReflect.Descriptor instead.
 func (*WatchHistoryEntry) Descriptor() ([]byte, []int) {
-	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{24}
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *WatchHistoryEntry) GetVideoId() string {
@@ -1682,7 +4878,7 @@ type VideoMetadata struct {
 
 func (x *VideoMetadata) Reset() {
 	*x = VideoMetadata{}
-	mi := &file_api_profile_v1_profile_proto_msgTypes[25]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1694,7 +4890,7 @@ func (x *VideoMetadata) String() string {
 func (*VideoMetadata) ProtoMessage() {}
 
 func (x *VideoMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_api_profile_v1_profile_proto_msgTypes[25]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1707,7 +4903,7 @@ func (x *VideoMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VideoMetadata.ProtoReflect.Descriptor instead.
 func (*VideoMetadata) Descriptor() ([]byte, []int) {
-	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{25}
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *VideoMetadata) GetVideoId() string {
@@ -1795,13 +4991,15 @@ type VideoStats struct {
 	UniqueWatchers    int64                  `protobuf:"varint,3,opt,name=unique_watchers,json=uniqueWatchers,proto3" json:"unique_watchers,omitempty"`
 	TotalWatchSeconds int64                  `protobuf:"varint,4,opt,name=total_watch_seconds,json=totalWatchSeconds,proto3" json:"total_watch_seconds,omitempty"`
 	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DislikeCount      int64                  `protobuf:"varint,6,opt,name=dislike_count,json=dislikeCount,proto3" json:"dislike_count,omitempty"`
+	ShareCount        int64                  `protobuf:"varint,7,opt,name=share_count,json=shareCount,proto3" json:"share_count,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *VideoStats) Reset() {
 	*x = VideoStats{}
-	mi := &file_api_profile_v1_profile_proto_msgTypes[26]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1813,7 +5011,7 @@ func (x *VideoStats) String() string {
 func (*VideoStats) ProtoMessage() {}
 
 func (x *VideoStats) ProtoReflect() protoreflect.Message {
-	mi := &file_api_profile_v1_profile_proto_msgTypes[26]
+	mi := &file_api_profile_v1_profile_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1826,7 +5024,7 @@ func (x *VideoStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VideoStats.ProtoReflect.Descriptor instead.
 func (*VideoStats) Descriptor() ([]byte, []int) {
-	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{26}
+	return file_api_profile_v1_profile_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *VideoStats) GetLikeCount() int64 {
@@ -1864,79 +5062,303 @@ func (x *VideoStats) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *VideoStats) GetDislikeCount() int64 {
+	if x != nil {
+		return x.DislikeCount
+	}
+	return 0
+}
+
+func (x *VideoStats) GetShareCount() int64 {
+	if x != nil {
+		return x.ShareCount
+	}
+	return 0
+}
+
 var File_api_profile_v1_profile_proto protoreflect.FileDescriptor
 
 const file_api_profile_v1_profile_proto_rawDesc = "" +
 	"\n" +
 	"\x1capi/profile/v1/profile.proto\x12\n" +
-	"profile.v1\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\",\n" +
-	"\x11GetProfileRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"C\n" +
+	"profile.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"9\n" +
+	"\x11GetProfileRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\"C\n" +
 	"\x12GetProfileResponse\x12-\n" +
-	"\aprofile\x18\x01 \x01(\v2\x13.profile.v1.ProfileR\aprofile\"\x9b\x02\n" +
-	"\x14UpdateProfileRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12-\n" +
+	"\aprofile\x18\x01 \x01(\v2\x13.profile.v1.ProfileR\aprofile\"\xa8\x02\n" +
+	"\x14UpdateProfileRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12-\n" +
 	"\aprofile\x18\x02 \x01(\v2\x13.profile.v1.ProfileR\aprofile\x12;\n" +
 	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
 	"updateMask\x12U\n" +
 	"\x18expected_profile_version\x18\x04 \x01(\v2\x1b.google.protobuf.Int64ValueR\x16expectedProfileVersion\x12'\n" +
 	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\"F\n" +
 	"\x15UpdateProfileResponse\x12-\n" +
-	"\aprofile\x18\x01 \x01(\v2\x13.profile.v1.ProfileR\aprofile\"\xab\x02\n" +
-	"\x18UpdatePreferencesRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
+	"\aprofile\x18\x01 \x01(\v2\x13.profile.v1.ProfileR\aprofile\"\xb8\x02\n" +
+	"\x18UpdatePreferencesRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x129\n" +
 	"\vpreferences\x18\x02 \x01(\v2\x17.profile.v1.PreferencesR\vpreferences\x12;\n" +
 	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
 	"updateMask\x12U\n" +
 	"\x18expected_profile_version\x18\x04 \x01(\v2\x1b.google.protobuf.Int64ValueR\x16expectedProfileVersion\x12'\n" +
 	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\"J\n" +
 	"\x19UpdatePreferencesResponse\x12-\n" +
-	"\aprofile\x18\x01 \x01(\v2\x13.profile.v1.ProfileR\aprofile\"\xa4\x02\n" +
-	"\x15MutateFavoriteRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
-	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x12=\n" +
-	"\rfavorite_type\x18\x03 \x01(\x0e2\x18.profile.v1.FavoriteTypeR\ffavoriteType\x122\n" +
-	"\x06action\x18\x04 \x01(\x0e2\x1a.profile.v1.FavoriteActionR\x06action\x12'\n" +
+	"\aprofile\x18\x01 \x01(\v2\x13.profile.v1.ProfileR\aprofile\"^\n" +
+	"\x11EngagementContext\x12(\n" +
+	"\ventry_point\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x18@R\n" +
+	"entryPoint\x12\x1f\n" +
+	"\x06device\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x18@R\x06device\"\xcf\x03\n" +
+	"\x15MutateFavoriteRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12&\n" +
+	"\bvideo_id\x18\x02 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\avideoId\x12I\n" +
+	"\rfavorite_type\x18\x03 \x01(\x0e2\x18.profile.v1.FavoriteTypeB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\ffavoriteType\x12>\n" +
+	"\x06action\x18\x04 \x01(\x0e2\x1a.profile.v1.FavoriteActionB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06action\x12'\n" +
 	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\x12;\n" +
 	"\voccurred_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"occurredAt\"w\n" +
+	"occurredAt\x12>\n" +
+	"\x06source\x18\a \x01(\x0e2\x1c.profile.v1.EngagementSourceB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06source\x127\n" +
+	"\acontext\x18\b \x01(\v2\x1d.profile.v1.EngagementContextR\acontext\"w\n" +
 	"\x16MutateFavoriteResponse\x12/\n" +
 	"\x05state\x18\x01 \x01(\v2\x19.profile.v1.FavoriteStateR\x05state\x12,\n" +
-	"\x05stats\x18\x02 \x01(\v2\x16.profile.v1.VideoStatsR\x05stats\"v\n" +
-	"\x19BatchQueryFavoriteRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
-	"\tvideo_ids\x18\x02 \x03(\tR\bvideoIds\x12#\n" +
+	"\x05stats\x18\x02 \x01(\v2\x16.profile.v1.VideoStatsR\x05stats\"\x94\x01\n" +
+	"\x19BatchQueryFavoriteRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12,\n" +
+	"\tvideo_ids\x18\x02 \x03(\tB\x0f\xbaH\f\x92\x01\t\x10d\"\x05r\x03\xb0\x01\x01R\bvideoIds\x12#\n" +
 	"\rinclude_stats\x18\x03 \x01(\bR\fincludeStats\"W\n" +
 	"\x1aBatchQueryFavoriteResponse\x129\n" +
-	"\tsummaries\x18\x01 \x03(\v2\x1b.profile.v1.FavoriteSummaryR\tsummaries\"k\n" +
-	"\x14ListFavoritesRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\tsummaries\x18\x01 \x03(\v2\x1b.profile.v1.FavoriteSummaryR\tsummaries\"\x94\x01\n" +
+	"\x14ListFavoritesRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSize\x12.\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"w\n" +
+	"page_token\x18\x03 \x01(\tB\x0f\xbaH\fr\n" +
+	"2\b^[0-9]*$R\tpageToken\"w\n" +
 	"\x15ListFavoritesResponse\x126\n" +
 	"\tfavorites\x18\x01 \x03(\v2\x18.profile.v1.FavoriteItemR\tfavorites\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xb0\x01\n" +
-	"\x1aUpsertWatchProgressRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
-	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x125\n" +
-	"\bprogress\x18\x03 \x01(\v2\x19.profile.v1.WatchProgressR\bprogress\x12'\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xd2\x01\n" +
+	"\x1aUpsertWatchProgressRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12&\n" +
+	"\bvideo_id\x18\x02 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\avideoId\x12=\n" +
+	"\bprogress\x18\x03 \x01(\v2\x19.profile.v1.WatchProgressB\x06\xbaH\x03\xc8\x01\x01R\bprogress\x12'\n" +
 	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\"\x82\x01\n" +
 	"\x1bUpsertWatchProgressResponse\x125\n" +
 	"\bprogress\x18\x01 \x01(\v2\x19.profile.v1.WatchProgressR\bprogress\x12,\n" +
-	"\x05stats\x18\x02 \x01(\v2\x16.profile.v1.VideoStatsR\x05stats\"n\n" +
-	"\x17ListWatchHistoryRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\x05stats\x18\x02 \x01(\v2\x16.profile.v1.VideoStatsR\x05stats\"\x97\x01\n" +
+	"\x17ListWatchHistoryRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSize\x12.\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"w\n" +
+	"page_token\x18\x03 \x01(\tB\x0f\xbaH\fr\n" +
+	"2\b^[0-9]*$R\tpageToken\"w\n" +
 	"\x18ListWatchHistoryResponse\x123\n" +
 	"\x05items\x18\x01 \x03(\v2\x1d.profile.v1.WatchHistoryEntryR\x05items\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"/\n" +
-	"\x14PurgeUserDataRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\";\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"<\n" +
+	"\x14PurgeUserDataRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\x06userId\";\n" +
 	"\x15PurgeUserDataResponse\x12\"\n" +
-	"\rpurge_task_id\x18\x01 \x01(\tR\vpurgeTaskId\"\xbe\x02\n" +
+	"\rpurge_task_id\x18\x01 \x01(\tR\vpurgeTaskId\"\xac\x01\n" +
+	"\x15SuspendAccountRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12U\n" +
+	"\x18expected_profile_version\x18\x03 \x01(\v2\x1b.google.protobuf.Int64ValueR\x16expectedProfileVersion\"G\n" +
+	"\x16SuspendAccountResponse\x12-\n" +
+	"\aprofile\x18\x01 \x01(\v2\x13.profile.v1.ProfileR\aprofile\"\xaf\x01\n" +
+	"\x18ReactivateAccountRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12U\n" +
+	"\x18expected_profile_version\x18\x03 \x01(\v2\x1b.google.protobuf.Int64ValueR\x16expectedProfileVersion\"J\n" +
+	"\x19ReactivateAccountResponse\x12-\n" +
+	"\aprofile\x18\x01 \x01(\v2\x13.profile.v1.ProfileR\aprofile\"\xf7\x02\n" +
+	"\x17ListAuditEntriesRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12#\n" +
+	"\bactor_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x02R\aactorId\x12 \n" +
+	"\x06action\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x01R\x06action\x123\n" +
+	"\btrace_id\x18\x04 \x01(\tB\x18\xbaH\x15\xd8\x01\x01r\x102\x0e^[0-9a-f]{32}$R\atraceId\x120\n" +
+	"\x05since\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12&\n" +
+	"\tpage_size\x18\a \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSize\x12.\n" +
+	"\n" +
+	"page_token\x18\b \x01(\tB\x0f\xbaH\fr\n" +
+	"2\b^[0-9]*$R\tpageToken\"t\n" +
+	"\x18ListAuditEntriesResponse\x120\n" +
+	"\aentries\x18\x01 \x03(\v2\x16.profile.v1.AuditEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8e\x03\n" +
+	"\n" +
+	"AuditEntry\x12\x19\n" +
+	"\baudit_id\x18\x01 \x01(\tR\aauditId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"actor_type\x18\x03 \x01(\tR\tactorType\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06action\x18\x05 \x01(\tR\x06action\x12#\n" +
+	"\rresource_type\x18\x06 \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\a \x01(\tR\n" +
+	"resourceId\x12/\n" +
+	"\x06before\x18\b \x01(\v2\x17.google.protobuf.StructR\x06before\x12-\n" +
+	"\x05after\x18\t \x01(\v2\x17.google.protobuf.StructR\x05after\x12\x19\n" +
+	"\btrace_id\x18\n" +
+	" \x01(\tR\atraceId\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x92\x02\n" +
+	"\x17UpdateVisibilityRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12=\n" +
+	"\n" +
+	"visibility\x18\x02 \x01(\v2\x1d.profile.v1.ProfileVisibilityR\n" +
+	"visibility\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12U\n" +
+	"\x18expected_profile_version\x18\x04 \x01(\v2\x1b.google.protobuf.Int64ValueR\x16expectedProfileVersion\"I\n" +
+	"\x18UpdateVisibilityResponse\x12-\n" +
+	"\aprofile\x18\x01 \x01(\v2\x13.profile.v1.ProfileR\aprofile\"?\n" +
+	"\x17GetPublicProfileRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\"O\n" +
+	"\x18GetPublicProfileResponse\x123\n" +
+	"\aprofile\x18\x01 \x01(\v2\x19.profile.v1.PublicProfileR\aprofile\"\x9a\x01\n" +
+	"\x1aListPublicBookmarksRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSize\x12.\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x0f\xbaH\fr\n" +
+	"2\b^[0-9]*$R\tpageToken\"\x7f\n" +
+	"\x1bListPublicBookmarksResponse\x128\n" +
+	"\tbookmarks\x18\x01 \x03(\v2\x1a.profile.v1.PublicBookmarkR\tbookmarks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x9d\x01\n" +
+	"\x19CreateAvatarUploadRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12*\n" +
+	"\fcontent_type\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\vcontentType\x12.\n" +
+	"\x0econtent_length\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\rcontentLength\"T\n" +
+	"\x1aCreateAvatarUploadResponse\x126\n" +
+	"\x06upload\x18\x01 \x01(\v2\x1e.profile.v1.AvatarUploadTargetR\x06upload\"\xc1\x01\n" +
+	"\x1aConfirmAvatarUploadRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12&\n" +
+	"\n" +
+	"upload_key\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tuploadKey\x12U\n" +
+	"\x18expected_profile_version\x18\x03 \x01(\v2\x1b.google.protobuf.Int64ValueR\x16expectedProfileVersion\"L\n" +
+	"\x1bConfirmAvatarUploadResponse\x12-\n" +
+	"\aprofile\x18\x01 \x01(\v2\x13.profile.v1.ProfileR\aprofile\"\xc5\x02\n" +
+	"\x12AvatarUploadTarget\x12\x1d\n" +
+	"\n" +
+	"upload_key\x18\x01 \x01(\tR\tuploadKey\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x02 \x01(\tR\tuploadUrl\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12E\n" +
+	"\aheaders\x18\x04 \x03(\v2+.profile.v1.AvatarUploadTarget.HeadersEntryR\aheaders\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1b\n" +
+	"\tmax_bytes\x18\x06 \x01(\x03R\bmaxBytes\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"^\n" +
+	"\x17CreateCollectionRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\x04name\"R\n" +
+	"\x18CreateCollectionResponse\x126\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x16.profile.v1.CollectionR\n" +
+	"collection\"\x96\x01\n" +
+	"\x16ListCollectionsRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSize\x12.\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x0f\xbaH\fr\n" +
+	"2\b^[0-9]*$R\tpageToken\"{\n" +
+	"\x17ListCollectionsResponse\x128\n" +
+	"\vcollections\x18\x01 \x03(\v2\x16.profile.v1.CollectionR\vcollections\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x90\x01\n" +
+	"\x17RenameCollectionRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x120\n" +
+	"\rcollection_id\x18\x02 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\fcollectionId\x12\x1d\n" +
+	"\x04name\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\x04name\"R\n" +
+	"\x18RenameCollectionResponse\x126\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x16.profile.v1.CollectionR\n" +
+	"collection\"q\n" +
+	"\x17DeleteCollectionRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x120\n" +
+	"\rcollection_id\x18\x02 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\fcollectionId\"\x1a\n" +
+	"\x18DeleteCollectionResponse\"\x9a\x01\n" +
+	"\x18AddCollectionItemRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x120\n" +
+	"\rcollection_id\x18\x02 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\fcollectionId\x12&\n" +
+	"\bvideo_id\x18\x03 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\avideoId\"K\n" +
+	"\x19AddCollectionItemResponse\x12.\n" +
+	"\x04item\x18\x01 \x01(\v2\x1a.profile.v1.CollectionItemR\x04item\"\x9d\x01\n" +
+	"\x1bRemoveCollectionItemRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x120\n" +
+	"\rcollection_id\x18\x02 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\fcollectionId\x12&\n" +
+	"\bvideo_id\x18\x03 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\avideoId\"\x1e\n" +
+	"\x1cRemoveCollectionItemResponse\"\xaa\x01\n" +
+	"\x1dReorderCollectionItemsRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x120\n" +
+	"\rcollection_id\x18\x02 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\fcollectionId\x121\n" +
+	"\tvideo_ids\x18\x03 \x03(\tB\x14\xbaH\x11\x92\x01\x0e\b\x01\x10\xe8\a\x18\x01\"\x05r\x03\xb0\x01\x01R\bvideoIds\" \n" +
+	"\x1eReorderCollectionItemsResponse\"\xda\x01\n" +
+	"\x19MoveCollectionItemRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x120\n" +
+	"\rcollection_id\x18\x02 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\fcollectionId\x12&\n" +
+	"\bvideo_id\x18\x03 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\avideoId\x12=\n" +
+	"\x14target_collection_id\x18\x04 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\x12targetCollectionId\"L\n" +
+	"\x1aMoveCollectionItemResponse\x12.\n" +
+	"\x04item\x18\x01 \x01(\v2\x1a.profile.v1.CollectionItemR\x04item\"\xcc\x01\n" +
+	"\x1aListCollectionItemsRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x120\n" +
+	"\rcollection_id\x18\x02 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\fcollectionId\x12&\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSize\x12.\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tB\x0f\xbaH\fr\n" +
+	"2\b^[0-9]*$R\tpageToken\"\xaf\x01\n" +
+	"\x1bListCollectionItemsResponse\x126\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x16.profile.v1.CollectionR\n" +
+	"collection\x120\n" +
+	"\x05items\x18\x02 \x03(\v2\x1a.profile.v1.CollectionItemR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xd9\x01\n" +
+	"\x18AddEngagementNoteRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12&\n" +
+	"\bvideo_id\x18\x02 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\avideoId\x12\x1e\n" +
+	"\x04body\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xd0\x0fR\x04body\x12O\n" +
+	"\x10position_seconds\x18\x04 \x01(\v2\x1b.google.protobuf.Int32ValueB\a\xbaH\x04\x1a\x02(\x00R\x0fpositionSeconds\"K\n" +
+	"\x19AddEngagementNoteResponse\x12.\n" +
+	"\x04note\x18\x01 \x01(\v2\x1a.profile.v1.EngagementNoteR\x04note\"\xda\x01\n" +
+	"\x1bUpdateEngagementNoteRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12$\n" +
+	"\anote_id\x18\x02 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\x06noteId\x12\x1e\n" +
+	"\x04body\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xd0\x0fR\x04body\x12O\n" +
+	"\x10position_seconds\x18\x04 \x01(\v2\x1b.google.protobuf.Int32ValueB\a\xbaH\x04\x1a\x02(\x00R\x0fpositionSeconds\"N\n" +
+	"\x1cUpdateEngagementNoteResponse\x12.\n" +
+	"\x04note\x18\x01 \x01(\v2\x1a.profile.v1.EngagementNoteR\x04note\"i\n" +
+	"\x1bDeleteEngagementNoteRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12$\n" +
+	"\anote_id\x18\x02 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\x06noteId\"\x1e\n" +
+	"\x1cDeleteEngagementNoteResponse\"\x96\x02\n" +
+	"\x0eEngagementNote\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12F\n" +
+	"\x10position_seconds\x18\x04 \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fpositionSeconds\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xda\x01\n" +
+	"\n" +
+	"Collection\x12#\n" +
+	"\rcollection_id\x18\x01 \x01(\tR\fcollectionId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\x03 \x01(\x03R\titemCount\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xaf\x01\n" +
+	"\x0eCollectionItem\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\x125\n" +
+	"\badded_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aaddedAt\x12/\n" +
+	"\x05video\x18\x04 \x01(\v2\x19.profile.v1.VideoMetadataR\x05video\"\xa0\x05\n" +
 	"\aProfile\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x1d\n" +
@@ -1947,16 +5369,52 @@ const file_api_profile_v1_profile_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xae\x01\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12@\n" +
+	"\x0eaccount_status\x18\b \x01(\x0e2\x19.profile.v1.AccountStatusR\raccountStatus\x12J\n" +
+	"\x13pending_deletion_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x11pendingDeletionAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12=\n" +
+	"\n" +
+	"visibility\x18\v \x01(\v2\x1d.profile.v1.ProfileVisibilityR\n" +
+	"visibility\x12)\n" +
+	"\x10preferred_locale\x18\f \x01(\tR\x0fpreferredLocale\x12-\n" +
+	"\x12preferred_timezone\x18\r \x01(\tR\x11preferredTimezone\"\xb6\x01\n" +
+	"\x11ProfileVisibility\x12.\n" +
+	"\x13public_display_name\x18\x01 \x01(\bR\x11publicDisplayName\x12#\n" +
+	"\rpublic_avatar\x18\x02 \x01(\bR\fpublicAvatar\x12)\n" +
+	"\x10public_bookmarks\x18\x03 \x01(\bR\x0fpublicBookmarks\x12!\n" +
+	"\fpublic_stats\x18\x04 \x01(\bR\vpublicStats\"\xcb\x01\n" +
+	"\rPublicProfile\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\x12)\n" +
+	"\x10bookmarks_public\x18\x04 \x01(\bR\x0fbookmarksPublic\x124\n" +
+	"\x05stats\x18\x05 \x01(\v2\x1e.profile.v1.PublicProfileStatsR\x05stats\"\xba\x01\n" +
+	"\x12PublicProfileStats\x12\x1d\n" +
+	"\n" +
+	"like_count\x18\x01 \x01(\x03R\tlikeCount\x12%\n" +
+	"\x0ebookmark_count\x18\x02 \x01(\x03R\rbookmarkCount\x12.\n" +
+	"\x13watched_video_count\x18\x03 \x01(\x03R\x11watchedVideoCount\x12.\n" +
+	"\x13total_watch_seconds\x18\x04 \x01(\x03R\x11totalWatchSeconds\"\x9d\x01\n" +
+	"\x0ePublicBookmark\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12/\n" +
+	"\x05video\x18\x02 \x01(\v2\x19.profile.v1.VideoMetadataR\x05video\x12?\n" +
+	"\rbookmarked_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fbookmarkedAt\"\xae\x01\n" +
 	"\vPreferences\x12#\n" +
 	"\rlearning_goal\x18\x01 \x01(\tR\flearningGoal\x12K\n" +
 	"\x13daily_quota_minutes\x18\x02 \x01(\v2\x1b.google.protobuf.Int32ValueR\x11dailyQuotaMinutes\x12-\n" +
-	"\x05extra\x18c \x01(\v2\x17.google.protobuf.StructR\x05extra\"\xcb\x01\n" +
+	"\x05extra\x18c \x01(\v2\x17.google.protobuf.StructR\x05extra\"\xb4\x02\n" +
 	"\rFavoriteState\x12\x1b\n" +
 	"\thas_liked\x18\x01 \x01(\bR\bhasLiked\x12%\n" +
 	"\x0ehas_bookmarked\x18\x02 \x01(\bR\rhasBookmarked\x125\n" +
 	"\bliked_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\alikedAt\x12?\n" +
-	"\rbookmarked_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fbookmarkedAt\"\xc0\x02\n" +
+	"\rbookmarked_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fbookmarkedAt\x12!\n" +
+	"\fhas_disliked\x18\x05 \x01(\bR\vhasDisliked\x12\x1d\n" +
+	"\n" +
+	"has_shared\x18\x06 \x01(\bR\thasShared\x12%\n" +
+	"\x0enot_interested\x18\a \x01(\bR\rnotInterested\"\xe1\x03\n" +
 	"\fFavoriteItem\x12\x19\n" +
 	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12=\n" +
 	"\rfavorite_type\x18\x02 \x01(\x0e2\x18.profile.v1.FavoriteTypeR\ffavoriteType\x12/\n" +
@@ -1965,15 +5423,18 @@ const file_api_profile_v1_profile_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8b\x01\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x124\n" +
+	"\x06source\x18\a \x01(\x0e2\x1c.profile.v1.EngagementSourceR\x06source\x127\n" +
+	"\acontext\x18\b \x01(\v2\x1d.profile.v1.EngagementContextR\acontext\x120\n" +
+	"\x05notes\x18\t \x03(\v2\x1a.profile.v1.EngagementNoteR\x05notes\"\x8b\x01\n" +
 	"\x0fFavoriteSummary\x12\x19\n" +
 	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12/\n" +
 	"\x05state\x18\x02 \x01(\v2\x19.profile.v1.FavoriteStateR\x05state\x12,\n" +
-	"\x05stats\x18\x03 \x01(\v2\x16.profile.v1.VideoStatsR\x05stats\"\xf5\x02\n" +
-	"\rWatchProgress\x12)\n" +
-	"\x10position_seconds\x18\x01 \x01(\x03R\x0fpositionSeconds\x12%\n" +
-	"\x0eprogress_ratio\x18\x02 \x01(\x01R\rprogressRatio\x12.\n" +
-	"\x13total_watch_seconds\x18\x03 \x01(\x03R\x11totalWatchSeconds\x12D\n" +
+	"\x05stats\x18\x03 \x01(\v2\x16.profile.v1.VideoStatsR\x05stats\"\xa0\x03\n" +
+	"\rWatchProgress\x122\n" +
+	"\x10position_seconds\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x0fpositionSeconds\x12>\n" +
+	"\x0eprogress_ratio\x18\x02 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\rprogressRatio\x127\n" +
+	"\x13total_watch_seconds\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x11totalWatchSeconds\x12D\n" +
 	"\x10first_watched_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0efirstWatchedAt\x12B\n" +
 	"\x0flast_watched_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rlastWatchedAt\x129\n" +
 	"\n" +
@@ -1997,7 +5458,7 @@ const file_api_profile_v1_profile_proto_rawDesc = "" +
 	"\aversion\x18\n" +
 	" \x01(\x03R\aversion\x129\n" +
 	"\n" +
-	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe6\x01\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xac\x02\n" +
 	"\n" +
 	"VideoStats\x12\x1d\n" +
 	"\n" +
@@ -2006,26 +5467,63 @@ const file_api_profile_v1_profile_proto_rawDesc = "" +
 	"\x0funique_watchers\x18\x03 \x01(\x03R\x0euniqueWatchers\x12.\n" +
 	"\x13total_watch_seconds\x18\x04 \x01(\x03R\x11totalWatchSeconds\x129\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt*f\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12#\n" +
+	"\rdislike_count\x18\x06 \x01(\x03R\fdislikeCount\x12\x1f\n" +
+	"\vshare_count\x18\a \x01(\x03R\n" +
+	"shareCount*f\n" +
 	"\x0eFavoriteAction\x12\x1f\n" +
 	"\x1bFAVORITE_ACTION_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13FAVORITE_ACTION_ADD\x10\x01\x12\x1a\n" +
-	"\x16FAVORITE_ACTION_REMOVE\x10\x02*a\n" +
+	"\x16FAVORITE_ACTION_REMOVE\x10\x02*\xb7\x01\n" +
 	"\fFavoriteType\x12\x1d\n" +
 	"\x19FAVORITE_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12FAVORITE_TYPE_LIKE\x10\x01\x12\x1a\n" +
-	"\x16FAVORITE_TYPE_BOOKMARK\x10\x022\xc6\x06\n" +
-	"\x0eProfileService\x12K\n" +
+	"\x16FAVORITE_TYPE_BOOKMARK\x10\x02\x12\x19\n" +
+	"\x15FAVORITE_TYPE_DISLIKE\x10\x03\x12 \n" +
+	"\x1cFAVORITE_TYPE_NOT_INTERESTED\x10\x04\x12\x17\n" +
+	"\x13FAVORITE_TYPE_SHARE\x10\x05*\x97\x01\n" +
+	"\x10EngagementSource\x12!\n" +
+	"\x1dENGAGEMENT_SOURCE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18ENGAGEMENT_SOURCE_MANUAL\x10\x01\x12$\n" +
+	" ENGAGEMENT_SOURCE_RECOMMENDATION\x10\x02\x12\x1c\n" +
+	"\x18ENGAGEMENT_SOURCE_SYSTEM\x10\x03*\xa9\x01\n" +
+	"\rAccountStatus\x12\x1e\n" +
+	"\x1aACCOUNT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ACCOUNT_STATUS_ACTIVE\x10\x01\x12\x1c\n" +
+	"\x18ACCOUNT_STATUS_SUSPENDED\x10\x02\x12#\n" +
+	"\x1fACCOUNT_STATUS_PENDING_DELETION\x10\x03\x12\x1a\n" +
+	"\x16ACCOUNT_STATUS_DELETED\x10\x042\xaa\x1f\n" +
+	"\x0eProfileService\x12d\n" +
 	"\n" +
-	"GetProfile\x12\x1d.profile.v1.GetProfileRequest\x1a\x1e.profile.v1.GetProfileResponse\x12T\n" +
-	"\rUpdateProfile\x12 .profile.v1.UpdateProfileRequest\x1a!.profile.v1.UpdateProfileResponse\x12`\n" +
-	"\x11UpdatePreferences\x12$.profile.v1.UpdatePreferencesRequest\x1a%.profile.v1.UpdatePreferencesResponse\x12W\n" +
-	"\x0eMutateFavorite\x12!.profile.v1.MutateFavoriteRequest\x1a\".profile.v1.MutateFavoriteResponse\x12c\n" +
-	"\x12BatchQueryFavorite\x12%.profile.v1.BatchQueryFavoriteRequest\x1a&.profile.v1.BatchQueryFavoriteResponse\x12T\n" +
-	"\rListFavorites\x12 .profile.v1.ListFavoritesRequest\x1a!.profile.v1.ListFavoritesResponse\x12f\n" +
-	"\x13UpsertWatchProgress\x12&.profile.v1.UpsertWatchProgressRequest\x1a'.profile.v1.UpsertWatchProgressResponse\x12]\n" +
-	"\x10ListWatchHistory\x12#.profile.v1.ListWatchHistoryRequest\x1a$.profile.v1.ListWatchHistoryResponse\x12T\n" +
-	"\rPurgeUserData\x12 .profile.v1.PurgeUserDataRequest\x1a!.profile.v1.PurgeUserDataResponseBHZFgithub.com/bionicotaku/lingo-services-profile/api/profile/v1;profilev1b\x06proto3"
+	"GetProfile\x12\x1d.profile.v1.GetProfileRequest\x1a\x1e.profile.v1.GetProfileResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/user/me\x12p\n" +
+	"\rUpdateProfile\x12 .profile.v1.UpdateProfileRequest\x1a!.profile.v1.UpdateProfileResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*2\x0f/api/v1/user/me\x12\x88\x01\n" +
+	"\x11UpdatePreferences\x12$.profile.v1.UpdatePreferencesRequest\x1a%.profile.v1.UpdatePreferencesResponse\"&\x82\xd3\xe4\x93\x02 :\x01*2\x1b/api/v1/user/me/preferences\x12\x85\x01\n" +
+	"\x0eMutateFavorite\x12!.profile.v1.MutateFavoriteRequest\x1a\".profile.v1.MutateFavoriteResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/video/{video_id}/favorite\x12\x94\x01\n" +
+	"\x12BatchQueryFavorite\x12%.profile.v1.BatchQueryFavoriteRequest\x1a&.profile.v1.BatchQueryFavoriteResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/user/me/favorites:batchQuery\x12w\n" +
+	"\rListFavorites\x12 .profile.v1.ListFavoritesRequest\x1a!.profile.v1.ListFavoritesResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/user/me/favorites\x12\x94\x01\n" +
+	"\x13UpsertWatchProgress\x12&.profile.v1.UpsertWatchProgressRequest\x1a'.profile.v1.UpsertWatchProgressResponse\",\x82\xd3\xe4\x93\x02&:\x01*\x1a!/api/v1/video/{video_id}/progress\x12\x84\x01\n" +
+	"\x10ListWatchHistory\x12#.profile.v1.ListWatchHistoryRequest\x1a$.profile.v1.ListWatchHistoryResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/user/me/watch-history\x12T\n" +
+	"\rPurgeUserData\x12 .profile.v1.PurgeUserDataRequest\x1a!.profile.v1.PurgeUserDataResponse\x12W\n" +
+	"\x0eSuspendAccount\x12!.profile.v1.SuspendAccountRequest\x1a\".profile.v1.SuspendAccountResponse\x12`\n" +
+	"\x11ReactivateAccount\x12$.profile.v1.ReactivateAccountRequest\x1a%.profile.v1.ReactivateAccountResponse\x12]\n" +
+	"\x10ListAuditEntries\x12#.profile.v1.ListAuditEntriesRequest\x1a$.profile.v1.ListAuditEntriesResponse\x12\x84\x01\n" +
+	"\x10UpdateVisibility\x12#.profile.v1.UpdateVisibilityRequest\x1a$.profile.v1.UpdateVisibilityResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*2\x1a/api/v1/user/me/visibility\x12\x86\x01\n" +
+	"\x10GetPublicProfile\x12#.profile.v1.GetPublicProfileRequest\x1a$.profile.v1.GetPublicProfileResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/users/{user_id}/profile\x12\x91\x01\n" +
+	"\x13ListPublicBookmarks\x12&.profile.v1.ListPublicBookmarksRequest\x1a'.profile.v1.ListPublicBookmarksResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/users/{user_id}/bookmarks\x12\x8d\x01\n" +
+	"\x12CreateAvatarUpload\x12%.profile.v1.CreateAvatarUploadRequest\x1a&.profile.v1.CreateAvatarUploadResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/user/me/avatar:upload\x12\x91\x01\n" +
+	"\x13ConfirmAvatarUpload\x12&.profile.v1.ConfirmAvatarUploadRequest\x1a'.profile.v1.ConfirmAvatarUploadResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/user/me/avatar:confirm\x12\x85\x01\n" +
+	"\x10CreateCollection\x12#.profile.v1.CreateCollectionRequest\x1a$.profile.v1.CreateCollectionResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/user/me/collections\x12\x7f\n" +
+	"\x0fListCollections\x12\".profile.v1.ListCollectionsRequest\x1a#.profile.v1.ListCollectionsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/user/me/collections\x12\x95\x01\n" +
+	"\x10RenameCollection\x12#.profile.v1.RenameCollectionRequest\x1a$.profile.v1.RenameCollectionResponse\"6\x82\xd3\xe4\x93\x020:\x01*2+/api/v1/user/me/collections/{collection_id}\x12\x92\x01\n" +
+	"\x10DeleteCollection\x12#.profile.v1.DeleteCollectionRequest\x1a$.profile.v1.DeleteCollectionResponse\"3\x82\xd3\xe4\x93\x02-*+/api/v1/user/me/collections/{collection_id}\x12\x9e\x01\n" +
+	"\x11AddCollectionItem\x12$.profile.v1.AddCollectionItemRequest\x1a%.profile.v1.AddCollectionItemResponse\"<\x82\xd3\xe4\x93\x026:\x01*\"1/api/v1/user/me/collections/{collection_id}/items\x12\xaf\x01\n" +
+	"\x14RemoveCollectionItem\x12'.profile.v1.RemoveCollectionItemRequest\x1a(.profile.v1.RemoveCollectionItemResponse\"D\x82\xd3\xe4\x93\x02>*</api/v1/user/me/collections/{collection_id}/items/{video_id}\x12\xb5\x01\n" +
+	"\x16ReorderCollectionItems\x12).profile.v1.ReorderCollectionItemsRequest\x1a*.profile.v1.ReorderCollectionItemsResponse\"D\x82\xd3\xe4\x93\x02>:\x01*\"9/api/v1/user/me/collections/{collection_id}/items:reorder\x12\xa6\x01\n" +
+	"\x12MoveCollectionItem\x12%.profile.v1.MoveCollectionItemRequest\x1a&.profile.v1.MoveCollectionItemResponse\"A\x82\xd3\xe4\x93\x02;:\x01*\"6/api/v1/user/me/collections/{collection_id}/items:move\x12\xa1\x01\n" +
+	"\x13ListCollectionItems\x12&.profile.v1.ListCollectionItemsRequest\x1a'.profile.v1.ListCollectionItemsResponse\"9\x82\xd3\xe4\x93\x023\x121/api/v1/user/me/collections/{collection_id}/items\x12\x8b\x01\n" +
+	"\x11AddEngagementNote\x12$.profile.v1.AddEngagementNoteRequest\x1a%.profile.v1.AddEngagementNoteResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/video/{video_id}/notes\x12\x95\x01\n" +
+	"\x14UpdateEngagementNote\x12'.profile.v1.UpdateEngagementNoteRequest\x1a(.profile.v1.UpdateEngagementNoteResponse\"*\x82\xd3\xe4\x93\x02$:\x01*2\x1f/api/v1/user/me/notes/{note_id}\x12\x92\x01\n" +
+	"\x14DeleteEngagementNote\x12'.profile.v1.DeleteEngagementNoteRequest\x1a(.profile.v1.DeleteEngagementNoteResponse\"'\x82\xd3\xe4\x93\x02!*\x1f/api/v1/user/me/notes/{note_id}BHZFgithub.com/bionicotaku/lingo-services-profile/api/profile/v1;profilev1b\x06proto3"
 
 var (
 	file_api_profile_v1_profile_proto_rawDescOnce sync.Once
@@ -2039,110 +5537,254 @@ func file_api_profile_v1_profile_proto_rawDescGZIP() []byte {
 	return file_api_profile_v1_profile_proto_rawDescData
 }
 
-var file_api_profile_v1_profile_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_profile_v1_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_api_profile_v1_profile_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_api_profile_v1_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 78)
 var file_api_profile_v1_profile_proto_goTypes = []any{
-	(FavoriteAction)(0),                 // 0: profile.v1.FavoriteAction
-	(FavoriteType)(0),                   // 1: profile.v1.FavoriteType
-	(*GetProfileRequest)(nil),           // 2: profile.v1.GetProfileRequest
-	(*GetProfileResponse)(nil),          // 3: profile.v1.GetProfileResponse
-	(*UpdateProfileRequest)(nil),        // 4: profile.v1.UpdateProfileRequest
-	(*UpdateProfileResponse)(nil),       // 5: profile.v1.UpdateProfileResponse
-	(*UpdatePreferencesRequest)(nil),    // 6: profile.v1.UpdatePreferencesRequest
-	(*UpdatePreferencesResponse)(nil),   // 7: profile.v1.UpdatePreferencesResponse
-	(*MutateFavoriteRequest)(nil),       // 8: profile.v1.MutateFavoriteRequest
-	(*MutateFavoriteResponse)(nil),      // 9: profile.v1.MutateFavoriteResponse
-	(*BatchQueryFavoriteRequest)(nil),   // 10: profile.v1.BatchQueryFavoriteRequest
-	(*BatchQueryFavoriteResponse)(nil),  // 11: profile.v1.BatchQueryFavoriteResponse
-	(*ListFavoritesRequest)(nil),        // 12: profile.v1.ListFavoritesRequest
-	(*ListFavoritesResponse)(nil),       // 13: profile.v1.ListFavoritesResponse
-	(*UpsertWatchProgressRequest)(nil),  // 14: profile.v1.UpsertWatchProgressRequest
-	(*UpsertWatchProgressResponse)(nil), // 15: profile.v1.UpsertWatchProgressResponse
-	(*ListWatchHistoryRequest)(nil),     // 16: profile.v1.ListWatchHistoryRequest
-	(*ListWatchHistoryResponse)(nil),    // 17: profile.v1.ListWatchHistoryResponse
-	(*PurgeUserDataRequest)(nil),        // 18: profile.v1.PurgeUserDataRequest
-	(*PurgeUserDataResponse)(nil),       // 19: profile.v1.PurgeUserDataResponse
-	(*Profile)(nil),                     // 20: profile.v1.Profile
-	(*Preferences)(nil),                 // 21: profile.v1.Preferences
-	(*FavoriteState)(nil),               // 22: profile.v1.FavoriteState
-	(*FavoriteItem)(nil),                // 23: profile.v1.FavoriteItem
-	(*FavoriteSummary)(nil),             // 24: profile.v1.FavoriteSummary
-	(*WatchProgress)(nil),               // 25: profile.v1.WatchProgress
-	(*WatchHistoryEntry)(nil),           // 26: profile.v1.WatchHistoryEntry
-	(*VideoMetadata)(nil),               // 27: profile.v1.VideoMetadata
-	(*VideoStats)(nil),                  // 28: profile.v1.VideoStats
-	(*fieldmaskpb.FieldMask)(nil),       // 29: google.protobuf.FieldMask
-	(*wrapperspb.Int64Value)(nil),       // 30: google.protobuf.Int64Value
-	(*timestamppb.Timestamp)(nil),       // 31: google.protobuf.Timestamp
-	(*wrapperspb.Int32Value)(nil),       // 32: google.protobuf.Int32Value
-	(*structpb.Struct)(nil),             // 33: google.protobuf.Struct
+	(FavoriteAction)(0),                    // 0: profile.v1.FavoriteAction
+	(FavoriteType)(0),                      // 1: profile.v1.FavoriteType
+	(EngagementSource)(0),                  // 2: profile.v1.EngagementSource
+	(AccountStatus)(0),                     // 3: profile.v1.AccountStatus
+	(*GetProfileRequest)(nil),              // 4: profile.v1.GetProfileRequest
+	(*GetProfileResponse)(nil),             // 5: profile.v1.GetProfileResponse
+	(*UpdateProfileRequest)(nil),           // 6: profile.v1.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),          // 7: profile.v1.UpdateProfileResponse
+	(*UpdatePreferencesRequest)(nil),       // 8: profile.v1.UpdatePreferencesRequest
+	(*UpdatePreferencesResponse)(nil),      // 9: profile.v1.UpdatePreferencesResponse
+	(*EngagementContext)(nil),              // 10: profile.v1.EngagementContext
+	(*MutateFavoriteRequest)(nil),          // 11: profile.v1.MutateFavoriteRequest
+	(*MutateFavoriteResponse)(nil),         // 12: profile.v1.MutateFavoriteResponse
+	(*BatchQueryFavoriteRequest)(nil),      // 13: profile.v1.BatchQueryFavoriteRequest
+	(*BatchQueryFavoriteResponse)(nil),     // 14: profile.v1.BatchQueryFavoriteResponse
+	(*ListFavoritesRequest)(nil),           // 15: profile.v1.ListFavoritesRequest
+	(*ListFavoritesResponse)(nil),          // 16: profile.v1.ListFavoritesResponse
+	(*UpsertWatchProgressRequest)(nil),     // 17: profile.v1.UpsertWatchProgressRequest
+	(*UpsertWatchProgressResponse)(nil),    // 18: profile.v1.UpsertWatchProgressResponse
+	(*ListWatchHistoryRequest)(nil),        // 19: profile.v1.ListWatchHistoryRequest
+	(*ListWatchHistoryResponse)(nil),       // 20: profile.v1.ListWatchHistoryResponse
+	(*PurgeUserDataRequest)(nil),           // 21: profile.v1.PurgeUserDataRequest
+	(*PurgeUserDataResponse)(nil),          // 22: profile.v1.PurgeUserDataResponse
+	(*SuspendAccountRequest)(nil),          // 23: profile.v1.SuspendAccountRequest
+	(*SuspendAccountResponse)(nil),         // 24: profile.v1.SuspendAccountResponse
+	(*ReactivateAccountRequest)(nil),       // 25: profile.v1.ReactivateAccountRequest
+	(*ReactivateAccountResponse)(nil),      // 26: profile.v1.ReactivateAccountResponse
+	(*ListAuditEntriesRequest)(nil),        // 27: profile.v1.ListAuditEntriesRequest
+	(*ListAuditEntriesResponse)(nil),       // 28: profile.v1.ListAuditEntriesResponse
+	(*AuditEntry)(nil),                     // 29: profile.v1.AuditEntry
+	(*UpdateVisibilityRequest)(nil),        // 30: profile.v1.UpdateVisibilityRequest
+	(*UpdateVisibilityResponse)(nil),       // 31: profile.v1.UpdateVisibilityResponse
+	(*GetPublicProfileRequest)(nil),        // 32: profile.v1.GetPublicProfileRequest
+	(*GetPublicProfileResponse)(nil),       // 33: profile.v1.GetPublicProfileResponse
+	(*ListPublicBookmarksRequest)(nil),     // 34: profile.v1.ListPublicBookmarksRequest
+	(*ListPublicBookmarksResponse)(nil),    // 35: profile.v1.ListPublicBookmarksResponse
+	(*CreateAvatarUploadRequest)(nil),      // 36: profile.v1.CreateAvatarUploadRequest
+	(*CreateAvatarUploadResponse)(nil),     // 37: profile.v1.CreateAvatarUploadResponse
+	(*ConfirmAvatarUploadRequest)(nil),     // 38: profile.v1.ConfirmAvatarUploadRequest
+	(*ConfirmAvatarUploadResponse)(nil),    // 39: profile.v1.ConfirmAvatarUploadResponse
+	(*AvatarUploadTarget)(nil),             // 40: profile.v1.AvatarUploadTarget
+	(*CreateCollectionRequest)(nil),        // 41: profile.v1.CreateCollectionRequest
+	(*CreateCollectionResponse)(nil),       // 42: profile.v1.CreateCollectionResponse
+	(*ListCollectionsRequest)(nil),         // 43: profile.v1.ListCollectionsRequest
+	(*ListCollectionsResponse)(nil),        // 44: profile.v1.ListCollectionsResponse
+	(*RenameCollectionRequest)(nil),        // 45: profile.v1.RenameCollectionRequest
+	(*RenameCollectionResponse)(nil),       // 46: profile.v1.RenameCollectionResponse
+	(*DeleteCollectionRequest)(nil),        // 47: profile.v1.DeleteCollectionRequest
+	(*DeleteCollectionResponse)(nil),       // 48: profile.v1.DeleteCollectionResponse
+	(*AddCollectionItemRequest)(nil),       // 49: profile.v1.AddCollectionItemRequest
+	(*AddCollectionItemResponse)(nil),      // 50: profile.v1.AddCollectionItemResponse
+	(*RemoveCollectionItemRequest)(nil),    // 51: profile.v1.RemoveCollectionItemRequest
+	(*RemoveCollectionItemResponse)(nil),   // 52: profile.v1.RemoveCollectionItemResponse
+	(*ReorderCollectionItemsRequest)(nil),  // 53: profile.v1.ReorderCollectionItemsRequest
+	(*ReorderCollectionItemsResponse)(nil), // 54: profile.v1.ReorderCollectionItemsResponse
+	(*MoveCollectionItemRequest)(nil),      // 55: profile.v1.MoveCollectionItemRequest
+	(*MoveCollectionItemResponse)(nil),     // 56: profile.v1.MoveCollectionItemResponse
+	(*ListCollectionItemsRequest)(nil),     // 57: profile.v1.ListCollectionItemsRequest
+	(*ListCollectionItemsResponse)(nil),    // 58: profile.v1.ListCollectionItemsResponse
+	(*AddEngagementNoteRequest)(nil),       // 59: profile.v1.AddEngagementNoteRequest
+	(*AddEngagementNoteResponse)(nil),      // 60: profile.v1.AddEngagementNoteResponse
+	(*UpdateEngagementNoteRequest)(nil),    // 61: profile.v1.UpdateEngagementNoteRequest
+	(*UpdateEngagementNoteResponse)(nil),   // 62: profile.v1.UpdateEngagementNoteResponse
+	(*DeleteEngagementNoteRequest)(nil),    // 63: profile.v1.DeleteEngagementNoteRequest
+	(*DeleteEngagementNoteResponse)(nil),   // 64: profile.v1.DeleteEngagementNoteResponse
+	(*EngagementNote)(nil),                 // 65: profile.v1.EngagementNote
+	(*Collection)(nil),                     // 66: profile.v1.Collection
+	(*CollectionItem)(nil),                 // 67: profile.v1.CollectionItem
+	(*Profile)(nil),                        // 68: profile.v1.Profile
+	(*ProfileVisibility)(nil),              // 69: profile.v1.ProfileVisibility
+	(*PublicProfile)(nil),                  // 70: profile.v1.PublicProfile
+	(*PublicProfileStats)(nil),             // 71: profile.v1.PublicProfileStats
+	(*PublicBookmark)(nil),                 // 72: profile.v1.PublicBookmark
+	(*Preferences)(nil),                    // 73: profile.v1.Preferences
+	(*FavoriteState)(nil),                  // 74: profile.v1.FavoriteState
+	(*FavoriteItem)(nil),                   // 75: profile.v1.FavoriteItem
+	(*FavoriteSummary)(nil),                // 76: profile.v1.FavoriteSummary
+	(*WatchProgress)(nil),                  // 77: profile.v1.WatchProgress
+	(*WatchHistoryEntry)(nil),              // 78: profile.v1.WatchHistoryEntry
+	(*VideoMetadata)(nil),                  // 79: profile.v1.VideoMetadata
+	(*VideoStats)(nil),                     // 80: profile.v1.VideoStats
+	nil,                                    // 81: profile.v1.AvatarUploadTarget.HeadersEntry
+	(*fieldmaskpb.FieldMask)(nil),          // 82: google.protobuf.FieldMask
+	(*wrapperspb.Int64Value)(nil),          // 83: google.protobuf.Int64Value
+	(*timestamppb.Timestamp)(nil),          // 84: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                // 85: google.protobuf.Struct
+	(*wrapperspb.Int32Value)(nil),          // 86: google.protobuf.Int32Value
 }
 var file_api_profile_v1_profile_proto_depIdxs = []int32{
-	20, // 0: profile.v1.GetProfileResponse.profile:type_name -> profile.v1.Profile
-	20, // 1: profile.v1.UpdateProfileRequest.profile:type_name -> profile.v1.Profile
-	29, // 2: profile.v1.UpdateProfileRequest.update_mask:type_name -> google.protobuf.FieldMask
-	30, // 3: profile.v1.UpdateProfileRequest.expected_profile_version:type_name -> google.protobuf.Int64Value
-	20, // 4: profile.v1.UpdateProfileResponse.profile:type_name -> profile.v1.Profile
-	21, // 5: profile.v1.UpdatePreferencesRequest.preferences:type_name -> profile.v1.Preferences
-	29, // 6: profile.v1.UpdatePreferencesRequest.update_mask:type_name -> google.protobuf.FieldMask
-	30, // 7: profile.v1.UpdatePreferencesRequest.expected_profile_version:type_name -> google.protobuf.Int64Value
-	20, // 8: profile.v1.UpdatePreferencesResponse.profile:type_name -> profile.v1.Profile
-	1,  // 9: profile.v1.MutateFavoriteRequest.favorite_type:type_name -> profile.v1.FavoriteType
-	0,  // 10: profile.v1.MutateFavoriteRequest.action:type_name -> profile.v1.FavoriteAction
-	31, // 11: profile.v1.MutateFavoriteRequest.occurred_at:type_name -> google.protobuf.Timestamp
-	22, // 12: profile.v1.MutateFavoriteResponse.state:type_name -> profile.v1.FavoriteState
-	28, // 13: profile.v1.MutateFavoriteResponse.stats:type_name -> profile.v1.VideoStats
-	24, // 14: profile.v1.BatchQueryFavoriteResponse.summaries:type_name -> profile.v1.FavoriteSummary
-	23, // 15: profile.v1.ListFavoritesResponse.favorites:type_name -> profile.v1.FavoriteItem
-	25, // 16: profile.v1.UpsertWatchProgressRequest.progress:type_name -> profile.v1.WatchProgress
-	25, // 17: profile.v1.UpsertWatchProgressResponse.progress:type_name -> profile.v1.WatchProgress
-	28, // 18: profile.v1.UpsertWatchProgressResponse.stats:type_name -> profile.v1.VideoStats
-	26, // 19: profile.v1.ListWatchHistoryResponse.items:type_name -> profile.v1.WatchHistoryEntry
-	21, // 20: profile.v1.Profile.preferences:type_name -> profile.v1.Preferences
-	31, // 21: profile.v1.Profile.created_at:type_name -> google.protobuf.Timestamp
-	31, // 22: profile.v1.Profile.updated_at:type_name -> google.protobuf.Timestamp
-	32, // 23: profile.v1.Preferences.daily_quota_minutes:type_name -> google.protobuf.Int32Value
-	33, // 24: profile.v1.Preferences.extra:type_name -> google.protobuf.Struct
-	31, // 25: profile.v1.FavoriteState.liked_at:type_name -> google.protobuf.Timestamp
-	31, // 26: profile.v1.FavoriteState.bookmarked_at:type_name -> google.protobuf.Timestamp
-	1,  // 27: profile.v1.FavoriteItem.favorite_type:type_name -> profile.v1.FavoriteType
-	22, // 28: profile.v1.FavoriteItem.state:type_name -> profile.v1.FavoriteState
-	27, // 29: profile.v1.FavoriteItem.video:type_name -> profile.v1.VideoMetadata
-	31, // 30: profile.v1.FavoriteItem.created_at:type_name -> google.protobuf.Timestamp
-	31, // 31: profile.v1.FavoriteItem.updated_at:type_name -> google.protobuf.Timestamp
-	22, // 32: profile.v1.FavoriteSummary.state:type_name -> profile.v1.FavoriteState
-	28, // 33: profile.v1.FavoriteSummary.stats:type_name -> profile.v1.VideoStats
-	31, // 34: profile.v1.WatchProgress.first_watched_at:type_name -> google.protobuf.Timestamp
-	31, // 35: profile.v1.WatchProgress.last_watched_at:type_name -> google.protobuf.Timestamp
-	31, // 36: profile.v1.WatchProgress.expires_at:type_name -> google.protobuf.Timestamp
-	25, // 37: profile.v1.WatchHistoryEntry.progress:type_name -> profile.v1.WatchProgress
-	27, // 38: profile.v1.WatchHistoryEntry.video:type_name -> profile.v1.VideoMetadata
-	31, // 39: profile.v1.VideoMetadata.published_at:type_name -> google.protobuf.Timestamp
-	31, // 40: profile.v1.VideoMetadata.updated_at:type_name -> google.protobuf.Timestamp
-	31, // 41: profile.v1.VideoStats.updated_at:type_name -> google.protobuf.Timestamp
-	2,  // 42: profile.v1.ProfileService.GetProfile:input_type -> profile.v1.GetProfileRequest
-	4,  // 43: profile.v1.ProfileService.UpdateProfile:input_type -> profile.v1.UpdateProfileRequest
-	6,  // 44: profile.v1.ProfileService.UpdatePreferences:input_type -> profile.v1.UpdatePreferencesRequest
-	8,  // 45: profile.v1.ProfileService.MutateFavorite:input_type -> profile.v1.MutateFavoriteRequest
-	10, // 46: profile.v1.ProfileService.BatchQueryFavorite:input_type -> profile.v1.BatchQueryFavoriteRequest
-	12, // 47: profile.v1.ProfileService.ListFavorites:input_type -> profile.v1.ListFavoritesRequest
-	14, // 48: profile.v1.ProfileService.UpsertWatchProgress:input_type -> profile.v1.UpsertWatchProgressRequest
-	16, // 49: profile.v1.ProfileService.ListWatchHistory:input_type -> profile.v1.ListWatchHistoryRequest
-	18, // 50: profile.v1.ProfileService.PurgeUserData:input_type -> profile.v1.PurgeUserDataRequest
-	3,  // 51: profile.v1.ProfileService.GetProfile:output_type -> profile.v1.GetProfileResponse
-	5,  // 52: profile.v1.ProfileService.UpdateProfile:output_type -> profile.v1.UpdateProfileResponse
-	7,  // 53: profile.v1.ProfileService.UpdatePreferences:output_type -> profile.v1.UpdatePreferencesResponse
-	9,  // 54: profile.v1.ProfileService.MutateFavorite:output_type -> profile.v1.MutateFavoriteResponse
-	11, // 55: profile.v1.ProfileService.BatchQueryFavorite:output_type -> profile.v1.BatchQueryFavoriteResponse
-	13, // 56: profile.v1.ProfileService.ListFavorites:output_type -> profile.v1.ListFavoritesResponse
-	15, // 57: profile.v1.ProfileService.UpsertWatchProgress:output_type -> profile.v1.UpsertWatchProgressResponse
-	17, // 58: profile.v1.ProfileService.ListWatchHistory:output_type -> profile.v1.ListWatchHistoryResponse
-	19, // 59: profile.v1.ProfileService.PurgeUserData:output_type -> profile.v1.PurgeUserDataResponse
-	51, // [51:60] is the sub-list for method output_type
-	42, // [42:51] is the sub-list for method input_type
-	42, // [42:42] is the sub-list for extension type_name
-	42, // [42:42] is the sub-list for extension extendee
-	0,  // [0:42] is the sub-list for field type_name
+	68,  // 0: profile.v1.GetProfileResponse.profile:type_name -> profile.v1.Profile
+	68,  // 1: profile.v1.UpdateProfileRequest.profile:type_name -> profile.v1.Profile
+	82,  // 2: profile.v1.UpdateProfileRequest.update_mask:type_name -> google.protobuf.FieldMask
+	83,  // 3: profile.v1.UpdateProfileRequest.expected_profile_version:type_name -> google.protobuf.Int64Value
+	68,  // 4: profile.v1.UpdateProfileResponse.profile:type_name -> profile.v1.Profile
+	73,  // 5: profile.v1.UpdatePreferencesRequest.preferences:type_name -> profile.v1.Preferences
+	82,  // 6: profile.v1.UpdatePreferencesRequest.update_mask:type_name -> google.protobuf.FieldMask
+	83,  // 7: profile.v1.UpdatePreferencesRequest.expected_profile_version:type_name -> google.protobuf.Int64Value
+	68,  // 8: profile.v1.UpdatePreferencesResponse.profile:type_name -> profile.v1.Profile
+	1,   // 9: profile.v1.MutateFavoriteRequest.favorite_type:type_name -> profile.v1.FavoriteType
+	0,   // 10: profile.v1.MutateFavoriteRequest.action:type_name -> profile.v1.FavoriteAction
+	84,  // 11: profile.v1.MutateFavoriteRequest.occurred_at:type_name -> google.protobuf.Timestamp
+	2,   // 12: profile.v1.MutateFavoriteRequest.source:type_name -> profile.v1.EngagementSource
+	10,  // 13: profile.v1.MutateFavoriteRequest.context:type_name -> profile.v1.EngagementContext
+	74,  // 14: profile.v1.MutateFavoriteResponse.state:type_name -> profile.v1.FavoriteState
+	80,  // 15: profile.v1.MutateFavoriteResponse.stats:type_name -> profile.v1.VideoStats
+	76,  // 16: profile.v1.BatchQueryFavoriteResponse.summaries:type_name -> profile.v1.FavoriteSummary
+	75,  // 17: profile.v1.ListFavoritesResponse.favorites:type_name -> profile.v1.FavoriteItem
+	77,  // 18: profile.v1.UpsertWatchProgressRequest.progress:type_name -> profile.v1.WatchProgress
+	77,  // 19: profile.v1.UpsertWatchProgressResponse.progress:type_name -> profile.v1.WatchProgress
+	80,  // 20: profile.v1.UpsertWatchProgressResponse.stats:type_name -> profile.v1.VideoStats
+	78,  // 21: profile.v1.ListWatchHistoryResponse.items:type_name -> profile.v1.WatchHistoryEntry
+	83,  // 22: profile.v1.SuspendAccountRequest.expected_profile_version:type_name -> google.protobuf.Int64Value
+	68,  // 23: profile.v1.SuspendAccountResponse.profile:type_name -> profile.v1.Profile
+	83,  // 24: profile.v1.ReactivateAccountRequest.expected_profile_version:type_name -> google.protobuf.Int64Value
+	68,  // 25: profile.v1.ReactivateAccountResponse.profile:type_name -> profile.v1.Profile
+	84,  // 26: profile.v1.ListAuditEntriesRequest.since:type_name -> google.protobuf.Timestamp
+	84,  // 27: profile.v1.ListAuditEntriesRequest.until:type_name -> google.protobuf.Timestamp
+	29,  // 28: profile.v1.ListAuditEntriesResponse.entries:type_name -> profile.v1.AuditEntry
+	85,  // 29: profile.v1.AuditEntry.before:type_name -> google.protobuf.Struct
+	85,  // 30: profile.v1.AuditEntry.after:type_name -> google.protobuf.Struct
+	84,  // 31: profile.v1.AuditEntry.created_at:type_name -> google.protobuf.Timestamp
+	69,  // 32: profile.v1.UpdateVisibilityRequest.visibility:type_name -> profile.v1.ProfileVisibility
+	82,  // 33: profile.v1.UpdateVisibilityRequest.update_mask:type_name -> google.protobuf.FieldMask
+	83,  // 34: profile.v1.UpdateVisibilityRequest.expected_profile_version:type_name -> google.protobuf.Int64Value
+	68,  // 35: profile.v1.UpdateVisibilityResponse.profile:type_name -> profile.v1.Profile
+	70,  // 36: profile.v1.GetPublicProfileResponse.profile:type_name -> profile.v1.PublicProfile
+	72,  // 37: profile.v1.ListPublicBookmarksResponse.bookmarks:type_name -> profile.v1.PublicBookmark
+	40,  // 38: profile.v1.CreateAvatarUploadResponse.upload:type_name -> profile.v1.AvatarUploadTarget
+	83,  // 39: profile.v1.ConfirmAvatarUploadRequest.expected_profile_version:type_name -> google.protobuf.Int64Value
+	68,  // 40: profile.v1.ConfirmAvatarUploadResponse.profile:type_name -> profile.v1.Profile
+	81,  // 41: profile.v1.AvatarUploadTarget.headers:type_name -> profile.v1.AvatarUploadTarget.HeadersEntry
+	84,  // 42: profile.v1.AvatarUploadTarget.expires_at:type_name -> google.protobuf.Timestamp
+	66,  // 43: profile.v1.CreateCollectionResponse.collection:type_name -> profile.v1.Collection
+	66,  // 44: profile.v1.ListCollectionsResponse.collections:type_name -> profile.v1.Collection
+	66,  // 45: profile.v1.RenameCollectionResponse.collection:type_name -> profile.v1.Collection
+	67,  // 46: profile.v1.AddCollectionItemResponse.item:type_name -> profile.v1.CollectionItem
+	67,  // 47: profile.v1.MoveCollectionItemResponse.item:type_name -> profile.v1.CollectionItem
+	66,  // 48: profile.v1.ListCollectionItemsResponse.collection:type_name -> profile.v1.Collection
+	67,  // 49: profile.v1.ListCollectionItemsResponse.items:type_name -> profile.v1.CollectionItem
+	86,  // 50: profile.v1.AddEngagementNoteRequest.position_seconds:type_name -> google.protobuf.Int32Value
+	65,  // 51: profile.v1.AddEngagementNoteResponse.note:type_name -> profile.v1.EngagementNote
+	86,  // 52: profile.v1.UpdateEngagementNoteRequest.position_seconds:type_name -> google.protobuf.Int32Value
+	65,  // 53: profile.v1.UpdateEngagementNoteResponse.note:type_name -> profile.v1.EngagementNote
+	86,  // 54: profile.v1.EngagementNote.position_seconds:type_name -> google.protobuf.Int32Value
+	84,  // 55: profile.v1.EngagementNote.created_at:type_name -> google.protobuf.Timestamp
+	84,  // 56: profile.v1.EngagementNote.updated_at:type_name -> google.protobuf.Timestamp
+	84,  // 57: profile.v1.Collection.created_at:type_name -> google.protobuf.Timestamp
+	84,  // 58: profile.v1.Collection.updated_at:type_name -> google.protobuf.Timestamp
+	84,  // 59: profile.v1.CollectionItem.added_at:type_name -> google.protobuf.Timestamp
+	79,  // 60: profile.v1.CollectionItem.video:type_name -> profile.v1.VideoMetadata
+	73,  // 61: profile.v1.Profile.preferences:type_name -> profile.v1.Preferences
+	84,  // 62: profile.v1.Profile.created_at:type_name -> google.protobuf.Timestamp
+	84,  // 63: profile.v1.Profile.updated_at:type_name -> google.protobuf.Timestamp
+	3,   // 64: profile.v1.Profile.account_status:type_name -> profile.v1.AccountStatus
+	84,  // 65: profile.v1.Profile.pending_deletion_at:type_name -> google.protobuf.Timestamp
+	84,  // 66: profile.v1.Profile.deleted_at:type_name -> google.protobuf.Timestamp
+	69,  // 67: profile.v1.Profile.visibility:type_name -> profile.v1.ProfileVisibility
+	71,  // 68: profile.v1.PublicProfile.stats:type_name -> profile.v1.PublicProfileStats
+	79,  // 69: profile.v1.PublicBookmark.video:type_name -> profile.v1.VideoMetadata
+	84,  // 70: profile.v1.PublicBookmark.bookmarked_at:type_name -> google.protobuf.Timestamp
+	86,  // 71: profile.v1.Preferences.daily_quota_minutes:type_name -> google.protobuf.Int32Value
+	85,  // 72: profile.v1.Preferences.extra:type_name -> google.protobuf.Struct
+	84,  // 73: profile.v1.FavoriteState.liked_at:type_name -> google.protobuf.Timestamp
+	84,  // 74: profile.v1.FavoriteState.bookmarked_at:type_name -> google.protobuf.Timestamp
+	1,   // 75: profile.v1.FavoriteItem.favorite_type:type_name -> profile.v1.FavoriteType
+	74,  // 76: profile.v1.FavoriteItem.state:type_name -> profile.v1.FavoriteState
+	79,  // 77: profile.v1.FavoriteItem.video:type_name -> profile.v1.VideoMetadata
+	84,  // 78: profile.v1.FavoriteItem.created_at:type_name -> google.protobuf.Timestamp
+	84,  // 79: profile.v1.FavoriteItem.updated_at:type_name -> google.protobuf.Timestamp
+	2,   // 80: profile.v1.FavoriteItem.source:type_name -> profile.v1.EngagementSource
+	10,  // 81: profile.v1.FavoriteItem.context:type_name -> profile.v1.EngagementContext
+	65,  // 82: profile.v1.FavoriteItem.notes:type_name -> profile.v1.EngagementNote
+	74,  // 83: profile.v1.FavoriteSummary.state:type_name -> profile.v1.FavoriteState
+	80,  // 84: profile.v1.FavoriteSummary.stats:type_name -> profile.v1.VideoStats
+	84,  // 85: profile.v1.WatchProgress.first_watched_at:type_name -> google.protobuf.Timestamp
+	84,  // 86: profile.v1.WatchProgress.last_watched_at:type_name -> google.protobuf.Timestamp
+	84,  // 87: profile.v1.WatchProgress.expires_at:type_name -> google.protobuf.Timestamp
+	77,  // 88: profile.v1.WatchHistoryEntry.progress:type_name -> profile.v1.WatchProgress
+	79,  // 89: profile.v1.WatchHistoryEntry.video:type_name -> profile.v1.VideoMetadata
+	84,  // 90: profile.v1.VideoMetadata.published_at:type_name -> google.protobuf.Timestamp
+	84,  // 91: profile.v1.VideoMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	84,  // 92: profile.v1.VideoStats.updated_at:type_name -> google.protobuf.Timestamp
+	4,   // 93: profile.v1.ProfileService.GetProfile:input_type -> profile.v1.GetProfileRequest
+	6,   // 94: profile.v1.ProfileService.UpdateProfile:input_type -> profile.v1.UpdateProfileRequest
+	8,   // 95: profile.v1.ProfileService.UpdatePreferences:input_type -> profile.v1.UpdatePreferencesRequest
+	11,  // 96: profile.v1.ProfileService.MutateFavorite:input_type -> profile.v1.MutateFavoriteRequest
+	13,  // 97: profile.v1.ProfileService.BatchQueryFavorite:input_type -> profile.v1.BatchQueryFavoriteRequest
+	15,  // 98: profile.v1.ProfileService.ListFavorites:input_type -> profile.v1.ListFavoritesRequest
+	17,  // 99: profile.v1.ProfileService.UpsertWatchProgress:input_type -> profile.v1.UpsertWatchProgressRequest
+	19,  // 100: profile.v1.ProfileService.ListWatchHistory:input_type -> profile.v1.ListWatchHistoryRequest
+	21,  // 101: profile.v1.ProfileService.PurgeUserData:input_type -> profile.v1.PurgeUserDataRequest
+	23,  // 102: profile.v1.ProfileService.SuspendAccount:input_type -> profile.v1.SuspendAccountRequest
+	25,  // 103: profile.v1.ProfileService.ReactivateAccount:input_type -> profile.v1.ReactivateAccountRequest
+	27,  // 104: profile.v1.ProfileService.ListAuditEntries:input_type -> profile.v1.ListAuditEntriesRequest
+	30,  // 105: profile.v1.ProfileService.UpdateVisibility:input_type -> profile.v1.UpdateVisibilityRequest
+	32,  // 106: profile.v1.ProfileService.GetPublicProfile:input_type -> profile.v1.GetPublicProfileRequest
+	34,  // 107: profile.v1.ProfileService.ListPublicBookmarks:input_type -> profile.v1.ListPublicBookmarksRequest
+	36,  // 108: profile.v1.ProfileService.CreateAvatarUpload:input_type -> profile.v1.CreateAvatarUploadRequest
+	38,  // 109: profile.v1.ProfileService.ConfirmAvatarUpload:input_type -> profile.v1.ConfirmAvatarUploadRequest
+	41,  // 110: profile.v1.ProfileService.CreateCollection:input_type -> profile.v1.CreateCollectionRequest
+	43,  // 111: profile.v1.ProfileService.ListCollections:input_type -> profile.v1.ListCollectionsRequest
+	45,  // 112: profile.v1.ProfileService.RenameCollection:input_type -> profile.v1.RenameCollectionRequest
+	47,  // 113: profile.v1.ProfileService.DeleteCollection:input_type -> profile.v1.DeleteCollectionRequest
+	49,  // 114: profile.v1.ProfileService.AddCollectionItem:input_type -> profile.v1.AddCollectionItemRequest
+	51,  // 115: profile.v1.ProfileService.RemoveCollectionItem:input_type -> profile.v1.RemoveCollectionItemRequest
+	53,  // 116: profile.v1.ProfileService.ReorderCollectionItems:input_type -> profile.v1.ReorderCollectionItemsRequest
+	55,  // 117: profile.v1.ProfileService.MoveCollectionItem:input_type -> profile.v1.MoveCollectionItemRequest
+	57,  // 118: profile.v1.ProfileService.ListCollectionItems:input_type -> profile.v1.ListCollectionItemsRequest
+	59,  // 119: profile.v1.ProfileService.AddEngagementNote:input_type -> profile.v1.AddEngagementNoteRequest
+	61,  // 120: profile.v1.ProfileService.UpdateEngagementNote:input_type -> profile.v1.UpdateEngagementNoteRequest
+	63,  // 121: profile.v1.ProfileService.DeleteEngagementNote:input_type -> profile.v1.DeleteEngagementNoteRequest
+	5,   // 122: profile.v1.ProfileService.GetProfile:output_type -> profile.v1.GetProfileResponse
+	7,   // 123: profile.v1.ProfileService.UpdateProfile:output_type -> profile.v1.UpdateProfileResponse
+	9,   // 124: profile.v1.ProfileService.UpdatePreferences:output_type -> profile.v1.UpdatePreferencesResponse
+	12,  // 125: profile.v1.ProfileService.MutateFavorite:output_type -> profile.v1.MutateFavoriteResponse
+	14,  // 126: profile.v1.ProfileService.BatchQueryFavorite:output_type -> profile.v1.BatchQueryFavoriteResponse
+	16,  // 127: profile.v1.ProfileService.ListFavorites:output_type -> profile.v1.ListFavoritesResponse
+	18,  // 128: profile.v1.ProfileService.UpsertWatchProgress:output_type -> profile.v1.UpsertWatchProgressResponse
+	20,  // 129: profile.v1.ProfileService.ListWatchHistory:output_type -> profile.v1.ListWatchHistoryResponse
+	22,  // 130: profile.v1.ProfileService.PurgeUserData:output_type -> profile.v1.PurgeUserDataResponse
+	24,  // 131: profile.v1.ProfileService.SuspendAccount:output_type -> profile.v1.SuspendAccountResponse
+	26,  // 132: profile.v1.ProfileService.ReactivateAccount:output_type -> profile.v1.ReactivateAccountResponse
+	28,  // 133: profile.v1.ProfileService.ListAuditEntries:output_type -> profile.v1.ListAuditEntriesResponse
+	31,  // 134: profile.v1.ProfileService.UpdateVisibility:output_type -> profile.v1.UpdateVisibilityResponse
+	33,  // 135: profile.v1.ProfileService.GetPublicProfile:output_type -> profile.v1.GetPublicProfileResponse
+	35,  // 136: profile.v1.ProfileService.ListPublicBookmarks:output_type -> profile.v1.ListPublicBookmarksResponse
+	37,  // 137: profile.v1.ProfileService.CreateAvatarUpload:output_type -> profile.v1.CreateAvatarUploadResponse
+	39,  // 138: profile.v1.ProfileService.ConfirmAvatarUpload:output_type -> profile.v1.ConfirmAvatarUploadResponse
+	42,  // 139: profile.v1.ProfileService.CreateCollection:output_type -> profile.v1.CreateCollectionResponse
+	44,  // 140: profile.v1.ProfileService.ListCollections:output_type -> profile.v1.ListCollectionsResponse
+	46,  // 141: profile.v1.ProfileService.RenameCollection:output_type -> profile.v1.RenameCollectionResponse
+	48,  // 142: profile.v1.ProfileService.DeleteCollection:output_type -> profile.v1.DeleteCollectionResponse
+	50,  // 143: profile.v1.ProfileService.AddCollectionItem:output_type -> profile.v1.AddCollectionItemResponse
+	52,  // 144: profile.v1.ProfileService.RemoveCollectionItem:output_type -> profile.v1.RemoveCollectionItemResponse
+	54,  // 145: profile.v1.ProfileService.ReorderCollectionItems:output_type -> profile.v1.ReorderCollectionItemsResponse
+	56,  // 146: profile.v1.ProfileService.MoveCollectionItem:output_type -> profile.v1.MoveCollectionItemResponse
+	58,  // 147: profile.v1.ProfileService.ListCollectionItems:output_type -> profile.v1.ListCollectionItemsResponse
+	60,  // 148: profile.v1.ProfileService.AddEngagementNote:output_type -> profile.v1.AddEngagementNoteResponse
+	62,  // 149: profile.v1.ProfileService.UpdateEngagementNote:output_type -> profile.v1.UpdateEngagementNoteResponse
+	64,  // 150: profile.v1.ProfileService.DeleteEngagementNote:output_type -> profile.v1.DeleteEngagementNoteResponse
+	122, // [122:151] is the sub-list for method output_type
+	93,  // [93:122] is the sub-list for method input_type
+	93,  // [93:93] is the sub-list for extension type_name
+	93,  // [93:93] is the sub-list for extension extendee
+	0,   // [0:93] is the sub-list for field type_name
 }
 
 func init() { file_api_profile_v1_profile_proto_init() }
@@ -2155,8 +5797,8 @@ func file_api_profile_v1_profile_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_profile_v1_profile_proto_rawDesc), len(file_api_profile_v1_profile_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   27,
+			NumEnums:      4,
+			NumMessages:   78,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
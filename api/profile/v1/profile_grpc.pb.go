@@ -19,15 +19,35 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProfileService_GetProfile_FullMethodName          = "/profile.v1.ProfileService/GetProfile"
-	ProfileService_UpdateProfile_FullMethodName       = "/profile.v1.ProfileService/UpdateProfile"
-	ProfileService_UpdatePreferences_FullMethodName   = "/profile.v1.ProfileService/UpdatePreferences"
-	ProfileService_MutateFavorite_FullMethodName      = "/profile.v1.ProfileService/MutateFavorite"
-	ProfileService_BatchQueryFavorite_FullMethodName  = "/profile.v1.ProfileService/BatchQueryFavorite"
-	ProfileService_ListFavorites_FullMethodName       = "/profile.v1.ProfileService/ListFavorites"
-	ProfileService_UpsertWatchProgress_FullMethodName = "/profile.v1.ProfileService/UpsertWatchProgress"
-	ProfileService_ListWatchHistory_FullMethodName    = "/profile.v1.ProfileService/ListWatchHistory"
-	ProfileService_PurgeUserData_FullMethodName       = "/profile.v1.ProfileService/PurgeUserData"
+	ProfileService_GetProfile_FullMethodName             = "/profile.v1.ProfileService/GetProfile"
+	ProfileService_UpdateProfile_FullMethodName          = "/profile.v1.ProfileService/UpdateProfile"
+	ProfileService_UpdatePreferences_FullMethodName      = "/profile.v1.ProfileService/UpdatePreferences"
+	ProfileService_MutateFavorite_FullMethodName         = "/profile.v1.ProfileService/MutateFavorite"
+	ProfileService_BatchQueryFavorite_FullMethodName     = "/profile.v1.ProfileService/BatchQueryFavorite"
+	ProfileService_ListFavorites_FullMethodName          = "/profile.v1.ProfileService/ListFavorites"
+	ProfileService_UpsertWatchProgress_FullMethodName    = "/profile.v1.ProfileService/UpsertWatchProgress"
+	ProfileService_ListWatchHistory_FullMethodName       = "/profile.v1.ProfileService/ListWatchHistory"
+	ProfileService_PurgeUserData_FullMethodName          = "/profile.v1.ProfileService/PurgeUserData"
+	ProfileService_SuspendAccount_FullMethodName         = "/profile.v1.ProfileService/SuspendAccount"
+	ProfileService_ReactivateAccount_FullMethodName      = "/profile.v1.ProfileService/ReactivateAccount"
+	ProfileService_ListAuditEntries_FullMethodName       = "/profile.v1.ProfileService/ListAuditEntries"
+	ProfileService_UpdateVisibility_FullMethodName       = "/profile.v1.ProfileService/UpdateVisibility"
+	ProfileService_GetPublicProfile_FullMethodName       = "/profile.v1.ProfileService/GetPublicProfile"
+	ProfileService_ListPublicBookmarks_FullMethodName    = "/profile.v1.ProfileService/ListPublicBookmarks"
+	ProfileService_CreateAvatarUpload_FullMethodName     = "/profile.v1.ProfileService/CreateAvatarUpload"
+	ProfileService_ConfirmAvatarUpload_FullMethodName    = "/profile.v1.ProfileService/ConfirmAvatarUpload"
+	ProfileService_CreateCollection_FullMethodName       = "/profile.v1.ProfileService/CreateCollection"
+	ProfileService_ListCollections_FullMethodName        = "/profile.v1.ProfileService/ListCollections"
+	ProfileService_RenameCollection_FullMethodName       = "/profile.v1.ProfileService/RenameCollection"
+	ProfileService_DeleteCollection_FullMethodName       = "/profile.v1.ProfileService/DeleteCollection"
+	ProfileService_AddCollectionItem_FullMethodName      = "/profile.v1.ProfileService/AddCollectionItem"
+	ProfileService_RemoveCollectionItem_FullMethodName   = "/profile.v1.ProfileService/RemoveCollectionItem"
+	ProfileService_ReorderCollectionItems_FullMethodName = "/profile.v1.ProfileService/ReorderCollectionItems"
+	ProfileService_MoveCollectionItem_FullMethodName     = "/profile.v1.ProfileService/MoveCollectionItem"
+	ProfileService_ListCollectionItems_FullMethodName    = "/profile.v1.ProfileService/ListCollectionItems"
+	ProfileService_AddEngagementNote_FullMethodName      = "/profile.v1.ProfileService/AddEngagementNote"
+	ProfileService_UpdateEngagementNote_FullMethodName   = "/profile.v1.ProfileService/UpdateEngagementNote"
+	ProfileService_DeleteEngagementNote_FullMethodName   = "/profile.v1.ProfileService/DeleteEngagementNote"
 )
 
 // ProfileServiceClient is the client API for ProfileService service.
@@ -54,6 +74,46 @@ type ProfileServiceClient interface {
 	ListWatchHistory(ctx context.Context, in *ListWatchHistoryRequest, opts ...grpc.CallOption) (*ListWatchHistoryResponse, error)
 	// PurgeUserData 触发用户数据清理流程。
 	PurgeUserData(ctx context.Context, in *PurgeUserDataRequest, opts ...grpc.CallOption) (*PurgeUserDataResponse, error)
+	// SuspendAccount 冻结账户，冻结期间禁止互动与观看进度写入（运营/内部调用）。
+	SuspendAccount(ctx context.Context, in *SuspendAccountRequest, opts ...grpc.CallOption) (*SuspendAccountResponse, error)
+	// ReactivateAccount 将冻结或待删除的账户恢复为 active（运营/内部调用）。
+	ReactivateAccount(ctx context.Context, in *ReactivateAccountRequest, opts ...grpc.CallOption) (*ReactivateAccountResponse, error)
+	// ListAuditEntries 按用户、操作者、动作、trace_id 与时间范围查询写操作审计记录（运营/内部调用）。
+	ListAuditEntries(ctx context.Context, in *ListAuditEntriesRequest, opts ...grpc.CallOption) (*ListAuditEntriesResponse, error)
+	// UpdateVisibility 更新公开主页的字段可见性设置（仅本人或服务身份）。
+	UpdateVisibility(ctx context.Context, in *UpdateVisibilityRequest, opts ...grpc.CallOption) (*UpdateVisibilityResponse, error)
+	// GetPublicProfile 返回用户公开主页，仅包含本人标记为公开的字段，允许匿名访问。
+	GetPublicProfile(ctx context.Context, in *GetPublicProfileRequest, opts ...grpc.CallOption) (*GetPublicProfileResponse, error)
+	// ListPublicBookmarks 分页返回用户公开的收藏列表；用户未公开收藏时返回 PermissionDenied。
+	ListPublicBookmarks(ctx context.Context, in *ListPublicBookmarksRequest, opts ...grpc.CallOption) (*ListPublicBookmarksResponse, error)
+	// CreateAvatarUpload 签发限时、限大小与类型的头像直传地址。
+	CreateAvatarUpload(ctx context.Context, in *CreateAvatarUploadRequest, opts ...grpc.CallOption) (*CreateAvatarUploadResponse, error)
+	// ConfirmAvatarUpload 校验已上传的头像文件并写入档案 avatar_url。
+	ConfirmAvatarUpload(ctx context.Context, in *ConfirmAvatarUploadRequest, opts ...grpc.CallOption) (*ConfirmAvatarUploadResponse, error)
+	// CreateCollection 新建收藏夹，名称在同一用户下大小写不敏感唯一。
+	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionResponse, error)
+	// ListCollections 按创建时间升序分页返回收藏夹。
+	ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error)
+	// RenameCollection 重命名收藏夹。
+	RenameCollection(ctx context.Context, in *RenameCollectionRequest, opts ...grpc.CallOption) (*RenameCollectionResponse, error)
+	// DeleteCollection 删除收藏夹及其全部成员。
+	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
+	// AddCollectionItem 将视频追加到收藏夹末尾，重复加入视为成功。
+	AddCollectionItem(ctx context.Context, in *AddCollectionItemRequest, opts ...grpc.CallOption) (*AddCollectionItemResponse, error)
+	// RemoveCollectionItem 将视频移出收藏夹。
+	RemoveCollectionItem(ctx context.Context, in *RemoveCollectionItemRequest, opts ...grpc.CallOption) (*RemoveCollectionItemResponse, error)
+	// ReorderCollectionItems 按给定顺序重排收藏夹成员，video_ids 须与当前成员完全一致。
+	ReorderCollectionItems(ctx context.Context, in *ReorderCollectionItemsRequest, opts ...grpc.CallOption) (*ReorderCollectionItemsResponse, error)
+	// MoveCollectionItem 将视频从当前收藏夹移动到目标收藏夹末尾。
+	MoveCollectionItem(ctx context.Context, in *MoveCollectionItemRequest, opts ...grpc.CallOption) (*MoveCollectionItemResponse, error)
+	// ListCollectionItems 按排序位置分页返回收藏夹成员，视频元数据来自 videos_projection。
+	ListCollectionItems(ctx context.Context, in *ListCollectionItemsRequest, opts ...grpc.CallOption) (*ListCollectionItemsResponse, error)
+	// AddEngagementNote 为已收藏的视频添加私有笔记，可选记录视频内时间点。
+	AddEngagementNote(ctx context.Context, in *AddEngagementNoteRequest, opts ...grpc.CallOption) (*AddEngagementNoteResponse, error)
+	// UpdateEngagementNote 整体替换笔记正文与时间点。
+	UpdateEngagementNote(ctx context.Context, in *UpdateEngagementNoteRequest, opts ...grpc.CallOption) (*UpdateEngagementNoteResponse, error)
+	// DeleteEngagementNote 删除笔记。
+	DeleteEngagementNote(ctx context.Context, in *DeleteEngagementNoteRequest, opts ...grpc.CallOption) (*DeleteEngagementNoteResponse, error)
 }
 
 type profileServiceClient struct {
@@ -154,6 +214,206 @@ func (c *profileServiceClient) PurgeUserData(ctx context.Context, in *PurgeUserD
 	return out, nil
 }
 
+func (c *profileServiceClient) SuspendAccount(ctx context.Context, in *SuspendAccountRequest, opts ...grpc.CallOption) (*SuspendAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspendAccountResponse)
+	err := c.cc.Invoke(ctx, ProfileService_SuspendAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) ReactivateAccount(ctx context.Context, in *ReactivateAccountRequest, opts ...grpc.CallOption) (*ReactivateAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactivateAccountResponse)
+	err := c.cc.Invoke(ctx, ProfileService_ReactivateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) ListAuditEntries(ctx context.Context, in *ListAuditEntriesRequest, opts ...grpc.CallOption) (*ListAuditEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEntriesResponse)
+	err := c.cc.Invoke(ctx, ProfileService_ListAuditEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) UpdateVisibility(ctx context.Context, in *UpdateVisibilityRequest, opts ...grpc.CallOption) (*UpdateVisibilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateVisibilityResponse)
+	err := c.cc.Invoke(ctx, ProfileService_UpdateVisibility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetPublicProfile(ctx context.Context, in *GetPublicProfileRequest, opts ...grpc.CallOption) (*GetPublicProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicProfileResponse)
+	err := c.cc.Invoke(ctx, ProfileService_GetPublicProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) ListPublicBookmarks(ctx context.Context, in *ListPublicBookmarksRequest, opts ...grpc.CallOption) (*ListPublicBookmarksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPublicBookmarksResponse)
+	err := c.cc.Invoke(ctx, ProfileService_ListPublicBookmarks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) CreateAvatarUpload(ctx context.Context, in *CreateAvatarUploadRequest, opts ...grpc.CallOption) (*CreateAvatarUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAvatarUploadResponse)
+	err := c.cc.Invoke(ctx, ProfileService_CreateAvatarUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) ConfirmAvatarUpload(ctx context.Context, in *ConfirmAvatarUploadRequest, opts ...grpc.CallOption) (*ConfirmAvatarUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmAvatarUploadResponse)
+	err := c.cc.Invoke(ctx, ProfileService_ConfirmAvatarUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCollectionResponse)
+	err := c.cc.Invoke(ctx, ProfileService_CreateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionsResponse)
+	err := c.cc.Invoke(ctx, ProfileService_ListCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) RenameCollection(ctx context.Context, in *RenameCollectionRequest, opts ...grpc.CallOption) (*RenameCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameCollectionResponse)
+	err := c.cc.Invoke(ctx, ProfileService_RenameCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCollectionResponse)
+	err := c.cc.Invoke(ctx, ProfileService_DeleteCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) AddCollectionItem(ctx context.Context, in *AddCollectionItemRequest, opts ...grpc.CallOption) (*AddCollectionItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCollectionItemResponse)
+	err := c.cc.Invoke(ctx, ProfileService_AddCollectionItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) RemoveCollectionItem(ctx context.Context, in *RemoveCollectionItemRequest, opts ...grpc.CallOption) (*RemoveCollectionItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveCollectionItemResponse)
+	err := c.cc.Invoke(ctx, ProfileService_RemoveCollectionItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) ReorderCollectionItems(ctx context.Context, in *ReorderCollectionItemsRequest, opts ...grpc.CallOption) (*ReorderCollectionItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderCollectionItemsResponse)
+	err := c.cc.Invoke(ctx, ProfileService_ReorderCollectionItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) MoveCollectionItem(ctx context.Context, in *MoveCollectionItemRequest, opts ...grpc.CallOption) (*MoveCollectionItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveCollectionItemResponse)
+	err := c.cc.Invoke(ctx, ProfileService_MoveCollectionItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) ListCollectionItems(ctx context.Context, in *ListCollectionItemsRequest, opts ...grpc.CallOption) (*ListCollectionItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionItemsResponse)
+	err := c.cc.Invoke(ctx, ProfileService_ListCollectionItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) AddEngagementNote(ctx context.Context, in *AddEngagementNoteRequest, opts ...grpc.CallOption) (*AddEngagementNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddEngagementNoteResponse)
+	err := c.cc.Invoke(ctx, ProfileService_AddEngagementNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) UpdateEngagementNote(ctx context.Context, in *UpdateEngagementNoteRequest, opts ...grpc.CallOption) (*UpdateEngagementNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateEngagementNoteResponse)
+	err := c.cc.Invoke(ctx, ProfileService_UpdateEngagementNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) DeleteEngagementNote(ctx context.Context, in *DeleteEngagementNoteRequest, opts ...grpc.CallOption) (*DeleteEngagementNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEngagementNoteResponse)
+	err := c.cc.Invoke(ctx, ProfileService_DeleteEngagementNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProfileServiceServer is the server API for ProfileService service.
 // All implementations must embed UnimplementedProfileServiceServer
 // for forward compatibility.
@@ -178,6 +438,46 @@ type ProfileServiceServer interface {
 	ListWatchHistory(context.Context, *ListWatchHistoryRequest) (*ListWatchHistoryResponse, error)
 	// PurgeUserData 触发用户数据清理流程。
 	PurgeUserData(context.Context, *PurgeUserDataRequest) (*PurgeUserDataResponse, error)
+	// SuspendAccount 冻结账户，冻结期间禁止互动与观看进度写入（运营/内部调用）。
+	SuspendAccount(context.Context, *SuspendAccountRequest) (*SuspendAccountResponse, error)
+	// ReactivateAccount 将冻结或待删除的账户恢复为 active（运营/内部调用）。
+	ReactivateAccount(context.Context, *ReactivateAccountRequest) (*ReactivateAccountResponse, error)
+	// ListAuditEntries 按用户、操作者、动作、trace_id 与时间范围查询写操作审计记录（运营/内部调用）。
+	ListAuditEntries(context.Context, *ListAuditEntriesRequest) (*ListAuditEntriesResponse, error)
+	// UpdateVisibility 更新公开主页的字段可见性设置（仅本人或服务身份）。
+	UpdateVisibility(context.Context, *UpdateVisibilityRequest) (*UpdateVisibilityResponse, error)
+	// GetPublicProfile 返回用户公开主页，仅包含本人标记为公开的字段，允许匿名访问。
+	GetPublicProfile(context.Context, *GetPublicProfileRequest) (*GetPublicProfileResponse, error)
+	// ListPublicBookmarks 分页返回用户公开的收藏列表；用户未公开收藏时返回 PermissionDenied。
+	ListPublicBookmarks(context.Context, *ListPublicBookmarksRequest) (*ListPublicBookmarksResponse, error)
+	// CreateAvatarUpload 签发限时、限大小与类型的头像直传地址。
+	CreateAvatarUpload(context.Context, *CreateAvatarUploadRequest) (*CreateAvatarUploadResponse, error)
+	// ConfirmAvatarUpload 校验已上传的头像文件并写入档案 avatar_url。
+	ConfirmAvatarUpload(context.Context, *ConfirmAvatarUploadRequest) (*ConfirmAvatarUploadResponse, error)
+	// CreateCollection 新建收藏夹，名称在同一用户下大小写不敏感唯一。
+	CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error)
+	// ListCollections 按创建时间升序分页返回收藏夹。
+	ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error)
+	// RenameCollection 重命名收藏夹。
+	RenameCollection(context.Context, *RenameCollectionRequest) (*RenameCollectionResponse, error)
+	// DeleteCollection 删除收藏夹及其全部成员。
+	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
+	// AddCollectionItem 将视频追加到收藏夹末尾，重复加入视为成功。
+	AddCollectionItem(context.Context, *AddCollectionItemRequest) (*AddCollectionItemResponse, error)
+	// RemoveCollectionItem 将视频移出收藏夹。
+	RemoveCollectionItem(context.Context, *RemoveCollectionItemRequest) (*RemoveCollectionItemResponse, error)
+	// ReorderCollectionItems 按给定顺序重排收藏夹成员，video_ids 须与当前成员完全一致。
+	ReorderCollectionItems(context.Context, *ReorderCollectionItemsRequest) (*ReorderCollectionItemsResponse, error)
+	// MoveCollectionItem 将视频从当前收藏夹移动到目标收藏夹末尾。
+	MoveCollectionItem(context.Context, *MoveCollectionItemRequest) (*MoveCollectionItemResponse, error)
+	// ListCollectionItems 按排序位置分页返回收藏夹成员，视频元数据来自 videos_projection。
+	ListCollectionItems(context.Context, *ListCollectionItemsRequest) (*ListCollectionItemsResponse, error)
+	// AddEngagementNote 为已收藏的视频添加私有笔记，可选记录视频内时间点。
+	AddEngagementNote(context.Context, *AddEngagementNoteRequest) (*AddEngagementNoteResponse, error)
+	// UpdateEngagementNote 整体替换笔记正文与时间点。
+	UpdateEngagementNote(context.Context, *UpdateEngagementNoteRequest) (*UpdateEngagementNoteResponse, error)
+	// DeleteEngagementNote 删除笔记。
+	DeleteEngagementNote(context.Context, *DeleteEngagementNoteRequest) (*DeleteEngagementNoteResponse, error)
 	mustEmbedUnimplementedProfileServiceServer()
 }
 
@@ -215,6 +515,66 @@ func (UnimplementedProfileServiceServer) ListWatchHistory(context.Context, *List
 func (UnimplementedProfileServiceServer) PurgeUserData(context.Context, *PurgeUserDataRequest) (*PurgeUserDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PurgeUserData not implemented")
 }
+func (UnimplementedProfileServiceServer) SuspendAccount(context.Context, *SuspendAccountRequest) (*SuspendAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendAccount not implemented")
+}
+func (UnimplementedProfileServiceServer) ReactivateAccount(context.Context, *ReactivateAccountRequest) (*ReactivateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactivateAccount not implemented")
+}
+func (UnimplementedProfileServiceServer) ListAuditEntries(context.Context, *ListAuditEntriesRequest) (*ListAuditEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEntries not implemented")
+}
+func (UnimplementedProfileServiceServer) UpdateVisibility(context.Context, *UpdateVisibilityRequest) (*UpdateVisibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVisibility not implemented")
+}
+func (UnimplementedProfileServiceServer) GetPublicProfile(context.Context, *GetPublicProfileRequest) (*GetPublicProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicProfile not implemented")
+}
+func (UnimplementedProfileServiceServer) ListPublicBookmarks(context.Context, *ListPublicBookmarksRequest) (*ListPublicBookmarksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPublicBookmarks not implemented")
+}
+func (UnimplementedProfileServiceServer) CreateAvatarUpload(context.Context, *CreateAvatarUploadRequest) (*CreateAvatarUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAvatarUpload not implemented")
+}
+func (UnimplementedProfileServiceServer) ConfirmAvatarUpload(context.Context, *ConfirmAvatarUploadRequest) (*ConfirmAvatarUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmAvatarUpload not implemented")
+}
+func (UnimplementedProfileServiceServer) CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedProfileServiceServer) ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollections not implemented")
+}
+func (UnimplementedProfileServiceServer) RenameCollection(context.Context, *RenameCollectionRequest) (*RenameCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameCollection not implemented")
+}
+func (UnimplementedProfileServiceServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
+}
+func (UnimplementedProfileServiceServer) AddCollectionItem(context.Context, *AddCollectionItemRequest) (*AddCollectionItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollectionItem not implemented")
+}
+func (UnimplementedProfileServiceServer) RemoveCollectionItem(context.Context, *RemoveCollectionItemRequest) (*RemoveCollectionItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollectionItem not implemented")
+}
+func (UnimplementedProfileServiceServer) ReorderCollectionItems(context.Context, *ReorderCollectionItemsRequest) (*ReorderCollectionItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderCollectionItems not implemented")
+}
+func (UnimplementedProfileServiceServer) MoveCollectionItem(context.Context, *MoveCollectionItemRequest) (*MoveCollectionItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCollectionItem not implemented")
+}
+func (UnimplementedProfileServiceServer) ListCollectionItems(context.Context, *ListCollectionItemsRequest) (*ListCollectionItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollectionItems not implemented")
+}
+func (UnimplementedProfileServiceServer) AddEngagementNote(context.Context, *AddEngagementNoteRequest) (*AddEngagementNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEngagementNote not implemented")
+}
+func (UnimplementedProfileServiceServer) UpdateEngagementNote(context.Context, *UpdateEngagementNoteRequest) (*UpdateEngagementNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEngagementNote not implemented")
+}
+func (UnimplementedProfileServiceServer) DeleteEngagementNote(context.Context, *DeleteEngagementNoteRequest) (*DeleteEngagementNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEngagementNote not implemented")
+}
 func (UnimplementedProfileServiceServer) mustEmbedUnimplementedProfileServiceServer() {}
 func (UnimplementedProfileServiceServer) testEmbeddedByValue()                        {}
 
@@ -398,6 +758,366 @@ func _ProfileService_PurgeUserData_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProfileService_SuspendAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).SuspendAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_SuspendAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).SuspendAccount(ctx, req.(*SuspendAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_ReactivateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactivateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ReactivateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_ReactivateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ReactivateAccount(ctx, req.(*ReactivateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_ListAuditEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ListAuditEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_ListAuditEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ListAuditEntries(ctx, req.(*ListAuditEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_UpdateVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVisibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).UpdateVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_UpdateVisibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).UpdateVisibility(ctx, req.(*UpdateVisibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_GetPublicProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).GetPublicProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_GetPublicProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).GetPublicProfile(ctx, req.(*GetPublicProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_ListPublicBookmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPublicBookmarksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ListPublicBookmarks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_ListPublicBookmarks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ListPublicBookmarks(ctx, req.(*ListPublicBookmarksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_CreateAvatarUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAvatarUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).CreateAvatarUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_CreateAvatarUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).CreateAvatarUpload(ctx, req.(*CreateAvatarUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_ConfirmAvatarUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmAvatarUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ConfirmAvatarUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_ConfirmAvatarUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ConfirmAvatarUpload(ctx, req.(*ConfirmAvatarUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_CreateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_ListCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ListCollections(ctx, req.(*ListCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_RenameCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).RenameCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_RenameCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).RenameCollection(ctx, req.(*RenameCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).DeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_DeleteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_AddCollectionItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCollectionItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).AddCollectionItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_AddCollectionItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).AddCollectionItem(ctx, req.(*AddCollectionItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_RemoveCollectionItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCollectionItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).RemoveCollectionItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_RemoveCollectionItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).RemoveCollectionItem(ctx, req.(*RemoveCollectionItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_ReorderCollectionItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderCollectionItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ReorderCollectionItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_ReorderCollectionItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ReorderCollectionItems(ctx, req.(*ReorderCollectionItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_MoveCollectionItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCollectionItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).MoveCollectionItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_MoveCollectionItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).MoveCollectionItem(ctx, req.(*MoveCollectionItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_ListCollectionItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ListCollectionItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_ListCollectionItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ListCollectionItems(ctx, req.(*ListCollectionItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_AddEngagementNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEngagementNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).AddEngagementNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_AddEngagementNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).AddEngagementNote(ctx, req.(*AddEngagementNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_UpdateEngagementNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEngagementNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).UpdateEngagementNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_UpdateEngagementNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).UpdateEngagementNote(ctx, req.(*UpdateEngagementNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_DeleteEngagementNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEngagementNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).DeleteEngagementNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_DeleteEngagementNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).DeleteEngagementNote(ctx, req.(*DeleteEngagementNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProfileService_ServiceDesc is the grpc.ServiceDesc for ProfileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -441,6 +1161,86 @@ var ProfileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PurgeUserData",
 			Handler:    _ProfileService_PurgeUserData_Handler,
 		},
+		{
+			MethodName: "SuspendAccount",
+			Handler:    _ProfileService_SuspendAccount_Handler,
+		},
+		{
+			MethodName: "ReactivateAccount",
+			Handler:    _ProfileService_ReactivateAccount_Handler,
+		},
+		{
+			MethodName: "ListAuditEntries",
+			Handler:    _ProfileService_ListAuditEntries_Handler,
+		},
+		{
+			MethodName: "UpdateVisibility",
+			Handler:    _ProfileService_UpdateVisibility_Handler,
+		},
+		{
+			MethodName: "GetPublicProfile",
+			Handler:    _ProfileService_GetPublicProfile_Handler,
+		},
+		{
+			MethodName: "ListPublicBookmarks",
+			Handler:    _ProfileService_ListPublicBookmarks_Handler,
+		},
+		{
+			MethodName: "CreateAvatarUpload",
+			Handler:    _ProfileService_CreateAvatarUpload_Handler,
+		},
+		{
+			MethodName: "ConfirmAvatarUpload",
+			Handler:    _ProfileService_ConfirmAvatarUpload_Handler,
+		},
+		{
+			MethodName: "CreateCollection",
+			Handler:    _ProfileService_CreateCollection_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _ProfileService_ListCollections_Handler,
+		},
+		{
+			MethodName: "RenameCollection",
+			Handler:    _ProfileService_RenameCollection_Handler,
+		},
+		{
+			MethodName: "DeleteCollection",
+			Handler:    _ProfileService_DeleteCollection_Handler,
+		},
+		{
+			MethodName: "AddCollectionItem",
+			Handler:    _ProfileService_AddCollectionItem_Handler,
+		},
+		{
+			MethodName: "RemoveCollectionItem",
+			Handler:    _ProfileService_RemoveCollectionItem_Handler,
+		},
+		{
+			MethodName: "ReorderCollectionItems",
+			Handler:    _ProfileService_ReorderCollectionItems_Handler,
+		},
+		{
+			MethodName: "MoveCollectionItem",
+			Handler:    _ProfileService_MoveCollectionItem_Handler,
+		},
+		{
+			MethodName: "ListCollectionItems",
+			Handler:    _ProfileService_ListCollectionItems_Handler,
+		},
+		{
+			MethodName: "AddEngagementNote",
+			Handler:    _ProfileService_AddEngagementNote_Handler,
+		},
+		{
+			MethodName: "UpdateEngagementNote",
+			Handler:    _ProfileService_UpdateEngagementNote_Handler,
+		},
+		{
+			MethodName: "DeleteEngagementNote",
+			Handler:    _ProfileService_DeleteEngagementNote_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/profile/v1/profile.proto",
@@ -9,38 +9,55 @@ import (
 	"sync"
 
 	configloader "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/configloader"
+	healthcheck "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/health_check"
+	outboxtasks "github.com/bionicotaku/lingo-services-profile/internal/tasks/outbox"
 	obswire "github.com/bionicotaku/lingo-utils/observability"
 	outboxpublisher "github.com/bionicotaku/lingo-utils/outbox/publisher"
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/go-kratos/kratos/v2/transport/http"
 
 	_ "go.uber.org/automaxprocs" // 自动设置 GOMAXPROCS 为容器 CPU 配额
 )
 
-// newApp 负责组装 Kratos 应用：注入观测组件、日志器、服务元信息以及 gRPC Server。
+// newApp 负责组装 Kratos 应用：注入观测组件、日志器、服务元信息以及 gRPC/HTTP Server。
 //
 // 参数：
 //   - obsCmp: 可观测性组件（Tracer/Meter Provider），Wire 自动管理生命周期
 //   - logger: 结构化日志器（gclog），包含 trace_id/span_id 关联
 //   - gs: 配置完整的 gRPC Server（已注册 Handler 和中间件）
+//   - hs: HTTP/JSON 网关，未配置 server.http.addr 时为空
 //   - meta: 服务元信息（Name/Version/Environment/InstanceID）
+//   - publisher: Outbox 发布器，为空时不启动
+//   - retention: 已发布 Outbox 事件的保留期清理，为空时不启动
+//   - observer: Outbox 积压观察器，周期性导出 profile_outbox_* gauge，为空时不启动
+//   - monitor: 健康检查监视器，随应用启动周期性探测
 //
 // 返回 kratos.App 实例，调用 app.Run() 启动服务并阻塞直到收到停止信号。
 func newApp(
 	_ *obswire.Component,
 	logger log.Logger,
 	gs *grpc.Server,
+	hs *http.Server,
 	meta configloader.ServiceInfo,
 	publisher *outboxpublisher.Runner,
+	retention *outboxtasks.RetentionWorker,
+	observer *outboxtasks.Observer,
+	monitor *healthcheck.Monitor,
 ) *kratos.App {
+	servers := []transport.Server{gs}
+	if hs != nil {
+		servers = append(servers, hs)
+	}
 	options := []kratos.Option{
 		kratos.ID(meta.InstanceID),
 		kratos.Name(meta.Name),
 		kratos.Version(meta.Version),
 		kratos.Metadata(map[string]string{"environment": meta.Environment}),
 		kratos.Logger(logger),
-		kratos.Server(gs),
+		kratos.Server(servers...),
 	}
 
 	type worker struct {
@@ -49,8 +66,17 @@ func newApp(
 	}
 
 	var workers []worker
+	if monitor != nil {
+		workers = append(workers, worker{name: "health monitor", run: monitor.Run})
+	}
 	if publisher != nil {
 		workers = append(workers, worker{name: "outbox publisher", run: publisher.Run})
+		if retention != nil {
+			workers = append(workers, worker{name: "outbox retention", run: retention.Run})
+		}
+	}
+	if observer != nil {
+		workers = append(workers, worker{name: "outbox observer", run: observer.Run})
 	}
 	if len(workers) > 0 {
 		var (
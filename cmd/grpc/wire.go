@@ -12,19 +12,25 @@ import (
 
 	"github.com/bionicotaku/lingo-services-profile/internal/controllers"
 	configloader "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/configloader"
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/eventbus"
 	grpcserver "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/grpc_server"
+	healthcheck "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/health_check"
+	httpserver "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/http_server"
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/objectstore"
+	ratelimiter "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/rate_limiter"
+	servermiddleware "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/server_middleware"
 	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
 	"github.com/bionicotaku/lingo-services-profile/internal/services"
 	outboxtasks "github.com/bionicotaku/lingo-services-profile/internal/tasks/outbox"
 
 	"github.com/bionicotaku/lingo-utils/gcjwt"
 	"github.com/bionicotaku/lingo-utils/gclog"
-	"github.com/bionicotaku/lingo-utils/gcpubsub"
 	obswire "github.com/bionicotaku/lingo-utils/observability"
 	"github.com/bionicotaku/lingo-utils/pgxpoolx"
 	"github.com/bionicotaku/lingo-utils/txmanager"
 	"github.com/go-kratos/kratos/v2"
 	"github.com/google/wire"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // wireApp 构建整个 Kratos 应用，分阶段装配依赖。
@@ -35,18 +41,23 @@ import (
 //  1. 配置加载: configloader.ProviderSet 解析配置并派生组件配置
 //  2. 基础设施: gclog → observability → gcjwt → pgxpoolx → txmanager
 //  3. 业务层: repositories → services → controllers
-//  4. 服务器: grpc_server.ProviderSet 组装 gRPC Server
+//  4. 服务器: grpc_server.ProviderSet / http_server.ProviderSet 组装 gRPC 与 HTTP Server
 //  5. 应用: newApp 创建 Kratos App
 func wireApp(context.Context, configloader.Params) (*kratos.App, func(), error) {
 	panic(wire.Build(
-		configloader.ProviderSet, // 配置加载与解析
-		gclog.ProviderSet,        // 结构化日志
-		gcjwt.ProviderSet,        // JWT 认证中间件
-		obswire.ProviderSet,      // OpenTelemetry 追踪和指标
-		pgxpoolx.ProviderSet,     // PostgreSQL 连接池
-		txmanager.ProviderSet,    // 事务管理器
-		gcpubsub.ProviderSet,     // Pub/Sub 发布与订阅
-		grpcserver.ProviderSet,   // gRPC Server
+		configloader.ProviderSet,     // 配置加载与解析
+		gclog.ProviderSet,            // 结构化日志
+		gcjwt.ProviderSet,            // JWT 认证中间件
+		obswire.ProviderSet,          // OpenTelemetry 追踪和指标
+		pgxpoolx.ProviderSet,         // PostgreSQL 连接池
+		txmanager.ProviderSet,        // 事务管理器
+		eventbus.ProviderSet,         // 事件总线（gcp / memory / postgres）
+		grpcserver.ProviderSet,       // gRPC Server
+		httpserver.ProviderSet,       // HTTP/JSON 网关（google.api.http）
+		servermiddleware.ProviderSet, // protovalidate 请求校验器
+		ratelimiter.ProviderSet,      // 按用户 × RPC 的令牌桶限流
+		healthcheck.ProviderSet,      // grpc.health.v1 探测
+		objectstore.ProviderSet,      // 头像对象存储
 		// grpcclient.ProviderSet, // 暂时不使用, 未来需要调用外部 gRPC 服务时再启用
 		// clients.ProviderSet,    // 暂时不使用, 未来需要调用外部服务时再启用
 		repositories.ProviderSet, // 数据访问层（sqlc）
@@ -59,13 +70,25 @@ func wireApp(context.Context, configloader.Params) (*kratos.App, func(), error)
 		wire.Bind(new(services.OutboxEnqueuer), new(*repositories.OutboxRepository)),
 		wire.Bind(new(services.VideoProjectionRepository), new(*repositories.ProfileVideoProjectionRepository)),
 		wire.Bind(new(services.VideoStatsRepository), new(*repositories.ProfileVideoStatsRepository)),
+		wire.Bind(new(services.AuditTrailRepository), new(*repositories.AuditTrailRepository)),
+		wire.Bind(new(services.CollectionsRepository), new(*repositories.ProfileCollectionsRepository)),
+		wire.Bind(new(services.EngagementNotesRepository), new(*repositories.ProfileEngagementNotesRepository)),
+		wire.Bind(new(services.AvatarObjectStore), new(objectstore.Store)),
+		wire.Bind(new(healthcheck.DatabasePinger), new(*pgxpool.Pool)),
+		wire.Bind(new(healthcheck.OutboxBacklog), new(*repositories.OutboxRepository)),
+		wire.Bind(new(healthcheck.InboxBacklog), new(*repositories.InboxRepository)),
 		wire.Bind(new(services.ProfileServiceInterface), new(*services.ProfileService)),
 		wire.Bind(new(services.EngagementServiceInterface), new(*services.EngagementService)),
 		wire.Bind(new(services.WatchHistoryServiceInterface), new(*services.WatchHistoryService)),
+		wire.Bind(new(services.CollectionServiceInterface), new(*services.CollectionService)),
+		wire.Bind(new(services.EngagementNoteServiceInterface), new(*services.EngagementNoteService)),
 		wire.Bind(new(services.VideoProjectionServiceInterface), new(*services.VideoProjectionService)),
 		wire.Bind(new(services.VideoStatsServiceInterface), new(*services.VideoStatsService)),
 		controllers.ProviderSet, // 控制器层（gRPC handlers）
+		outboxtasks.ProvideRouter,
 		outboxtasks.ProvideRunner,
+		outboxtasks.ProvideRetentionWorker,
+		outboxtasks.ProvideObserver,
 		newApp, // 组装 Kratos 应用
 	))
 }
@@ -176,10 +199,28 @@ func wireApp(context.Context, configloader.Params) (*kratos.App, func(), error)
 // │ 7. gRPC Server 层 (grpcserver.ProviderSet)                              │
 // └─────────────────────────────────────────────────────────────────────────┘
 //
+//   - servermiddleware.NewValidator() (*protovalidate.Validator, error)
+//       构建共享的 protovalidate 校验器，按 profile.proto 中的 buf.validate 注解校验请求。
+//
+//   - ratelimiter.NewLimiter(ratelimiter.Config) *ratelimiter.Limiter
+//       按 server.rate_limit 构建「用户 × RPC」令牌桶；未启用时返回 nil，中间件直接放行。
+//
 //   - grpcserver.NewGRPCServer(*configpb.Server, *observability.MetricsConfig,
-//                               gcjwt.ServerMiddleware, *controllers.VideoHandler,
-//                               log.Logger) *grpc.Server
-//       构建 gRPC Server，注入指标、日志、JWT 等中间件。
+//                               gcjwt.ServerMiddleware, *protovalidate.Validator,
+//                               *ratelimiter.Limiter, *controllers.VideoHandler,
+//                               *healthcheck.Monitor, log.Logger) *grpc.Server
+//       构建 gRPC Server，注入指标、日志、JWT 等中间件，并注册 grpc.health.v1。
+//
+//   - healthcheck.NewMonitor(healthcheck.Config, healthcheck.DatabasePinger,
+//                            healthcheck.OutboxBacklog, healthcheck.InboxBacklog,
+//                            log.Logger) *healthcheck.Monitor
+//       周期性 Ping 连接池并检查 Outbox 积压/Inbox 滞后，维护整体与 ProfileService 的服务状态。
+//
+//   - httpserver.NewHTTPServer(configloader.ServerConfig, gcjwt.ServerMiddleware,
+//                              *protovalidate.Validator, *ratelimiter.Limiter,
+//                              *controllers.ProfileHandler, objectstore.Store,
+//                              objectstore.Config, log.Logger) *http.Server
+//       按 google.api.http 注解注册 REST 路由，复用 gRPC 的中间件链；未配置地址时返回 nil。
 //
 // ┌─────────────────────────────────────────────────────────────────────────┐
 // │ 8. 业务层 (repositories/services/controllers)                           │
@@ -205,6 +246,9 @@ func wireApp(context.Context, configloader.Params) (*kratos.App, func(), error)
 //       组装视频业务用例，协调仓储访问及 Outbox 写入。
 //       注: VideoRepo / OutboxRepo 接口通过 wire.Bind 绑定到对应 Repository 实现。
 //
+//   - services.NewAuditRecorder(services.AuditTrailRepository) *services.AuditRecorder
+//       在写操作事务内追加 profile.audit_trail 记录，注入 ProfileService 与 EngagementService。
+//
 //   - controllers.NewLifecycleHandler(*services.LifecycleService, *controllers.BaseHandler) *controllers.LifecycleHandler
 //   - controllers.NewVideoQueryHandler(*services.VideoQueryService, *controllers.BaseHandler) *controllers.VideoQueryHandler
 //       构造视频控制层，为 gRPC handler 提供入口。
@@ -10,13 +10,18 @@ import (
 	"context"
 	"github.com/bionicotaku/lingo-services-profile/internal/controllers"
 	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/configloader"
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/eventbus"
 	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/grpc_server"
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/health_check"
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/http_server"
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/objectstore"
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/rate_limiter"
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/server_middleware"
 	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
 	"github.com/bionicotaku/lingo-services-profile/internal/services"
 	"github.com/bionicotaku/lingo-services-profile/internal/tasks/outbox"
 	"github.com/bionicotaku/lingo-utils/gcjwt"
 	"github.com/bionicotaku/lingo-utils/gclog"
-	"github.com/bionicotaku/lingo-utils/gcpubsub"
 	"github.com/bionicotaku/lingo-utils/observability"
 	"github.com/bionicotaku/lingo-utils/pgxpoolx"
 	"github.com/bionicotaku/lingo-utils/txmanager"
@@ -37,7 +42,7 @@ import (
 //  1. 配置加载: configloader.ProviderSet 解析配置并派生组件配置
 //  2. 基础设施: gclog → observability → gcjwt → pgxpoolx → txmanager
 //  3. 业务层: repositories → services → controllers
-//  4. 服务器: grpc_server.ProviderSet 组装 gRPC Server
+//  4. 服务器: grpc_server.ProviderSet / http_server.ProviderSet 组装 gRPC 与 HTTP Server
 //  5. 应用: newApp 创建 Kratos App
 func wireApp(contextContext context.Context, params configloader.Params) (*kratos.App, func(), error) {
 	runtimeConfig, err := configloader.LoadRuntimeConfig(params)
@@ -74,6 +79,15 @@ func wireApp(contextContext context.Context, params configloader.Params) (*krato
 		cleanup()
 		return nil, nil, err
 	}
+	validator, err := servermiddleware.NewValidator()
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	ratelimiterConfig := configloader.ProvideRateLimitConfig(runtimeConfig)
+	limiter := ratelimiter.NewLimiter(ratelimiterConfig)
 	databaseConfig := configloader.ProvideDatabaseConfig(runtimeConfig)
 	pgxpoolxConfig := configloader.ProvidePgxConfig(databaseConfig)
 	pgxpoolxComponent, cleanup4, err := pgxpoolx.ProvideComponent(contextContext, pgxpoolxConfig, logger)
@@ -95,25 +109,60 @@ func wireApp(contextContext context.Context, params configloader.Params) (*krato
 		return nil, nil, err
 	}
 	manager := txmanager.ProvideManager(txmanagerComponent)
-	profileService := services.NewProfileService(profileUsersRepository, manager, logger)
+	objectstoreConfig := configloader.ProvideAvatarStoreConfig(runtimeConfig)
+	store, err := objectstore.ProvideStore(objectstoreConfig)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	avatarPolicy := configloader.ProvideAvatarPolicy(runtimeConfig)
+	displayNamePolicy := configloader.ProvideDisplayNamePolicy(runtimeConfig)
+	displayNameModerator := services.ProvideDisplayNameModerator(displayNamePolicy)
+	auditTrailRepository := repositories.NewAuditTrailRepository(pool, logger)
+	auditRecorder := services.NewAuditRecorder(auditTrailRepository)
+	profileService := services.ProvideProfileService(profileUsersRepository, manager, logger, store, avatarPolicy, displayNamePolicy, displayNameModerator, auditRecorder)
 	profileEngagementsRepository := repositories.NewProfileEngagementsRepository(pool, logger)
 	profileVideoStatsRepository := repositories.NewProfileVideoStatsRepository(pool, logger)
 	messagingConfig := configloader.ProvideMessagingConfig(runtimeConfig)
 	configConfig := configloader.ProvideOutboxConfig(messagingConfig)
 	outboxRepository := repositories.NewOutboxRepository(pool, logger, configConfig)
-	engagementService := services.NewEngagementService(profileEngagementsRepository, profileVideoStatsRepository, outboxRepository, manager, logger)
+	engagementService := services.ProvideEngagementService(profileEngagementsRepository, profileVideoStatsRepository, outboxRepository, manager, logger, auditRecorder)
 	profileWatchLogsRepository := repositories.NewProfileWatchLogsRepository(pool, logger)
 	watchHistoryService := services.NewWatchHistoryService(profileWatchLogsRepository, profileVideoStatsRepository, outboxRepository, manager, logger)
 	profileVideoProjectionRepository := repositories.NewProfileVideoProjectionRepository(pool, logger)
 	videoProjectionService := services.NewVideoProjectionService(profileVideoProjectionRepository, logger)
 	videoStatsService := services.NewVideoStatsService(profileVideoStatsRepository, logger)
+	profileCollectionsRepository := repositories.NewProfileCollectionsRepository(pool, logger)
+	collectionService := services.ProvideCollectionService(profileCollectionsRepository, outboxRepository, manager, logger, auditRecorder)
+	profileEngagementNotesRepository := repositories.NewProfileEngagementNotesRepository(pool, logger)
+	engagementNoteService := services.ProvideEngagementNoteService(profileEngagementNotesRepository, profileEngagementsRepository, manager, logger, auditRecorder)
+	adminPolicy, err := configloader.ProvideAdminPolicy(runtimeConfig)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	handlerTimeouts := configloader.ProvideHandlerTimeouts(runtimeConfig)
 	baseHandler := controllers.NewBaseHandler(handlerTimeouts)
-	profileHandler := controllers.NewProfileHandler(profileService, engagementService, watchHistoryService, videoProjectionService, videoStatsService, baseHandler)
-	server := grpcserver.NewGRPCServer(serverConfig, metricsConfig, serverMiddleware, profileHandler, logger)
+	profileHandler := controllers.ProvideProfileHandler(profileService, engagementService, watchHistoryService, videoProjectionService, videoStatsService, collectionService, engagementNoteService, adminPolicy, baseHandler)
+	healthcheckConfig := configloader.ProvideHealthConfig(runtimeConfig, configConfig)
+	inboxRepository := repositories.NewInboxRepository(pool, logger, configConfig)
+	monitor := healthcheck.NewMonitor(healthcheckConfig, pool, outboxRepository, inboxRepository, logger)
+	server := grpcserver.NewGRPCServer(serverConfig, metricsConfig, serverMiddleware, validator, limiter, profileHandler, monitor, logger)
+	httpServer := httpserver.NewHTTPServer(serverConfig, serverMiddleware, validator, limiter, profileHandler, store, objectstoreConfig, logger)
+	routingConfig := configloader.ProvideOutboxRouting(messagingConfig)
+	eventbusConfig := configloader.ProvideEventBusConfig(messagingConfig)
 	gcpubsubConfig := configloader.ProvidePubSubConfig(messagingConfig)
 	dependencies := configloader.ProvidePubSubDependencies(logger)
-	gcpubsubComponent, cleanup6, err := gcpubsub.NewComponent(contextContext, gcpubsubConfig, dependencies)
+	eventBusRepository := repositories.NewEventBusRepository(pool, logger)
+	bus, cleanup6, err := eventbus.NewBus(contextContext, eventbusConfig, gcpubsubConfig, dependencies, pool, eventBusRepository, logger)
 	if err != nil {
 		cleanup5()
 		cleanup4()
@@ -122,10 +171,24 @@ func wireApp(contextContext context.Context, params configloader.Params) (*krato
 		cleanup()
 		return nil, nil, err
 	}
-	publisher := gcpubsub.ProvidePublisher(gcpubsubComponent)
-	runner := outbox.ProvideRunner(outboxRepository, publisher, gcpubsubConfig, configConfig, logger)
-	app := newApp(observabilityComponent, logger, server, serviceInfo, runner)
+	publisher := eventbus.ProvidePublisher(bus)
+	router, cleanup7, err := outbox.ProvideRouter(contextContext, routingConfig, publisher, bus, outboxRepository, logger)
+	if err != nil {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	runner := outbox.ProvideRunner(outboxRepository, router, gcpubsubConfig, configConfig, logger)
+	retentionPolicy := configloader.ProvideOutboxRetentionPolicy(messagingConfig)
+	retentionWorker := outbox.ProvideRetentionWorker(outboxRepository, retentionPolicy, logger)
+	observer := outbox.ProvideObserver(outboxRepository, configConfig, logger)
+	app := newApp(observabilityComponent, logger, server, httpServer, serviceInfo, runner, retentionWorker, observer, monitor)
 	return app, func() {
+		cleanup7()
 		cleanup6()
 		cleanup5()
 		cleanup4()
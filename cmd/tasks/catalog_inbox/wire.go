@@ -9,11 +9,11 @@ import (
 	"fmt"
 
 	configloader "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/configloader"
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/eventbus"
 	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
 	cataloginbox "github.com/bionicotaku/lingo-services-profile/internal/tasks/catalog_inbox"
 
 	"github.com/bionicotaku/lingo-utils/gclog"
-	"github.com/bionicotaku/lingo-utils/gcpubsub"
 	obswire "github.com/bionicotaku/lingo-utils/observability"
 	"github.com/bionicotaku/lingo-utils/pgxpoolx"
 	"github.com/bionicotaku/lingo-utils/txmanager"
@@ -26,6 +26,10 @@ import (
 var catalogInboxRepoSet = wire.NewSet(
 	repositories.NewInboxRepository,
 	repositories.NewProfileVideoProjectionRepository,
+	repositories.NewPendingVideoEventsRepository,
+	repositories.NewSubscriptionOffsetsRepository,
+	repositories.NewInboxQuarantineRepository,
+	repositories.NewEventBusRepository,
 )
 
 func wireCatalogInboxTask(context.Context, configloader.Params) (*catalogInboxApp, func(), error) {
@@ -35,7 +39,7 @@ func wireCatalogInboxTask(context.Context, configloader.Params) (*catalogInboxAp
 		obswire.ProviderSet,
 		pgxpoolx.ProviderSet,
 		txmanager.ProviderSet,
-		gcpubsub.ProviderSet,
+		eventbus.ProviderSet,
 		catalogInboxRepoSet,
 		cataloginbox.ProvideTask,
 		newCatalogInboxApp,
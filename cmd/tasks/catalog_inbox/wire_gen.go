@@ -10,10 +10,10 @@ import (
 	"context"
 	"fmt"
 	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/configloader"
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/eventbus"
 	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
 	"github.com/bionicotaku/lingo-services-profile/internal/tasks/catalog_inbox"
 	"github.com/bionicotaku/lingo-utils/gclog"
-	"github.com/bionicotaku/lingo-utils/gcpubsub"
 	"github.com/bionicotaku/lingo-utils/observability"
 	"github.com/bionicotaku/lingo-utils/pgxpoolx"
 	"github.com/bionicotaku/lingo-utils/txmanager"
@@ -43,28 +43,33 @@ func wireCatalogInboxTask(contextContext context.Context, params configloader.Pa
 		return nil, nil, err
 	}
 	messagingConfig := configloader.ProvideMessagingConfig(runtimeConfig)
+	eventbusConfig := configloader.ProvideEventBusConfig(messagingConfig)
 	gcpubsubConfig := configloader.ProvidePubSubConfig(messagingConfig)
 	dependencies := configloader.ProvidePubSubDependencies(logger)
-	gcpubsubComponent, cleanup3, err := gcpubsub.NewComponent(contextContext, gcpubsubConfig, dependencies)
+	databaseConfig := configloader.ProvideDatabaseConfig(runtimeConfig)
+	pgxpoolxConfig := configloader.ProvidePgxConfig(databaseConfig)
+	pgxpoolxComponent, cleanup3, err := pgxpoolx.ProvideComponent(contextContext, pgxpoolxConfig, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	subscriber := gcpubsub.ProvideSubscriber(gcpubsubComponent)
-	databaseConfig := configloader.ProvideDatabaseConfig(runtimeConfig)
-	pgxpoolxConfig := configloader.ProvidePgxConfig(databaseConfig)
-	pgxpoolxComponent, cleanup4, err := pgxpoolx.ProvideComponent(contextContext, pgxpoolxConfig, logger)
+	pool := pgxpoolx.ProvidePool(pgxpoolxComponent)
+	eventBusRepository := repositories.NewEventBusRepository(pool, logger)
+	bus, cleanup4, err := eventbus.NewBus(contextContext, eventbusConfig, gcpubsubConfig, dependencies, pool, eventBusRepository, logger)
 	if err != nil {
 		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	pool := pgxpoolx.ProvidePool(pgxpoolxComponent)
+	subscriber := eventbus.ProvideSubscriber(bus)
 	configConfig := configloader.ProvideOutboxConfig(messagingConfig)
 	inboxRepository := repositories.NewInboxRepository(pool, logger, configConfig)
 	profileVideoProjectionRepository := repositories.NewProfileVideoProjectionRepository(pool, logger)
+	pendingVideoEventsRepository := repositories.NewPendingVideoEventsRepository(pool, logger)
+	subscriptionOffsetsRepository := repositories.NewSubscriptionOffsetsRepository(pool, logger)
+	inboxQuarantineRepository := repositories.NewInboxQuarantineRepository(pool, logger)
 	txmanagerConfig := configloader.ProvideTxConfig(runtimeConfig)
 	txmanagerComponent, cleanup5, err := txmanager.NewComponent(txmanagerConfig, pool, logger)
 	if err != nil {
@@ -75,7 +80,8 @@ func wireCatalogInboxTask(contextContext context.Context, params configloader.Pa
 		return nil, nil, err
 	}
 	manager := txmanager.ProvideManager(txmanagerComponent)
-	task := cataloginbox.ProvideTask(subscriber, inboxRepository, profileVideoProjectionRepository, manager, configConfig, logger)
+	quarantinePolicy := configloader.ProvideInboxQuarantinePolicy(messagingConfig)
+	task := cataloginbox.ProvideTask(subscriber, inboxRepository, profileVideoProjectionRepository, pendingVideoEventsRepository, subscriptionOffsetsRepository, inboxQuarantineRepository, manager, configConfig, gcpubsubConfig, quarantinePolicy, logger)
 	mainCatalogInboxApp, err := newCatalogInboxApp(observabilityComponent, logger, task)
 	if err != nil {
 		cleanup5()
@@ -96,7 +102,7 @@ func wireCatalogInboxTask(contextContext context.Context, params configloader.Pa
 
 // wire.go:
 
-var catalogInboxRepoSet = wire.NewSet(repositories.NewInboxRepository, repositories.NewProfileVideoProjectionRepository)
+var catalogInboxRepoSet = wire.NewSet(repositories.NewInboxRepository, repositories.NewProfileVideoProjectionRepository, repositories.NewPendingVideoEventsRepository, repositories.NewSubscriptionOffsetsRepository, repositories.NewInboxQuarantineRepository, repositories.NewEventBusRepository)
 
 func newCatalogInboxApp(_ *observability.Component, logger log.Logger, task *cataloginbox.Task) (*catalogInboxApp, error) {
 	if task == nil {
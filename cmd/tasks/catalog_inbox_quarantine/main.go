@@ -0,0 +1,124 @@
+// Package main 提供 Catalog Inbox 隔离区的管理入口：列出、重试或丢弃处理失败的事件。
+//
+// 用法：
+//
+//	catalog_inbox_quarantine -conf configs/config.yaml list [-status quarantined] [-limit 50]
+//	catalog_inbox_quarantine -conf configs/config.yaml retry -event <event_id>
+//	catalog_inbox_quarantine -conf configs/config.yaml discard -event <event_id>
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	configloader "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/configloader"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	cataloginbox "github.com/bionicotaku/lingo-services-profile/internal/tasks/catalog_inbox"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+type catalogInboxQuarantineApp struct {
+	Admin  *cataloginbox.QuarantineAdmin
+	Logger log.Logger
+}
+
+func main() {
+	ctx := context.Background()
+
+	confFlag := flag.String("conf", "", "config path or directory, eg: -conf configs/config.yaml")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [-conf path] list|retry|discard [flags]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	action := flag.Arg(0)
+	sub := flag.NewFlagSet(action, flag.ExitOnError)
+	status := sub.String("status", po.InboxQuarantineQuarantined, "list: filter by status (failing|quarantined|resolved|discarded, empty for all)")
+	limit := sub.Int("limit", 50, "list: maximum number of entries")
+	eventFlag := sub.String("event", "", "retry/discard: inbox event_id")
+	operator := sub.String("operator", defaultOperator(), "retry/discard: operator recorded as resolved_by")
+	_ = sub.Parse(flag.Args()[1:])
+
+	params := configloader.Params{ConfPath: *confFlag}
+	app, cleanup, err := wireCatalogInboxQuarantine(ctx, params)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	logger := app.Logger
+	if logger == nil {
+		logger = log.NewStdLogger(os.Stdout)
+	}
+	helper := log.NewHelper(logger)
+	if app.Admin == nil {
+		helper.Error("catalog inbox quarantine admin disabled (missing database dependencies)")
+		os.Exit(1)
+	}
+
+	runCtx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	switch action {
+	case "list":
+		entries, err := app.Admin.List(runCtx, *status, int32(*limit))
+		if err != nil {
+			helper.Errorf("list quarantine failed: %v", err)
+			os.Exit(1)
+		}
+		printEntries(entries)
+	case "retry", "discard":
+		eventID, err := uuid.Parse(*eventFlag)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "invalid -event: %v\n", err)
+			os.Exit(2)
+		}
+		if action == "retry" {
+			err = app.Admin.Retry(runCtx, eventID, *operator)
+		} else {
+			err = app.Admin.Discard(runCtx, eventID, *operator)
+		}
+		if err != nil {
+			helper.Errorf("%s %s failed: %v", action, eventID, err)
+			os.Exit(1)
+		}
+		helper.Infof("%s %s done", action, eventID)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func printEntries(entries []*po.InboxQuarantine) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "EVENT_ID\tEVENT_TYPE\tSTATUS\tATTEMPTS\tLAST_FAILED_AT\tLAST_ERROR")
+	for _, entry := range entries {
+		lastErr := ""
+		if entry.LastError != nil {
+			lastErr = *entry.LastError
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d/%d\t%s\t%s\n",
+			entry.EventID, entry.EventType, entry.Status, entry.Attempts, entry.MaxAttempts,
+			entry.LastFailedAt.Format(time.RFC3339), lastErr)
+	}
+	_ = w.Flush()
+}
+
+func defaultOperator() string {
+	if user := os.Getenv("USER"); user != "" {
+		return user
+	}
+	return "cli"
+}
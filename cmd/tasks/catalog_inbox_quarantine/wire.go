@@ -0,0 +1,53 @@
+//go:build wireinject
+// +build wireinject
+
+// Package main 为 catalog inbox 隔离区管理命令提供 Wire 依赖注入定义。
+package main
+
+import (
+	"context"
+	"fmt"
+
+	configloader "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/configloader"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	cataloginbox "github.com/bionicotaku/lingo-services-profile/internal/tasks/catalog_inbox"
+
+	"github.com/bionicotaku/lingo-utils/gclog"
+	obswire "github.com/bionicotaku/lingo-utils/observability"
+	"github.com/bionicotaku/lingo-utils/pgxpoolx"
+	"github.com/bionicotaku/lingo-utils/txmanager"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+)
+
+//go:generate go run github.com/google/wire/cmd/wire
+
+var catalogInboxQuarantineRepoSet = wire.NewSet(
+	repositories.NewInboxRepository,
+	repositories.NewProfileVideoProjectionRepository,
+	repositories.NewPendingVideoEventsRepository,
+	repositories.NewInboxQuarantineRepository,
+)
+
+func wireCatalogInboxQuarantine(context.Context, configloader.Params) (*catalogInboxQuarantineApp, func(), error) {
+	panic(wire.Build(
+		configloader.ProviderSet,
+		gclog.ProviderSet,
+		obswire.ProviderSet,
+		pgxpoolx.ProviderSet,
+		txmanager.ProviderSet,
+		catalogInboxQuarantineRepoSet,
+		cataloginbox.ProvideQuarantineAdmin,
+		newCatalogInboxQuarantineApp,
+	))
+}
+
+func newCatalogInboxQuarantineApp(_ *obswire.Component, logger log.Logger, admin *cataloginbox.QuarantineAdmin) (*catalogInboxQuarantineApp, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger not initialized")
+	}
+	return &catalogInboxQuarantineApp{
+		Admin:  admin,
+		Logger: logger,
+	}, nil
+}
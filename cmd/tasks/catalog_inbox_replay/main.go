@@ -0,0 +1,117 @@
+// Package main 提供 Catalog Inbox 回放任务的独立入口，
+// 按时间或事件 ID 区间读取 profile.inbox_events 中保存的载荷并重新执行投影逻辑。
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	configloader "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/configloader"
+	cataloginbox "github.com/bionicotaku/lingo-services-profile/internal/tasks/catalog_inbox"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+type catalogInboxReplayApp struct {
+	Replayer *cataloginbox.Replayer
+	Logger   log.Logger
+}
+
+func main() {
+	ctx := context.Background()
+
+	confFlag := flag.String("conf", "", "config path or directory, eg: -conf configs/config.yaml")
+	from := flag.String("from", "", "replay events received at or after this RFC3339 time")
+	to := flag.String("to", "", "replay events received at or before this RFC3339 time (default: now)")
+	fromEvent := flag.String("from-event", "", "replay starting at this inbox event_id (inclusive, overrides -from)")
+	toEvent := flag.String("to-event", "", "replay up to this inbox event_id (inclusive, overrides -to)")
+	batchSize := flag.Int("batch-size", int(cataloginbox.DefaultReplayBatchSize), "number of inbox events read per batch")
+	includeProcessed := flag.Bool("include-processed", false, "bypass dedup and re-run events that were already processed")
+	dryRun := flag.Bool("dry-run", false, "only count the events that would be replayed")
+	flag.Parse()
+
+	opts, err := buildOptions(*from, *to, *fromEvent, *toEvent)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	opts.BatchSize = int32(*batchSize)
+	opts.IncludeProcessed = *includeProcessed
+	opts.DryRun = *dryRun
+
+	params := configloader.Params{ConfPath: *confFlag}
+	app, cleanup, err := wireCatalogInboxReplay(ctx, params)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	logger := app.Logger
+	if logger == nil {
+		logger = log.NewStdLogger(os.Stdout)
+	}
+	helper := log.NewHelper(logger)
+
+	if app.Replayer == nil {
+		helper.Error("catalog inbox replay disabled (missing messaging.inbox.source_service)")
+		os.Exit(1)
+	}
+
+	runCtx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if opts.IncludeProcessed {
+		helper.Warn("dedup bypassed: already processed events will be re-applied")
+	}
+	result, err := app.Replayer.Replay(runCtx, opts)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			helper.Warnf("catalog inbox replay interrupted: scanned=%d replayed=%d last_event=%s", result.Scanned, result.Replayed, result.Last.EventID)
+			return
+		}
+		helper.Errorf("catalog inbox replay failed after %d events: %v", result.Scanned, err)
+		os.Exit(1)
+	}
+
+	helper.Infof("catalog inbox replay finished: scanned=%d replayed=%d skipped=%d failed=%d dry_run=%t",
+		result.Scanned, result.Replayed, result.Skipped, result.Failed, opts.DryRun)
+	if result.Failed > 0 {
+		os.Exit(1)
+	}
+}
+
+func buildOptions(from, to, fromEvent, toEvent string) (cataloginbox.ReplayOptions, error) {
+	var opts cataloginbox.ReplayOptions
+	var err error
+	if from != "" {
+		if opts.From, err = time.Parse(time.RFC3339Nano, from); err != nil {
+			return opts, fmt.Errorf("invalid -from: %w", err)
+		}
+	}
+	if to != "" {
+		if opts.To, err = time.Parse(time.RFC3339Nano, to); err != nil {
+			return opts, fmt.Errorf("invalid -to: %w", err)
+		}
+	}
+	if fromEvent != "" {
+		id, parseErr := uuid.Parse(fromEvent)
+		if parseErr != nil {
+			return opts, fmt.Errorf("invalid -from-event: %w", parseErr)
+		}
+		opts.FromEventID = &id
+	}
+	if toEvent != "" {
+		id, parseErr := uuid.Parse(toEvent)
+		if parseErr != nil {
+			return opts, fmt.Errorf("invalid -to-event: %w", parseErr)
+		}
+		opts.ToEventID = &id
+	}
+	return opts, nil
+}
@@ -0,0 +1,52 @@
+//go:build wireinject
+// +build wireinject
+
+// Package main 为 catalog inbox 回放任务提供 Wire 依赖注入定义。
+package main
+
+import (
+	"context"
+	"fmt"
+
+	configloader "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/configloader"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	cataloginbox "github.com/bionicotaku/lingo-services-profile/internal/tasks/catalog_inbox"
+
+	"github.com/bionicotaku/lingo-utils/gclog"
+	obswire "github.com/bionicotaku/lingo-utils/observability"
+	"github.com/bionicotaku/lingo-utils/pgxpoolx"
+	"github.com/bionicotaku/lingo-utils/txmanager"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+)
+
+//go:generate go run github.com/google/wire/cmd/wire
+
+var catalogInboxReplayRepoSet = wire.NewSet(
+	repositories.NewInboxRepository,
+	repositories.NewProfileVideoProjectionRepository,
+	repositories.NewPendingVideoEventsRepository,
+)
+
+func wireCatalogInboxReplay(context.Context, configloader.Params) (*catalogInboxReplayApp, func(), error) {
+	panic(wire.Build(
+		configloader.ProviderSet,
+		gclog.ProviderSet,
+		obswire.ProviderSet,
+		pgxpoolx.ProviderSet,
+		txmanager.ProviderSet,
+		catalogInboxReplayRepoSet,
+		cataloginbox.ProvideReplayer,
+		newCatalogInboxReplayApp,
+	))
+}
+
+func newCatalogInboxReplayApp(_ *obswire.Component, logger log.Logger, replayer *cataloginbox.Replayer) (*catalogInboxReplayApp, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger not initialized")
+	}
+	return &catalogInboxReplayApp{
+		Replayer: replayer,
+		Logger:   logger,
+	}, nil
+}
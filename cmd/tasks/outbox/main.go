@@ -1,4 +1,5 @@
-// Package main 提供 Outbox Runner 独立进程入口，便于在后台单独运行发布器。
+// Package main 提供 Outbox Runner 独立进程入口，便于在后台单独运行发布器；
+// 同时运行积压观察器（profile_outbox_* gauge），配置了 messaging.outbox.retention.max_age 时运行已发布事件的保留期清理。
 package main
 
 import (
@@ -7,16 +8,20 @@ import (
 	"flag"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 
 	configloader "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/configloader"
+	outboxtasks "github.com/bionicotaku/lingo-services-profile/internal/tasks/outbox"
 	outboxpublisher "github.com/bionicotaku/lingo-utils/outbox/publisher"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
 type outboxTaskApp struct {
-	Runner *outboxpublisher.Runner
-	Logger log.Logger
+	Runner    *outboxpublisher.Runner
+	Retention *outboxtasks.RetentionWorker
+	Observer  *outboxtasks.Observer
+	Logger    log.Logger
 }
 
 func main() {
@@ -48,7 +53,28 @@ func main() {
 	runCtx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
-	if err := app.Runner.Run(runCtx); err != nil && !errors.Is(err, context.Canceled) {
+	var wg sync.WaitGroup
+	background := map[string]func(context.Context) error{}
+	if app.Retention != nil {
+		background["outbox retention"] = app.Retention.Run
+	}
+	if app.Observer != nil {
+		background["outbox observer"] = app.Observer.Run
+	}
+	for name, run := range background {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := run(runCtx); err != nil && !errors.Is(err, context.Canceled) {
+				helper.Warnf("%s stopped: %v", name, err)
+			}
+		}()
+	}
+
+	err = app.Runner.Run(runCtx)
+	stop()
+	wg.Wait()
+	if err != nil && !errors.Is(err, context.Canceled) {
 		helper.Errorf("outbox runner stopped unexpectedly: %v", err)
 		os.Exit(1)
 	}
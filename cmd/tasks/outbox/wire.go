@@ -9,11 +9,11 @@ import (
 	"fmt"
 
 	configloader "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/configloader"
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/eventbus"
 	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
 	outboxtasks "github.com/bionicotaku/lingo-services-profile/internal/tasks/outbox"
 
 	"github.com/bionicotaku/lingo-utils/gclog"
-	"github.com/bionicotaku/lingo-utils/gcpubsub"
 	obswire "github.com/bionicotaku/lingo-utils/observability"
 	outboxpublisher "github.com/bionicotaku/lingo-utils/outbox/publisher"
 	"github.com/bionicotaku/lingo-utils/pgxpoolx"
@@ -23,7 +23,7 @@ import (
 
 //go:generate go run github.com/google/wire/cmd/wire
 
-var outboxRepositorySet = wire.NewSet(repositories.NewOutboxRepository)
+var outboxRepositorySet = wire.NewSet(repositories.NewOutboxRepository, repositories.NewEventBusRepository)
 
 func wireOutboxTask(context.Context, configloader.Params) (*outboxTaskApp, func(), error) {
 	panic(wire.Build(
@@ -31,14 +31,17 @@ func wireOutboxTask(context.Context, configloader.Params) (*outboxTaskApp, func(
 		gclog.ProviderSet,
 		obswire.ProviderSet,
 		pgxpoolx.ProviderSet,
-		gcpubsub.ProviderSet,
+		eventbus.ProviderSet,
 		outboxRepositorySet,
+		outboxtasks.ProvideRouter,
 		outboxtasks.ProvideRunner,
+		outboxtasks.ProvideRetentionWorker,
+		outboxtasks.ProvideObserver,
 		newOutboxTaskApp,
 	))
 }
 
-func newOutboxTaskApp(_ *obswire.Component, logger log.Logger, runner *outboxpublisher.Runner) (*outboxTaskApp, error) {
+func newOutboxTaskApp(_ *obswire.Component, logger log.Logger, runner *outboxpublisher.Runner, retention *outboxtasks.RetentionWorker, observer *outboxtasks.Observer) (*outboxTaskApp, error) {
 	if runner == nil {
 		return &outboxTaskApp{Logger: logger}, nil
 	}
@@ -46,7 +49,9 @@ func newOutboxTaskApp(_ *obswire.Component, logger log.Logger, runner *outboxpub
 		return nil, fmt.Errorf("logger not initialized")
 	}
 	return &outboxTaskApp{
-		Runner: runner,
-		Logger: logger,
+		Runner:    runner,
+		Retention: retention,
+		Observer:  observer,
+		Logger:    logger,
 	}, nil
 }
@@ -10,10 +10,10 @@ import (
 	"context"
 	"fmt"
 	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/configloader"
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/eventbus"
 	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
 	"github.com/bionicotaku/lingo-services-profile/internal/tasks/outbox"
 	"github.com/bionicotaku/lingo-utils/gclog"
-	"github.com/bionicotaku/lingo-utils/gcpubsub"
 	"github.com/bionicotaku/lingo-utils/observability"
 	"github.com/bionicotaku/lingo-utils/outbox/publisher"
 	"github.com/bionicotaku/lingo-utils/pgxpoolx"
@@ -54,18 +54,20 @@ func wireOutboxTask(contextContext context.Context, params configloader.Params)
 	messagingConfig := configloader.ProvideMessagingConfig(runtimeConfig)
 	configConfig := configloader.ProvideOutboxConfig(messagingConfig)
 	outboxRepository := repositories.NewOutboxRepository(pool, logger, configConfig)
+	routingConfig := configloader.ProvideOutboxRouting(messagingConfig)
+	eventbusConfig := configloader.ProvideEventBusConfig(messagingConfig)
 	gcpubsubConfig := configloader.ProvidePubSubConfig(messagingConfig)
 	dependencies := configloader.ProvidePubSubDependencies(logger)
-	gcpubsubComponent, cleanup4, err := gcpubsub.NewComponent(contextContext, gcpubsubConfig, dependencies)
+	eventBusRepository := repositories.NewEventBusRepository(pool, logger)
+	bus, cleanup4, err := eventbus.NewBus(contextContext, eventbusConfig, gcpubsubConfig, dependencies, pool, eventBusRepository, logger)
 	if err != nil {
 		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	publisher := gcpubsub.ProvidePublisher(gcpubsubComponent)
-	runner := outbox.ProvideRunner(outboxRepository, publisher, gcpubsubConfig, configConfig, logger)
-	mainOutboxTaskApp, err := newOutboxTaskApp(observabilityComponent, logger, runner)
+	publisher := eventbus.ProvidePublisher(bus)
+	router, cleanup5, err := outbox.ProvideRouter(contextContext, routingConfig, publisher, bus, outboxRepository, logger)
 	if err != nil {
 		cleanup4()
 		cleanup3()
@@ -73,7 +75,21 @@ func wireOutboxTask(contextContext context.Context, params configloader.Params)
 		cleanup()
 		return nil, nil, err
 	}
+	runner := outbox.ProvideRunner(outboxRepository, router, gcpubsubConfig, configConfig, logger)
+	retentionPolicy := configloader.ProvideOutboxRetentionPolicy(messagingConfig)
+	retentionWorker := outbox.ProvideRetentionWorker(outboxRepository, retentionPolicy, logger)
+	observer := outbox.ProvideObserver(outboxRepository, configConfig, logger)
+	mainOutboxTaskApp, err := newOutboxTaskApp(observabilityComponent, logger, runner, retentionWorker, observer)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	return mainOutboxTaskApp, func() {
+		cleanup5()
 		cleanup4()
 		cleanup3()
 		cleanup2()
@@ -83,9 +99,9 @@ func wireOutboxTask(contextContext context.Context, params configloader.Params)
 
 // wire.go:
 
-var outboxRepositorySet = wire.NewSet(repositories.NewOutboxRepository)
+var outboxRepositorySet = wire.NewSet(repositories.NewOutboxRepository, repositories.NewEventBusRepository)
 
-func newOutboxTaskApp(_ *observability.Component, logger log.Logger, runner *publisher.Runner) (*outboxTaskApp, error) {
+func newOutboxTaskApp(_ *observability.Component, logger log.Logger, runner *publisher.Runner, retention *outbox.RetentionWorker, observer *outbox.Observer) (*outboxTaskApp, error) {
 	if runner == nil {
 		return &outboxTaskApp{Logger: logger}, nil
 	}
@@ -93,7 +109,9 @@ func newOutboxTaskApp(_ *observability.Component, logger log.Logger, runner *pub
 		return nil, fmt.Errorf("logger not initialized")
 	}
 	return &outboxTaskApp{
-		Runner: runner,
-		Logger: logger,
+		Runner:    runner,
+		Retention: retention,
+		Observer:  observer,
+		Logger:    logger,
 	}, nil
 }
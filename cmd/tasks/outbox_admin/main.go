@@ -0,0 +1,265 @@
+// Package main 提供 Outbox 运维入口：查看积压事件与 last_error、强制重试、移入死信以及清理已发布事件。
+//
+// 用法：
+//
+//	outbox_admin -conf configs/config.yaml list [-status pending|failing|published|dead-letter] [-min-attempts 0] [-limit 50]
+//	outbox_admin -conf configs/config.yaml show -event <event_id>
+//	outbox_admin -conf configs/config.yaml retry -event <event_id> [-reset-attempts]
+//	outbox_admin -conf configs/config.yaml dead-letter -event <event_id> [-reason text] [-operator name]
+//	outbox_admin -conf configs/config.yaml purge-published-before (-before 2025-01-01T00:00:00Z | -older-than 168h) [-mode delete|archive]
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	configloader "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/configloader"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	outboxtasks "github.com/bionicotaku/lingo-services-profile/internal/tasks/outbox"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// statusDeadLetter 为 list 子命令中表示死信表的状态值。
+const statusDeadLetter = "dead-letter"
+
+type outboxAdminApp struct {
+	Admin  *outboxtasks.Admin
+	Logger log.Logger
+}
+
+func main() {
+	ctx := context.Background()
+
+	confFlag := flag.String("conf", "", "config path or directory, eg: -conf configs/config.yaml")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [-conf path] list|show|retry|dead-letter|purge-published-before [flags]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	action := flag.Arg(0)
+	sub := flag.NewFlagSet(action, flag.ExitOnError)
+	status := sub.String("status", repositories.OutboxStatusPending, "list: filter by status (pending|failing|published|dead-letter, empty for all)")
+	minAttempts := sub.Int("min-attempts", 0, "list: only events with at least this many delivery attempts")
+	limit := sub.Int("limit", int(outboxtasks.DefaultAdminListLimit), "list: maximum number of events")
+	eventFlag := sub.String("event", "", "show/retry/dead-letter: outbox event_id")
+	resetAttempts := sub.Bool("reset-attempts", false, "retry: reset delivery_attempts to 0")
+	reason := sub.String("reason", "", "dead-letter: reason recorded with the event")
+	operator := sub.String("operator", defaultOperator(), "dead-letter: operator recorded as dead_lettered_by")
+	beforeFlag := sub.String("before", "", "purge-published-before: RFC3339 cutoff on published_at")
+	olderThan := sub.Duration("older-than", 0, "purge-published-before: cutoff relative to now, eg: 168h")
+	mode := sub.String("mode", "", "purge-published-before: delete|archive (default from messaging.outbox.retention.mode)")
+	batchSize := sub.Int("batch-size", 0, "purge-published-before: rows per batch (default from messaging.outbox.retention.batch_size)")
+	_ = sub.Parse(flag.Args()[1:])
+
+	params := configloader.Params{ConfPath: *confFlag}
+	app, cleanup, err := wireOutboxAdmin(ctx, params)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	logger := app.Logger
+	if logger == nil {
+		logger = log.NewStdLogger(os.Stdout)
+	}
+	helper := log.NewHelper(logger)
+	if app.Admin == nil {
+		helper.Error("outbox admin disabled (missing database dependencies)")
+		os.Exit(1)
+	}
+
+	runCtx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	switch action {
+	case "list":
+		if *status == statusDeadLetter {
+			items, err := app.Admin.ListDeadLetters(runCtx, int32(*limit))
+			if err != nil {
+				helper.Errorf("list outbox dead letters failed: %v", err)
+				os.Exit(1)
+			}
+			printDeadLetters(items)
+			return
+		}
+		events, err := app.Admin.List(runCtx, outboxtasks.AdminListOptions{
+			Status:      *status,
+			MinAttempts: int32(*minAttempts),
+			Limit:       int32(*limit),
+		})
+		if err != nil {
+			helper.Errorf("list outbox events failed: %v", err)
+			os.Exit(1)
+		}
+		printEvents(events)
+	case "show", "retry", "dead-letter":
+		eventID, err := uuid.Parse(*eventFlag)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "invalid -event: %v\n", err)
+			os.Exit(2)
+		}
+		switch action {
+		case "show":
+			var detail *outboxtasks.EventDetail
+			detail, err = app.Admin.Show(runCtx, eventID)
+			if err == nil {
+				printDetail(detail)
+			}
+		case "retry":
+			var requeued bool
+			requeued, err = app.Admin.Retry(runCtx, eventID, *resetAttempts)
+			if err == nil && requeued {
+				helper.Infof("requeued %s from dead letters", eventID)
+			}
+		default:
+			err = app.Admin.DeadLetter(runCtx, eventID, *reason, *operator)
+		}
+		if err != nil {
+			if errors.Is(err, repositories.ErrOutboxEventNotFound) {
+				helper.Errorf("%s %s: event not found or already published", action, eventID)
+			} else {
+				helper.Errorf("%s %s failed: %v", action, eventID, err)
+			}
+			os.Exit(1)
+		}
+		if action != "show" {
+			helper.Infof("%s %s done", action, eventID)
+		}
+	case "purge-published-before":
+		cutoff, err := resolveCutoff(*beforeFlag, *olderThan)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(2)
+		}
+		rows, err := app.Admin.PurgePublishedBefore(runCtx, cutoff, *mode, int32(*batchSize))
+		if err != nil {
+			helper.Errorf("purge published before %s failed after %d rows: %v", cutoff.Format(time.RFC3339), rows, err)
+			os.Exit(1)
+		}
+		helper.Infof("purged %d published events before %s", rows, cutoff.Format(time.RFC3339))
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func resolveCutoff(before string, olderThan time.Duration) (time.Time, error) {
+	switch {
+	case before != "" && olderThan > 0:
+		return time.Time{}, errors.New("-before and -older-than are mutually exclusive")
+	case before != "":
+		cutoff, err := time.Parse(time.RFC3339, before)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid -before: %w", err)
+		}
+		return cutoff.UTC(), nil
+	case olderThan > 0:
+		return time.Now().UTC().Add(-olderThan), nil
+	default:
+		return time.Time{}, errors.New("one of -before or -older-than is required")
+	}
+}
+
+func printEvents(events []*repositories.OutboxEvent) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "EVENT_ID\tEVENT_TYPE\tATTEMPTS\tOCCURRED_AT\tAVAILABLE_AT\tPUBLISHED_AT\tLAST_ERROR")
+	for _, event := range events {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%s\t%s\n",
+			event.EventID, event.EventType, event.DeliveryAttempts,
+			event.OccurredAt.Format(time.RFC3339), event.AvailableAt.Format(time.RFC3339),
+			formatTimePtr(event.PublishedAt), stringValue(event.LastError))
+	}
+	_ = w.Flush()
+}
+
+func printDeadLetters(items []*po.OutboxDeadLetter) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "EVENT_ID\tEVENT_TYPE\tATTEMPTS\tDEAD_LETTERED_AT\tBY\tREASON\tLAST_ERROR")
+	for _, item := range items {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%s\t%s\n",
+			item.EventID, item.EventType, item.DeliveryAttempts, item.DeadLetteredAt.Format(time.RFC3339),
+			stringValue(item.DeadLetteredBy), stringValue(item.Reason), stringValue(item.LastError))
+	}
+	_ = w.Flush()
+}
+
+func printDetail(detail *outboxtasks.EventDetail) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	var headers map[string]string
+	if event := detail.Event; event != nil {
+		state := "pending"
+		if event.PublishedAt != nil {
+			state = "published"
+		}
+		fmt.Fprintf(w, "event_id\t%s\n", event.EventID)
+		fmt.Fprintf(w, "state\t%s\n", state)
+		fmt.Fprintf(w, "event_type\t%s\n", event.EventType)
+		fmt.Fprintf(w, "aggregate\t%s/%s\n", event.AggregateType, event.AggregateID)
+		fmt.Fprintf(w, "occurred_at\t%s\n", event.OccurredAt.Format(time.RFC3339))
+		fmt.Fprintf(w, "available_at\t%s\n", event.AvailableAt.Format(time.RFC3339))
+		fmt.Fprintf(w, "published_at\t%s\n", formatTimePtr(event.PublishedAt))
+		fmt.Fprintf(w, "delivery_attempts\t%d\n", event.DeliveryAttempts)
+		fmt.Fprintf(w, "locked_at\t%s\n", formatTimePtr(event.LockedAt))
+		fmt.Fprintf(w, "last_error\t%s\n", stringValue(event.LastError))
+		fmt.Fprintf(w, "payload_bytes\t%d\n", len(event.Payload))
+		headers = event.Headers
+	} else if item := detail.DeadLetter; item != nil {
+		fmt.Fprintf(w, "event_id\t%s\n", item.EventID)
+		fmt.Fprintf(w, "state\t%s\n", statusDeadLetter)
+		fmt.Fprintf(w, "event_type\t%s\n", item.EventType)
+		fmt.Fprintf(w, "aggregate\t%s/%s\n", item.AggregateType, item.AggregateID)
+		fmt.Fprintf(w, "occurred_at\t%s\n", item.OccurredAt.Format(time.RFC3339))
+		fmt.Fprintf(w, "dead_lettered_at\t%s\n", item.DeadLetteredAt.Format(time.RFC3339))
+		fmt.Fprintf(w, "dead_lettered_by\t%s\n", stringValue(item.DeadLetteredBy))
+		fmt.Fprintf(w, "reason\t%s\n", stringValue(item.Reason))
+		fmt.Fprintf(w, "delivery_attempts\t%d\n", item.DeliveryAttempts)
+		fmt.Fprintf(w, "last_error\t%s\n", stringValue(item.LastError))
+		fmt.Fprintf(w, "payload_bytes\t%d\n", len(item.Payload))
+		headers = item.Headers
+	}
+	keys := make([]string, 0, len(headers))
+	for key := range headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(w, "header.%s\t%s\n", key, headers[key])
+	}
+	_ = w.Flush()
+}
+
+func formatTimePtr(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Format(time.RFC3339)
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func defaultOperator() string {
+	if user := os.Getenv("USER"); user != "" {
+		return user
+	}
+	return "cli"
+}
@@ -0,0 +1,46 @@
+//go:build wireinject
+// +build wireinject
+
+// Package main 为 outbox 运维命令提供 Wire 依赖注入定义。
+package main
+
+import (
+	"context"
+	"fmt"
+
+	configloader "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/configloader"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	outboxtasks "github.com/bionicotaku/lingo-services-profile/internal/tasks/outbox"
+
+	"github.com/bionicotaku/lingo-utils/gclog"
+	obswire "github.com/bionicotaku/lingo-utils/observability"
+	"github.com/bionicotaku/lingo-utils/pgxpoolx"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+)
+
+//go:generate go run github.com/google/wire/cmd/wire
+
+var outboxAdminRepositorySet = wire.NewSet(repositories.NewOutboxRepository)
+
+func wireOutboxAdmin(context.Context, configloader.Params) (*outboxAdminApp, func(), error) {
+	panic(wire.Build(
+		configloader.ProviderSet,
+		gclog.ProviderSet,
+		obswire.ProviderSet,
+		pgxpoolx.ProviderSet,
+		outboxAdminRepositorySet,
+		outboxtasks.NewAdmin,
+		newOutboxAdminApp,
+	))
+}
+
+func newOutboxAdminApp(_ *obswire.Component, logger log.Logger, admin *outboxtasks.Admin) (*outboxAdminApp, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger not initialized")
+	}
+	return &outboxAdminApp{
+		Admin:  admin,
+		Logger: logger,
+	}, nil
+}
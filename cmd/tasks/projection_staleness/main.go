@@ -0,0 +1,69 @@
+// Package main 提供投影新鲜度巡检任务的独立入口，
+// 周期性刷新 Inbox 滞后、陈旧投影行数与订阅高水位指标。
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+
+	configloader "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/configloader"
+	projectionstaleness "github.com/bionicotaku/lingo-services-profile/internal/tasks/projection_staleness"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type projectionStalenessApp struct {
+	Monitor *projectionstaleness.Monitor
+	Logger  log.Logger
+}
+
+func main() {
+	ctx := context.Background()
+
+	confFlag := flag.String("conf", "", "config path or directory, eg: -conf configs/config.yaml")
+	interval := flag.Duration("interval", projectionstaleness.DefaultInterval, "interval between freshness checks")
+	staleAfter := flag.Duration("stale-after", projectionstaleness.DefaultStaleAfter, "projection rows not updated within this window count as stale")
+	once := flag.Bool("once", false, "run a single check, print the report and exit")
+	flag.Parse()
+
+	params := configloader.Params{ConfPath: *confFlag}
+	app, cleanup, err := wireProjectionStaleness(ctx, params)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	logger := app.Logger
+	if logger == nil {
+		logger = log.NewStdLogger(os.Stdout)
+	}
+	helper := log.NewHelper(logger)
+
+	if app.Monitor == nil {
+		helper.Error("projection staleness monitor disabled (missing database dependencies)")
+		os.Exit(1)
+	}
+
+	runCtx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if *once {
+		report, err := app.Monitor.Check(runCtx, *staleAfter)
+		if err != nil {
+			helper.Errorf("projection staleness check failed: %v", err)
+			os.Exit(1)
+		}
+		helper.Infof("projection staleness: inbox_lag=%s stale_rows=%d consumer_groups=%d",
+			report.InboxLag, report.StaleRows, len(report.Offsets))
+		return
+	}
+
+	helper.Infof("starting projection staleness monitor: interval=%s stale_after=%s", *interval, *staleAfter)
+	if err := app.Monitor.Run(runCtx, projectionstaleness.Options{Interval: *interval, StaleAfter: *staleAfter}); err != nil {
+		helper.Errorf("projection staleness monitor exited with error: %v", err)
+		os.Exit(1)
+	}
+	helper.Info("projection staleness monitor stopped")
+}
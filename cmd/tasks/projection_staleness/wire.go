@@ -0,0 +1,50 @@
+//go:build wireinject
+// +build wireinject
+
+// Package main 为 projection staleness 任务提供 Wire 依赖注入定义。
+package main
+
+import (
+	"context"
+	"fmt"
+
+	configloader "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/configloader"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	projectionstaleness "github.com/bionicotaku/lingo-services-profile/internal/tasks/projection_staleness"
+
+	"github.com/bionicotaku/lingo-utils/gclog"
+	obswire "github.com/bionicotaku/lingo-utils/observability"
+	"github.com/bionicotaku/lingo-utils/pgxpoolx"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+)
+
+//go:generate go run github.com/google/wire/cmd/wire
+
+var projectionStalenessRepoSet = wire.NewSet(
+	repositories.NewInboxRepository,
+	repositories.NewProfileVideoProjectionRepository,
+	repositories.NewSubscriptionOffsetsRepository,
+)
+
+func wireProjectionStaleness(context.Context, configloader.Params) (*projectionStalenessApp, func(), error) {
+	panic(wire.Build(
+		configloader.ProviderSet,
+		gclog.ProviderSet,
+		obswire.ProviderSet,
+		pgxpoolx.ProviderSet,
+		projectionStalenessRepoSet,
+		projectionstaleness.ProvideMonitor,
+		newProjectionStalenessApp,
+	))
+}
+
+func newProjectionStalenessApp(_ *obswire.Component, logger log.Logger, monitor *projectionstaleness.Monitor) (*projectionStalenessApp, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger not initialized")
+	}
+	return &projectionStalenessApp{
+		Monitor: monitor,
+		Logger:  logger,
+	}, nil
+}
@@ -29,6 +29,8 @@ type Bootstrap struct {
 	Data          *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	Observability *Observability         `protobuf:"bytes,3,opt,name=observability,proto3" json:"observability,omitempty"`
 	Messaging     *Messaging             `protobuf:"bytes,4,opt,name=messaging,proto3" json:"messaging,omitempty"`
+	Storage       *Storage               `protobuf:"bytes,5,opt,name=storage,proto3" json:"storage,omitempty"`
+	Moderation    *Moderation            `protobuf:"bytes,6,opt,name=moderation,proto3" json:"moderation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -91,12 +93,30 @@ func (x *Bootstrap) GetMessaging() *Messaging {
 	return nil
 }
 
+func (x *Bootstrap) GetStorage() *Storage {
+	if x != nil {
+		return x.Storage
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetModeration() *Moderation {
+	if x != nil {
+		return x.Moderation
+	}
+	return nil
+}
+
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Grpc          *Server_GRPC           `protobuf:"bytes,1,opt,name=grpc,proto3" json:"grpc,omitempty"`
 	Jwt           *Server_JWT            `protobuf:"bytes,2,opt,name=jwt,proto3" json:"jwt,omitempty"`
 	Handlers      *Server_Handlers       `protobuf:"bytes,3,opt,name=handlers,proto3" json:"handlers,omitempty"`
 	MetadataKeys  []string               `protobuf:"bytes,4,rep,name=metadata_keys,json=metadataKeys,proto3" json:"metadata_keys,omitempty"` // 透传 header 列表，如 X-Apigateway-Api-Userinfo（actor 字段 Post-MVP 可追加）
+	Health        *Server_Health         `protobuf:"bytes,5,opt,name=health,proto3" json:"health,omitempty"`
+	Http          *Server_HTTP           `protobuf:"bytes,6,opt,name=http,proto3" json:"http,omitempty"`
+	RateLimit     *Server_RateLimit      `protobuf:"bytes,7,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	Admin         *Server_Admin          `protobuf:"bytes,8,opt,name=admin,proto3" json:"admin,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -159,6 +179,34 @@ func (x *Server) GetMetadataKeys() []string {
 	return nil
 }
 
+func (x *Server) GetHealth() *Server_Health {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *Server) GetHttp() *Server_HTTP {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *Server) GetRateLimit() *Server_RateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+func (x *Server) GetAdmin() *Server_Admin {
+	if x != nil {
+		return x.Admin
+	}
+	return nil
+}
+
 type Data struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Postgres      *Data_PostgreSQL       `protobuf:"bytes,1,opt,name=postgres,proto3" json:"postgres,omitempty"`
@@ -278,7 +326,10 @@ type Messaging struct {
 	Topics map[string]*PubSub `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	Outbox *OutboxPublisher   `protobuf:"bytes,3,opt,name=outbox,proto3" json:"outbox,omitempty"`
 	// 支持多个 inbox 消费者，key 为任务名称
-	Inboxes       map[string]*InboxConsumer `protobuf:"bytes,4,rep,name=inboxes,proto3" json:"inboxes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Inboxes map[string]*InboxConsumer `protobuf:"bytes,4,rep,name=inboxes,proto3" json:"inboxes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 事件总线驱动：gcp（默认）、memory（进程内，仅单进程开发/测试）、postgres（LISTEN/NOTIFY，单库离线运行）
+	Driver        string    `protobuf:"bytes,5,opt,name=driver,proto3" json:"driver,omitempty"`
+	EventBus      *EventBus `protobuf:"bytes,6,opt,name=event_bus,json=eventBus,proto3" json:"event_bus,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -341,6 +392,89 @@ func (x *Messaging) GetInboxes() map[string]*InboxConsumer {
 	return nil
 }
 
+func (x *Messaging) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Messaging) GetEventBus() *EventBus {
+	if x != nil {
+		return x.EventBus
+	}
+	return nil
+}
+
+// memory / postgres 驱动参数，topic 与订阅名沿用 topics.*.topic_id / subscription_id
+type EventBus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PollInterval  *durationpb.Duration   `protobuf:"bytes,1,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"` // postgres 未收到通知时的兜底轮询间隔，默认 1s
+	BatchSize     int32                  `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`         // postgres 单次读取消息数，默认 100
+	RetryDelay    *durationpb.Duration   `protobuf:"bytes,3,opt,name=retry_delay,json=retryDelay,proto3" json:"retry_delay,omitempty"`       // handler 失败后重投同一消息前的等待，默认 1s
+	Retention     *durationpb.Duration   `protobuf:"bytes,4,opt,name=retention,proto3" json:"retention,omitempty"`                           // postgres 已确认消息的保留时长，默认 24h
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventBus) Reset() {
+	*x = EventBus{}
+	mi := &file_configs_conf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventBus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventBus) ProtoMessage() {}
+
+func (x *EventBus) ProtoReflect() protoreflect.Message {
+	mi := &file_configs_conf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventBus.ProtoReflect.Descriptor instead.
+func (*EventBus) Descriptor() ([]byte, []int) {
+	return file_configs_conf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EventBus) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+func (x *EventBus) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *EventBus) GetRetryDelay() *durationpb.Duration {
+	if x != nil {
+		return x.RetryDelay
+	}
+	return nil
+}
+
+func (x *EventBus) GetRetention() *durationpb.Duration {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
 type PubSub struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId           string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
@@ -360,7 +494,7 @@ type PubSub struct {
 
 func (x *PubSub) Reset() {
 	*x = PubSub{}
-	mi := &file_configs_conf_proto_msgTypes[5]
+	mi := &file_configs_conf_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -372,7 +506,7 @@ func (x *PubSub) String() string {
 func (*PubSub) ProtoMessage() {}
 
 func (x *PubSub) ProtoReflect() protoreflect.Message {
-	mi := &file_configs_conf_proto_msgTypes[5]
+	mi := &file_configs_conf_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -385,7 +519,7 @@ func (x *PubSub) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PubSub.ProtoReflect.Descriptor instead.
 func (*PubSub) Descriptor() ([]byte, []int) {
-	return file_configs_conf_proto_rawDescGZIP(), []int{5}
+	return file_configs_conf_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PubSub) GetProjectId() string {
@@ -478,7 +612,7 @@ type Receive struct {
 
 func (x *Receive) Reset() {
 	*x = Receive{}
-	mi := &file_configs_conf_proto_msgTypes[6]
+	mi := &file_configs_conf_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -490,7 +624,7 @@ func (x *Receive) String() string {
 func (*Receive) ProtoMessage() {}
 
 func (x *Receive) ProtoReflect() protoreflect.Message {
-	mi := &file_configs_conf_proto_msgTypes[6]
+	mi := &file_configs_conf_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -503,7 +637,7 @@ func (x *Receive) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Receive.ProtoReflect.Descriptor instead.
 func (*Receive) Descriptor() ([]byte, []int) {
-	return file_configs_conf_proto_rawDescGZIP(), []int{6}
+	return file_configs_conf_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Receive) GetNumGoroutines() int32 {
@@ -542,24 +676,26 @@ func (x *Receive) GetMaxExtensionPeriod() *durationpb.Duration {
 }
 
 type OutboxPublisher struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	BatchSize      int32                  `protobuf:"varint,1,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	TickInterval   *durationpb.Duration   `protobuf:"bytes,2,opt,name=tick_interval,json=tickInterval,proto3" json:"tick_interval,omitempty"`
-	InitialBackoff *durationpb.Duration   `protobuf:"bytes,3,opt,name=initial_backoff,json=initialBackoff,proto3" json:"initial_backoff,omitempty"`
-	MaxBackoff     *durationpb.Duration   `protobuf:"bytes,4,opt,name=max_backoff,json=maxBackoff,proto3" json:"max_backoff,omitempty"`
-	MaxAttempts    int32                  `protobuf:"varint,5,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
-	PublishTimeout *durationpb.Duration   `protobuf:"bytes,6,opt,name=publish_timeout,json=publishTimeout,proto3" json:"publish_timeout,omitempty"`
-	Workers        int32                  `protobuf:"varint,7,opt,name=workers,proto3" json:"workers,omitempty"`
-	LockTtl        *durationpb.Duration   `protobuf:"bytes,8,opt,name=lock_ttl,json=lockTtl,proto3" json:"lock_ttl,omitempty"`
-	LoggingEnabled *bool                  `protobuf:"varint,9,opt,name=logging_enabled,json=loggingEnabled,proto3,oneof" json:"logging_enabled,omitempty"`
-	MetricsEnabled *bool                  `protobuf:"varint,10,opt,name=metrics_enabled,json=metricsEnabled,proto3,oneof" json:"metrics_enabled,omitempty"`
+	state          protoimpl.MessageState     `protogen:"open.v1"`
+	BatchSize      int32                      `protobuf:"varint,1,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	TickInterval   *durationpb.Duration       `protobuf:"bytes,2,opt,name=tick_interval,json=tickInterval,proto3" json:"tick_interval,omitempty"`
+	InitialBackoff *durationpb.Duration       `protobuf:"bytes,3,opt,name=initial_backoff,json=initialBackoff,proto3" json:"initial_backoff,omitempty"`
+	MaxBackoff     *durationpb.Duration       `protobuf:"bytes,4,opt,name=max_backoff,json=maxBackoff,proto3" json:"max_backoff,omitempty"`
+	MaxAttempts    int32                      `protobuf:"varint,5,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	PublishTimeout *durationpb.Duration       `protobuf:"bytes,6,opt,name=publish_timeout,json=publishTimeout,proto3" json:"publish_timeout,omitempty"`
+	Workers        int32                      `protobuf:"varint,7,opt,name=workers,proto3" json:"workers,omitempty"`
+	LockTtl        *durationpb.Duration       `protobuf:"bytes,8,opt,name=lock_ttl,json=lockTtl,proto3" json:"lock_ttl,omitempty"`
+	LoggingEnabled *bool                      `protobuf:"varint,9,opt,name=logging_enabled,json=loggingEnabled,proto3,oneof" json:"logging_enabled,omitempty"`
+	MetricsEnabled *bool                      `protobuf:"varint,10,opt,name=metrics_enabled,json=metricsEnabled,proto3,oneof" json:"metrics_enabled,omitempty"`
+	Retention      *OutboxPublisher_Retention `protobuf:"bytes,11,opt,name=retention,proto3" json:"retention,omitempty"`
+	Routes         []*OutboxPublisher_Route   `protobuf:"bytes,12,rep,name=routes,proto3" json:"routes,omitempty"` // 未命中任何规则的事件发布到默认 topic（topics.default）
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *OutboxPublisher) Reset() {
 	*x = OutboxPublisher{}
-	mi := &file_configs_conf_proto_msgTypes[7]
+	mi := &file_configs_conf_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -571,7 +707,7 @@ func (x *OutboxPublisher) String() string {
 func (*OutboxPublisher) ProtoMessage() {}
 
 func (x *OutboxPublisher) ProtoReflect() protoreflect.Message {
-	mi := &file_configs_conf_proto_msgTypes[7]
+	mi := &file_configs_conf_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -584,7 +720,7 @@ func (x *OutboxPublisher) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OutboxPublisher.ProtoReflect.Descriptor instead.
 func (*OutboxPublisher) Descriptor() ([]byte, []int) {
-	return file_configs_conf_proto_rawDescGZIP(), []int{7}
+	return file_configs_conf_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *OutboxPublisher) GetBatchSize() int32 {
@@ -657,19 +793,34 @@ func (x *OutboxPublisher) GetMetricsEnabled() bool {
 	return false
 }
 
+func (x *OutboxPublisher) GetRetention() *OutboxPublisher_Retention {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *OutboxPublisher) GetRoutes() []*OutboxPublisher_Route {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
 type InboxConsumer struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	SourceService  string                 `protobuf:"bytes,1,opt,name=source_service,json=sourceService,proto3" json:"source_service,omitempty"`
 	MaxConcurrency int32                  `protobuf:"varint,2,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
 	LoggingEnabled *bool                  `protobuf:"varint,3,opt,name=logging_enabled,json=loggingEnabled,proto3,oneof" json:"logging_enabled,omitempty"`
 	MetricsEnabled *bool                  `protobuf:"varint,4,opt,name=metrics_enabled,json=metricsEnabled,proto3,oneof" json:"metrics_enabled,omitempty"`
+	MaxAttempts    int32                  `protobuf:"varint,5,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"` // 单个事件处理失败达到该次数后进入隔离区不再重试，<=0 时使用默认值 10
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *InboxConsumer) Reset() {
 	*x = InboxConsumer{}
-	mi := &file_configs_conf_proto_msgTypes[8]
+	mi := &file_configs_conf_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -681,7 +832,7 @@ func (x *InboxConsumer) String() string {
 func (*InboxConsumer) ProtoMessage() {}
 
 func (x *InboxConsumer) ProtoReflect() protoreflect.Message {
-	mi := &file_configs_conf_proto_msgTypes[8]
+	mi := &file_configs_conf_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -694,7 +845,7 @@ func (x *InboxConsumer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InboxConsumer.ProtoReflect.Descriptor instead.
 func (*InboxConsumer) Descriptor() ([]byte, []int) {
-	return file_configs_conf_proto_rawDescGZIP(), []int{8}
+	return file_configs_conf_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *InboxConsumer) GetSourceService() string {
@@ -725,6 +876,101 @@ func (x *InboxConsumer) GetMetricsEnabled() bool {
 	return false
 }
 
+func (x *InboxConsumer) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+type Storage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Avatar        *Storage_Avatar        `protobuf:"bytes,1,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Storage) Reset() {
+	*x = Storage{}
+	mi := &file_configs_conf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Storage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Storage) ProtoMessage() {}
+
+func (x *Storage) ProtoReflect() protoreflect.Message {
+	mi := &file_configs_conf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Storage.ProtoReflect.Descriptor instead.
+func (*Storage) Descriptor() ([]byte, []int) {
+	return file_configs_conf_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Storage) GetAvatar() *Storage_Avatar {
+	if x != nil {
+		return x.Avatar
+	}
+	return nil
+}
+
+type Moderation struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	DisplayName   *Moderation_DisplayName `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Moderation) Reset() {
+	*x = Moderation{}
+	mi := &file_configs_conf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Moderation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Moderation) ProtoMessage() {}
+
+func (x *Moderation) ProtoReflect() protoreflect.Message {
+	mi := &file_configs_conf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Moderation.ProtoReflect.Descriptor instead.
+func (*Moderation) Descriptor() ([]byte, []int) {
+	return file_configs_conf_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Moderation) GetDisplayName() *Moderation_DisplayName {
+	if x != nil {
+		return x.DisplayName
+	}
+	return nil
+}
+
 type Server_GRPC struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -736,7 +982,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_configs_conf_proto_msgTypes[9]
+	mi := &file_configs_conf_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -748,7 +994,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_configs_conf_proto_msgTypes[9]
+	mi := &file_configs_conf_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -785,6 +1031,67 @@ func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
 	return nil
 }
 
+// HTTP/JSON 网关，按 google.api.http 注解暴露 REST 路由；addr 留空表示不启动
+type Server_HTTP struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_HTTP) Reset() {
+	*x = Server_HTTP{}
+	mi := &file_configs_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_HTTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_HTTP) ProtoMessage() {}
+
+func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
+	mi := &file_configs_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_HTTP.ProtoReflect.Descriptor instead.
+func (*Server_HTTP) Descriptor() ([]byte, []int) {
+	return file_configs_conf_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *Server_HTTP) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Server_HTTP) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Server_HTTP) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
 type Server_JWT struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	ExpectedAudience string                 `protobuf:"bytes,1,opt,name=expected_audience,json=expectedAudience,proto3" json:"expected_audience,omitempty"`
@@ -797,7 +1104,7 @@ type Server_JWT struct {
 
 func (x *Server_JWT) Reset() {
 	*x = Server_JWT{}
-	mi := &file_configs_conf_proto_msgTypes[10]
+	mi := &file_configs_conf_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -809,7 +1116,7 @@ func (x *Server_JWT) String() string {
 func (*Server_JWT) ProtoMessage() {}
 
 func (x *Server_JWT) ProtoReflect() protoreflect.Message {
-	mi := &file_configs_conf_proto_msgTypes[10]
+	mi := &file_configs_conf_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -822,7 +1129,7 @@ func (x *Server_JWT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Server_JWT.ProtoReflect.Descriptor instead.
 func (*Server_JWT) Descriptor() ([]byte, []int) {
-	return file_configs_conf_proto_rawDescGZIP(), []int{1, 1}
+	return file_configs_conf_proto_rawDescGZIP(), []int{1, 2}
 }
 
 func (x *Server_JWT) GetExpectedAudience() string {
@@ -864,7 +1171,7 @@ type Server_Handlers struct {
 
 func (x *Server_Handlers) Reset() {
 	*x = Server_Handlers{}
-	mi := &file_configs_conf_proto_msgTypes[11]
+	mi := &file_configs_conf_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -876,7 +1183,7 @@ func (x *Server_Handlers) String() string {
 func (*Server_Handlers) ProtoMessage() {}
 
 func (x *Server_Handlers) ProtoReflect() protoreflect.Message {
-	mi := &file_configs_conf_proto_msgTypes[11]
+	mi := &file_configs_conf_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -889,7 +1196,7 @@ func (x *Server_Handlers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Server_Handlers.ProtoReflect.Descriptor instead.
 func (*Server_Handlers) Descriptor() ([]byte, []int) {
-	return file_configs_conf_proto_rawDescGZIP(), []int{1, 2}
+	return file_configs_conf_proto_rawDescGZIP(), []int{1, 3}
 }
 
 func (x *Server_Handlers) GetDefaultTimeout() *durationpb.Duration {
@@ -913,6 +1220,257 @@ func (x *Server_Handlers) GetQueryTimeout() *durationpb.Duration {
 	return nil
 }
 
+// 健康检查与就绪探针配置
+type Server_Health struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	CheckInterval          *durationpb.Duration   `protobuf:"bytes,1,opt,name=check_interval,json=checkInterval,proto3" json:"check_interval,omitempty"`                               // 探测周期
+	ProbeTimeout           *durationpb.Duration   `protobuf:"bytes,2,opt,name=probe_timeout,json=probeTimeout,proto3" json:"probe_timeout,omitempty"`                                  // 单次探测超时
+	OutboxPendingThreshold int64                  `protobuf:"varint,3,opt,name=outbox_pending_threshold,json=outboxPendingThreshold,proto3" json:"outbox_pending_threshold,omitempty"` // Outbox 积压阈值，0 表示不检查
+	InboxLagThreshold      *durationpb.Duration   `protobuf:"bytes,4,opt,name=inbox_lag_threshold,json=inboxLagThreshold,proto3" json:"inbox_lag_threshold,omitempty"`                 // Inbox 最老未处理事件的滞后阈值，0 表示不检查
+	InboxSourceService     string                 `protobuf:"bytes,5,opt,name=inbox_source_service,json=inboxSourceService,proto3" json:"inbox_source_service,omitempty"`              // 计算 Inbox 滞后的来源服务，默认取 messaging.inboxes
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Server_Health) Reset() {
+	*x = Server_Health{}
+	mi := &file_configs_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Health) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Health) ProtoMessage() {}
+
+func (x *Server_Health) ProtoReflect() protoreflect.Message {
+	mi := &file_configs_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Health.ProtoReflect.Descriptor instead.
+func (*Server_Health) Descriptor() ([]byte, []int) {
+	return file_configs_conf_proto_rawDescGZIP(), []int{1, 4}
+}
+
+func (x *Server_Health) GetCheckInterval() *durationpb.Duration {
+	if x != nil {
+		return x.CheckInterval
+	}
+	return nil
+}
+
+func (x *Server_Health) GetProbeTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ProbeTimeout
+	}
+	return nil
+}
+
+func (x *Server_Health) GetOutboxPendingThreshold() int64 {
+	if x != nil {
+		return x.OutboxPendingThreshold
+	}
+	return 0
+}
+
+func (x *Server_Health) GetInboxLagThreshold() *durationpb.Duration {
+	if x != nil {
+		return x.InboxLagThreshold
+	}
+	return nil
+}
+
+func (x *Server_Health) GetInboxSourceService() string {
+	if x != nil {
+		return x.InboxSourceService
+	}
+	return ""
+}
+
+// 按「用户 × RPC」的令牌桶限流；methods 的键为方法名（如 UpsertWatchProgress）或完整 operation
+type Server_RateLimit struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Enabled       bool                              `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	DefaultRule   *Server_RateLimit_Rule            `protobuf:"bytes,2,opt,name=default_rule,json=defaultRule,proto3" json:"default_rule,omitempty"`                                                // 未单独配置的方法使用的规则
+	Methods       map[string]*Server_RateLimit_Rule `protobuf:"bytes,3,rep,name=methods,proto3" json:"methods,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 方法级覆盖
+	IdleTtl       *durationpb.Duration              `protobuf:"bytes,4,opt,name=idle_ttl,json=idleTtl,proto3" json:"idle_ttl,omitempty"`                                                            // 令牌桶空闲多久后回收，默认 10m
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_RateLimit) Reset() {
+	*x = Server_RateLimit{}
+	mi := &file_configs_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_RateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_RateLimit) ProtoMessage() {}
+
+func (x *Server_RateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_configs_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_RateLimit.ProtoReflect.Descriptor instead.
+func (*Server_RateLimit) Descriptor() ([]byte, []int) {
+	return file_configs_conf_proto_rawDescGZIP(), []int{1, 5}
+}
+
+func (x *Server_RateLimit) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Server_RateLimit) GetDefaultRule() *Server_RateLimit_Rule {
+	if x != nil {
+		return x.DefaultRule
+	}
+	return nil
+}
+
+func (x *Server_RateLimit) GetMethods() map[string]*Server_RateLimit_Rule {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+func (x *Server_RateLimit) GetIdleTtl() *durationpb.Duration {
+	if x != nil {
+		return x.IdleTtl
+	}
+	return nil
+}
+
+// 运营/内部 RPC（SuspendAccount、ReactivateAccount、ListAuditEntries）的调用方授权名单；均为空时拒绝所有调用
+type Server_Admin struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ServiceAccounts []string               `protobuf:"bytes,1,rep,name=service_accounts,json=serviceAccounts,proto3" json:"service_accounts,omitempty"` // 允许调用的内部服务账号，匹配入站 JWT 的 email 声明
+	Roles           []string               `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`                                            // 视作运营人员的 userinfo app_metadata 角色
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Server_Admin) Reset() {
+	*x = Server_Admin{}
+	mi := &file_configs_conf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Admin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Admin) ProtoMessage() {}
+
+func (x *Server_Admin) ProtoReflect() protoreflect.Message {
+	mi := &file_configs_conf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Admin.ProtoReflect.Descriptor instead.
+func (*Server_Admin) Descriptor() ([]byte, []int) {
+	return file_configs_conf_proto_rawDescGZIP(), []int{1, 6}
+}
+
+func (x *Server_Admin) GetServiceAccounts() []string {
+	if x != nil {
+		return x.ServiceAccounts
+	}
+	return nil
+}
+
+func (x *Server_Admin) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type Server_RateLimit_Rule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rps           float64                `protobuf:"fixed64,1,opt,name=rps,proto3" json:"rps,omitempty"`    // 每秒补充的令牌数，0 表示不限流
+	Burst         int32                  `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"` // 桶容量（允许的突发请求数），0 按 1 处理
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_RateLimit_Rule) Reset() {
+	*x = Server_RateLimit_Rule{}
+	mi := &file_configs_conf_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_RateLimit_Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_RateLimit_Rule) ProtoMessage() {}
+
+func (x *Server_RateLimit_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_configs_conf_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_RateLimit_Rule.ProtoReflect.Descriptor instead.
+func (*Server_RateLimit_Rule) Descriptor() ([]byte, []int) {
+	return file_configs_conf_proto_rawDescGZIP(), []int{1, 5, 0}
+}
+
+func (x *Server_RateLimit_Rule) GetRps() float64 {
+	if x != nil {
+		return x.Rps
+	}
+	return 0
+}
+
+func (x *Server_RateLimit_Rule) GetBurst() int32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
 // PostgreSQL 数据库配置（Supabase 专用）
 type Data_PostgreSQL struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -935,7 +1493,7 @@ type Data_PostgreSQL struct {
 
 func (x *Data_PostgreSQL) Reset() {
 	*x = Data_PostgreSQL{}
-	mi := &file_configs_conf_proto_msgTypes[12]
+	mi := &file_configs_conf_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -947,7 +1505,7 @@ func (x *Data_PostgreSQL) String() string {
 func (*Data_PostgreSQL) ProtoMessage() {}
 
 func (x *Data_PostgreSQL) ProtoReflect() protoreflect.Message {
-	mi := &file_configs_conf_proto_msgTypes[12]
+	mi := &file_configs_conf_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1045,7 +1603,7 @@ type Data_Client struct {
 
 func (x *Data_Client) Reset() {
 	*x = Data_Client{}
-	mi := &file_configs_conf_proto_msgTypes[13]
+	mi := &file_configs_conf_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1057,7 +1615,7 @@ func (x *Data_Client) String() string {
 func (*Data_Client) ProtoMessage() {}
 
 func (x *Data_Client) ProtoReflect() protoreflect.Message {
-	mi := &file_configs_conf_proto_msgTypes[13]
+	mi := &file_configs_conf_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1107,7 +1665,7 @@ type Data_PostgreSQL_Transaction struct {
 
 func (x *Data_PostgreSQL_Transaction) Reset() {
 	*x = Data_PostgreSQL_Transaction{}
-	mi := &file_configs_conf_proto_msgTypes[14]
+	mi := &file_configs_conf_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1119,7 +1677,7 @@ func (x *Data_PostgreSQL_Transaction) String() string {
 func (*Data_PostgreSQL_Transaction) ProtoMessage() {}
 
 func (x *Data_PostgreSQL_Transaction) ProtoReflect() protoreflect.Message {
-	mi := &file_configs_conf_proto_msgTypes[14]
+	mi := &file_configs_conf_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1181,7 +1739,7 @@ type Data_Client_JWT struct {
 
 func (x *Data_Client_JWT) Reset() {
 	*x = Data_Client_JWT{}
-	mi := &file_configs_conf_proto_msgTypes[15]
+	mi := &file_configs_conf_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1193,7 +1751,7 @@ func (x *Data_Client_JWT) String() string {
 func (*Data_Client_JWT) ProtoMessage() {}
 
 func (x *Data_Client_JWT) ProtoReflect() protoreflect.Message {
-	mi := &file_configs_conf_proto_msgTypes[15]
+	mi := &file_configs_conf_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1253,7 +1811,7 @@ type Observability_Tracing struct {
 
 func (x *Observability_Tracing) Reset() {
 	*x = Observability_Tracing{}
-	mi := &file_configs_conf_proto_msgTypes[16]
+	mi := &file_configs_conf_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1265,7 +1823,7 @@ func (x *Observability_Tracing) String() string {
 func (*Observability_Tracing) ProtoMessage() {}
 
 func (x *Observability_Tracing) ProtoReflect() protoreflect.Message {
-	mi := &file_configs_conf_proto_msgTypes[16]
+	mi := &file_configs_conf_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1405,7 +1963,7 @@ type Observability_Metrics struct {
 
 func (x *Observability_Metrics) Reset() {
 	*x = Observability_Metrics{}
-	mi := &file_configs_conf_proto_msgTypes[17]
+	mi := &file_configs_conf_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1417,7 +1975,7 @@ func (x *Observability_Metrics) String() string {
 func (*Observability_Metrics) ProtoMessage() {}
 
 func (x *Observability_Metrics) ProtoReflect() protoreflect.Message {
-	mi := &file_configs_conf_proto_msgTypes[17]
+	mi := &file_configs_conf_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1510,25 +2068,338 @@ func (x *Observability_Metrics) GetGrpcIncludeHealth() bool {
 	return false
 }
 
+// 已发布事件的保留期清理
+type OutboxPublisher_Retention struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxAge        *durationpb.Duration   `protobuf:"bytes,1,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`           // 已发布事件保留时长，未配置或 <=0 时不清理
+	Mode          string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`                             // 清理方式：delete（默认）或 archive（移入 outbox_events_archive）
+	Interval      *durationpb.Duration   `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`                     // 清理周期，默认 10m
+	BatchSize     int32                  `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"` // 单批删除/归档的行数，默认 1000
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutboxPublisher_Retention) Reset() {
+	*x = OutboxPublisher_Retention{}
+	mi := &file_configs_conf_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutboxPublisher_Retention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboxPublisher_Retention) ProtoMessage() {}
+
+func (x *OutboxPublisher_Retention) ProtoReflect() protoreflect.Message {
+	mi := &file_configs_conf_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboxPublisher_Retention.ProtoReflect.Descriptor instead.
+func (*OutboxPublisher_Retention) Descriptor() ([]byte, []int) {
+	return file_configs_conf_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *OutboxPublisher_Retention) GetMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
+func (x *OutboxPublisher_Retention) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *OutboxPublisher_Retention) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *OutboxPublisher_Retention) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+// 按 event_type 将事件路由到 messaging.topics 中的 topic，按顺序匹配首条命中的规则
+type OutboxPublisher_Route struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventType     string                 `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // 事件类型或通配模式，如 profile.engagement.*；* 匹配任意字符
+	Topic         string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`                          // messaging.topics 的 key
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutboxPublisher_Route) Reset() {
+	*x = OutboxPublisher_Route{}
+	mi := &file_configs_conf_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutboxPublisher_Route) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboxPublisher_Route) ProtoMessage() {}
+
+func (x *OutboxPublisher_Route) ProtoReflect() protoreflect.Message {
+	mi := &file_configs_conf_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboxPublisher_Route.ProtoReflect.Descriptor instead.
+func (*OutboxPublisher_Route) Descriptor() ([]byte, []int) {
+	return file_configs_conf_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *OutboxPublisher_Route) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *OutboxPublisher_Route) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+// 头像上传配置
+type Storage_Avatar struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Driver              string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`                                      // 对象存储驱动：local；留空表示禁用上传接口
+	LocalDir            string                 `protobuf:"bytes,2,opt,name=local_dir,json=localDir,proto3" json:"local_dir,omitempty"`                  // local 驱动的落盘目录
+	UploadBaseUrl       string                 `protobuf:"bytes,3,opt,name=upload_base_url,json=uploadBaseUrl,proto3" json:"upload_base_url,omitempty"` // 签名上传地址前缀
+	PublicBaseUrl       string                 `protobuf:"bytes,4,opt,name=public_base_url,json=publicBaseUrl,proto3" json:"public_base_url,omitempty"` // 头像对外访问地址前缀
+	SigningKey          string                 `protobuf:"bytes,5,opt,name=signing_key,json=signingKey,proto3" json:"signing_key,omitempty"`            // 上传签名密钥，留空时进程启动随机生成
+	UploadTtl           *durationpb.Duration   `protobuf:"bytes,6,opt,name=upload_ttl,json=uploadTtl,proto3" json:"upload_ttl,omitempty"`               // 上传签名有效期
+	MaxBytes            int64                  `protobuf:"varint,7,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	AllowedContentTypes []string               `protobuf:"bytes,8,rep,name=allowed_content_types,json=allowedContentTypes,proto3" json:"allowed_content_types,omitempty"`
+	AllowedHosts        []string               `protobuf:"bytes,9,rep,name=allowed_hosts,json=allowedHosts,proto3" json:"allowed_hosts,omitempty"` // UpdateProfile 可接受的头像 URL host，支持 *.example.com
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Storage_Avatar) Reset() {
+	*x = Storage_Avatar{}
+	mi := &file_configs_conf_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Storage_Avatar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Storage_Avatar) ProtoMessage() {}
+
+func (x *Storage_Avatar) ProtoReflect() protoreflect.Message {
+	mi := &file_configs_conf_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Storage_Avatar.ProtoReflect.Descriptor instead.
+func (*Storage_Avatar) Descriptor() ([]byte, []int) {
+	return file_configs_conf_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *Storage_Avatar) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Storage_Avatar) GetLocalDir() string {
+	if x != nil {
+		return x.LocalDir
+	}
+	return ""
+}
+
+func (x *Storage_Avatar) GetUploadBaseUrl() string {
+	if x != nil {
+		return x.UploadBaseUrl
+	}
+	return ""
+}
+
+func (x *Storage_Avatar) GetPublicBaseUrl() string {
+	if x != nil {
+		return x.PublicBaseUrl
+	}
+	return ""
+}
+
+func (x *Storage_Avatar) GetSigningKey() string {
+	if x != nil {
+		return x.SigningKey
+	}
+	return ""
+}
+
+func (x *Storage_Avatar) GetUploadTtl() *durationpb.Duration {
+	if x != nil {
+		return x.UploadTtl
+	}
+	return nil
+}
+
+func (x *Storage_Avatar) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *Storage_Avatar) GetAllowedContentTypes() []string {
+	if x != nil {
+		return x.AllowedContentTypes
+	}
+	return nil
+}
+
+func (x *Storage_Avatar) GetAllowedHosts() []string {
+	if x != nil {
+		return x.AllowedHosts
+	}
+	return nil
+}
+
+// 昵称审核配置
+type Moderation_DisplayName struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinLength     int32                  `protobuf:"varint,1,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`             // 最小字符数（按 rune 计）
+	MaxLength     int32                  `protobuf:"varint,2,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`             // 最大字符数（按 rune 计）
+	Blocklist     []string               `protobuf:"bytes,3,rep,name=blocklist,proto3" json:"blocklist,omitempty"`                               // 屏蔽词，大小写不敏感、忽略标点后按子串匹配
+	RequireUnique bool                   `protobuf:"varint,4,opt,name=require_unique,json=requireUnique,proto3" json:"require_unique,omitempty"` // 是否要求昵称大小写不敏感唯一
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Moderation_DisplayName) Reset() {
+	*x = Moderation_DisplayName{}
+	mi := &file_configs_conf_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Moderation_DisplayName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Moderation_DisplayName) ProtoMessage() {}
+
+func (x *Moderation_DisplayName) ProtoReflect() protoreflect.Message {
+	mi := &file_configs_conf_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Moderation_DisplayName.ProtoReflect.Descriptor instead.
+func (*Moderation_DisplayName) Descriptor() ([]byte, []int) {
+	return file_configs_conf_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *Moderation_DisplayName) GetMinLength() int32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *Moderation_DisplayName) GetMaxLength() int32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+func (x *Moderation_DisplayName) GetBlocklist() []string {
+	if x != nil {
+		return x.Blocklist
+	}
+	return nil
+}
+
+func (x *Moderation_DisplayName) GetRequireUnique() bool {
+	if x != nil {
+		return x.RequireUnique
+	}
+	return false
+}
+
 var File_configs_conf_proto protoreflect.FileDescriptor
 
 const file_configs_conf_proto_rawDesc = "" +
 	"\n" +
 	"\x12configs/conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bbuf/validate/validate.proto\"\xd3\x01\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bbuf/validate/validate.proto\"\xba\x02\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
 	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x12?\n" +
 	"\robservability\x18\x03 \x01(\v2\x19.kratos.api.ObservabilityR\robservability\x123\n" +
-	"\tmessaging\x18\x04 \x01(\v2\x15.kratos.api.MessagingR\tmessaging\"\x92\x05\n" +
+	"\tmessaging\x18\x04 \x01(\v2\x15.kratos.api.MessagingR\tmessaging\x12-\n" +
+	"\astorage\x18\x05 \x01(\v2\x13.kratos.api.StorageR\astorage\x126\n" +
+	"\n" +
+	"moderation\x18\x06 \x01(\v2\x16.kratos.api.ModerationR\n" +
+	"moderation\"\xf2\r\n" +
 	"\x06Server\x12+\n" +
 	"\x04grpc\x18\x01 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x12(\n" +
 	"\x03jwt\x18\x02 \x01(\v2\x16.kratos.api.Server.JWTR\x03jwt\x127\n" +
 	"\bhandlers\x18\x03 \x01(\v2\x1b.kratos.api.Server.HandlersR\bhandlers\x12#\n" +
-	"\rmetadata_keys\x18\x04 \x03(\tR\fmetadataKeys\x1ai\n" +
+	"\rmetadata_keys\x18\x04 \x03(\tR\fmetadataKeys\x121\n" +
+	"\x06health\x18\x05 \x01(\v2\x19.kratos.api.Server.HealthR\x06health\x12+\n" +
+	"\x04http\x18\x06 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12;\n" +
+	"\n" +
+	"rate_limit\x18\a \x01(\v2\x1c.kratos.api.Server.RateLimitR\trateLimit\x12.\n" +
+	"\x05admin\x18\b \x01(\v2\x18.kratos.api.Server.AdminR\x05admin\x1ai\n" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1ai\n" +
+	"\x04HTTP\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
 	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1a\x92\x01\n" +
 	"\x03JWT\x12+\n" +
 	"\x11expected_audience\x18\x01 \x01(\tR\x10expectedAudience\x12#\n" +
@@ -1539,7 +2410,27 @@ const file_configs_conf_proto_rawDesc = "" +
 	"\bHandlers\x12B\n" +
 	"\x0fdefault_timeout\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x0edefaultTimeout\x12B\n" +
 	"\x0fcommand_timeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0ecommandTimeout\x12>\n" +
-	"\rquery_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\fqueryTimeout\"\xe6\t\n" +
+	"\rquery_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\fqueryTimeout\x1a\xca\x02\n" +
+	"\x06Health\x12@\n" +
+	"\x0echeck_interval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\rcheckInterval\x12>\n" +
+	"\rprobe_timeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\fprobeTimeout\x12A\n" +
+	"\x18outbox_pending_threshold\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x16outboxPendingThreshold\x12I\n" +
+	"\x13inbox_lag_threshold\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x11inboxLagThreshold\x120\n" +
+	"\x14inbox_source_service\x18\x05 \x01(\tR\x12inboxSourceService\x1a\x8e\x03\n" +
+	"\tRateLimit\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12D\n" +
+	"\fdefault_rule\x18\x02 \x01(\v2!.kratos.api.Server.RateLimit.RuleR\vdefaultRule\x12C\n" +
+	"\amethods\x18\x03 \x03(\v2).kratos.api.Server.RateLimit.MethodsEntryR\amethods\x124\n" +
+	"\bidle_ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\aidleTtl\x1aG\n" +
+	"\x04Rule\x12 \n" +
+	"\x03rps\x18\x01 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x03rps\x12\x1d\n" +
+	"\x05burst\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x05burst\x1a]\n" +
+	"\fMethodsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x127\n" +
+	"\x05value\x18\x02 \x01(\v2!.kratos.api.Server.RateLimit.RuleR\x05value:\x028\x01\x1aH\n" +
+	"\x05Admin\x12)\n" +
+	"\x10service_accounts\x18\x01 \x03(\tR\x0fserviceAccounts\x12\x14\n" +
+	"\x05roles\x18\x02 \x03(\tR\x05roles\"\xe6\t\n" +
 	"\x04Data\x12?\n" +
 	"\bpostgres\x18\x01 \x01(\v2\x1b.kratos.api.Data.PostgreSQLB\x06\xbaH\x03\xc8\x01\x01R\bpostgres\x128\n" +
 	"\vgrpc_client\x18\x02 \x01(\v2\x17.kratos.api.Data.ClientR\n" +
@@ -1627,18 +2518,27 @@ const file_configs_conf_proto_rawDesc = "" +
 	"\x14_grpc_include_health\x1aC\n" +
 	"\x15GlobalAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf7\x02\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc2\x03\n" +
 	"\tMessaging\x12\x16\n" +
 	"\x06schema\x18\x01 \x01(\tR\x06schema\x129\n" +
 	"\x06topics\x18\x02 \x03(\v2!.kratos.api.Messaging.TopicsEntryR\x06topics\x123\n" +
 	"\x06outbox\x18\x03 \x01(\v2\x1b.kratos.api.OutboxPublisherR\x06outbox\x12<\n" +
-	"\ainboxes\x18\x04 \x03(\v2\".kratos.api.Messaging.InboxesEntryR\ainboxes\x1aM\n" +
+	"\ainboxes\x18\x04 \x03(\v2\".kratos.api.Messaging.InboxesEntryR\ainboxes\x12\x16\n" +
+	"\x06driver\x18\x05 \x01(\tR\x06driver\x121\n" +
+	"\tevent_bus\x18\x06 \x01(\v2\x14.kratos.api.EventBusR\beventBus\x1aM\n" +
 	"\vTopicsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
 	"\x05value\x18\x02 \x01(\v2\x12.kratos.api.PubSubR\x05value:\x028\x01\x1aU\n" +
 	"\fInboxesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
-	"\x05value\x18\x02 \x01(\v2\x19.kratos.api.InboxConsumerR\x05value:\x028\x01\"\xf4\x03\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.kratos.api.InboxConsumerR\x05value:\x028\x01\"\xde\x01\n" +
+	"\bEventBus\x12>\n" +
+	"\rpoll_interval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\fpollInterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x02 \x01(\x05R\tbatchSize\x12:\n" +
+	"\vretry_delay\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"retryDelay\x127\n" +
+	"\tretention\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\tretention\"\xf4\x03\n" +
 	"\x06PubSub\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x19\n" +
@@ -1658,7 +2558,7 @@ const file_configs_conf_proto_rawDesc = "" +
 	"\x18max_outstanding_messages\x18\x02 \x01(\x05R\x16maxOutstandingMessages\x122\n" +
 	"\x15max_outstanding_bytes\x18\x03 \x01(\x05R\x13maxOutstandingBytes\x12>\n" +
 	"\rmax_extension\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fmaxExtension\x12K\n" +
-	"\x14max_extension_period\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x12maxExtensionPeriod\"\xab\x04\n" +
+	"\x14max_extension_period\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x12maxExtensionPeriod\"\x95\a\n" +
 	"\x0fOutboxPublisher\x12\x1d\n" +
 	"\n" +
 	"batch_size\x18\x01 \x01(\x05R\tbatchSize\x12>\n" +
@@ -1672,16 +2572,55 @@ const file_configs_conf_proto_rawDesc = "" +
 	"\block_ttl\x18\b \x01(\v2\x19.google.protobuf.DurationR\alockTtl\x12,\n" +
 	"\x0flogging_enabled\x18\t \x01(\bH\x00R\x0eloggingEnabled\x88\x01\x01\x12,\n" +
 	"\x0fmetrics_enabled\x18\n" +
-	" \x01(\bH\x01R\x0emetricsEnabled\x88\x01\x01B\x12\n" +
+	" \x01(\bH\x01R\x0emetricsEnabled\x88\x01\x01\x12C\n" +
+	"\tretention\x18\v \x01(\v2%.kratos.api.OutboxPublisher.RetentionR\tretention\x129\n" +
+	"\x06routes\x18\f \x03(\v2!.kratos.api.OutboxPublisher.RouteR\x06routes\x1a\xa9\x01\n" +
+	"\tRetention\x122\n" +
+	"\amax_age\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x06maxAge\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x125\n" +
+	"\binterval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x04 \x01(\x05R\tbatchSize\x1a<\n" +
+	"\x05Route\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\tR\teventType\x12\x14\n" +
+	"\x05topic\x18\x02 \x01(\tR\x05topicB\x12\n" +
 	"\x10_logging_enabledB\x12\n" +
-	"\x10_metrics_enabled\"\xe3\x01\n" +
+	"\x10_metrics_enabled\"\x86\x02\n" +
 	"\rInboxConsumer\x12%\n" +
 	"\x0esource_service\x18\x01 \x01(\tR\rsourceService\x12'\n" +
 	"\x0fmax_concurrency\x18\x02 \x01(\x05R\x0emaxConcurrency\x12,\n" +
 	"\x0flogging_enabled\x18\x03 \x01(\bH\x00R\x0eloggingEnabled\x88\x01\x01\x12,\n" +
-	"\x0fmetrics_enabled\x18\x04 \x01(\bH\x01R\x0emetricsEnabled\x88\x01\x01B\x12\n" +
+	"\x0fmetrics_enabled\x18\x04 \x01(\bH\x01R\x0emetricsEnabled\x88\x01\x01\x12!\n" +
+	"\fmax_attempts\x18\x05 \x01(\x05R\vmaxAttemptsB\x12\n" +
 	"\x10_logging_enabledB\x12\n" +
-	"\x10_metrics_enabledB@Z>github.com/bionicotaku/lingo-services-profile/configs;configpbb\x06proto3"
+	"\x10_metrics_enabled\"\xac\x03\n" +
+	"\aStorage\x122\n" +
+	"\x06avatar\x18\x01 \x01(\v2\x1a.kratos.api.Storage.AvatarR\x06avatar\x1a\xec\x02\n" +
+	"\x06Avatar\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x1b\n" +
+	"\tlocal_dir\x18\x02 \x01(\tR\blocalDir\x12&\n" +
+	"\x0fupload_base_url\x18\x03 \x01(\tR\ruploadBaseUrl\x12&\n" +
+	"\x0fpublic_base_url\x18\x04 \x01(\tR\rpublicBaseUrl\x12\x1f\n" +
+	"\vsigning_key\x18\x05 \x01(\tR\n" +
+	"signingKey\x128\n" +
+	"\n" +
+	"upload_ttl\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\tuploadTtl\x12)\n" +
+	"\tmax_bytes\x18\a \x01(\x03B\f\xbaH\t\"\a\x18\x80\x80\x80\n" +
+	"(\x00R\bmaxBytes\x122\n" +
+	"\x15allowed_content_types\x18\b \x03(\tR\x13allowedContentTypes\x12#\n" +
+	"\rallowed_hosts\x18\t \x03(\tR\fallowedHosts\"\xfd\x01\n" +
+	"\n" +
+	"Moderation\x12E\n" +
+	"\fdisplay_name\x18\x01 \x01(\v2\".kratos.api.Moderation.DisplayNameR\vdisplayName\x1a\xa7\x01\n" +
+	"\vDisplayName\x12(\n" +
+	"\n" +
+	"min_length\x18\x01 \x01(\x05B\t\xbaH\x06\x1a\x04\x18@(\x00R\tminLength\x12)\n" +
+	"\n" +
+	"max_length\x18\x02 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\x80\x01(\x00R\tmaxLength\x12\x1c\n" +
+	"\tblocklist\x18\x03 \x03(\tR\tblocklist\x12%\n" +
+	"\x0erequire_unique\x18\x04 \x01(\bR\rrequireUniqueB@Z>github.com/bionicotaku/lingo-services-profile/configs;configpbb\x06proto3"
 
 var (
 	file_configs_conf_proto_rawDescOnce sync.Once
@@ -1695,85 +2634,123 @@ func file_configs_conf_proto_rawDescGZIP() []byte {
 	return file_configs_conf_proto_rawDescData
 }
 
-var file_configs_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_configs_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_configs_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),                   // 0: kratos.api.Bootstrap
 	(*Server)(nil),                      // 1: kratos.api.Server
 	(*Data)(nil),                        // 2: kratos.api.Data
 	(*Observability)(nil),               // 3: kratos.api.Observability
 	(*Messaging)(nil),                   // 4: kratos.api.Messaging
-	(*PubSub)(nil),                      // 5: kratos.api.PubSub
-	(*Receive)(nil),                     // 6: kratos.api.Receive
-	(*OutboxPublisher)(nil),             // 7: kratos.api.OutboxPublisher
-	(*InboxConsumer)(nil),               // 8: kratos.api.InboxConsumer
-	(*Server_GRPC)(nil),                 // 9: kratos.api.Server.GRPC
-	(*Server_JWT)(nil),                  // 10: kratos.api.Server.JWT
-	(*Server_Handlers)(nil),             // 11: kratos.api.Server.Handlers
-	(*Data_PostgreSQL)(nil),             // 12: kratos.api.Data.PostgreSQL
-	(*Data_Client)(nil),                 // 13: kratos.api.Data.Client
-	(*Data_PostgreSQL_Transaction)(nil), // 14: kratos.api.Data.PostgreSQL.Transaction
-	(*Data_Client_JWT)(nil),             // 15: kratos.api.Data.Client.JWT
-	(*Observability_Tracing)(nil),       // 16: kratos.api.Observability.Tracing
-	(*Observability_Metrics)(nil),       // 17: kratos.api.Observability.Metrics
-	nil,                                 // 18: kratos.api.Observability.GlobalAttributesEntry
-	nil,                                 // 19: kratos.api.Observability.Tracing.HeadersEntry
-	nil,                                 // 20: kratos.api.Observability.Tracing.AttributesEntry
-	nil,                                 // 21: kratos.api.Observability.Metrics.HeadersEntry
-	nil,                                 // 22: kratos.api.Observability.Metrics.ResourceAttributesEntry
-	nil,                                 // 23: kratos.api.Messaging.TopicsEntry
-	nil,                                 // 24: kratos.api.Messaging.InboxesEntry
-	(*durationpb.Duration)(nil),         // 25: google.protobuf.Duration
+	(*EventBus)(nil),                    // 5: kratos.api.EventBus
+	(*PubSub)(nil),                      // 6: kratos.api.PubSub
+	(*Receive)(nil),                     // 7: kratos.api.Receive
+	(*OutboxPublisher)(nil),             // 8: kratos.api.OutboxPublisher
+	(*InboxConsumer)(nil),               // 9: kratos.api.InboxConsumer
+	(*Storage)(nil),                     // 10: kratos.api.Storage
+	(*Moderation)(nil),                  // 11: kratos.api.Moderation
+	(*Server_GRPC)(nil),                 // 12: kratos.api.Server.GRPC
+	(*Server_HTTP)(nil),                 // 13: kratos.api.Server.HTTP
+	(*Server_JWT)(nil),                  // 14: kratos.api.Server.JWT
+	(*Server_Handlers)(nil),             // 15: kratos.api.Server.Handlers
+	(*Server_Health)(nil),               // 16: kratos.api.Server.Health
+	(*Server_RateLimit)(nil),            // 17: kratos.api.Server.RateLimit
+	(*Server_Admin)(nil),                // 18: kratos.api.Server.Admin
+	(*Server_RateLimit_Rule)(nil),       // 19: kratos.api.Server.RateLimit.Rule
+	nil,                                 // 20: kratos.api.Server.RateLimit.MethodsEntry
+	(*Data_PostgreSQL)(nil),             // 21: kratos.api.Data.PostgreSQL
+	(*Data_Client)(nil),                 // 22: kratos.api.Data.Client
+	(*Data_PostgreSQL_Transaction)(nil), // 23: kratos.api.Data.PostgreSQL.Transaction
+	(*Data_Client_JWT)(nil),             // 24: kratos.api.Data.Client.JWT
+	(*Observability_Tracing)(nil),       // 25: kratos.api.Observability.Tracing
+	(*Observability_Metrics)(nil),       // 26: kratos.api.Observability.Metrics
+	nil,                                 // 27: kratos.api.Observability.GlobalAttributesEntry
+	nil,                                 // 28: kratos.api.Observability.Tracing.HeadersEntry
+	nil,                                 // 29: kratos.api.Observability.Tracing.AttributesEntry
+	nil,                                 // 30: kratos.api.Observability.Metrics.HeadersEntry
+	nil,                                 // 31: kratos.api.Observability.Metrics.ResourceAttributesEntry
+	nil,                                 // 32: kratos.api.Messaging.TopicsEntry
+	nil,                                 // 33: kratos.api.Messaging.InboxesEntry
+	(*OutboxPublisher_Retention)(nil),   // 34: kratos.api.OutboxPublisher.Retention
+	(*OutboxPublisher_Route)(nil),       // 35: kratos.api.OutboxPublisher.Route
+	(*Storage_Avatar)(nil),              // 36: kratos.api.Storage.Avatar
+	(*Moderation_DisplayName)(nil),      // 37: kratos.api.Moderation.DisplayName
+	(*durationpb.Duration)(nil),         // 38: google.protobuf.Duration
 }
 var file_configs_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
 	2,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
 	3,  // 2: kratos.api.Bootstrap.observability:type_name -> kratos.api.Observability
 	4,  // 3: kratos.api.Bootstrap.messaging:type_name -> kratos.api.Messaging
-	9,  // 4: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	10, // 5: kratos.api.Server.jwt:type_name -> kratos.api.Server.JWT
-	11, // 6: kratos.api.Server.handlers:type_name -> kratos.api.Server.Handlers
-	12, // 7: kratos.api.Data.postgres:type_name -> kratos.api.Data.PostgreSQL
-	13, // 8: kratos.api.Data.grpc_client:type_name -> kratos.api.Data.Client
-	18, // 9: kratos.api.Observability.global_attributes:type_name -> kratos.api.Observability.GlobalAttributesEntry
-	16, // 10: kratos.api.Observability.tracing:type_name -> kratos.api.Observability.Tracing
-	17, // 11: kratos.api.Observability.metrics:type_name -> kratos.api.Observability.Metrics
-	23, // 12: kratos.api.Messaging.topics:type_name -> kratos.api.Messaging.TopicsEntry
-	7,  // 13: kratos.api.Messaging.outbox:type_name -> kratos.api.OutboxPublisher
-	24, // 14: kratos.api.Messaging.inboxes:type_name -> kratos.api.Messaging.InboxesEntry
-	25, // 15: kratos.api.PubSub.publish_timeout:type_name -> google.protobuf.Duration
-	6,  // 16: kratos.api.PubSub.receive:type_name -> kratos.api.Receive
-	25, // 17: kratos.api.Receive.max_extension:type_name -> google.protobuf.Duration
-	25, // 18: kratos.api.Receive.max_extension_period:type_name -> google.protobuf.Duration
-	25, // 19: kratos.api.OutboxPublisher.tick_interval:type_name -> google.protobuf.Duration
-	25, // 20: kratos.api.OutboxPublisher.initial_backoff:type_name -> google.protobuf.Duration
-	25, // 21: kratos.api.OutboxPublisher.max_backoff:type_name -> google.protobuf.Duration
-	25, // 22: kratos.api.OutboxPublisher.publish_timeout:type_name -> google.protobuf.Duration
-	25, // 23: kratos.api.OutboxPublisher.lock_ttl:type_name -> google.protobuf.Duration
-	25, // 24: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	25, // 25: kratos.api.Server.Handlers.default_timeout:type_name -> google.protobuf.Duration
-	25, // 26: kratos.api.Server.Handlers.command_timeout:type_name -> google.protobuf.Duration
-	25, // 27: kratos.api.Server.Handlers.query_timeout:type_name -> google.protobuf.Duration
-	25, // 28: kratos.api.Data.PostgreSQL.max_conn_lifetime:type_name -> google.protobuf.Duration
-	25, // 29: kratos.api.Data.PostgreSQL.max_conn_idle_time:type_name -> google.protobuf.Duration
-	25, // 30: kratos.api.Data.PostgreSQL.health_check_period:type_name -> google.protobuf.Duration
-	14, // 31: kratos.api.Data.PostgreSQL.transaction:type_name -> kratos.api.Data.PostgreSQL.Transaction
-	15, // 32: kratos.api.Data.Client.jwt:type_name -> kratos.api.Data.Client.JWT
-	25, // 33: kratos.api.Data.PostgreSQL.Transaction.default_timeout:type_name -> google.protobuf.Duration
-	25, // 34: kratos.api.Data.PostgreSQL.Transaction.lock_timeout:type_name -> google.protobuf.Duration
-	19, // 35: kratos.api.Observability.Tracing.headers:type_name -> kratos.api.Observability.Tracing.HeadersEntry
-	25, // 36: kratos.api.Observability.Tracing.batch_timeout:type_name -> google.protobuf.Duration
-	25, // 37: kratos.api.Observability.Tracing.export_timeout:type_name -> google.protobuf.Duration
-	20, // 38: kratos.api.Observability.Tracing.attributes:type_name -> kratos.api.Observability.Tracing.AttributesEntry
-	21, // 39: kratos.api.Observability.Metrics.headers:type_name -> kratos.api.Observability.Metrics.HeadersEntry
-	25, // 40: kratos.api.Observability.Metrics.interval:type_name -> google.protobuf.Duration
-	22, // 41: kratos.api.Observability.Metrics.resource_attributes:type_name -> kratos.api.Observability.Metrics.ResourceAttributesEntry
-	5,  // 42: kratos.api.Messaging.TopicsEntry.value:type_name -> kratos.api.PubSub
-	8,  // 43: kratos.api.Messaging.InboxesEntry.value:type_name -> kratos.api.InboxConsumer
-	44, // [44:44] is the sub-list for method output_type
-	44, // [44:44] is the sub-list for method input_type
-	44, // [44:44] is the sub-list for extension type_name
-	44, // [44:44] is the sub-list for extension extendee
-	0,  // [0:44] is the sub-list for field type_name
+	10, // 4: kratos.api.Bootstrap.storage:type_name -> kratos.api.Storage
+	11, // 5: kratos.api.Bootstrap.moderation:type_name -> kratos.api.Moderation
+	12, // 6: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	14, // 7: kratos.api.Server.jwt:type_name -> kratos.api.Server.JWT
+	15, // 8: kratos.api.Server.handlers:type_name -> kratos.api.Server.Handlers
+	16, // 9: kratos.api.Server.health:type_name -> kratos.api.Server.Health
+	13, // 10: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	17, // 11: kratos.api.Server.rate_limit:type_name -> kratos.api.Server.RateLimit
+	18, // 12: kratos.api.Server.admin:type_name -> kratos.api.Server.Admin
+	21, // 13: kratos.api.Data.postgres:type_name -> kratos.api.Data.PostgreSQL
+	22, // 14: kratos.api.Data.grpc_client:type_name -> kratos.api.Data.Client
+	27, // 15: kratos.api.Observability.global_attributes:type_name -> kratos.api.Observability.GlobalAttributesEntry
+	25, // 16: kratos.api.Observability.tracing:type_name -> kratos.api.Observability.Tracing
+	26, // 17: kratos.api.Observability.metrics:type_name -> kratos.api.Observability.Metrics
+	32, // 18: kratos.api.Messaging.topics:type_name -> kratos.api.Messaging.TopicsEntry
+	8,  // 19: kratos.api.Messaging.outbox:type_name -> kratos.api.OutboxPublisher
+	33, // 20: kratos.api.Messaging.inboxes:type_name -> kratos.api.Messaging.InboxesEntry
+	5,  // 21: kratos.api.Messaging.event_bus:type_name -> kratos.api.EventBus
+	38, // 22: kratos.api.EventBus.poll_interval:type_name -> google.protobuf.Duration
+	38, // 23: kratos.api.EventBus.retry_delay:type_name -> google.protobuf.Duration
+	38, // 24: kratos.api.EventBus.retention:type_name -> google.protobuf.Duration
+	38, // 25: kratos.api.PubSub.publish_timeout:type_name -> google.protobuf.Duration
+	7,  // 26: kratos.api.PubSub.receive:type_name -> kratos.api.Receive
+	38, // 27: kratos.api.Receive.max_extension:type_name -> google.protobuf.Duration
+	38, // 28: kratos.api.Receive.max_extension_period:type_name -> google.protobuf.Duration
+	38, // 29: kratos.api.OutboxPublisher.tick_interval:type_name -> google.protobuf.Duration
+	38, // 30: kratos.api.OutboxPublisher.initial_backoff:type_name -> google.protobuf.Duration
+	38, // 31: kratos.api.OutboxPublisher.max_backoff:type_name -> google.protobuf.Duration
+	38, // 32: kratos.api.OutboxPublisher.publish_timeout:type_name -> google.protobuf.Duration
+	38, // 33: kratos.api.OutboxPublisher.lock_ttl:type_name -> google.protobuf.Duration
+	34, // 34: kratos.api.OutboxPublisher.retention:type_name -> kratos.api.OutboxPublisher.Retention
+	35, // 35: kratos.api.OutboxPublisher.routes:type_name -> kratos.api.OutboxPublisher.Route
+	36, // 36: kratos.api.Storage.avatar:type_name -> kratos.api.Storage.Avatar
+	37, // 37: kratos.api.Moderation.display_name:type_name -> kratos.api.Moderation.DisplayName
+	38, // 38: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	38, // 39: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	38, // 40: kratos.api.Server.Handlers.default_timeout:type_name -> google.protobuf.Duration
+	38, // 41: kratos.api.Server.Handlers.command_timeout:type_name -> google.protobuf.Duration
+	38, // 42: kratos.api.Server.Handlers.query_timeout:type_name -> google.protobuf.Duration
+	38, // 43: kratos.api.Server.Health.check_interval:type_name -> google.protobuf.Duration
+	38, // 44: kratos.api.Server.Health.probe_timeout:type_name -> google.protobuf.Duration
+	38, // 45: kratos.api.Server.Health.inbox_lag_threshold:type_name -> google.protobuf.Duration
+	19, // 46: kratos.api.Server.RateLimit.default_rule:type_name -> kratos.api.Server.RateLimit.Rule
+	20, // 47: kratos.api.Server.RateLimit.methods:type_name -> kratos.api.Server.RateLimit.MethodsEntry
+	38, // 48: kratos.api.Server.RateLimit.idle_ttl:type_name -> google.protobuf.Duration
+	19, // 49: kratos.api.Server.RateLimit.MethodsEntry.value:type_name -> kratos.api.Server.RateLimit.Rule
+	38, // 50: kratos.api.Data.PostgreSQL.max_conn_lifetime:type_name -> google.protobuf.Duration
+	38, // 51: kratos.api.Data.PostgreSQL.max_conn_idle_time:type_name -> google.protobuf.Duration
+	38, // 52: kratos.api.Data.PostgreSQL.health_check_period:type_name -> google.protobuf.Duration
+	23, // 53: kratos.api.Data.PostgreSQL.transaction:type_name -> kratos.api.Data.PostgreSQL.Transaction
+	24, // 54: kratos.api.Data.Client.jwt:type_name -> kratos.api.Data.Client.JWT
+	38, // 55: kratos.api.Data.PostgreSQL.Transaction.default_timeout:type_name -> google.protobuf.Duration
+	38, // 56: kratos.api.Data.PostgreSQL.Transaction.lock_timeout:type_name -> google.protobuf.Duration
+	28, // 57: kratos.api.Observability.Tracing.headers:type_name -> kratos.api.Observability.Tracing.HeadersEntry
+	38, // 58: kratos.api.Observability.Tracing.batch_timeout:type_name -> google.protobuf.Duration
+	38, // 59: kratos.api.Observability.Tracing.export_timeout:type_name -> google.protobuf.Duration
+	29, // 60: kratos.api.Observability.Tracing.attributes:type_name -> kratos.api.Observability.Tracing.AttributesEntry
+	30, // 61: kratos.api.Observability.Metrics.headers:type_name -> kratos.api.Observability.Metrics.HeadersEntry
+	38, // 62: kratos.api.Observability.Metrics.interval:type_name -> google.protobuf.Duration
+	31, // 63: kratos.api.Observability.Metrics.resource_attributes:type_name -> kratos.api.Observability.Metrics.ResourceAttributesEntry
+	6,  // 64: kratos.api.Messaging.TopicsEntry.value:type_name -> kratos.api.PubSub
+	9,  // 65: kratos.api.Messaging.InboxesEntry.value:type_name -> kratos.api.InboxConsumer
+	38, // 66: kratos.api.OutboxPublisher.Retention.max_age:type_name -> google.protobuf.Duration
+	38, // 67: kratos.api.OutboxPublisher.Retention.interval:type_name -> google.protobuf.Duration
+	38, // 68: kratos.api.Storage.Avatar.upload_ttl:type_name -> google.protobuf.Duration
+	69, // [69:69] is the sub-list for method output_type
+	69, // [69:69] is the sub-list for method input_type
+	69, // [69:69] is the sub-list for extension type_name
+	69, // [69:69] is the sub-list for extension extendee
+	0,  // [0:69] is the sub-list for field type_name
 }
 
 func init() { file_configs_conf_proto_init() }
@@ -1781,18 +2758,18 @@ func file_configs_conf_proto_init() {
 	if File_configs_conf_proto != nil {
 		return
 	}
-	file_configs_conf_proto_msgTypes[7].OneofWrappers = []any{}
 	file_configs_conf_proto_msgTypes[8].OneofWrappers = []any{}
-	file_configs_conf_proto_msgTypes[12].OneofWrappers = []any{}
-	file_configs_conf_proto_msgTypes[14].OneofWrappers = []any{}
-	file_configs_conf_proto_msgTypes[17].OneofWrappers = []any{}
+	file_configs_conf_proto_msgTypes[9].OneofWrappers = []any{}
+	file_configs_conf_proto_msgTypes[21].OneofWrappers = []any{}
+	file_configs_conf_proto_msgTypes[23].OneofWrappers = []any{}
+	file_configs_conf_proto_msgTypes[26].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_configs_conf_proto_rawDesc), len(file_configs_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
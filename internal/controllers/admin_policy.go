@@ -0,0 +1,55 @@
+package controllers
+
+import (
+	"strings"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/metadata"
+
+	"google.golang.org/grpc/codes"
+)
+
+// AdminPolicy 描述运营/内部 RPC（账户冻结与恢复、审计查询等）的调用方授权名单。
+// 零值不放行任何调用方。
+type AdminPolicy struct {
+	// ServiceAccounts 为允许调用的内部服务账号，匹配入站 JWT 的 email 声明。
+	ServiceAccounts []string
+	// Roles 为视作运营人员的 userinfo app_metadata 角色。
+	Roles []string
+}
+
+// WithAdminPolicy 设置运营/内部 RPC 的授权名单；未设置时这些 RPC 一律返回 PermissionDenied。
+func WithAdminPolicy(policy AdminPolicy) ProfileHandlerOption {
+	return func(h *ProfileHandler) {
+		h.admin = policy
+	}
+}
+
+// Allows 判断调用方是否为名单内的服务账号或持有运营角色的用户。
+func (p AdminPolicy) Allows(meta metadata.HandlerMetadata) bool {
+	if meta.ServiceAccount != "" && containsFold(p.ServiceAccounts, meta.ServiceAccount) {
+		return true
+	}
+	for _, role := range meta.Roles {
+		if containsFold(p.Roles, role) {
+			return true
+		}
+	}
+	return false
+}
+
+// requireAdmin 拒绝不在授权名单内的调用方。
+func (h *ProfileHandler) requireAdmin(meta metadata.HandlerMetadata) error {
+	if h.admin.Allows(meta) {
+		return nil
+	}
+	return problemStatus(codes.PermissionDenied, ReasonPermissionDenied, "caller is not allowed to perform admin operations", nil)
+}
+
+func containsFold(values []string, target string) bool {
+	for _, value := range values {
+		if strings.EqualFold(strings.TrimSpace(value), target) {
+			return true
+		}
+	}
+	return false
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	metadata "github.com/bionicotaku/lingo-services-profile/internal/metadata"
+	"github.com/go-kratos/kratos/v2/transport"
 	grpcmetadata "google.golang.org/grpc/metadata"
 )
 
@@ -87,17 +88,23 @@ func (h *BaseHandler) WithTimeout(ctx context.Context, kind HandlerType) (contex
 }
 
 // ExtractMetadata 解析请求中常见的幂等与条件请求 Header。
+// 优先读取 gRPC incoming metadata；HTTP 请求则回落到 Kratos transport 的请求头。
 func (h *BaseHandler) ExtractMetadata(ctx context.Context) metadata.HandlerMetadata {
-	md, ok := grpcmetadata.FromIncomingContext(ctx)
+	return extractMetadata(ctx)
+}
+
+func extractMetadata(ctx context.Context) metadata.HandlerMetadata {
+	// 操作者身份只取自经校验的凭证：服务账号由 gcjwt 校验后的中间件写入 Context，终端用户取自网关注入的 userinfo，
+	// 不解码请求自带的 Authorization，也不接受客户端自报的 Header。
+	meta := metadata.HandlerMetadata{ServiceAccount: metadata.VerifiedServiceAccount(ctx)}
+	lookup, ok := headerLookup(ctx)
 	if !ok {
-		return metadata.HandlerMetadata{}
-	}
-	meta := metadata.HandlerMetadata{
-		IdempotencyKey: firstMetadata(md, headerIdempotencyKey),
-		IfMatch:        firstMetadata(md, headerIfMatch),
-		IfNoneMatch:    firstMetadata(md, headerIfNoneMatch),
+		return meta
 	}
-	rawUserInfo := firstMetadata(md, headerUserInfo)
+	meta.IdempotencyKey = lookup(headerIdempotencyKey)
+	meta.IfMatch = lookup(headerIfMatch)
+	meta.IfNoneMatch = lookup(headerIfNoneMatch)
+	rawUserInfo := lookup(headerUserInfo)
 	meta.RawUserInfo = rawUserInfo
 	if rawUserInfo != "" {
 		if userID, err := metadata.ExtractUserIDFromUserInfo(rawUserInfo); err == nil {
@@ -109,6 +116,13 @@ func (h *BaseHandler) ExtractMetadata(ctx context.Context) metadata.HandlerMetad
 		} else {
 			meta.InvalidUserInfo = true
 		}
+		if locale, timezone, err := metadata.ExtractLocaleFromUserInfo(rawUserInfo); err == nil {
+			meta.Locale = locale
+			meta.Timezone = timezone
+		}
+		if roles, err := metadata.ExtractRolesFromUserInfo(rawUserInfo); err == nil {
+			meta.Roles = roles
+		}
 	}
 	return meta
 }
@@ -123,6 +137,18 @@ func HandlerMetadataFromContext(ctx context.Context) (metadata.HandlerMetadata,
 	return metadata.FromContext(ctx)
 }
 
+// headerLookup 返回按 Header 名读取首个值的函数。
+func headerLookup(ctx context.Context) (func(string) string, bool) {
+	if md, ok := grpcmetadata.FromIncomingContext(ctx); ok {
+		return func(key string) string { return firstMetadata(md, key) }, true
+	}
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		header := tr.RequestHeader()
+		return func(key string) string { return strings.TrimSpace(header.Get(key)) }, true
+	}
+	return nil, false
+}
+
 func firstMetadata(md grpcmetadata.MD, key string) string {
 	if len(md) == 0 {
 		return ""
@@ -0,0 +1,376 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	profilev1 "github.com/bionicotaku/lingo-services-profile/api/profile/v1"
+	"github.com/bionicotaku/lingo-services-profile/internal/controllers/dto"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/vo"
+	"github.com/bionicotaku/lingo-services-profile/internal/services"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+// CreateCollection 新建收藏夹。
+func (h *ProfileHandler) CreateCollection(ctx context.Context, req *profilev1.CreateCollectionRequest) (*profilev1.CreateCollectionResponse, error) {
+	if h.collections == nil {
+		return nil, collectionsUnavailable()
+	}
+	meta := h.ExtractMetadata(ctx)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
+	if err != nil {
+		return nil, invalidField("user_id", err)
+	}
+
+	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeCommand)
+	defer cancel()
+	timeoutCtx = InjectHandlerMetadata(timeoutCtx, meta)
+
+	if err := h.profiles.EnsureAccountWritable(timeoutCtx, userID); err != nil {
+		return nil, mapProfileError(err)
+	}
+	collection, err := h.collections.CreateCollection(timeoutCtx, userID, req.GetName())
+	if err != nil {
+		return nil, mapCollectionError(err)
+	}
+	return &profilev1.CreateCollectionResponse{Collection: dto.ToProtoCollection(collection)}, nil
+}
+
+// ListCollections 分页返回收藏夹。
+func (h *ProfileHandler) ListCollections(ctx context.Context, req *profilev1.ListCollectionsRequest) (*profilev1.ListCollectionsResponse, error) {
+	if h.collections == nil {
+		return nil, collectionsUnavailable()
+	}
+	meta := h.ExtractMetadata(ctx)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
+	if err != nil {
+		return nil, invalidField("user_id", err)
+	}
+	limit, offset, err := parsePagination(req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, invalidField("page_token", err)
+	}
+
+	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeQuery)
+	defer cancel()
+	timeoutCtx = InjectHandlerMetadata(timeoutCtx, meta)
+
+	collections, err := h.collections.ListCollections(timeoutCtx, userID, limit+1, int32(offset))
+	if err != nil {
+		return nil, mapCollectionError(err)
+	}
+	nextToken := ""
+	if len(collections) > int(limit) {
+		nextToken = strconv.Itoa(offset + int(limit))
+		collections = collections[:limit]
+	}
+	result := make([]*profilev1.Collection, 0, len(collections))
+	for _, collection := range collections {
+		result = append(result, dto.ToProtoCollection(collection))
+	}
+	return &profilev1.ListCollectionsResponse{Collections: result, NextPageToken: nextToken}, nil
+}
+
+// RenameCollection 重命名收藏夹。
+func (h *ProfileHandler) RenameCollection(ctx context.Context, req *profilev1.RenameCollectionRequest) (*profilev1.RenameCollectionResponse, error) {
+	if h.collections == nil {
+		return nil, collectionsUnavailable()
+	}
+	meta := h.ExtractMetadata(ctx)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
+	if err != nil {
+		return nil, invalidField("user_id", err)
+	}
+	collectionID, err := parseUUID(req.GetCollectionId())
+	if err != nil {
+		return nil, invalidField("collection_id", fmt.Errorf("invalid collection_id: %w", err))
+	}
+
+	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeCommand)
+	defer cancel()
+	timeoutCtx = InjectHandlerMetadata(timeoutCtx, meta)
+
+	if err := h.profiles.EnsureAccountWritable(timeoutCtx, userID); err != nil {
+		return nil, mapProfileError(err)
+	}
+	collection, err := h.collections.RenameCollection(timeoutCtx, userID, collectionID, req.GetName())
+	if err != nil {
+		return nil, mapCollectionError(err)
+	}
+	return &profilev1.RenameCollectionResponse{Collection: dto.ToProtoCollection(collection)}, nil
+}
+
+// DeleteCollection 删除收藏夹。
+func (h *ProfileHandler) DeleteCollection(ctx context.Context, req *profilev1.DeleteCollectionRequest) (*profilev1.DeleteCollectionResponse, error) {
+	if h.collections == nil {
+		return nil, collectionsUnavailable()
+	}
+	meta := h.ExtractMetadata(ctx)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
+	if err != nil {
+		return nil, invalidField("user_id", err)
+	}
+	collectionID, err := parseUUID(req.GetCollectionId())
+	if err != nil {
+		return nil, invalidField("collection_id", fmt.Errorf("invalid collection_id: %w", err))
+	}
+
+	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeCommand)
+	defer cancel()
+	timeoutCtx = InjectHandlerMetadata(timeoutCtx, meta)
+
+	if err := h.profiles.EnsureAccountWritable(timeoutCtx, userID); err != nil {
+		return nil, mapProfileError(err)
+	}
+	if err := h.collections.DeleteCollection(timeoutCtx, userID, collectionID); err != nil {
+		return nil, mapCollectionError(err)
+	}
+	return &profilev1.DeleteCollectionResponse{}, nil
+}
+
+// AddCollectionItem 将视频加入收藏夹。
+func (h *ProfileHandler) AddCollectionItem(ctx context.Context, req *profilev1.AddCollectionItemRequest) (*profilev1.AddCollectionItemResponse, error) {
+	if h.collections == nil {
+		return nil, collectionsUnavailable()
+	}
+	meta := h.ExtractMetadata(ctx)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
+	if err != nil {
+		return nil, invalidField("user_id", err)
+	}
+	collectionID, err := parseUUID(req.GetCollectionId())
+	if err != nil {
+		return nil, invalidField("collection_id", fmt.Errorf("invalid collection_id: %w", err))
+	}
+	videoID, err := parseUUID(req.GetVideoId())
+	if err != nil {
+		return nil, invalidField("video_id", fmt.Errorf("invalid video_id: %w", err))
+	}
+
+	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeCommand)
+	defer cancel()
+	timeoutCtx = InjectHandlerMetadata(timeoutCtx, meta)
+
+	if err := h.profiles.EnsureAccountWritable(timeoutCtx, userID); err != nil {
+		return nil, mapProfileError(err)
+	}
+	item, err := h.collections.AddItem(timeoutCtx, services.AddCollectionItemInput{
+		UserID:       userID,
+		CollectionID: collectionID,
+		VideoID:      videoID,
+	})
+	if err != nil {
+		return nil, mapCollectionError(err)
+	}
+	items, err := h.hydrateCollectionItems(timeoutCtx, []*po.CollectionItem{item})
+	if err != nil {
+		return nil, err
+	}
+	return &profilev1.AddCollectionItemResponse{Item: items[0]}, nil
+}
+
+// RemoveCollectionItem 将视频移出收藏夹。
+func (h *ProfileHandler) RemoveCollectionItem(ctx context.Context, req *profilev1.RemoveCollectionItemRequest) (*profilev1.RemoveCollectionItemResponse, error) {
+	if h.collections == nil {
+		return nil, collectionsUnavailable()
+	}
+	meta := h.ExtractMetadata(ctx)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
+	if err != nil {
+		return nil, invalidField("user_id", err)
+	}
+	collectionID, err := parseUUID(req.GetCollectionId())
+	if err != nil {
+		return nil, invalidField("collection_id", fmt.Errorf("invalid collection_id: %w", err))
+	}
+	videoID, err := parseUUID(req.GetVideoId())
+	if err != nil {
+		return nil, invalidField("video_id", fmt.Errorf("invalid video_id: %w", err))
+	}
+
+	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeCommand)
+	defer cancel()
+	timeoutCtx = InjectHandlerMetadata(timeoutCtx, meta)
+
+	if err := h.profiles.EnsureAccountWritable(timeoutCtx, userID); err != nil {
+		return nil, mapProfileError(err)
+	}
+	if err := h.collections.RemoveItem(timeoutCtx, userID, collectionID, videoID); err != nil {
+		return nil, mapCollectionError(err)
+	}
+	return &profilev1.RemoveCollectionItemResponse{}, nil
+}
+
+// ReorderCollectionItems 重排收藏夹成员。
+func (h *ProfileHandler) ReorderCollectionItems(ctx context.Context, req *profilev1.ReorderCollectionItemsRequest) (*profilev1.ReorderCollectionItemsResponse, error) {
+	if h.collections == nil {
+		return nil, collectionsUnavailable()
+	}
+	meta := h.ExtractMetadata(ctx)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
+	if err != nil {
+		return nil, invalidField("user_id", err)
+	}
+	collectionID, err := parseUUID(req.GetCollectionId())
+	if err != nil {
+		return nil, invalidField("collection_id", fmt.Errorf("invalid collection_id: %w", err))
+	}
+	videoIDs, err := parseUUIDs(req.GetVideoIds())
+	if err != nil {
+		return nil, invalidField("video_ids", fmt.Errorf("invalid video_ids: %w", err))
+	}
+
+	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeCommand)
+	defer cancel()
+	timeoutCtx = InjectHandlerMetadata(timeoutCtx, meta)
+
+	if err := h.profiles.EnsureAccountWritable(timeoutCtx, userID); err != nil {
+		return nil, mapProfileError(err)
+	}
+	if err := h.collections.ReorderItems(timeoutCtx, userID, collectionID, videoIDs); err != nil {
+		return nil, mapCollectionError(err)
+	}
+	return &profilev1.ReorderCollectionItemsResponse{}, nil
+}
+
+// MoveCollectionItem 在收藏夹之间移动视频。
+func (h *ProfileHandler) MoveCollectionItem(ctx context.Context, req *profilev1.MoveCollectionItemRequest) (*profilev1.MoveCollectionItemResponse, error) {
+	if h.collections == nil {
+		return nil, collectionsUnavailable()
+	}
+	meta := h.ExtractMetadata(ctx)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
+	if err != nil {
+		return nil, invalidField("user_id", err)
+	}
+	sourceID, err := parseUUID(req.GetCollectionId())
+	if err != nil {
+		return nil, invalidField("collection_id", fmt.Errorf("invalid collection_id: %w", err))
+	}
+	videoID, err := parseUUID(req.GetVideoId())
+	if err != nil {
+		return nil, invalidField("video_id", fmt.Errorf("invalid video_id: %w", err))
+	}
+	targetID, err := parseUUID(req.GetTargetCollectionId())
+	if err != nil {
+		return nil, invalidField("target_collection_id", fmt.Errorf("invalid target_collection_id: %w", err))
+	}
+
+	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeCommand)
+	defer cancel()
+	timeoutCtx = InjectHandlerMetadata(timeoutCtx, meta)
+
+	if err := h.profiles.EnsureAccountWritable(timeoutCtx, userID); err != nil {
+		return nil, mapProfileError(err)
+	}
+	item, err := h.collections.MoveItem(timeoutCtx, services.MoveItemInput{
+		UserID:             userID,
+		SourceCollectionID: sourceID,
+		TargetCollectionID: targetID,
+		VideoID:            videoID,
+	})
+	if err != nil {
+		return nil, mapCollectionError(err)
+	}
+	items, err := h.hydrateCollectionItems(timeoutCtx, []*po.CollectionItem{item})
+	if err != nil {
+		return nil, err
+	}
+	return &profilev1.MoveCollectionItemResponse{Item: items[0]}, nil
+}
+
+// ListCollectionItems 分页返回收藏夹成员，视频元数据来自 videos_projection。
+func (h *ProfileHandler) ListCollectionItems(ctx context.Context, req *profilev1.ListCollectionItemsRequest) (*profilev1.ListCollectionItemsResponse, error) {
+	if h.collections == nil {
+		return nil, collectionsUnavailable()
+	}
+	meta := h.ExtractMetadata(ctx)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
+	if err != nil {
+		return nil, invalidField("user_id", err)
+	}
+	collectionID, err := parseUUID(req.GetCollectionId())
+	if err != nil {
+		return nil, invalidField("collection_id", fmt.Errorf("invalid collection_id: %w", err))
+	}
+	limit, offset, err := parsePagination(req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, invalidField("page_token", err)
+	}
+
+	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeQuery)
+	defer cancel()
+	timeoutCtx = InjectHandlerMetadata(timeoutCtx, meta)
+
+	collection, items, err := h.collections.ListCollectionItems(timeoutCtx, userID, collectionID, limit+1, int32(offset))
+	if err != nil {
+		return nil, mapCollectionError(err)
+	}
+	nextToken := ""
+	if len(items) > int(limit) {
+		nextToken = strconv.Itoa(offset + int(limit))
+		items = items[:limit]
+	}
+	result, err := h.hydrateCollectionItems(timeoutCtx, items)
+	if err != nil {
+		return nil, err
+	}
+	return &profilev1.ListCollectionItemsResponse{
+		Collection:    dto.ToProtoCollection(collection),
+		Items:         result,
+		NextPageToken: nextToken,
+	}, nil
+}
+
+// hydrateCollectionItems 批量读取视频投影并转换为 proto，投影缺失的成员 video 为空。
+func (h *ProfileHandler) hydrateCollectionItems(ctx context.Context, items []*po.CollectionItem) ([]*profilev1.CollectionItem, error) {
+	metaMap := map[uuid.UUID]*vo.ProfileVideoMetadata{}
+	if len(items) > 0 && h.projections != nil {
+		videoIDs := make([]uuid.UUID, 0, len(items))
+		for _, item := range items {
+			videoIDs = append(videoIDs, item.VideoID)
+		}
+		proj, err := h.projections.ListProjections(ctx, videoIDs)
+		if err != nil {
+			return nil, internalError("list projections", err)
+		}
+		for _, p := range proj {
+			metaMap[p.VideoID] = projectionToMetadataVO(p)
+		}
+	}
+	result := make([]*profilev1.CollectionItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, dto.ToProtoCollectionItem(vo.NewCollectionItemFromPO(item, metaMap[item.VideoID])))
+	}
+	return result, nil
+}
+
+func collectionsUnavailable() error {
+	return problemStatus(codes.Unimplemented, ReasonNotImplemented, "collections not enabled", nil)
+}
+
+func mapCollectionError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrCollectionNotFound):
+		return problemStatus(codes.NotFound, ReasonCollectionNotFound, err.Error(), nil)
+	case errors.Is(err, services.ErrCollectionItemNotFound):
+		return problemStatus(codes.NotFound, ReasonCollectionItemNotFound, err.Error(), nil)
+	case errors.Is(err, services.ErrCollectionNameTaken):
+		return problemStatus(codes.AlreadyExists, ReasonCollectionNameTaken, err.Error(), map[string]string{"field": "name"})
+	case errors.Is(err, services.ErrCollectionFull):
+		return preconditionFailed(codes.FailedPrecondition, ReasonCollectionFull, preconditionCollectionCapacity, "collection_id", err)
+	case errors.Is(err, services.ErrInvalidCollectionName):
+		return invalidField("name", err)
+	case errors.Is(err, services.ErrCollectionOrderMismatch):
+		return invalidField("video_ids", err)
+	case errors.Is(err, services.ErrCollectionMoveSameTarget):
+		return invalidField("target_collection_id", err)
+	default:
+		return internalError("", err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	profilev1 "github.com/bionicotaku/lingo-services-profile/api/profile/v1"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
 	"github.com/bionicotaku/lingo-services-profile/internal/models/vo"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -17,13 +18,67 @@ func ToProtoProfile(profile *vo.Profile) *profilev1.Profile {
 		return nil
 	}
 	return &profilev1.Profile{
-		UserId:         profile.UserID,
-		DisplayName:    profile.DisplayName,
-		AvatarUrl:      valueOrEmpty(profile.AvatarURL),
-		ProfileVersion: profile.ProfileVersion,
-		Preferences:    toProtoPreferences(profile.Preferences),
-		CreatedAt:      unixTime(profile.CreatedAt),
-		UpdatedAt:      unixTime(profile.UpdatedAt),
+		UserId:            profile.UserID,
+		DisplayName:       profile.DisplayName,
+		AvatarUrl:         valueOrEmpty(profile.AvatarURL),
+		ProfileVersion:    profile.ProfileVersion,
+		Preferences:       toProtoPreferences(profile.Preferences),
+		CreatedAt:         unixTime(profile.CreatedAt),
+		UpdatedAt:         unixTime(profile.UpdatedAt),
+		AccountStatus:     ToProtoAccountStatus(profile.AccountStatus),
+		PendingDeletionAt: timePtr(profile.PendingDeletionAt),
+		DeletedAt:         timePtr(profile.DeletedAt),
+		Visibility:        ToProtoVisibility(profile.Visibility),
+		PreferredLocale:   valueOrEmpty(profile.PreferredLocale),
+		PreferredTimezone: valueOrEmpty(profile.PreferredTimezone),
+	}
+}
+
+// ToProtoVisibility 转换字段可见性设置。
+func ToProtoVisibility(visibility vo.ProfileVisibility) *profilev1.ProfileVisibility {
+	return &profilev1.ProfileVisibility{
+		PublicDisplayName: visibility.PublicDisplayName,
+		PublicAvatar:      visibility.PublicAvatar,
+		PublicBookmarks:   visibility.PublicBookmarks,
+		PublicStats:       visibility.PublicStats,
+	}
+}
+
+// ToProtoPublicProfile 转换公开主页视图，未公开字段保持零值。
+func ToProtoPublicProfile(profile *vo.PublicProfile) *profilev1.PublicProfile {
+	if profile == nil {
+		return nil
+	}
+	proto := &profilev1.PublicProfile{
+		UserId:          profile.UserID,
+		DisplayName:     valueOrEmpty(profile.DisplayName),
+		AvatarUrl:       valueOrEmpty(profile.AvatarURL),
+		BookmarksPublic: profile.BookmarksPublic,
+	}
+	if stats := profile.Stats; stats != nil {
+		proto.Stats = &profilev1.PublicProfileStats{
+			LikeCount:         stats.LikeCount,
+			BookmarkCount:     stats.BookmarkCount,
+			WatchedVideoCount: stats.WatchedVideoCount,
+			TotalWatchSeconds: stats.TotalWatchSeconds,
+		}
+	}
+	return proto
+}
+
+// ToProtoAccountStatus 将账户状态字符串转换为 protobuf 枚举，空值视为 active。
+func ToProtoAccountStatus(status string) profilev1.AccountStatus {
+	switch status {
+	case "", "active":
+		return profilev1.AccountStatus_ACCOUNT_STATUS_ACTIVE
+	case "suspended":
+		return profilev1.AccountStatus_ACCOUNT_STATUS_SUSPENDED
+	case "pending_deletion":
+		return profilev1.AccountStatus_ACCOUNT_STATUS_PENDING_DELETION
+	case "deleted":
+		return profilev1.AccountStatus_ACCOUNT_STATUS_DELETED
+	default:
+		return profilev1.AccountStatus_ACCOUNT_STATUS_UNSPECIFIED
 	}
 }
 
@@ -46,6 +101,9 @@ func ToProtoFavoriteState(state vo.FavoriteState) *profilev1.FavoriteState {
 		HasBookmarked: state.HasBookmarked,
 		LikedAt:       timePtr(state.LikedAt),
 		BookmarkedAt:  timePtr(state.BookmarkedAt),
+		HasDisliked:   state.HasDisliked,
+		HasShared:     state.HasShared,
+		NotInterested: state.NotInterested,
 	}
 }
 
@@ -57,6 +115,8 @@ func ToProtoVideoStats(stats *vo.ProfileVideoStats) *profilev1.VideoStats {
 	return &profilev1.VideoStats{
 		LikeCount:         stats.LikeCount,
 		BookmarkCount:     stats.BookmarkCount,
+		DislikeCount:      stats.DislikeCount,
+		ShareCount:        stats.ShareCount,
 		UniqueWatchers:    stats.UniqueWatchers,
 		TotalWatchSeconds: stats.TotalWatchSeconds,
 		UpdatedAt:         unixTime(stats.UpdatedAt),
@@ -99,6 +159,84 @@ func ToProtoWatchProgress(progress *vo.WatchProgress) *profilev1.WatchProgress {
 	}
 }
 
+// ToProtoEngagementContext 从互动 metadata 中提取已知上下文字段；均为空时返回 nil。
+func ToProtoEngagementContext(metadata map[string]any) *profilev1.EngagementContext {
+	entryPoint, _ := metadata[po.EngagementMetadataEntryPoint].(string)
+	device, _ := metadata[po.EngagementMetadataDevice].(string)
+	if entryPoint == "" && device == "" {
+		return nil
+	}
+	return &profilev1.EngagementContext{EntryPoint: entryPoint, Device: device}
+}
+
+// ToProtoAuditEntry 转换审计记录；无法表示为 Struct 的快照降级为空对象。
+func ToProtoAuditEntry(entry *vo.AuditEntry) *profilev1.AuditEntry {
+	if entry == nil {
+		return nil
+	}
+	before, _ := structpb.NewStruct(entry.Before)
+	after, _ := structpb.NewStruct(entry.After)
+	return &profilev1.AuditEntry{
+		AuditId:      entry.AuditID,
+		UserId:       entry.UserID,
+		ActorType:    entry.ActorType,
+		ActorId:      entry.ActorID,
+		Action:       entry.Action,
+		ResourceType: entry.ResourceType,
+		ResourceId:   entry.ResourceID,
+		Before:       before,
+		After:        after,
+		TraceId:      entry.TraceID,
+		CreatedAt:    unixTime(entry.CreatedAt),
+	}
+}
+
+// ToProtoCollection 将收藏夹 VO 转换为 proto。
+func ToProtoCollection(collection *vo.Collection) *profilev1.Collection {
+	if collection == nil {
+		return nil
+	}
+	return &profilev1.Collection{
+		CollectionId: collection.CollectionID,
+		Name:         collection.Name,
+		ItemCount:    collection.ItemCount,
+		CreatedAt:    unixTime(collection.CreatedAt),
+		UpdatedAt:    unixTime(collection.UpdatedAt),
+	}
+}
+
+// ToProtoCollectionItem 将收藏夹成员 VO 转换为 proto。
+func ToProtoCollectionItem(item *vo.CollectionItem) *profilev1.CollectionItem {
+	if item == nil {
+		return nil
+	}
+	return &profilev1.CollectionItem{
+		VideoId:  item.VideoID,
+		Position: item.Position,
+		AddedAt:  unixTime(item.AddedAt),
+		Video:    ToProtoVideoMetadata(item.Video),
+	}
+}
+
+// ToProtoEngagementNote 将收藏笔记 VO 转换为 proto。
+func ToProtoEngagementNote(note *vo.EngagementNote) *profilev1.EngagementNote {
+	if note == nil {
+		return nil
+	}
+	var position *wrapperspb.Int32Value
+	if note.PositionSeconds != nil {
+		position = wrapperspb.Int32(*note.PositionSeconds)
+	}
+	return &profilev1.EngagementNote{
+		NoteId:          note.NoteID,
+		VideoId:         note.VideoID,
+		Body:            note.Body,
+		PositionSeconds: position,
+		CreatedAt:       unixTime(note.CreatedAt),
+		UpdatedAt:       unixTime(note.UpdatedAt),
+	}
+}
+
 func valueOrEmpty(ptr *string) string {
 	if ptr == nil {
 		return ""
@@ -106,6 +244,21 @@ func valueOrEmpty(ptr *string) string {
 	return *ptr
 }
 
+// ToProtoAvatarUploadTarget 将头像上传目标转换为 gRPC AvatarUploadTarget。
+func ToProtoAvatarUploadTarget(upload *vo.AvatarUpload) *profilev1.AvatarUploadTarget {
+	if upload == nil {
+		return nil
+	}
+	return &profilev1.AvatarUploadTarget{
+		UploadKey: upload.Key,
+		UploadUrl: upload.URL,
+		Method:    upload.Method,
+		Headers:   upload.Headers,
+		ExpiresAt: unixTime(upload.ExpiresAt),
+		MaxBytes:  upload.MaxBytes,
+	}
+}
+
 func derefInt64(ptr *int64) int64 {
 	if ptr == nil {
 		return 0
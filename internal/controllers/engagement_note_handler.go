@@ -0,0 +1,167 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	profilev1 "github.com/bionicotaku/lingo-services-profile/api/profile/v1"
+	"github.com/bionicotaku/lingo-services-profile/internal/controllers/dto"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/vo"
+	"github.com/bionicotaku/lingo-services-profile/internal/services"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// AddEngagementNote 为收藏添加笔记。
+func (h *ProfileHandler) AddEngagementNote(ctx context.Context, req *profilev1.AddEngagementNoteRequest) (*profilev1.AddEngagementNoteResponse, error) {
+	if h.notes == nil {
+		return nil, notesUnavailable()
+	}
+	meta := h.ExtractMetadata(ctx)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
+	if err != nil {
+		return nil, invalidField("user_id", err)
+	}
+	videoID, err := parseUUID(req.GetVideoId())
+	if err != nil {
+		return nil, invalidField("video_id", fmt.Errorf("invalid video_id: %w", err))
+	}
+
+	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeCommand)
+	defer cancel()
+	timeoutCtx = InjectHandlerMetadata(timeoutCtx, meta)
+
+	if err := h.profiles.EnsureAccountWritable(timeoutCtx, userID); err != nil {
+		return nil, mapProfileError(err)
+	}
+	note, err := h.notes.AddNote(timeoutCtx, services.AddEngagementNoteInput{
+		UserID:          userID,
+		VideoID:         videoID,
+		Body:            req.GetBody(),
+		PositionSeconds: int32ValuePtr(req.GetPositionSeconds()),
+	})
+	if err != nil {
+		return nil, mapEngagementNoteError(err)
+	}
+	return &profilev1.AddEngagementNoteResponse{Note: dto.ToProtoEngagementNote(vo.NewEngagementNoteFromPO(note))}, nil
+}
+
+// UpdateEngagementNote 修改笔记。
+func (h *ProfileHandler) UpdateEngagementNote(ctx context.Context, req *profilev1.UpdateEngagementNoteRequest) (*profilev1.UpdateEngagementNoteResponse, error) {
+	if h.notes == nil {
+		return nil, notesUnavailable()
+	}
+	meta := h.ExtractMetadata(ctx)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
+	if err != nil {
+		return nil, invalidField("user_id", err)
+	}
+	noteID, err := parseUUID(req.GetNoteId())
+	if err != nil {
+		return nil, invalidField("note_id", fmt.Errorf("invalid note_id: %w", err))
+	}
+
+	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeCommand)
+	defer cancel()
+	timeoutCtx = InjectHandlerMetadata(timeoutCtx, meta)
+
+	if err := h.profiles.EnsureAccountWritable(timeoutCtx, userID); err != nil {
+		return nil, mapProfileError(err)
+	}
+	note, err := h.notes.UpdateNote(timeoutCtx, services.UpdateEngagementNoteInput{
+		UserID:          userID,
+		NoteID:          noteID,
+		Body:            req.GetBody(),
+		PositionSeconds: int32ValuePtr(req.GetPositionSeconds()),
+	})
+	if err != nil {
+		return nil, mapEngagementNoteError(err)
+	}
+	return &profilev1.UpdateEngagementNoteResponse{Note: dto.ToProtoEngagementNote(vo.NewEngagementNoteFromPO(note))}, nil
+}
+
+// DeleteEngagementNote 删除笔记。
+func (h *ProfileHandler) DeleteEngagementNote(ctx context.Context, req *profilev1.DeleteEngagementNoteRequest) (*profilev1.DeleteEngagementNoteResponse, error) {
+	if h.notes == nil {
+		return nil, notesUnavailable()
+	}
+	meta := h.ExtractMetadata(ctx)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
+	if err != nil {
+		return nil, invalidField("user_id", err)
+	}
+	noteID, err := parseUUID(req.GetNoteId())
+	if err != nil {
+		return nil, invalidField("note_id", fmt.Errorf("invalid note_id: %w", err))
+	}
+
+	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeCommand)
+	defer cancel()
+	timeoutCtx = InjectHandlerMetadata(timeoutCtx, meta)
+
+	if err := h.profiles.EnsureAccountWritable(timeoutCtx, userID); err != nil {
+		return nil, mapProfileError(err)
+	}
+	if err := h.notes.DeleteNote(timeoutCtx, userID, noteID); err != nil {
+		return nil, mapEngagementNoteError(err)
+	}
+	return &profilev1.DeleteEngagementNoteResponse{}, nil
+}
+
+// bookmarkNotes 批量读取收藏条目上的笔记并按视频分组；未启用笔记时返回空映射。
+func (h *ProfileHandler) bookmarkNotes(ctx context.Context, userID uuid.UUID, items []*po.ProfileEngagement) (map[uuid.UUID][]*profilev1.EngagementNote, error) {
+	result := map[uuid.UUID][]*profilev1.EngagementNote{}
+	if h.notes == nil {
+		return result, nil
+	}
+	videoIDs := make([]uuid.UUID, 0, len(items))
+	for _, item := range items {
+		if item.EngagementType == services.EngagementTypeBookmark && item.DeletedAt == nil {
+			videoIDs = append(videoIDs, item.VideoID)
+		}
+	}
+	if len(videoIDs) == 0 {
+		return result, nil
+	}
+	notes, err := h.notes.ListBookmarkNotes(ctx, userID, videoIDs)
+	if err != nil {
+		return nil, internalError("list notes", err)
+	}
+	for _, note := range notes {
+		result[note.VideoID] = append(result[note.VideoID], dto.ToProtoEngagementNote(vo.NewEngagementNoteFromPO(note)))
+	}
+	return result, nil
+}
+
+func int32ValuePtr(value *wrapperspb.Int32Value) *int32 {
+	if value == nil {
+		return nil
+	}
+	v := value.GetValue()
+	return &v
+}
+
+func notesUnavailable() error {
+	return problemStatus(codes.Unimplemented, ReasonNotImplemented, "engagement notes not enabled", nil)
+}
+
+func mapEngagementNoteError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrEngagementNoteNotFound):
+		return problemStatus(codes.NotFound, ReasonEngagementNoteNotFound, err.Error(), nil)
+	case errors.Is(err, services.ErrNoteRequiresBookmark):
+		return preconditionFailed(codes.FailedPrecondition, ReasonNoteRequiresBookmark, preconditionBookmark, "video_id", err)
+	case errors.Is(err, services.ErrTooManyNotes):
+		return preconditionFailed(codes.FailedPrecondition, ReasonTooManyNotes, preconditionNoteCapacity, "video_id", err)
+	case errors.Is(err, services.ErrInvalidNoteBody):
+		return invalidField("body", err)
+	case errors.Is(err, services.ErrInvalidNotePosition):
+		return invalidField("position_seconds", err)
+	default:
+		return internalError("", err)
+	}
+}
@@ -0,0 +1,71 @@
+package controllers
+
+// ErrorDomain 为 Profile 服务错误中 google.rpc.ErrorInfo.domain 的取值。
+const ErrorDomain = "lingo-services-profile"
+
+// 以下为 google.rpc.ErrorInfo.reason 的稳定取值，Gateway 据此映射为 Problem 类型（profile.errors.*）。
+// 新增原因只允许追加，已发布的取值不得修改或复用。
+const (
+	// ReasonInvalidArgument 表示请求参数缺失或格式错误，BadRequest 详情中给出具体字段。
+	ReasonInvalidArgument = "profile.errors.invalid_argument"
+	// ReasonProfileNotFound 表示档案不存在（或非 active 账户的公开主页）。
+	ReasonProfileNotFound = "profile.errors.profile_not_found"
+	// ReasonPreferenceConflict 表示 expected_profile_version 与当前版本不一致。
+	ReasonPreferenceConflict = "profile.errors.preference_conflict"
+	// ReasonAccountNotActive 表示账户状态不允许写操作。
+	ReasonAccountNotActive = "profile.errors.account_not_active"
+	// ReasonInvalidAccountStatusTransition 表示不允许的账户状态迁移。
+	ReasonInvalidAccountStatusTransition = "profile.errors.invalid_account_status_transition"
+	// ReasonInvalidLocale 表示 preferred_locale 不是合法的 BCP47 标签。
+	ReasonInvalidLocale = "profile.errors.invalid_locale"
+	// ReasonInvalidTimezone 表示 preferred_timezone 不是合法的 IANA 时区。
+	ReasonInvalidTimezone = "profile.errors.invalid_timezone"
+	// ReasonFieldNotPublic 表示请求的公开主页字段未被用户公开。
+	ReasonFieldNotPublic = "profile.errors.field_not_public"
+	// ReasonAvatarURLNotAllowed 表示头像地址不在允许的域名范围内。
+	ReasonAvatarURLNotAllowed = "profile.errors.avatar_url_not_allowed"
+	// ReasonAvatarContentTypeNotAllowed 表示头像文件类型不被接受。
+	ReasonAvatarContentTypeNotAllowed = "profile.errors.avatar_content_type_not_allowed"
+	// ReasonAvatarTooLarge 表示头像文件超过大小上限。
+	ReasonAvatarTooLarge = "profile.errors.avatar_too_large"
+	// ReasonAvatarUploadNotFound 表示头像上传对象不存在或不属于当前用户。
+	ReasonAvatarUploadNotFound = "profile.errors.avatar_upload_not_found"
+	// ReasonAvatarUploadUnavailable 表示服务未启用头像直传。
+	ReasonAvatarUploadUnavailable = "profile.errors.avatar_upload_unavailable"
+	// ReasonDisplayNameTooShort 表示昵称短于最小长度。
+	ReasonDisplayNameTooShort = "profile.errors.display_name_too_short"
+	// ReasonDisplayNameTooLong 表示昵称超过最大长度。
+	ReasonDisplayNameTooLong = "profile.errors.display_name_too_long"
+	// ReasonDisplayNameInvalidCharacters 表示昵称包含不允许的字符。
+	ReasonDisplayNameInvalidCharacters = "profile.errors.display_name_invalid_characters"
+	// ReasonDisplayNameBlocked 表示昵称命中屏蔽词。
+	ReasonDisplayNameBlocked = "profile.errors.display_name_blocked"
+	// ReasonDisplayNameRejected 表示昵称被外部审核拒绝。
+	ReasonDisplayNameRejected = "profile.errors.display_name_rejected"
+	// ReasonDisplayNameTaken 表示昵称已被占用。
+	ReasonDisplayNameTaken = "profile.errors.display_name_taken"
+	// ReasonUnsupportedEngagementType 表示不支持的互动类型。
+	ReasonUnsupportedEngagementType = "profile.errors.unsupported_engagement_type"
+	// ReasonCollectionNotFound 表示收藏夹不存在或不属于当前用户。
+	ReasonCollectionNotFound = "profile.errors.collection_not_found"
+	// ReasonCollectionNameTaken 表示用户已有同名收藏夹。
+	ReasonCollectionNameTaken = "profile.errors.collection_name_taken"
+	// ReasonCollectionItemNotFound 表示视频不在收藏夹中。
+	ReasonCollectionItemNotFound = "profile.errors.collection_item_not_found"
+	// ReasonCollectionFull 表示收藏夹成员数已达上限。
+	ReasonCollectionFull = "profile.errors.collection_full"
+	// ReasonEngagementNoteNotFound 表示笔记不存在或不属于当前用户。
+	ReasonEngagementNoteNotFound = "profile.errors.engagement_note_not_found"
+	// ReasonNoteRequiresBookmark 表示视频未被收藏，无法添加笔记。
+	ReasonNoteRequiresBookmark = "profile.errors.note_requires_bookmark"
+	// ReasonTooManyNotes 表示收藏上的笔记数量已达上限。
+	ReasonTooManyNotes = "profile.errors.too_many_notes"
+	// ReasonRateLimited 表示调用方在当前 RPC 上超出限流配额。
+	ReasonRateLimited = "profile.errors.rate_limited"
+	// ReasonPermissionDenied 表示调用方无权访问运营/内部接口。
+	ReasonPermissionDenied = "profile.errors.permission_denied"
+	// ReasonNotImplemented 表示接口尚未实现。
+	ReasonNotImplemented = "profile.errors.not_implemented"
+	// ReasonInternal 表示服务内部错误。
+	ReasonInternal = "profile.errors.internal"
+)
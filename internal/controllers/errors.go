@@ -0,0 +1,72 @@
+package controllers
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// PreconditionFailure.violations[].type 的取值。
+const (
+	preconditionProfileVersion     = "PROFILE_VERSION"
+	preconditionAccountStatus      = "ACCOUNT_STATUS"
+	preconditionAvatarUpload       = "AVATAR_UPLOAD"
+	preconditionCollectionCapacity = "COLLECTION_CAPACITY"
+	preconditionBookmark           = "BOOKMARK"
+	preconditionNoteCapacity       = "NOTE_CAPACITY"
+)
+
+// problemStatus 构造携带 errdetails.ErrorInfo 及附加详情的 gRPC 状态，reason 取自 error_reasons.go 中的 Reason* 常量。
+func problemStatus(code codes.Code, reason, message string, metadata map[string]string, details ...protoadapt.MessageV1) error {
+	st := status.New(code, message)
+	all := make([]protoadapt.MessageV1, 0, len(details)+1)
+	all = append(all, &errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   ErrorDomain,
+		Metadata: metadata,
+	})
+	all = append(all, details...)
+	detailed, err := st.WithDetails(all...)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// invalidArgument 返回 InvalidArgument，并附带指向具体字段的 BadRequest。
+func invalidArgument(reason, field string, err error) error {
+	return problemStatus(codes.InvalidArgument, reason, err.Error(),
+		map[string]string{"field": field},
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+			Field:       field,
+			Description: err.Error(),
+		}}},
+	)
+}
+
+// invalidField 是 ReasonInvalidArgument 的便捷写法，用于请求格式类错误。
+func invalidField(field string, err error) error {
+	return invalidArgument(ReasonInvalidArgument, field, err)
+}
+
+// preconditionFailed 返回带 PreconditionFailure 详情的错误，供版本冲突、状态不满足等场景使用。
+func preconditionFailed(code codes.Code, reason, violationType, subject string, err error) error {
+	return problemStatus(code, reason, err.Error(),
+		map[string]string{"precondition": violationType},
+		&errdetails.PreconditionFailure{Violations: []*errdetails.PreconditionFailure_Violation{{
+			Type:        violationType,
+			Subject:     subject,
+			Description: err.Error(),
+		}}},
+	)
+}
+
+// internalError 返回 Internal，op 描述失败的步骤。
+func internalError(op string, err error) error {
+	msg := err.Error()
+	if op != "" {
+		msg = op + ": " + msg
+	}
+	return problemStatus(codes.Internal, ReasonInternal, msg, nil)
+}
@@ -7,5 +7,5 @@ import "github.com/google/wire"
 // ProviderSet exposes controller/handler constructors for DI.
 var ProviderSet = wire.NewSet(
 	NewBaseHandler,
-	NewProfileHandler,
+	ProvideProfileHandler,
 )
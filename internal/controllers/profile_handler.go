@@ -16,16 +16,16 @@ import (
 	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
 	"github.com/bionicotaku/lingo-services-profile/internal/services"
 
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-const (
-	defaultPageSize = 20
-	maxPageSize     = 100
-)
+// defaultPageSize 为 page_size 缺省时的分页大小；上限 100 由 profile.proto 的 buf.validate 规则约束。
+const defaultPageSize = 20
 
 // ProfileHandler 实现 ProfileService gRPC 接口。
 type ProfileHandler struct {
@@ -37,6 +37,26 @@ type ProfileHandler struct {
 	watchHistory services.WatchHistoryServiceInterface
 	projections  services.VideoProjectionServiceInterface
 	stats        services.VideoStatsServiceInterface
+	collections  services.CollectionServiceInterface
+	notes        services.EngagementNoteServiceInterface
+	admin        AdminPolicy
+}
+
+// ProfileHandlerOption 定制 ProfileHandler 的可选依赖。
+type ProfileHandlerOption func(*ProfileHandler)
+
+// WithCollections 启用收藏夹相关 RPC；未设置时这些 RPC 返回未实现错误。
+func WithCollections(collections services.CollectionServiceInterface) ProfileHandlerOption {
+	return func(h *ProfileHandler) {
+		h.collections = collections
+	}
+}
+
+// WithEngagementNotes 启用收藏笔记 RPC，并在收藏列表中返回笔记；未设置时笔记 RPC 返回未实现错误。
+func WithEngagementNotes(notes services.EngagementNoteServiceInterface) ProfileHandlerOption {
+	return func(h *ProfileHandler) {
+		h.notes = notes
+	}
 }
 
 // NewProfileHandler 构造 ProfileHandler。
@@ -47,11 +67,12 @@ func NewProfileHandler(
 	projections services.VideoProjectionServiceInterface,
 	stats services.VideoStatsServiceInterface,
 	base *BaseHandler,
+	opts ...ProfileHandlerOption,
 ) *ProfileHandler {
 	if base == nil {
 		base = NewBaseHandler(HandlerTimeouts{})
 	}
-	return &ProfileHandler{
+	h := &ProfileHandler{
 		BaseHandler:  base,
 		profiles:     profiles,
 		engagements:  engagements,
@@ -59,14 +80,37 @@ func NewProfileHandler(
 		projections:  projections,
 		stats:        stats,
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// ProvideProfileHandler 供 Wire 使用，注入收藏夹、收藏笔记用例与运营接口授权名单。
+func ProvideProfileHandler(
+	profiles services.ProfileServiceInterface,
+	engagements services.EngagementServiceInterface,
+	watchHistory services.WatchHistoryServiceInterface,
+	projections services.VideoProjectionServiceInterface,
+	stats services.VideoStatsServiceInterface,
+	collections services.CollectionServiceInterface,
+	notes services.EngagementNoteServiceInterface,
+	admin AdminPolicy,
+	base *BaseHandler,
+) *ProfileHandler {
+	return NewProfileHandler(profiles, engagements, watchHistory, projections, stats, base,
+		WithCollections(collections),
+		WithEngagementNotes(notes),
+		WithAdminPolicy(admin),
+	)
 }
 
 // GetProfile 返回档案。
 func (h *ProfileHandler) GetProfile(ctx context.Context, req *profilev1.GetProfileRequest) (*profilev1.GetProfileResponse, error) {
 	meta := h.ExtractMetadata(ctx)
-	userID, err := h.resolveUserID(req.GetUserId(), meta)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		return nil, invalidField("user_id", err)
 	}
 
 	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeQuery)
@@ -83,14 +127,14 @@ func (h *ProfileHandler) GetProfile(ctx context.Context, req *profilev1.GetProfi
 // UpdateProfile 更新档案基础信息。
 func (h *ProfileHandler) UpdateProfile(ctx context.Context, req *profilev1.UpdateProfileRequest) (*profilev1.UpdateProfileResponse, error) {
 	meta := h.ExtractMetadata(ctx)
-	userID, err := h.resolveUserID(req.GetUserId(), meta)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		return nil, invalidField("user_id", err)
 	}
 
 	input, err := buildUpdateProfileInput(userID, req)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		return nil, invalidField("profile", err)
 	}
 
 	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeCommand)
@@ -107,14 +151,14 @@ func (h *ProfileHandler) UpdateProfile(ctx context.Context, req *profilev1.Updat
 // UpdatePreferences 更新偏好字段。
 func (h *ProfileHandler) UpdatePreferences(ctx context.Context, req *profilev1.UpdatePreferencesRequest) (*profilev1.UpdatePreferencesResponse, error) {
 	meta := h.ExtractMetadata(ctx)
-	userID, err := h.resolveUserID(req.GetUserId(), meta)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		return nil, invalidField("user_id", err)
 	}
 
 	input, err := buildUpdatePreferencesInput(userID, req)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		return nil, invalidField("preferences", err)
 	}
 
 	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeCommand)
@@ -131,21 +175,21 @@ func (h *ProfileHandler) UpdatePreferences(ctx context.Context, req *profilev1.U
 // MutateFavorite 新增或取消收藏/点赞。
 func (h *ProfileHandler) MutateFavorite(ctx context.Context, req *profilev1.MutateFavoriteRequest) (*profilev1.MutateFavoriteResponse, error) {
 	meta := h.ExtractMetadata(ctx)
-	userID, err := h.resolveUserID(req.GetUserId(), meta)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		return nil, invalidField("user_id", err)
 	}
 	videoID, err := parseUUID(req.GetVideoId())
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid video_id: %v", err)
+		return nil, invalidField("video_id", fmt.Errorf("invalid video_id: %w", err))
 	}
 	typeStr, err := favoriteTypeToString(req.GetFavoriteType())
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		return nil, invalidArgument(ReasonUnsupportedEngagementType, "favorite_type", err)
 	}
 	action, err := favoriteActionToEnum(req.GetAction())
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		return nil, invalidField("action", err)
 	}
 
 	var occurred *time.Time
@@ -154,18 +198,24 @@ func (h *ProfileHandler) MutateFavorite(ctx context.Context, req *profilev1.Muta
 		occurred = &value
 	}
 
+	source := engagementSourceToString(req.GetSource())
 	input := services.MutateEngagementInput{
 		UserID:         userID,
 		VideoID:        videoID,
 		EngagementType: typeStr,
 		Action:         action,
 		OccurredAt:     occurred,
+		Source:         &source,
+		Metadata:       engagementContextToMetadata(req.GetContext()),
 	}
 
 	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeCommand)
 	defer cancel()
 	timeoutCtx = InjectHandlerMetadata(timeoutCtx, meta)
 
+	if err := h.profiles.EnsureAccountWritable(timeoutCtx, userID); err != nil {
+		return nil, mapProfileError(err)
+	}
 	if err := h.engagements.Mutate(timeoutCtx, input); err != nil {
 		return nil, mapEngagementError(err)
 	}
@@ -177,7 +227,7 @@ func (h *ProfileHandler) MutateFavorite(ctx context.Context, req *profilev1.Muta
 
 	stats, err := h.stats.GetStats(timeoutCtx, videoID)
 	if err != nil && !isStatsNotFound(err) {
-		return nil, status.Errorf(codes.Internal, "query stats: %v", err)
+		return nil, internalError("query stats", err)
 	}
 
 	return &profilev1.MutateFavoriteResponse{
@@ -189,9 +239,9 @@ func (h *ProfileHandler) MutateFavorite(ctx context.Context, req *profilev1.Muta
 // BatchQueryFavorite 批量查询收藏状态。
 func (h *ProfileHandler) BatchQueryFavorite(ctx context.Context, req *profilev1.BatchQueryFavoriteRequest) (*profilev1.BatchQueryFavoriteResponse, error) {
 	meta := h.ExtractMetadata(ctx)
-	userID, err := h.resolveUserID(req.GetUserId(), meta)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		return nil, invalidField("user_id", err)
 	}
 
 	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeQuery)
@@ -200,14 +250,14 @@ func (h *ProfileHandler) BatchQueryFavorite(ctx context.Context, req *profilev1.
 
 	videoIDs, err := parseUUIDs(req.GetVideoIds())
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid video_ids: %v", err)
+		return nil, invalidField("video_ids", fmt.Errorf("invalid video_ids: %w", err))
 	}
 
 	statsMap := map[uuid.UUID]*vo.ProfileVideoStats{}
 	if req.GetIncludeStats() && len(videoIDs) > 0 {
 		statsSlice, err := h.stats.ListStats(timeoutCtx, videoIDs)
 		if err != nil && !isStatsNotFound(err) {
-			return nil, status.Errorf(codes.Internal, "list stats: %v", err)
+			return nil, internalError("list stats", err)
 		}
 		for _, item := range statsSlice {
 			statsMap[item.VideoID] = statsToVO(item)
@@ -234,17 +284,17 @@ func (h *ProfileHandler) BatchQueryFavorite(ctx context.Context, req *profilev1.
 	return &profilev1.BatchQueryFavoriteResponse{Summaries: summaries}, nil
 }
 
-// ListFavorites 返回收藏列表。
+// ListFavorites 返回收藏列表，仅包含点赞与收藏（不含 dislike/not_interested/share 等反馈信号）。
 func (h *ProfileHandler) ListFavorites(ctx context.Context, req *profilev1.ListFavoritesRequest) (*profilev1.ListFavoritesResponse, error) {
 	meta := h.ExtractMetadata(ctx)
-	userID, err := h.resolveUserID(req.GetUserId(), meta)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		return nil, invalidField("user_id", err)
 	}
 
 	limit, offset, err := parsePagination(req.GetPageSize(), req.GetPageToken())
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		return nil, invalidField("page_token", err)
 	}
 
 	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeQuery)
@@ -253,7 +303,6 @@ func (h *ProfileHandler) ListFavorites(ctx context.Context, req *profilev1.ListF
 
 	items, err := h.engagements.ListFavorites(timeoutCtx, services.ListFavoritesInput{
 		UserID:         userID,
-		EngagementType: nil,
 		IncludeDeleted: false,
 		Limit:          limit + 1,
 		Offset:         int32(offset),
@@ -273,7 +322,7 @@ func (h *ProfileHandler) ListFavorites(ctx context.Context, req *profilev1.ListF
 	if len(videoIDs) > 0 {
 		proj, err := h.projections.ListProjections(timeoutCtx, videoIDs)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "list projections: %v", err)
+			return nil, internalError("list projections", err)
 		}
 		for _, p := range proj {
 			metaMap[p.VideoID] = projectionToMetadataVO(p)
@@ -284,21 +333,28 @@ func (h *ProfileHandler) ListFavorites(ctx context.Context, req *profilev1.ListF
 	if len(videoIDs) > 0 {
 		statsSlice, err := h.stats.ListStats(timeoutCtx, videoIDs)
 		if err != nil && !isStatsNotFound(err) {
-			return nil, status.Errorf(codes.Internal, "list stats: %v", err)
+			return nil, internalError("list stats", err)
 		}
 		for _, s := range statsSlice {
 			statsMap[s.VideoID] = statsToVO(s)
 		}
 	}
 
+	notesMap, err := h.bookmarkNotes(timeoutCtx, userID, items)
+	if err != nil {
+		return nil, err
+	}
+
 	favorites := make([]*profilev1.FavoriteItem, 0, len(items))
 	for _, item := range items {
 		state := vo.FavoriteState{}
-		if item.EngagementType == "like" && item.DeletedAt == nil {
-			state.HasLiked = true
+		if item.DeletedAt == nil {
+			state = stateToVO(services.FavoriteStateFromEngagements(item.EngagementType))
 		}
-		if item.EngagementType == "bookmark" && item.DeletedAt == nil {
-			state.HasBookmarked = true
+
+		var notes []*profilev1.EngagementNote
+		if item.EngagementType == services.EngagementTypeBookmark {
+			notes = notesMap[item.VideoID]
 		}
 
 		favType, _ := favoriteTypeFromString(item.EngagementType)
@@ -309,6 +365,9 @@ func (h *ProfileHandler) ListFavorites(ctx context.Context, req *profilev1.ListF
 			Video:        dto.ToProtoVideoMetadata(metaMap[item.VideoID]),
 			CreatedAt:    timestamppb.New(item.CreatedAt.UTC()),
 			UpdatedAt:    timestamppb.New(item.UpdatedAt.UTC()),
+			Source:       engagementSourceFromString(item.Source),
+			Context:      dto.ToProtoEngagementContext(item.Metadata),
+			Notes:        notes,
 		})
 	}
 
@@ -321,19 +380,16 @@ func (h *ProfileHandler) ListFavorites(ctx context.Context, req *profilev1.ListF
 // UpsertWatchProgress 写入观看进度。
 func (h *ProfileHandler) UpsertWatchProgress(ctx context.Context, req *profilev1.UpsertWatchProgressRequest) (*profilev1.UpsertWatchProgressResponse, error) {
 	meta := h.ExtractMetadata(ctx)
-	userID, err := h.resolveUserID(req.GetUserId(), meta)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		return nil, invalidField("user_id", err)
 	}
 	videoID, err := parseUUID(req.GetVideoId())
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid video_id: %v", err)
+		return nil, invalidField("video_id", fmt.Errorf("invalid video_id: %w", err))
 	}
 
 	progress := req.GetProgress()
-	if progress == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "progress is required")
-	}
 
 	input := services.UpsertWatchProgressInput{
 		UserID:            userID,
@@ -351,14 +407,17 @@ func (h *ProfileHandler) UpsertWatchProgress(ctx context.Context, req *profilev1
 	defer cancel()
 	timeoutCtx = InjectHandlerMetadata(timeoutCtx, meta)
 
+	if err := h.profiles.EnsureAccountWritable(timeoutCtx, userID); err != nil {
+		return nil, mapProfileError(err)
+	}
 	logRecord, err := h.watchHistory.UpsertProgress(timeoutCtx, input)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "upsert watch log: %v", err)
+		return nil, internalError("upsert watch log", err)
 	}
 
 	stats, err := h.stats.GetStats(timeoutCtx, videoID)
 	if err != nil && !isStatsNotFound(err) {
-		return nil, status.Errorf(codes.Internal, "query stats: %v", err)
+		return nil, internalError("query stats", err)
 	}
 
 	return &profilev1.UpsertWatchProgressResponse{
@@ -370,14 +429,14 @@ func (h *ProfileHandler) UpsertWatchProgress(ctx context.Context, req *profilev1
 // ListWatchHistory 返回观看历史。
 func (h *ProfileHandler) ListWatchHistory(ctx context.Context, req *profilev1.ListWatchHistoryRequest) (*profilev1.ListWatchHistoryResponse, error) {
 	meta := h.ExtractMetadata(ctx)
-	userID, err := h.resolveUserID(req.GetUserId(), meta)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		return nil, invalidField("user_id", err)
 	}
 
 	limit, offset, err := parsePagination(req.GetPageSize(), req.GetPageToken())
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		return nil, invalidField("page_token", err)
 	}
 
 	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeQuery)
@@ -391,7 +450,7 @@ func (h *ProfileHandler) ListWatchHistory(ctx context.Context, req *profilev1.Li
 		Offset:          int32(offset),
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "list watch history: %v", err)
+		return nil, internalError("list watch history", err)
 	}
 
 	nextToken := ""
@@ -405,7 +464,7 @@ func (h *ProfileHandler) ListWatchHistory(ctx context.Context, req *profilev1.Li
 	if len(videoIDs) > 0 {
 		proj, err := h.projections.ListProjections(timeoutCtx, videoIDs)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "list projections: %v", err)
+			return nil, internalError("list projections", err)
 		}
 		for _, p := range proj {
 			metaMap[p.VideoID] = projectionToMetadataVO(p)
@@ -429,13 +488,290 @@ func (h *ProfileHandler) ListWatchHistory(ctx context.Context, req *profilev1.Li
 
 // PurgeUserData 暂未实现，返回未实现错误。
 func (h *ProfileHandler) PurgeUserData(context.Context, *profilev1.PurgeUserDataRequest) (*profilev1.PurgeUserDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "purge user data not implemented")
+	return nil, problemStatus(codes.Unimplemented, ReasonNotImplemented, "purge user data not implemented", nil)
+}
+
+// SuspendAccount 冻结指定账户（运营/内部调用，需通过 AdminPolicy 授权）。
+func (h *ProfileHandler) SuspendAccount(ctx context.Context, req *profilev1.SuspendAccountRequest) (*profilev1.SuspendAccountResponse, error) {
+	profile, err := h.transitionAccountStatus(ctx, req.GetUserId(), services.AccountStatusSuspended, req.GetReason(), req.GetExpectedProfileVersion())
+	if err != nil {
+		return nil, err
+	}
+	return &profilev1.SuspendAccountResponse{Profile: dto.ToProtoProfile(profile)}, nil
+}
+
+// ReactivateAccount 将冻结或待删除的账户恢复为 active（运营/内部调用，需通过 AdminPolicy 授权）。
+func (h *ProfileHandler) ReactivateAccount(ctx context.Context, req *profilev1.ReactivateAccountRequest) (*profilev1.ReactivateAccountResponse, error) {
+	profile, err := h.transitionAccountStatus(ctx, req.GetUserId(), services.AccountStatusActive, req.GetReason(), req.GetExpectedProfileVersion())
+	if err != nil {
+		return nil, err
+	}
+	return &profilev1.ReactivateAccountResponse{Profile: dto.ToProtoProfile(profile)}, nil
+}
+
+func (h *ProfileHandler) transitionAccountStatus(ctx context.Context, rawUserID string, target services.AccountStatus, reason string, expected *wrapperspb.Int64Value) (*vo.Profile, error) {
+	meta := h.ExtractMetadata(ctx)
+	if err := h.requireAdmin(meta); err != nil {
+		return nil, err
+	}
+	userID, err := parseUUID(rawUserID)
+	if err != nil {
+		return nil, invalidField("user_id", fmt.Errorf("invalid user_id: %w", err))
+	}
+
+	input := services.TransitionAccountStatusInput{
+		UserID: userID,
+		Target: target,
+		Reason: strings.TrimSpace(reason),
+	}
+	if expected != nil {
+		value := expected.GetValue()
+		input.ExpectedVersion = &value
+	}
+
+	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeCommand)
+	defer cancel()
+	timeoutCtx = InjectHandlerMetadata(timeoutCtx, meta)
+
+	profile, err := h.profiles.TransitionAccountStatus(timeoutCtx, input)
+	if err != nil {
+		return nil, mapProfileError(err)
+	}
+	return profile, nil
+}
+
+// ListAuditEntries 按过滤条件分页返回写操作审计记录（运营/内部调用，需通过 AdminPolicy 授权）。
+func (h *ProfileHandler) ListAuditEntries(ctx context.Context, req *profilev1.ListAuditEntriesRequest) (*profilev1.ListAuditEntriesResponse, error) {
+	meta := h.ExtractMetadata(ctx)
+	if err := h.requireAdmin(meta); err != nil {
+		return nil, err
+	}
+	input := services.ListAuditEntriesInput{
+		ActorID: strings.TrimSpace(req.GetActorId()),
+		Action:  strings.TrimSpace(req.GetAction()),
+		TraceID: req.GetTraceId(),
+	}
+	if raw := req.GetUserId(); raw != "" {
+		userID, err := parseUUID(raw)
+		if err != nil {
+			return nil, invalidField("user_id", fmt.Errorf("invalid user_id: %w", err))
+		}
+		input.UserID = &userID
+	}
+	if req.GetSince() != nil {
+		since := req.GetSince().AsTime()
+		input.Since = &since
+	}
+	if req.GetUntil() != nil {
+		until := req.GetUntil().AsTime()
+		input.Until = &until
+	}
+	if input.Since != nil && input.Until != nil && !input.Since.Before(*input.Until) {
+		return nil, invalidField("until", fmt.Errorf("until must be after since"))
+	}
+
+	limit, offset, err := parsePagination(req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, invalidField("page_token", err)
+	}
+	input.Limit = limit + 1
+	input.Offset = int32(offset)
+
+	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeQuery)
+	defer cancel()
+	timeoutCtx = InjectHandlerMetadata(timeoutCtx, meta)
+
+	entries, err := h.profiles.ListAuditEntries(timeoutCtx, input)
+	if err != nil {
+		return nil, internalError("list audit entries", err)
+	}
+
+	nextToken := ""
+	if len(entries) > int(limit) {
+		nextToken = strconv.Itoa(offset + int(limit))
+		entries = entries[:limit]
+	}
+
+	resp := &profilev1.ListAuditEntriesResponse{
+		Entries:       make([]*profilev1.AuditEntry, 0, len(entries)),
+		NextPageToken: nextToken,
+	}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, dto.ToProtoAuditEntry(entry))
+	}
+	return resp, nil
+}
+
+// UpdateVisibility 更新公开主页字段可见性。
+func (h *ProfileHandler) UpdateVisibility(ctx context.Context, req *profilev1.UpdateVisibilityRequest) (*profilev1.UpdateVisibilityResponse, error) {
+	meta := h.ExtractMetadata(ctx)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
+	if err != nil {
+		return nil, invalidField("user_id", err)
+	}
+
+	input, err := buildUpdateVisibilityInput(userID, req)
+	if err != nil {
+		return nil, invalidField("visibility", err)
+	}
+
+	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeCommand)
+	defer cancel()
+	timeoutCtx = InjectHandlerMetadata(timeoutCtx, meta)
+
+	profile, err := h.profiles.UpdateVisibility(timeoutCtx, input)
+	if err != nil {
+		return nil, mapProfileError(err)
+	}
+	return &profilev1.UpdateVisibilityResponse{Profile: dto.ToProtoProfile(profile)}, nil
+}
+
+// GetPublicProfile 返回公开主页。
+func (h *ProfileHandler) GetPublicProfile(ctx context.Context, req *profilev1.GetPublicProfileRequest) (*profilev1.GetPublicProfileResponse, error) {
+	meta := h.ExtractMetadata(ctx)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
+	if err != nil {
+		return nil, invalidField("user_id", err)
+	}
+
+	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeQuery)
+	defer cancel()
+	timeoutCtx = InjectHandlerMetadata(timeoutCtx, meta)
+
+	profile, err := h.profiles.GetPublicProfile(timeoutCtx, userID)
+	if err != nil {
+		return nil, mapProfileError(err)
+	}
+	return &profilev1.GetPublicProfileResponse{Profile: dto.ToProtoPublicProfile(profile)}, nil
+}
+
+// ListPublicBookmarks 返回用户公开的收藏列表，仅包含 Catalog 标记为 public 的视频。
+func (h *ProfileHandler) ListPublicBookmarks(ctx context.Context, req *profilev1.ListPublicBookmarksRequest) (*profilev1.ListPublicBookmarksResponse, error) {
+	meta := h.ExtractMetadata(ctx)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
+	if err != nil {
+		return nil, invalidField("user_id", err)
+	}
+
+	limit, offset, err := parsePagination(req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, invalidField("page_token", err)
+	}
+
+	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeQuery)
+	defer cancel()
+	timeoutCtx = InjectHandlerMetadata(timeoutCtx, meta)
+
+	if err := h.profiles.EnsureBookmarksPublic(timeoutCtx, userID); err != nil {
+		return nil, mapProfileError(err)
+	}
+
+	items, err := h.engagements.ListFavorites(timeoutCtx, services.ListFavoritesInput{
+		UserID:          userID,
+		EngagementTypes: []string{services.EngagementTypeBookmark},
+		IncludeDeleted:  false,
+		Limit:           limit + 1,
+		Offset:          int32(offset),
+	})
+	if err != nil {
+		return nil, mapEngagementError(err)
+	}
+
+	nextToken := ""
+	if len(items) > int(limit) {
+		nextToken = strconv.Itoa(offset + int(limit))
+		items = items[:limit]
+	}
+
+	projMap := map[uuid.UUID]*po.ProfileVideoProjection{}
+	if videoIDs := uniqueVideoIDs(items); len(videoIDs) > 0 {
+		proj, err := h.projections.ListProjections(timeoutCtx, videoIDs)
+		if err != nil {
+			return nil, internalError("list projections", err)
+		}
+		for _, p := range proj {
+			projMap[p.VideoID] = p
+		}
+	}
+
+	// 分页游标按原始收藏记录推进，过滤非公开视频后单页条数可能少于 page_size。
+	bookmarks := make([]*profilev1.PublicBookmark, 0, len(items))
+	for _, item := range items {
+		proj := projMap[item.VideoID]
+		if !isPublicVideo(proj) {
+			continue
+		}
+		bookmarks = append(bookmarks, &profilev1.PublicBookmark{
+			VideoId:      item.VideoID.String(),
+			Video:        dto.ToProtoVideoMetadata(projectionToMetadataVO(proj)),
+			BookmarkedAt: timestamppb.New(item.CreatedAt.UTC()),
+		})
+	}
+
+	return &profilev1.ListPublicBookmarksResponse{
+		Bookmarks:     bookmarks,
+		NextPageToken: nextToken,
+	}, nil
+}
+
+// CreateAvatarUpload 签发头像直传地址。
+func (h *ProfileHandler) CreateAvatarUpload(ctx context.Context, req *profilev1.CreateAvatarUploadRequest) (*profilev1.CreateAvatarUploadResponse, error) {
+	meta := h.ExtractMetadata(ctx)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
+	if err != nil {
+		return nil, invalidField("user_id", err)
+	}
+
+	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeCommand)
+	defer cancel()
+	timeoutCtx = InjectHandlerMetadata(timeoutCtx, meta)
+
+	upload, err := h.profiles.CreateAvatarUpload(timeoutCtx, services.CreateAvatarUploadInput{
+		UserID:        userID,
+		ContentType:   req.GetContentType(),
+		ContentLength: req.GetContentLength(),
+	})
+	if err != nil {
+		return nil, mapProfileError(err)
+	}
+	return &profilev1.CreateAvatarUploadResponse{Upload: dto.ToProtoAvatarUploadTarget(upload)}, nil
+}
+
+// ConfirmAvatarUpload 确认头像上传并更新档案。
+func (h *ProfileHandler) ConfirmAvatarUpload(ctx context.Context, req *profilev1.ConfirmAvatarUploadRequest) (*profilev1.ConfirmAvatarUploadResponse, error) {
+	meta := h.ExtractMetadata(ctx)
+	userID, err := h.resolveUserID(ctx, req.GetUserId(), meta)
+	if err != nil {
+		return nil, invalidField("user_id", err)
+	}
+
+	input := services.ConfirmAvatarUploadInput{
+		UserID: userID,
+		Key:    req.GetUploadKey(),
+	}
+	if req.GetExpectedProfileVersion() != nil {
+		v := req.GetExpectedProfileVersion().GetValue()
+		input.ExpectedVersion = &v
+	}
+
+	timeoutCtx, cancel := h.WithTimeout(ctx, HandlerTypeCommand)
+	defer cancel()
+	timeoutCtx = InjectHandlerMetadata(timeoutCtx, meta)
+
+	profile, err := h.profiles.ConfirmAvatarUpload(timeoutCtx, input)
+	if err != nil {
+		return nil, mapProfileError(err)
+	}
+	return &profilev1.ConfirmAvatarUploadResponse{Profile: dto.ToProtoProfile(profile)}, nil
 }
 
 // 辅助函数
 
-func (h *ProfileHandler) resolveUserID(requestUserID string, meta metadata.HandlerMetadata) (uuid.UUID, error) {
-	if strings.TrimSpace(requestUserID) != "" {
+// resolveUserID 确定请求作用的用户。gRPC 调用中请求 user_id 优先（内部服务代用户调用），缺省时回落到调用方身份；
+// HTTP 路由只有路径显式携带 {user_id} 的公开主页才使用请求 user_id，/api/v1/user/me 等其余路由
+// 一律以网关认证的调用方为准，忽略 query/body 中的 user_id，避免读写他人数据。
+func (h *ProfileHandler) resolveUserID(ctx context.Context, requestUserID string, meta metadata.HandlerMetadata) (uuid.UUID, error) {
+	if strings.TrimSpace(requestUserID) != "" && !isCallerScopedRoute(ctx) {
 		return parseUUID(requestUserID)
 	}
 	if strings.TrimSpace(meta.UserID) != "" {
@@ -444,9 +780,24 @@ func (h *ProfileHandler) resolveUserID(requestUserID string, meta metadata.Handl
 	return uuid.Nil, fmt.Errorf("user_id required")
 }
 
+// isCallerScopedRoute 判断当前请求是否为路径不含 {user_id} 的 HTTP 路由（即作用于调用方本人）。
+func isCallerScopedRoute(ctx context.Context) bool {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return false
+	}
+	ht, ok := tr.(khttp.Transporter)
+	if !ok {
+		return false
+	}
+	return !strings.Contains(ht.PathTemplate(), "{user_id}")
+}
+
 func buildUpdateProfileInput(userID uuid.UUID, req *profilev1.UpdateProfileRequest) (services.UpdateProfileInput, error) {
 	var displayName *string
 	var avatarURL *string
+	var locale *string
+	var timezone *string
 	var prefsPatch *vo.Preferences
 
 	mask := maskSet(req.GetUpdateMask().GetPaths())
@@ -465,6 +816,13 @@ func buildUpdateProfileInput(userID uuid.UUID, req *profilev1.UpdateProfileReque
 			avatar := profile.GetAvatarUrl()
 			avatarURL = &avatar
 		}
+		// 未指定 mask 时仅在有值时更新区域信息，避免整体覆盖意外清空。
+		if mask["profile.preferred_locale"] || (len(mask) == 0 && profile.GetPreferredLocale() != "") {
+			locale = stringPtr(profile.GetPreferredLocale())
+		}
+		if mask["profile.preferred_timezone"] || (len(mask) == 0 && profile.GetPreferredTimezone() != "") {
+			timezone = stringPtr(profile.GetPreferredTimezone())
+		}
 		if should("profile.preferences") || should("profile.preferences.learning_goal") || should("profile.preferences.daily_quota_minutes") || should("profile.preferences.extra") {
 			prefs := vo.Preferences{Extra: map[string]any{}}
 			if prefsPB := profile.GetPreferences(); prefsPB != nil {
@@ -491,14 +849,50 @@ func buildUpdateProfileInput(userID uuid.UUID, req *profilev1.UpdateProfileReque
 	}
 
 	return services.UpdateProfileInput{
-		UserID:           userID,
-		DisplayName:      displayName,
-		AvatarURL:        avatarURL,
-		ExpectedVersion:  expectedVersion,
-		PreferencesPatch: prefsPatch,
+		UserID:            userID,
+		DisplayName:       displayName,
+		AvatarURL:         avatarURL,
+		ExpectedVersion:   expectedVersion,
+		PreferencesPatch:  prefsPatch,
+		PreferredLocale:   locale,
+		PreferredTimezone: timezone,
 	}, nil
 }
 
+func buildUpdateVisibilityInput(userID uuid.UUID, req *profilev1.UpdateVisibilityRequest) (services.UpdateVisibilityInput, error) {
+	visibility := req.GetVisibility()
+	if visibility == nil {
+		return services.UpdateVisibilityInput{}, fmt.Errorf("visibility is required")
+	}
+
+	mask := maskSet(req.GetUpdateMask().GetPaths())
+	should := func(path string) bool {
+		if len(mask) == 0 {
+			return true
+		}
+		return mask[path]
+	}
+
+	input := services.UpdateVisibilityInput{UserID: userID}
+	if should("visibility.public_display_name") {
+		input.PublicDisplayName = boolPtr(visibility.GetPublicDisplayName())
+	}
+	if should("visibility.public_avatar") {
+		input.PublicAvatar = boolPtr(visibility.GetPublicAvatar())
+	}
+	if should("visibility.public_bookmarks") {
+		input.PublicBookmarks = boolPtr(visibility.GetPublicBookmarks())
+	}
+	if should("visibility.public_stats") {
+		input.PublicStats = boolPtr(visibility.GetPublicStats())
+	}
+	if v := req.GetExpectedProfileVersion(); v != nil {
+		value := v.GetValue()
+		input.ExpectedVersion = &value
+	}
+	return input, nil
+}
+
 func buildUpdatePreferencesInput(userID uuid.UUID, req *profilev1.UpdatePreferencesRequest) (services.UpdatePreferencesInput, error) {
 	mask := maskSet(req.GetUpdateMask().GetPaths())
 	should := func(path string) bool {
@@ -548,18 +942,12 @@ func parsePagination(pageSize int32, pageToken string) (int32, int, error) {
 	if limit <= 0 {
 		limit = defaultPageSize
 	}
-	if limit > maxPageSize {
-		limit = maxPageSize
-	}
 	offset := 0
 	if strings.TrimSpace(pageToken) != "" {
 		val, err := strconv.Atoi(pageToken)
 		if err != nil {
 			return 0, 0, fmt.Errorf("invalid page_token")
 		}
-		if val < 0 {
-			val = 0
-		}
 		offset = val
 	}
 	return limit, offset, nil
@@ -596,6 +984,9 @@ func stateToVO(state services.FavoriteState) vo.FavoriteState {
 	return vo.FavoriteState{
 		HasLiked:      state.HasLiked,
 		HasBookmarked: state.HasBookmarked,
+		HasDisliked:   state.HasDisliked,
+		HasShared:     state.HasShared,
+		NotInterested: state.NotInterested,
 	}
 }
 
@@ -606,6 +997,8 @@ func statsToVO(stats *po.ProfileVideoStats) *vo.ProfileVideoStats {
 	return &vo.ProfileVideoStats{
 		LikeCount:         stats.LikeCount,
 		BookmarkCount:     stats.BookmarkCount,
+		DislikeCount:      stats.DislikeCount,
+		ShareCount:        stats.ShareCount,
 		UniqueWatchers:    stats.UniqueWatchers,
 		TotalWatchSeconds: stats.TotalWatchSeconds,
 		UpdatedAt:         stats.UpdatedAt,
@@ -670,28 +1063,70 @@ func uniqueWatchVideoIDs(items []*po.ProfileWatchLog) []uuid.UUID {
 	return result
 }
 
+// favoriteTypeNames 维护 FavoriteType 枚举与服务端互动类型注册表的对应关系。
+var favoriteTypeNames = map[profilev1.FavoriteType]string{
+	profilev1.FavoriteType_FAVORITE_TYPE_LIKE:           services.EngagementTypeLike,
+	profilev1.FavoriteType_FAVORITE_TYPE_BOOKMARK:       services.EngagementTypeBookmark,
+	profilev1.FavoriteType_FAVORITE_TYPE_DISLIKE:        services.EngagementTypeDislike,
+	profilev1.FavoriteType_FAVORITE_TYPE_NOT_INTERESTED: services.EngagementTypeNotInterested,
+	profilev1.FavoriteType_FAVORITE_TYPE_SHARE:          services.EngagementTypeShare,
+}
+
 func favoriteTypeToString(t profilev1.FavoriteType) (string, error) {
-	switch t {
-	case profilev1.FavoriteType_FAVORITE_TYPE_LIKE:
-		return "like", nil
-	case profilev1.FavoriteType_FAVORITE_TYPE_BOOKMARK:
-		return "bookmark", nil
-	default:
+	name, ok := favoriteTypeNames[t]
+	if !ok {
 		return "", fmt.Errorf("unsupported favorite_type")
 	}
+	return name, nil
 }
 
 func favoriteTypeFromString(v string) (profilev1.FavoriteType, error) {
-	switch strings.ToLower(v) {
-	case "like":
-		return profilev1.FavoriteType_FAVORITE_TYPE_LIKE, nil
-	case "bookmark":
-		return profilev1.FavoriteType_FAVORITE_TYPE_BOOKMARK, nil
+	v = strings.ToLower(v)
+	for t, name := range favoriteTypeNames {
+		if name == v {
+			return t, nil
+		}
+	}
+	return profilev1.FavoriteType_FAVORITE_TYPE_UNSPECIFIED, fmt.Errorf("unsupported favorite_type")
+}
+
+// engagementSourceToString 将来源枚举转换为存储值，UNSPECIFIED 视为 manual。
+func engagementSourceToString(source profilev1.EngagementSource) string {
+	switch source {
+	case profilev1.EngagementSource_ENGAGEMENT_SOURCE_RECOMMENDATION:
+		return services.EngagementSourceRecommendation
+	case profilev1.EngagementSource_ENGAGEMENT_SOURCE_SYSTEM:
+		return services.EngagementSourceSystem
+	default:
+		return services.EngagementSourceManual
+	}
+}
+
+func engagementSourceFromString(v string) profilev1.EngagementSource {
+	switch v {
+	case services.EngagementSourceManual:
+		return profilev1.EngagementSource_ENGAGEMENT_SOURCE_MANUAL
+	case services.EngagementSourceRecommendation:
+		return profilev1.EngagementSource_ENGAGEMENT_SOURCE_RECOMMENDATION
+	case services.EngagementSourceSystem:
+		return profilev1.EngagementSource_ENGAGEMENT_SOURCE_SYSTEM
 	default:
-		return profilev1.FavoriteType_FAVORITE_TYPE_UNSPECIFIED, fmt.Errorf("unsupported favorite_type")
+		return profilev1.EngagementSource_ENGAGEMENT_SOURCE_UNSPECIFIED
 	}
 }
 
+// engagementContextToMetadata 将请求中的互动上下文转换为 metadata，空字段不落库。
+func engagementContextToMetadata(ctx *profilev1.EngagementContext) map[string]any {
+	metadata := map[string]any{}
+	if value := strings.TrimSpace(ctx.GetEntryPoint()); value != "" {
+		metadata[po.EngagementMetadataEntryPoint] = value
+	}
+	if value := strings.TrimSpace(ctx.GetDevice()); value != "" {
+		metadata[po.EngagementMetadataDevice] = value
+	}
+	return metadata
+}
+
 func favoriteActionToEnum(a profilev1.FavoriteAction) (services.EngagementAction, error) {
 	switch a {
 	case profilev1.FavoriteAction_FAVORITE_ACTION_ADD:
@@ -711,28 +1146,74 @@ func tsToPointer(ts *timestamppb.Timestamp) *time.Time {
 	return &value
 }
 
+// isPublicVideo 判断投影视频是否可在公开页面展示；投影缺失或未显式公开时一律隐藏。
+func isPublicVideo(p *po.ProfileVideoProjection) bool {
+	return p != nil && p.VisibilityStatus != nil && *p.VisibilityStatus == po.VisibilityPublic
+}
+
 func isStatsNotFound(err error) bool {
 	return err != nil && strings.Contains(err.Error(), "no rows")
 }
 
 func stringPtr(v string) *string { return &v }
 
+func boolPtr(v bool) *bool { return &v }
+
 func mapProfileError(err error) error {
 	switch {
 	case errors.Is(err, services.ErrProfileNotFound):
-		return status.Errorf(codes.NotFound, "%v", err)
+		return problemStatus(codes.NotFound, ReasonProfileNotFound, err.Error(), nil)
 	case errors.Is(err, services.ErrProfileVersionConflict):
-		return status.Errorf(codes.Aborted, "%v", err)
+		return preconditionFailed(codes.Aborted, ReasonPreferenceConflict, preconditionProfileVersion, "expected_profile_version", err)
+	case errors.Is(err, services.ErrAccountNotActive):
+		return preconditionFailed(codes.FailedPrecondition, ReasonAccountNotActive, preconditionAccountStatus, "account_status", err)
+	case errors.Is(err, services.ErrInvalidAccountStatusTransition):
+		return preconditionFailed(codes.FailedPrecondition, ReasonInvalidAccountStatusTransition, preconditionAccountStatus, "account_status", err)
+	case errors.Is(err, services.ErrInvalidLocale):
+		return invalidArgument(ReasonInvalidLocale, "profile.preferred_locale", err)
+	case errors.Is(err, services.ErrInvalidTimezone):
+		return invalidArgument(ReasonInvalidTimezone, "profile.preferred_timezone", err)
+	case errors.Is(err, services.ErrProfileFieldNotPublic):
+		return problemStatus(codes.PermissionDenied, ReasonFieldNotPublic, err.Error(), nil)
+	case errors.Is(err, services.ErrNoVisibilityFields):
+		return invalidField("update_mask", err)
+	case errors.Is(err, services.ErrAvatarURLNotAllowed):
+		return invalidArgument(ReasonAvatarURLNotAllowed, "profile.avatar_url", err)
+	case errors.Is(err, services.ErrAvatarContentTypeNotAllowed):
+		return invalidArgument(ReasonAvatarContentTypeNotAllowed, "content_type", err)
+	case errors.Is(err, services.ErrAvatarTooLarge):
+		return invalidArgument(ReasonAvatarTooLarge, "content_length", err)
+	case errors.Is(err, services.ErrAvatarUploadNotFound):
+		return preconditionFailed(codes.FailedPrecondition, ReasonAvatarUploadNotFound, preconditionAvatarUpload, "upload_key", err)
+	case errors.Is(err, services.ErrAvatarUploadUnavailable):
+		return problemStatus(codes.Unimplemented, ReasonAvatarUploadUnavailable, err.Error(), nil)
+	case errors.Is(err, services.ErrDisplayNameTooShort):
+		return invalidArgument(ReasonDisplayNameTooShort, "profile.display_name", err)
+	case errors.Is(err, services.ErrDisplayNameTooLong):
+		return invalidArgument(ReasonDisplayNameTooLong, "profile.display_name", err)
+	case errors.Is(err, services.ErrDisplayNameInvalidCharacters):
+		return invalidArgument(ReasonDisplayNameInvalidCharacters, "profile.display_name", err)
+	case errors.Is(err, services.ErrDisplayNameBlocked):
+		return invalidArgument(ReasonDisplayNameBlocked, "profile.display_name", err)
+	case errors.Is(err, services.ErrDisplayNameRejected):
+		return invalidArgument(ReasonDisplayNameRejected, "profile.display_name", err)
+	case errors.Is(err, services.ErrDisplayNameTaken):
+		return problemStatus(codes.AlreadyExists, ReasonDisplayNameTaken, err.Error(),
+			map[string]string{"field": "profile.display_name"})
 	default:
-		return status.Errorf(codes.Internal, "%v", err)
+		return internalError("", err)
 	}
 }
 
 func mapEngagementError(err error) error {
 	switch {
 	case errors.Is(err, services.ErrUnsupportedEngagementType):
-		return status.Errorf(codes.InvalidArgument, "%v", err)
+		return invalidArgument(ReasonUnsupportedEngagementType, "favorite_type", err)
+	case errors.Is(err, services.ErrUnsupportedEngagementSource):
+		return invalidField("source", err)
+	case errors.Is(err, services.ErrEngagementNotRemovable):
+		return invalidField("action", err)
 	default:
-		return status.Errorf(codes.Internal, "%v", err)
+		return internalError("", err)
 	}
 }
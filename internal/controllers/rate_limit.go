@@ -0,0 +1,67 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// CallerID 解析限流维度的调用方：
+//   - 经网关认证的终端用户：取 X-Apigateway-Api-Userinfo 中的 sub，忽略请求体中的 user_id，避免伪造身份换取新桶或耗尽他人配额；
+//   - 经校验的服务账号：按「服务账号 + 请求 user_id」分桶，代多个用户调用的后端服务不会因单一用户的流量整体被限；
+//   - 匿名调用：回落到对端地址（不含端口），均不可得时返回空串。
+func CallerID(ctx context.Context, req any) string {
+	meta := extractMetadata(ctx)
+	if meta.UserID != "" {
+		return meta.UserID
+	}
+	if meta.ServiceAccount != "" {
+		key := "service:" + meta.ServiceAccount
+		if r, ok := req.(interface{ GetUserId() string }); ok {
+			if userID := strings.TrimSpace(r.GetUserId()); userID != "" {
+				key += "/" + userID
+			}
+		}
+		return key
+	}
+	if addr := peerAddress(ctx); addr != "" {
+		return "peer:" + addr
+	}
+	return ""
+}
+
+// peerAddress 返回 gRPC 对端或 HTTP RemoteAddr 的主机部分。
+func peerAddress(ctx context.Context) string {
+	var addr string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr = p.Addr.String()
+	} else if r, ok := khttp.RequestFromServerContext(ctx); ok {
+		addr = r.RemoteAddr
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return strings.TrimSpace(addr)
+}
+
+// RateLimited 返回 ResourceExhausted，携带 RetryInfo 与 ErrorInfo.metadata.retry_after（秒）。
+func RateLimited(operation string, retryAfter time.Duration) error {
+	seconds := max(int64((retryAfter+time.Second-1)/time.Second), 1)
+	return problemStatus(codes.ResourceExhausted, ReasonRateLimited,
+		fmt.Sprintf("rate limit exceeded for %s, retry after %ds", operation, seconds),
+		map[string]string{
+			"operation":   operation,
+			"retry_after": strconv.FormatInt(seconds, 10),
+		},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)},
+	)
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"reflect"
 	"testing"
 	"time"
 
 	"github.com/bionicotaku/lingo-services-profile/internal/controllers"
+	profilemd "github.com/bionicotaku/lingo-services-profile/internal/metadata"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -55,7 +57,7 @@ func TestBaseHandlerExtractMetadata(t *testing.T) {
 	if !ok {
 		t.Fatalf("expected metadata in context")
 	}
-	if stored != meta {
+	if !reflect.DeepEqual(stored, meta) {
 		t.Fatalf("stored metadata mismatch: %+v vs %+v", stored, meta)
 	}
 }
@@ -88,3 +90,37 @@ func TestBaseHandlerInvalidUserInfo(t *testing.T) {
 		t.Fatalf("expected empty user id, got %q", meta.UserID)
 	}
 }
+
+func TestBaseHandlerIdentityFromVerifiedCredentials(t *testing.T) {
+	userInfo, err := json.Marshal(map[string]any{
+		"sub":           "7b61d0ed-5ba1-4f21-a636-7f9f1a9f9a01",
+		"role":          "authenticated",
+		"app_metadata":  map[string]any{"role": "support", "roles": []any{"admin"}},
+		"user_metadata": map[string]any{"roles": []any{"superuser"}},
+	})
+	if err != nil {
+		t.Fatalf("marshal userinfo: %v", err)
+	}
+	token := "e30." + base64.RawURLEncoding.EncodeToString([]byte(`{"email":"ops@proj.iam.gserviceaccount.com"}`)) + ".sig"
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"x-apigateway-api-userinfo", base64.RawURLEncoding.EncodeToString(userInfo),
+		"authorization", "Bearer "+token,
+		"x-md-actor-type", "service",
+		"x-md-actor-id", "spoofed",
+	))
+
+	handler := controllers.NewBaseHandler(controllers.HandlerTimeouts{})
+	meta := handler.ExtractMetadata(ctx)
+
+	if meta.ServiceAccount != "" {
+		t.Fatalf("unverified bearer token must not yield a service account, got %q", meta.ServiceAccount)
+	}
+	if !reflect.DeepEqual(meta.Roles, []string{"support", "admin"}) {
+		t.Fatalf("expected roles from app_metadata only, got %v", meta.Roles)
+	}
+
+	meta = handler.ExtractMetadata(profilemd.WithVerifiedServiceAccount(ctx, "ops@proj.iam.gserviceaccount.com"))
+	if meta.ServiceAccount != "ops@proj.iam.gserviceaccount.com" {
+		t.Fatalf("expected verified service account, got %q", meta.ServiceAccount)
+	}
+}
@@ -0,0 +1,197 @@
+package controllers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	profilev1 "github.com/bionicotaku/lingo-services-profile/api/profile/v1"
+	"github.com/bionicotaku/lingo-services-profile/internal/controllers"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/vo"
+	"github.com/bionicotaku/lingo-services-profile/internal/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestProfileHandler_MutateFavorite_SuspendedAccountRejected(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	profiles := &profileServiceStub{
+		ensureWritableFn: func(_ context.Context, id uuid.UUID) error {
+			require.Equal(t, userID, id)
+			return fmt.Errorf("%w: %s", services.ErrAccountNotActive, services.AccountStatusSuspended)
+		},
+	}
+	engagement := &engagementServiceStub{
+		mutateFn: func(context.Context, services.MutateEngagementInput) error {
+			t.Fatal("mutate must not be called for suspended account")
+			return nil
+		},
+	}
+	handler := controllers.NewProfileHandler(
+		profiles,
+		engagement,
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+	)
+
+	ctx := metadataContextWithUser(t, userID)
+	_, err := handler.MutateFavorite(ctx, &profilev1.MutateFavoriteRequest{
+		VideoId:      uuid.NewString(),
+		FavoriteType: profilev1.FavoriteType_FAVORITE_TYPE_LIKE,
+		Action:       profilev1.FavoriteAction_FAVORITE_ACTION_ADD,
+	})
+	require.Error(t, err)
+	st, _ := status.FromError(err)
+	require.Equal(t, codes.FailedPrecondition, st.Code())
+}
+
+func TestProfileHandler_UpsertWatchProgress_SuspendedAccountRejected(t *testing.T) {
+	t.Parallel()
+
+	profiles := &profileServiceStub{
+		ensureWritableFn: func(context.Context, uuid.UUID) error {
+			return services.ErrAccountNotActive
+		},
+	}
+	handler := controllers.NewProfileHandler(
+		profiles,
+		&engagementServiceStub{},
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+	)
+
+	ctx := metadataContextWithUser(t, uuid.New())
+	_, err := handler.UpsertWatchProgress(ctx, &profilev1.UpsertWatchProgressRequest{
+		VideoId:  uuid.NewString(),
+		Progress: &profilev1.WatchProgress{PositionSeconds: 10, ProgressRatio: 0.1},
+	})
+	require.Error(t, err)
+	st, _ := status.FromError(err)
+	require.Equal(t, codes.FailedPrecondition, st.Code())
+}
+
+func TestProfileHandler_SuspendAccount_MapsInput(t *testing.T) {
+	t.Parallel()
+
+	target := uuid.New()
+	profiles := &profileServiceStub{
+		transitionFn: func(_ context.Context, input services.TransitionAccountStatusInput) (*vo.Profile, error) {
+			require.Equal(t, target, input.UserID)
+			require.Equal(t, services.AccountStatusSuspended, input.Target)
+			require.Equal(t, "spam", input.Reason)
+			require.NotNil(t, input.ExpectedVersion)
+			require.Equal(t, int64(3), *input.ExpectedVersion)
+			return &vo.Profile{UserID: target.String(), ProfileVersion: 4, AccountStatus: "suspended"}, nil
+		},
+	}
+	handler := controllers.NewProfileHandler(
+		profiles,
+		&engagementServiceStub{},
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+		controllers.WithAdminPolicy(testAdminPolicy),
+	)
+
+	resp, err := handler.SuspendAccount(serviceAccountContext(opsServiceAccount), &profilev1.SuspendAccountRequest{
+		UserId:                 target.String(),
+		Reason:                 " spam ",
+		ExpectedProfileVersion: wrapperspb.Int64(3),
+	})
+	require.NoError(t, err)
+	require.Equal(t, profilev1.AccountStatus_ACCOUNT_STATUS_SUSPENDED, resp.GetProfile().GetAccountStatus())
+	require.Equal(t, int64(4), resp.GetProfile().GetProfileVersion())
+}
+
+func TestProfileHandler_SuspendAccount_RequiresUserID(t *testing.T) {
+	t.Parallel()
+
+	handler := controllers.NewProfileHandler(
+		&profileServiceStub{},
+		&engagementServiceStub{},
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+		controllers.WithAdminPolicy(testAdminPolicy),
+	)
+
+	// 管理接口不回落到调用方身份，避免误冻结自身账户。
+	ctx := adminUserContext(uuid.New())
+	_, err := handler.SuspendAccount(ctx, &profilev1.SuspendAccountRequest{})
+	require.Error(t, err)
+	st, _ := status.FromError(err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestProfileHandler_ReactivateAccount_InvalidTransition(t *testing.T) {
+	t.Parallel()
+
+	profiles := &profileServiceStub{
+		transitionFn: func(context.Context, services.TransitionAccountStatusInput) (*vo.Profile, error) {
+			return nil, fmt.Errorf("%w: deleted -> active", services.ErrInvalidAccountStatusTransition)
+		},
+	}
+	handler := controllers.NewProfileHandler(
+		profiles,
+		&engagementServiceStub{},
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+		controllers.WithAdminPolicy(testAdminPolicy),
+	)
+
+	_, err := handler.ReactivateAccount(serviceAccountContext(opsServiceAccount), &profilev1.ReactivateAccountRequest{UserId: uuid.NewString()})
+	require.Error(t, err)
+	st, _ := status.FromError(err)
+	require.Equal(t, codes.FailedPrecondition, st.Code())
+}
+
+func TestProfileHandler_AccountTransitions_RequireAdmin(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	handler := controllers.NewProfileHandler(
+		&profileServiceStub{transitionFn: func(context.Context, services.TransitionAccountStatusInput) (*vo.Profile, error) {
+			called = true
+			return &vo.Profile{}, nil
+		}},
+		&engagementServiceStub{},
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+		controllers.WithAdminPolicy(testAdminPolicy),
+	)
+
+	// 终端用户不能冻结或恢复任何账户，包括自己的账户。
+	self := uuid.New()
+	ctx := metadataContextWithUser(t, self)
+	_, err := handler.SuspendAccount(ctx, &profilev1.SuspendAccountRequest{UserId: self.String()})
+	st, _ := status.FromError(err)
+	require.Equal(t, codes.PermissionDenied, st.Code())
+	_, err = handler.ReactivateAccount(ctx, &profilev1.ReactivateAccountRequest{UserId: self.String()})
+	st, _ = status.FromError(err)
+	require.Equal(t, codes.PermissionDenied, st.Code())
+
+	// 未经 gcjwt 校验的 Bearer Token 即使声称是名单内服务账号也不放行。
+	_, err = handler.SuspendAccount(unverifiedBearerContext(opsServiceAccount), &profilev1.SuspendAccountRequest{UserId: self.String()})
+	st, _ = status.FromError(err)
+	require.Equal(t, codes.PermissionDenied, st.Code())
+	require.False(t, called)
+
+	_, err = handler.ReactivateAccount(adminUserContext(uuid.New()), &profilev1.ReactivateAccountRequest{UserId: self.String()})
+	require.NoError(t, err)
+	require.True(t, called)
+}
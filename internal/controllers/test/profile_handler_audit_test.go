@@ -0,0 +1,159 @@
+package controllers_test
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	profilev1 "github.com/bionicotaku/lingo-services-profile/api/profile/v1"
+	"github.com/bionicotaku/lingo-services-profile/internal/controllers"
+	profilemd "github.com/bionicotaku/lingo-services-profile/internal/metadata"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/vo"
+	"github.com/bionicotaku/lingo-services-profile/internal/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const opsServiceAccount = "ops-console@proj.iam.gserviceaccount.com"
+
+var testAdminPolicy = controllers.AdminPolicy{
+	ServiceAccounts: []string{opsServiceAccount},
+	Roles:           []string{"admin"},
+}
+
+// serviceAccountContext 模拟经 gcjwt 校验的内部服务调用：服务账号由 JWT 之后的中间件写入 Context。
+func serviceAccountContext(email string) context.Context {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{})
+	return profilemd.WithVerifiedServiceAccount(ctx, email)
+}
+
+// unverifiedBearerContext 模拟未经校验（skip_validate 或伪造）的 Bearer Token，仅携带 payload。
+func unverifiedBearerContext(email string) context.Context {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"email":"` + email + `"}`))
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer e30."+payload+".sig"))
+}
+
+// adminUserContext 模拟经网关认证、app_metadata 持有 admin 角色的运营人员。
+func adminUserContext(userID uuid.UUID) context.Context {
+	info := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"` + userID.String() + `","app_metadata":{"roles":["admin"]}}`))
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-apigateway-api-userinfo", info))
+}
+
+func TestProfileHandler_ListAuditEntries_FiltersAndPaginates(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	profiles := &profileServiceStub{
+		listAuditFn: func(_ context.Context, input services.ListAuditEntriesInput) ([]*vo.AuditEntry, error) {
+			require.NotNil(t, input.UserID)
+			require.Equal(t, userID, *input.UserID)
+			require.Equal(t, "ops-console", input.ActorID)
+			require.Equal(t, services.AuditActionAccountStatus, input.Action)
+			require.Equal(t, since, *input.Since)
+			require.Nil(t, input.Until)
+			require.Equal(t, int32(3), input.Limit)
+			require.Equal(t, int32(2), input.Offset)
+			entries := make([]*vo.AuditEntry, 0, 3)
+			for i := 0; i < 3; i++ {
+				entries = append(entries, &vo.AuditEntry{
+					AuditID:   uuid.NewString(),
+					UserID:    userID.String(),
+					ActorType: services.AuditActorService,
+					ActorID:   "ops-console",
+					Action:    services.AuditActionAccountStatus,
+					Before:    map[string]any{"account_status": "active"},
+					After:     map[string]any{"account_status": "suspended"},
+					CreatedAt: since.Add(time.Hour),
+				})
+			}
+			return entries, nil
+		},
+	}
+	handler := controllers.NewProfileHandler(
+		profiles,
+		&engagementServiceStub{},
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+		controllers.WithAdminPolicy(testAdminPolicy),
+	)
+
+	resp, err := handler.ListAuditEntries(serviceAccountContext(opsServiceAccount), &profilev1.ListAuditEntriesRequest{
+		UserId:    userID.String(),
+		ActorId:   " ops-console ",
+		Action:    services.AuditActionAccountStatus,
+		Since:     timestamppb.New(since),
+		PageSize:  2,
+		PageToken: "2",
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetEntries(), 2)
+	require.Equal(t, "4", resp.GetNextPageToken())
+	require.Equal(t, "suspended", resp.GetEntries()[0].GetAfter().GetFields()["account_status"].GetStringValue())
+}
+
+func TestProfileHandler_ListAuditEntries_RejectsInvertedRange(t *testing.T) {
+	t.Parallel()
+
+	handler := controllers.NewProfileHandler(
+		&profileServiceStub{},
+		&engagementServiceStub{},
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+		controllers.WithAdminPolicy(testAdminPolicy),
+	)
+
+	now := time.Now()
+	_, err := handler.ListAuditEntries(serviceAccountContext(opsServiceAccount), &profilev1.ListAuditEntriesRequest{
+		Since: timestamppb.New(now),
+		Until: timestamppb.New(now.Add(-time.Minute)),
+	})
+	require.Error(t, err)
+	st, _ := status.FromError(err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestProfileHandler_ListAuditEntries_RequiresAdmin(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	handler := controllers.NewProfileHandler(
+		&profileServiceStub{listAuditFn: func(context.Context, services.ListAuditEntriesInput) ([]*vo.AuditEntry, error) {
+			called = true
+			return nil, nil
+		}},
+		&engagementServiceStub{},
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+		controllers.WithAdminPolicy(testAdminPolicy),
+	)
+
+	cases := map[string]context.Context{
+		"anonymous":            context.Background(),
+		"end user":             metadataContextWithUser(t, uuid.New()),
+		"other service":        serviceAccountContext("api-gateway@proj.iam.gserviceaccount.com"),
+		"unverified bearer":    unverifiedBearerContext(opsServiceAccount),
+		"spoofed actor header": metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-md-actor-type", "service", "x-md-actor-id", opsServiceAccount)),
+	}
+	for name, ctx := range cases {
+		_, err := handler.ListAuditEntries(ctx, &profilev1.ListAuditEntriesRequest{})
+		st, _ := status.FromError(err)
+		require.Equal(t, codes.PermissionDenied, st.Code(), name)
+	}
+	require.False(t, called)
+
+	_, err := handler.ListAuditEntries(adminUserContext(uuid.New()), &profilev1.ListAuditEntriesRequest{})
+	require.NoError(t, err)
+	require.True(t, called)
+}
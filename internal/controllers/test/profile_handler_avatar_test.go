@@ -0,0 +1,116 @@
+package controllers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	profilev1 "github.com/bionicotaku/lingo-services-profile/api/profile/v1"
+	"github.com/bionicotaku/lingo-services-profile/internal/controllers"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/vo"
+	"github.com/bionicotaku/lingo-services-profile/internal/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func newAvatarHandler(profiles *profileServiceStub) *controllers.ProfileHandler {
+	return controllers.NewProfileHandler(
+		profiles,
+		&engagementServiceStub{},
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+	)
+}
+
+func TestProfileHandler_CreateAvatarUpload(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	expires := time.Now().Add(10 * time.Minute).UTC()
+	handler := newAvatarHandler(&profileServiceStub{
+		createAvatarFn: func(_ context.Context, input services.CreateAvatarUploadInput) (*vo.AvatarUpload, error) {
+			require.Equal(t, userID, input.UserID)
+			require.Equal(t, "image/png", input.ContentType)
+			require.Equal(t, int64(512), input.ContentLength)
+			return &vo.AvatarUpload{
+				Key:       "avatars/k.png",
+				URL:       "http://upload/avatars/k.png",
+				Method:    "PUT",
+				Headers:   map[string]string{"Content-Type": "image/png"},
+				ExpiresAt: expires,
+				MaxBytes:  1024,
+			}, nil
+		},
+	})
+
+	resp, err := handler.CreateAvatarUpload(context.Background(), &profilev1.CreateAvatarUploadRequest{
+		UserId:        userID.String(),
+		ContentType:   "image/png",
+		ContentLength: 512,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "avatars/k.png", resp.GetUpload().GetUploadKey())
+	require.Equal(t, "PUT", resp.GetUpload().GetMethod())
+	require.Equal(t, "image/png", resp.GetUpload().GetHeaders()["Content-Type"])
+	require.Equal(t, int64(1024), resp.GetUpload().GetMaxBytes())
+	require.True(t, resp.GetUpload().GetExpiresAt().AsTime().Equal(expires))
+
+	err = callValidated(t, &profilev1.CreateAvatarUploadRequest{UserId: userID.String()}, handler.CreateAvatarUpload)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestProfileHandler_ConfirmAvatarUpload(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	avatar := "https://cdn.example.com/avatars/k.png"
+	handler := newAvatarHandler(&profileServiceStub{
+		confirmAvatarFn: func(_ context.Context, input services.ConfirmAvatarUploadInput) (*vo.Profile, error) {
+			require.Equal(t, "avatars/k.png", input.Key)
+			require.NotNil(t, input.ExpectedVersion)
+			require.Equal(t, int64(3), *input.ExpectedVersion)
+			return &vo.Profile{UserID: userID.String(), AvatarURL: &avatar, ProfileVersion: 4}, nil
+		},
+	})
+
+	resp, err := handler.ConfirmAvatarUpload(context.Background(), &profilev1.ConfirmAvatarUploadRequest{
+		UserId:                 userID.String(),
+		UploadKey:              "avatars/k.png",
+		ExpectedProfileVersion: wrapperspb.Int64(3),
+	})
+	require.NoError(t, err)
+	require.Equal(t, avatar, resp.GetProfile().GetAvatarUrl())
+}
+
+func TestProfileHandler_AvatarErrorMapping(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{fmt.Errorf("%w: host", services.ErrAvatarURLNotAllowed), codes.InvalidArgument},
+		{services.ErrAvatarContentTypeNotAllowed, codes.InvalidArgument},
+		{services.ErrAvatarTooLarge, codes.InvalidArgument},
+		{services.ErrAvatarUploadNotFound, codes.FailedPrecondition},
+		{services.ErrAvatarUploadUnavailable, codes.Unimplemented},
+	}
+	for _, tc := range cases {
+		handler := newAvatarHandler(&profileServiceStub{
+			confirmAvatarFn: func(context.Context, services.ConfirmAvatarUploadInput) (*vo.Profile, error) {
+				return nil, tc.err
+			},
+		})
+		_, err := handler.ConfirmAvatarUpload(context.Background(), &profilev1.ConfirmAvatarUploadRequest{
+			UserId:    uuid.NewString(),
+			UploadKey: "avatars/k.png",
+		})
+		require.Equal(t, tc.code, status.Code(err), tc.err.Error())
+	}
+}
@@ -0,0 +1,124 @@
+package controllers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	profilev1 "github.com/bionicotaku/lingo-services-profile/api/profile/v1"
+	"github.com/bionicotaku/lingo-services-profile/internal/controllers"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/vo"
+	"github.com/bionicotaku/lingo-services-profile/internal/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// collectionServiceStub 仅覆盖用例所需方法，其余调用会因 nil 接口 panic。
+type collectionServiceStub struct {
+	services.CollectionServiceInterface
+	createFn    func(context.Context, uuid.UUID, string) (*vo.Collection, error)
+	listItemsFn func(context.Context, uuid.UUID, uuid.UUID, int32, int32) (*vo.Collection, []*po.CollectionItem, error)
+}
+
+func (s *collectionServiceStub) CreateCollection(ctx context.Context, userID uuid.UUID, name string) (*vo.Collection, error) {
+	return s.createFn(ctx, userID, name)
+}
+
+func (s *collectionServiceStub) ListCollectionItems(ctx context.Context, userID, collectionID uuid.UUID, limit, offset int32) (*vo.Collection, []*po.CollectionItem, error) {
+	return s.listItemsFn(ctx, userID, collectionID, limit, offset)
+}
+
+func newCollectionsHandler(collections services.CollectionServiceInterface, projections *videoProjectionServiceStub) *controllers.ProfileHandler {
+	return controllers.NewProfileHandler(
+		&profileServiceStub{},
+		&engagementServiceStub{},
+		&watchHistoryServiceStub{},
+		projections,
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+		controllers.WithCollections(collections),
+	)
+}
+
+func TestProfileHandler_ListCollectionItems_HydratesFromProjection(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	collectionID := uuid.New()
+	known := uuid.New()
+	missing := uuid.New()
+	addedAt := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+
+	collections := &collectionServiceStub{
+		listItemsFn: func(_ context.Context, uid, cid uuid.UUID, limit, offset int32) (*vo.Collection, []*po.CollectionItem, error) {
+			require.Equal(t, userID, uid)
+			require.Equal(t, collectionID, cid)
+			require.Equal(t, int32(3), limit)
+			require.Equal(t, int32(0), offset)
+			return &vo.Collection{CollectionID: collectionID.String(), Name: "Watch later", ItemCount: 3},
+				[]*po.CollectionItem{
+					{CollectionID: collectionID, VideoID: known, Position: 1, AddedAt: addedAt},
+					{CollectionID: collectionID, VideoID: missing, Position: 2, AddedAt: addedAt},
+					{CollectionID: collectionID, VideoID: uuid.New(), Position: 3, AddedAt: addedAt},
+				}, nil
+		},
+	}
+	projections := &videoProjectionServiceStub{
+		listFn: func(_ context.Context, ids []uuid.UUID) ([]*po.ProfileVideoProjection, error) {
+			require.ElementsMatch(t, []uuid.UUID{known, missing}, ids)
+			return []*po.ProfileVideoProjection{{VideoID: known, Title: "Greetings"}}, nil
+		},
+	}
+	handler := newCollectionsHandler(collections, projections)
+
+	resp, err := handler.ListCollectionItems(context.Background(), &profilev1.ListCollectionItemsRequest{
+		UserId:       userID.String(),
+		CollectionId: collectionID.String(),
+		PageSize:     2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Watch later", resp.GetCollection().GetName())
+	require.Equal(t, "2", resp.GetNextPageToken())
+	require.Len(t, resp.GetItems(), 2)
+	require.Equal(t, "Greetings", resp.GetItems()[0].GetVideo().GetTitle())
+	require.Equal(t, int32(1), resp.GetItems()[0].GetPosition())
+	require.Nil(t, resp.GetItems()[1].GetVideo())
+}
+
+func TestProfileHandler_CreateCollection_MapsNameTaken(t *testing.T) {
+	t.Parallel()
+
+	collections := &collectionServiceStub{
+		createFn: func(context.Context, uuid.UUID, string) (*vo.Collection, error) {
+			return nil, services.ErrCollectionNameTaken
+		},
+	}
+	handler := newCollectionsHandler(collections, &videoProjectionServiceStub{})
+
+	_, err := handler.CreateCollection(context.Background(), &profilev1.CreateCollectionRequest{
+		UserId: uuid.NewString(),
+		Name:   "Favorites",
+	})
+	st, details := detailsOf(t, err)
+	require.Equal(t, codes.AlreadyExists, st.Code())
+	require.Equal(t, controllers.ReasonCollectionNameTaken, details.info.GetReason())
+}
+
+func TestProfileHandler_Collections_UnavailableWithoutService(t *testing.T) {
+	t.Parallel()
+
+	handler := controllers.NewProfileHandler(
+		&profileServiceStub{},
+		&engagementServiceStub{},
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+	)
+
+	_, err := handler.ListCollections(context.Background(), &profilev1.ListCollectionsRequest{UserId: uuid.NewString()})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
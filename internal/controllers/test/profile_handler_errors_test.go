@@ -2,7 +2,10 @@ package controllers_test
 
 import (
 	"context"
+	"errors"
+	"net"
 	"testing"
+	"time"
 
 	profilev1 "github.com/bionicotaku/lingo-services-profile/api/profile/v1"
 	"github.com/bionicotaku/lingo-services-profile/internal/controllers"
@@ -10,26 +13,187 @@ import (
 	"github.com/bionicotaku/lingo-services-profile/internal/services"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
+	grpcmetadata "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
-func TestProfileHandler_GetProfile_ProfileNotFound(t *testing.T) {
-	t.Parallel()
+type statusDetails struct {
+	info         *errdetails.ErrorInfo
+	badRequest   *errdetails.BadRequest
+	precondition *errdetails.PreconditionFailure
+}
+
+func detailsOf(t *testing.T, err error) (*status.Status, statusDetails) {
+	t.Helper()
+	st, ok := status.FromError(err)
+	require.True(t, ok, "expected gRPC status error")
 
-	handler := controllers.NewProfileHandler(
-		&profileServiceStub{getProfileFn: func(context.Context, uuid.UUID) (*vo.Profile, error) {
-			return nil, services.ErrProfileNotFound
-		}},
-		&engagementServiceStub{},
+	var out statusDetails
+	for _, detail := range st.Details() {
+		switch v := detail.(type) {
+		case *errdetails.ErrorInfo:
+			out.info = v
+		case *errdetails.BadRequest:
+			out.badRequest = v
+		case *errdetails.PreconditionFailure:
+			out.precondition = v
+		}
+	}
+	require.NotNil(t, out.info, "every error must carry ErrorInfo")
+	require.Equal(t, controllers.ErrorDomain, out.info.GetDomain())
+	return st, out
+}
+
+func newErrorsHandler(profiles *profileServiceStub, engagements *engagementServiceStub) *controllers.ProfileHandler {
+	return controllers.NewProfileHandler(
+		profiles,
+		engagements,
 		&watchHistoryServiceStub{},
 		&videoProjectionServiceStub{},
 		&videoStatsServiceStub{},
 		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
 	)
+}
+
+func TestProfileHandler_InvalidArgumentCarriesFieldViolation(t *testing.T) {
+	t.Parallel()
+
+	handler := newErrorsHandler(&profileServiceStub{}, &engagementServiceStub{})
+
+	_, err := handler.GetProfile(context.Background(), &profilev1.GetProfileRequest{UserId: "not-a-uuid"})
+	st, details := detailsOf(t, err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Equal(t, controllers.ReasonInvalidArgument, details.info.GetReason())
+	require.Equal(t, "user_id", details.info.GetMetadata()["field"])
+	require.Len(t, details.badRequest.GetFieldViolations(), 1)
+	require.Equal(t, "user_id", details.badRequest.GetFieldViolations()[0].GetField())
+
+	_, err = handler.ListFavorites(context.Background(), &profilev1.ListFavoritesRequest{
+		UserId:    uuid.NewString(),
+		PageToken: "abc",
+	})
+	_, details = detailsOf(t, err)
+	require.Equal(t, "page_token", details.badRequest.GetFieldViolations()[0].GetField())
+
+	_, err = handler.MutateFavorite(context.Background(), &profilev1.MutateFavoriteRequest{
+		UserId:  uuid.NewString(),
+		VideoId: uuid.NewString(),
+	})
+	_, details = detailsOf(t, err)
+	require.Equal(t, controllers.ReasonUnsupportedEngagementType, details.info.GetReason())
+	require.Equal(t, "favorite_type", details.badRequest.GetFieldViolations()[0].GetField())
+}
+
+func TestProfileHandler_VersionConflictCarriesPreconditionFailure(t *testing.T) {
+	t.Parallel()
+
+	handler := newErrorsHandler(&profileServiceStub{
+		updatePreferencesFn: func(context.Context, services.UpdatePreferencesInput) (*vo.Profile, error) {
+			return nil, services.ErrProfileVersionConflict
+		},
+	}, &engagementServiceStub{})
+
+	_, err := handler.UpdatePreferences(context.Background(), &profilev1.UpdatePreferencesRequest{
+		UserId:      uuid.NewString(),
+		Preferences: &profilev1.Preferences{LearningGoal: "fluency"},
+	})
+	st, details := detailsOf(t, err)
+	require.Equal(t, codes.Aborted, st.Code())
+	require.Equal(t, controllers.ReasonPreferenceConflict, details.info.GetReason())
+	require.Len(t, details.precondition.GetViolations(), 1)
+	require.Equal(t, "PROFILE_VERSION", details.precondition.GetViolations()[0].GetType())
+	require.Equal(t, "expected_profile_version", details.precondition.GetViolations()[0].GetSubject())
+}
+
+func TestProfileHandler_ServiceErrorsMapToReasons(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		err    error
+		code   codes.Code
+		reason string
+	}{
+		{services.ErrProfileNotFound, codes.NotFound, controllers.ReasonProfileNotFound},
+		{services.ErrAccountNotActive, codes.FailedPrecondition, controllers.ReasonAccountNotActive},
+		{services.ErrInvalidAccountStatusTransition, codes.FailedPrecondition, controllers.ReasonInvalidAccountStatusTransition},
+		{services.ErrInvalidLocale, codes.InvalidArgument, controllers.ReasonInvalidLocale},
+		{services.ErrInvalidTimezone, codes.InvalidArgument, controllers.ReasonInvalidTimezone},
+		{services.ErrProfileFieldNotPublic, codes.PermissionDenied, controllers.ReasonFieldNotPublic},
+		{errors.New("boom"), codes.Internal, controllers.ReasonInternal},
+	}
+	for _, tc := range cases {
+		handler := newErrorsHandler(&profileServiceStub{
+			getProfileFn: func(context.Context, uuid.UUID) (*vo.Profile, error) {
+				return nil, tc.err
+			},
+		}, &engagementServiceStub{})
+
+		_, err := handler.GetProfile(context.Background(), &profilev1.GetProfileRequest{UserId: uuid.NewString()})
+		st, details := detailsOf(t, err)
+		require.Equal(t, tc.code, st.Code(), tc.reason)
+		require.Equal(t, tc.reason, details.info.GetReason())
+	}
+}
+
+func TestProfileHandler_PurgeUserDataReportsNotImplemented(t *testing.T) {
+	t.Parallel()
+
+	handler := newErrorsHandler(&profileServiceStub{}, &engagementServiceStub{})
+	_, err := handler.PurgeUserData(context.Background(), &profilev1.PurgeUserDataRequest{})
+	st, details := detailsOf(t, err)
+	require.Equal(t, codes.Unimplemented, st.Code())
+	require.Equal(t, controllers.ReasonNotImplemented, details.info.GetReason())
+}
+
+func TestRateLimitedCarriesRetryInfo(t *testing.T) {
+	t.Parallel()
+
+	err := controllers.RateLimited("/profile.v1.ProfileService/UpsertWatchProgress", 1500*time.Millisecond)
+	st, details := detailsOf(t, err)
+	require.Equal(t, codes.ResourceExhausted, st.Code())
+	require.Equal(t, controllers.ReasonRateLimited, details.info.GetReason())
+	require.Equal(t, "2", details.info.GetMetadata()["retry_after"])
+
+	var retry *errdetails.RetryInfo
+	for _, d := range st.Details() {
+		if v, ok := d.(*errdetails.RetryInfo); ok {
+			retry = v
+		}
+	}
+	require.NotNil(t, retry)
+	require.Equal(t, 1500*time.Millisecond, retry.GetRetryDelay().AsDuration())
+}
+
+func TestCallerIDIgnoresRequestUserID(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.NewString()
+	ctx := grpcmetadata.NewIncomingContext(context.Background(),
+		grpcmetadata.Pairs("x-apigateway-api-userinfo", userInfoHeader(uuid.MustParse(userID))))
+	require.Equal(t, userID, controllers.CallerID(ctx, &profilev1.GetProfileRequest{}))
+	require.Equal(t, userID, controllers.CallerID(ctx, &profilev1.GetProfileRequest{UserId: uuid.NewString()}))
+
+	peerCtx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.7"), Port: 51234}})
+	require.Equal(t, "peer:10.0.0.7", controllers.CallerID(peerCtx, &profilev1.GetProfileRequest{UserId: uuid.NewString()}))
+	require.Empty(t, controllers.CallerID(context.Background(), &profilev1.GetProfileRequest{UserId: uuid.NewString()}))
+}
+
+func TestCallerIDKeysServiceCallersPerActedUser(t *testing.T) {
+	t.Parallel()
+
+	addr := &net.TCPAddr{IP: net.ParseIP("10.0.0.7"), Port: 51234}
+	ctx := peer.NewContext(serviceAccountContext(opsServiceAccount), &peer.Peer{Addr: addr})
+
+	alice, bob := uuid.NewString(), uuid.NewString()
+	aliceKey := controllers.CallerID(ctx, &profilev1.UpsertWatchProgressRequest{UserId: alice})
+	bobKey := controllers.CallerID(ctx, &profilev1.UpsertWatchProgressRequest{UserId: bob})
+	require.Equal(t, "service:"+opsServiceAccount+"/"+alice, aliceKey)
+	require.Equal(t, "service:"+opsServiceAccount+"/"+bob, bobKey)
+	require.NotEqual(t, aliceKey, bobKey)
 
-	ctx := metadataContextWithUser(t, uuid.New())
-	_, err := handler.GetProfile(ctx, &profilev1.GetProfileRequest{})
-	st, _ := status.FromError(err)
-	require.Equal(t, codes.NotFound, st.Code())
+	// 不针对具体用户的请求按服务账号分桶，不回落到共享的对端地址。
+	require.Equal(t, "service:"+opsServiceAccount, controllers.CallerID(ctx, &profilev1.ListAuditEntriesRequest{}))
 }
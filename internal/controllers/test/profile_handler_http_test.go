@@ -0,0 +1,203 @@
+package controllers_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	profilev1 "github.com/bionicotaku/lingo-services-profile/api/profile/v1"
+	"github.com/bionicotaku/lingo-services-profile/internal/controllers"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/vo"
+	"github.com/bionicotaku/lingo-services-profile/internal/services"
+	kratoshttp "github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func newHTTPTestServer(handler *controllers.ProfileHandler) *kratoshttp.Server {
+	srv := kratoshttp.NewServer()
+	profilev1.RegisterProfileServiceHTTPServer(srv, handler)
+	return srv
+}
+
+func userInfoHeader(userID uuid.UUID) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"` + userID.String() + `"}`))
+}
+
+func TestProfileHandler_HTTP_GetProfileReadsUserInfoHeader(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	handler := controllers.NewProfileHandler(
+		&profileServiceStub{getProfileFn: func(_ context.Context, got uuid.UUID) (*vo.Profile, error) {
+			require.Equal(t, userID, got)
+			return &vo.Profile{UserID: got.String(), DisplayName: "Alice"}, nil
+		}},
+		&engagementServiceStub{},
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+	)
+
+	req := httptest.NewRequest(nethttp.MethodGet, "/api/v1/user/me", nil)
+	req.Header.Set("X-Apigateway-Api-Userinfo", userInfoHeader(userID))
+	rec := httptest.NewRecorder()
+	newHTTPTestServer(handler).ServeHTTP(rec, req)
+
+	require.Equal(t, nethttp.StatusOK, rec.Code, rec.Body.String())
+	var resp profilev1.GetProfileResponse
+	require.NoError(t, protojson.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Equal(t, "Alice", resp.GetProfile().GetDisplayName())
+	require.Equal(t, userID.String(), resp.GetProfile().GetUserId())
+}
+
+func TestProfileHandler_HTTP_MutateFavoriteBindsPathAndBody(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	videoID := uuid.New()
+	engagement := &engagementServiceStub{
+		mutateFn: func(_ context.Context, input services.MutateEngagementInput) error {
+			require.Equal(t, userID, input.UserID)
+			require.Equal(t, videoID, input.VideoID)
+			require.Equal(t, "like", input.EngagementType)
+			require.Equal(t, services.EngagementActionAdd, input.Action)
+			return nil
+		},
+		getStateFn: func(_ context.Context, _ uuid.UUID, _ uuid.UUID) (services.FavoriteState, error) {
+			return services.FavoriteState{HasLiked: true}, nil
+		},
+	}
+	statsSvc := &videoStatsServiceStub{
+		getFn: func(_ context.Context, _ uuid.UUID) (*po.ProfileVideoStats, error) {
+			return &po.ProfileVideoStats{LikeCount: 7, UpdatedAt: time.Now()}, nil
+		},
+	}
+	handler := controllers.NewProfileHandler(
+		&profileServiceStub{},
+		engagement,
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		statsSvc,
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+	)
+
+	body := `{"favoriteType":"FAVORITE_TYPE_LIKE","action":"FAVORITE_ACTION_ADD"}`
+	req := httptest.NewRequest(nethttp.MethodPost, "/api/v1/video/"+videoID.String()+"/favorite", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Apigateway-Api-Userinfo", userInfoHeader(userID))
+	rec := httptest.NewRecorder()
+	newHTTPTestServer(handler).ServeHTTP(rec, req)
+
+	require.Equal(t, nethttp.StatusOK, rec.Code, rec.Body.String())
+	var resp profilev1.MutateFavoriteResponse
+	require.NoError(t, protojson.Unmarshal(rec.Body.Bytes(), &resp))
+	require.True(t, resp.GetState().GetHasLiked())
+	require.EqualValues(t, 7, resp.GetStats().GetLikeCount())
+}
+
+func TestProfileHandler_HTTP_ErrorCarriesReason(t *testing.T) {
+	t.Parallel()
+
+	handler := controllers.NewProfileHandler(
+		&profileServiceStub{},
+		&engagementServiceStub{},
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+	)
+
+	req := httptest.NewRequest(nethttp.MethodGet, "/api/v1/user/me", nil)
+	rec := httptest.NewRecorder()
+	newHTTPTestServer(handler).ServeHTTP(rec, req)
+
+	require.Equal(t, nethttp.StatusBadRequest, rec.Code)
+	var problem struct {
+		Reason   string            `json:"reason"`
+		Metadata map[string]string `json:"metadata"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+	require.Equal(t, controllers.ReasonInvalidArgument, problem.Reason)
+	require.Equal(t, "user_id", problem.Metadata["field"])
+}
+
+func TestProfileHandler_HTTP_MeRoutesIgnoreRequestUserID(t *testing.T) {
+	t.Parallel()
+
+	caller := uuid.New()
+	victim := uuid.New()
+	var gotProfile, gotPreferences uuid.UUID
+	handler := controllers.NewProfileHandler(
+		&profileServiceStub{
+			getProfileFn: func(_ context.Context, got uuid.UUID) (*vo.Profile, error) {
+				gotProfile = got
+				return &vo.Profile{UserID: got.String()}, nil
+			},
+			updatePreferencesFn: func(_ context.Context, input services.UpdatePreferencesInput) (*vo.Profile, error) {
+				gotPreferences = input.UserID
+				return &vo.Profile{UserID: input.UserID.String()}, nil
+			},
+		},
+		&engagementServiceStub{},
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+	)
+	srv := newHTTPTestServer(handler)
+
+	req := httptest.NewRequest(nethttp.MethodGet, "/api/v1/user/me?user_id="+victim.String(), nil)
+	req.Header.Set("X-Apigateway-Api-Userinfo", userInfoHeader(caller))
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+	require.Equal(t, nethttp.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, caller, gotProfile)
+
+	body := `{"user_id":"` + victim.String() + `","preferences":{"learning_goal":"travel"}}`
+	req = httptest.NewRequest(nethttp.MethodPatch, "/api/v1/user/me/preferences", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Apigateway-Api-Userinfo", userInfoHeader(caller))
+	rec = httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+	require.Equal(t, nethttp.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, caller, gotPreferences)
+
+	// 未携带 userinfo 时不能凭请求 user_id 访问他人档案。
+	req = httptest.NewRequest(nethttp.MethodGet, "/api/v1/user/me?user_id="+victim.String(), nil)
+	rec = httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+	require.Equal(t, nethttp.StatusBadRequest, rec.Code, rec.Body.String())
+}
+
+func TestProfileHandler_HTTP_PublicProfileUsesPathUserID(t *testing.T) {
+	t.Parallel()
+
+	caller := uuid.New()
+	owner := uuid.New()
+	handler := controllers.NewProfileHandler(
+		&profileServiceStub{getPublicProfileFn: func(_ context.Context, got uuid.UUID) (*vo.PublicProfile, error) {
+			require.Equal(t, owner, got)
+			return &vo.PublicProfile{UserID: got.String()}, nil
+		}},
+		&engagementServiceStub{},
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+	)
+
+	req := httptest.NewRequest(nethttp.MethodGet, "/api/v1/users/"+owner.String()+"/profile", nil)
+	req.Header.Set("X-Apigateway-Api-Userinfo", userInfoHeader(caller))
+	rec := httptest.NewRecorder()
+	newHTTPTestServer(handler).ServeHTTP(rec, req)
+	require.Equal(t, nethttp.StatusOK, rec.Code, rec.Body.String())
+}
@@ -0,0 +1,104 @@
+package controllers_test
+
+import (
+	"context"
+	"testing"
+
+	profilev1 "github.com/bionicotaku/lingo-services-profile/api/profile/v1"
+	"github.com/bionicotaku/lingo-services-profile/internal/controllers"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// engagementNoteServiceStub 仅覆盖用例所需方法，其余调用会因 nil 接口 panic。
+type engagementNoteServiceStub struct {
+	services.EngagementNoteServiceInterface
+	addFn  func(context.Context, services.AddEngagementNoteInput) (*po.EngagementNote, error)
+	listFn func(context.Context, uuid.UUID, []uuid.UUID) ([]*po.EngagementNote, error)
+}
+
+func (s *engagementNoteServiceStub) AddNote(ctx context.Context, input services.AddEngagementNoteInput) (*po.EngagementNote, error) {
+	return s.addFn(ctx, input)
+}
+
+func (s *engagementNoteServiceStub) ListBookmarkNotes(ctx context.Context, userID uuid.UUID, videoIDs []uuid.UUID) ([]*po.EngagementNote, error) {
+	return s.listFn(ctx, userID, videoIDs)
+}
+
+func newNotesHandler(engagements *engagementServiceStub, notes services.EngagementNoteServiceInterface) *controllers.ProfileHandler {
+	return controllers.NewProfileHandler(
+		&profileServiceStub{},
+		engagements,
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+		controllers.WithEngagementNotes(notes),
+	)
+}
+
+func TestProfileHandler_ListFavorites_AttachesBookmarkNotes(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	videoID := uuid.New()
+	position := int32(192)
+
+	engagements := &engagementServiceStub{
+		listFavoritesFn: func(context.Context, services.ListFavoritesInput) ([]*po.ProfileEngagement, error) {
+			return []*po.ProfileEngagement{
+				{UserID: userID, VideoID: videoID, EngagementType: "bookmark"},
+				{UserID: userID, VideoID: videoID, EngagementType: "like"},
+			}, nil
+		},
+	}
+	notes := &engagementNoteServiceStub{
+		listFn: func(_ context.Context, uid uuid.UUID, ids []uuid.UUID) ([]*po.EngagementNote, error) {
+			require.Equal(t, userID, uid)
+			require.Equal(t, []uuid.UUID{videoID}, ids)
+			return []*po.EngagementNote{
+				{NoteID: uuid.New(), UserID: userID, VideoID: videoID, Body: "idiom at 03:12", PositionSeconds: &position},
+				{NoteID: uuid.New(), UserID: userID, VideoID: videoID, Body: "review later"},
+			}, nil
+		},
+	}
+	handler := newNotesHandler(engagements, notes)
+
+	resp, err := handler.ListFavorites(context.Background(), &profilev1.ListFavoritesRequest{UserId: userID.String()})
+	require.NoError(t, err)
+	require.Len(t, resp.GetFavorites(), 2)
+
+	bookmark := resp.GetFavorites()[0]
+	require.Len(t, bookmark.GetNotes(), 2)
+	require.Equal(t, "idiom at 03:12", bookmark.GetNotes()[0].GetBody())
+	require.Equal(t, int32(192), bookmark.GetNotes()[0].GetPositionSeconds().GetValue())
+	require.Nil(t, bookmark.GetNotes()[1].GetPositionSeconds())
+	require.Empty(t, resp.GetFavorites()[1].GetNotes())
+}
+
+func TestProfileHandler_AddEngagementNote_RequiresBookmark(t *testing.T) {
+	t.Parallel()
+
+	notes := &engagementNoteServiceStub{
+		addFn: func(_ context.Context, input services.AddEngagementNoteInput) (*po.EngagementNote, error) {
+			require.NotNil(t, input.PositionSeconds)
+			require.Equal(t, int32(5), *input.PositionSeconds)
+			return nil, services.ErrNoteRequiresBookmark
+		},
+	}
+	handler := newNotesHandler(&engagementServiceStub{}, notes)
+
+	_, err := handler.AddEngagementNote(context.Background(), &profilev1.AddEngagementNoteRequest{
+		UserId:          uuid.NewString(),
+		VideoId:         uuid.NewString(),
+		Body:            "note",
+		PositionSeconds: wrapperspb.Int32(5),
+	})
+	st, details := detailsOf(t, err)
+	require.Equal(t, codes.FailedPrecondition, st.Code())
+	require.Equal(t, controllers.ReasonNoteRequiresBookmark, details.info.GetReason())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/bionicotaku/lingo-services-profile/internal/services"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -24,6 +26,14 @@ type profileServiceStub struct {
 	getProfileFn        func(context.Context, uuid.UUID) (*vo.Profile, error)
 	updateProfileFn     func(context.Context, services.UpdateProfileInput) (*vo.Profile, error)
 	updatePreferencesFn func(context.Context, services.UpdatePreferencesInput) (*vo.Profile, error)
+	transitionFn        func(context.Context, services.TransitionAccountStatusInput) (*vo.Profile, error)
+	ensureWritableFn    func(context.Context, uuid.UUID) error
+	updateVisibilityFn  func(context.Context, services.UpdateVisibilityInput) (*vo.Profile, error)
+	getPublicProfileFn  func(context.Context, uuid.UUID) (*vo.PublicProfile, error)
+	ensureBookmarksFn   func(context.Context, uuid.UUID) error
+	createAvatarFn      func(context.Context, services.CreateAvatarUploadInput) (*vo.AvatarUpload, error)
+	confirmAvatarFn     func(context.Context, services.ConfirmAvatarUploadInput) (*vo.Profile, error)
+	listAuditFn         func(context.Context, services.ListAuditEntriesInput) ([]*vo.AuditEntry, error)
 }
 
 func (s *profileServiceStub) GetProfile(ctx context.Context, userID uuid.UUID) (*vo.Profile, error) {
@@ -47,6 +57,62 @@ func (s *profileServiceStub) UpdatePreferences(ctx context.Context, input servic
 	return nil, nil
 }
 
+func (s *profileServiceStub) TransitionAccountStatus(ctx context.Context, input services.TransitionAccountStatusInput) (*vo.Profile, error) {
+	if s.transitionFn != nil {
+		return s.transitionFn(ctx, input)
+	}
+	return nil, nil
+}
+
+func (s *profileServiceStub) EnsureAccountWritable(ctx context.Context, userID uuid.UUID) error {
+	if s.ensureWritableFn != nil {
+		return s.ensureWritableFn(ctx, userID)
+	}
+	return nil
+}
+
+func (s *profileServiceStub) UpdateVisibility(ctx context.Context, input services.UpdateVisibilityInput) (*vo.Profile, error) {
+	if s.updateVisibilityFn != nil {
+		return s.updateVisibilityFn(ctx, input)
+	}
+	return nil, nil
+}
+
+func (s *profileServiceStub) GetPublicProfile(ctx context.Context, userID uuid.UUID) (*vo.PublicProfile, error) {
+	if s.getPublicProfileFn != nil {
+		return s.getPublicProfileFn(ctx, userID)
+	}
+	return nil, nil
+}
+
+func (s *profileServiceStub) EnsureBookmarksPublic(ctx context.Context, userID uuid.UUID) error {
+	if s.ensureBookmarksFn != nil {
+		return s.ensureBookmarksFn(ctx, userID)
+	}
+	return nil
+}
+
+func (s *profileServiceStub) CreateAvatarUpload(ctx context.Context, input services.CreateAvatarUploadInput) (*vo.AvatarUpload, error) {
+	if s.createAvatarFn != nil {
+		return s.createAvatarFn(ctx, input)
+	}
+	return nil, nil
+}
+
+func (s *profileServiceStub) ConfirmAvatarUpload(ctx context.Context, input services.ConfirmAvatarUploadInput) (*vo.Profile, error) {
+	if s.confirmAvatarFn != nil {
+		return s.confirmAvatarFn(ctx, input)
+	}
+	return nil, nil
+}
+
+func (s *profileServiceStub) ListAuditEntries(ctx context.Context, input services.ListAuditEntriesInput) ([]*vo.AuditEntry, error) {
+	if s.listAuditFn != nil {
+		return s.listAuditFn(ctx, input)
+	}
+	return nil, nil
+}
+
 type engagementServiceStub struct {
 	mutateFn        func(context.Context, services.MutateEngagementInput) error
 	getStateFn      func(context.Context, uuid.UUID, uuid.UUID) (services.FavoriteState, error)
@@ -171,6 +237,8 @@ func TestProfileHandler_MutateFavorite_MapsInput(t *testing.T) {
 			require.Equal(t, expectedVideo, input.VideoID)
 			require.Equal(t, "bookmark", input.EngagementType)
 			require.Equal(t, services.EngagementActionAdd, input.Action)
+			require.Equal(t, services.EngagementSourceRecommendation, *input.Source)
+			require.Equal(t, map[string]any{"entry_point": "feed", "device": "ios"}, input.Metadata)
 			return nil
 		},
 		getStateFn: func(_ context.Context, _ uuid.UUID, _ uuid.UUID) (services.FavoriteState, error) {
@@ -198,6 +266,8 @@ func TestProfileHandler_MutateFavorite_MapsInput(t *testing.T) {
 		VideoId:      expectedVideo.String(),
 		FavoriteType: profilev1.FavoriteType_FAVORITE_TYPE_BOOKMARK,
 		Action:       profilev1.FavoriteAction_FAVORITE_ACTION_ADD,
+		Source:       profilev1.EngagementSource_ENGAGEMENT_SOURCE_RECOMMENDATION,
+		Context:      &profilev1.EngagementContext{EntryPoint: "feed", Device: " ios "},
 	}
 	resp, err := handler.MutateFavorite(ctx, req)
 	require.NoError(t, err)
@@ -205,6 +275,77 @@ func TestProfileHandler_MutateFavorite_MapsInput(t *testing.T) {
 	require.EqualValues(t, 3, resp.GetStats().GetBookmarkCount())
 }
 
+func TestProfileHandler_MutateFavorite_DislikeReturnsExtendedState(t *testing.T) {
+	t.Parallel()
+
+	videoID := uuid.New()
+	engagement := &engagementServiceStub{
+		mutateFn: func(_ context.Context, input services.MutateEngagementInput) error {
+			require.Equal(t, services.EngagementTypeDislike, input.EngagementType)
+			return nil
+		},
+		getStateFn: func(context.Context, uuid.UUID, uuid.UUID) (services.FavoriteState, error) {
+			return services.FavoriteState{HasDisliked: true, HasShared: true}, nil
+		},
+	}
+	statsSvc := &videoStatsServiceStub{
+		getFn: func(context.Context, uuid.UUID) (*po.ProfileVideoStats, error) {
+			return &po.ProfileVideoStats{DislikeCount: 4, ShareCount: 2, UpdatedAt: time.Now()}, nil
+		},
+	}
+	handler := controllers.NewProfileHandler(
+		&profileServiceStub{},
+		engagement,
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		statsSvc,
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+	)
+
+	ctx := metadataContextWithUser(t, uuid.New())
+	resp, err := handler.MutateFavorite(ctx, &profilev1.MutateFavoriteRequest{
+		VideoId:      videoID.String(),
+		FavoriteType: profilev1.FavoriteType_FAVORITE_TYPE_DISLIKE,
+		Action:       profilev1.FavoriteAction_FAVORITE_ACTION_ADD,
+	})
+	require.NoError(t, err)
+	require.True(t, resp.GetState().GetHasDisliked())
+	require.True(t, resp.GetState().GetHasShared())
+	require.False(t, resp.GetState().GetHasLiked())
+	require.EqualValues(t, 4, resp.GetStats().GetDislikeCount())
+	require.EqualValues(t, 2, resp.GetStats().GetShareCount())
+}
+
+func TestProfileHandler_MutateFavorite_RemoveShareReturnsInvalidArgument(t *testing.T) {
+	t.Parallel()
+
+	engagement := &engagementServiceStub{
+		mutateFn: func(_ context.Context, input services.MutateEngagementInput) error {
+			require.Equal(t, services.EngagementTypeShare, input.EngagementType)
+			return services.ErrEngagementNotRemovable
+		},
+	}
+	handler := controllers.NewProfileHandler(
+		&profileServiceStub{},
+		engagement,
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+	)
+
+	ctx := metadataContextWithUser(t, uuid.New())
+	_, err := handler.MutateFavorite(ctx, &profilev1.MutateFavoriteRequest{
+		VideoId:      uuid.NewString(),
+		FavoriteType: profilev1.FavoriteType_FAVORITE_TYPE_SHARE,
+		Action:       profilev1.FavoriteAction_FAVORITE_ACTION_REMOVE,
+	})
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+}
+
 func TestProfileHandler_UpdateProfile_ConflictMapsToAborted(t *testing.T) {
 	t.Parallel()
 
@@ -385,7 +526,8 @@ func TestProfileHandler_ListFavorites_PaginationAndStats(t *testing.T) {
 			require.Equal(t, int32(3), input.Limit)
 			require.Equal(t, int32(0), input.Offset)
 			return []*po.ProfileEngagement{
-				{UserID: userID, VideoID: videoIDs[0], EngagementType: "like", CreatedAt: now, UpdatedAt: now},
+				{UserID: userID, VideoID: videoIDs[0], EngagementType: "like", CreatedAt: now, UpdatedAt: now,
+					Source: "recommendation", Metadata: map[string]any{"entry_point": "feed", "device": "web"}},
 				{UserID: userID, VideoID: videoIDs[1], EngagementType: "bookmark", CreatedAt: now.Add(time.Minute), UpdatedAt: now.Add(time.Minute)},
 				{UserID: userID, VideoID: videoIDs[2], EngagementType: "bookmark", CreatedAt: now.Add(2 * time.Minute), UpdatedAt: now.Add(2 * time.Minute)},
 			}, nil
@@ -435,12 +577,16 @@ func TestProfileHandler_ListFavorites_PaginationAndStats(t *testing.T) {
 	require.True(t, first.GetState().GetHasLiked())
 	require.False(t, first.GetState().GetHasBookmarked())
 	require.Equal(t, "Lesson 1", first.GetVideo().GetTitle())
+	require.Equal(t, profilev1.EngagementSource_ENGAGEMENT_SOURCE_RECOMMENDATION, first.GetSource())
+	require.Equal(t, "feed", first.GetContext().GetEntryPoint())
+	require.Equal(t, "web", first.GetContext().GetDevice())
 
 	second := resp.GetFavorites()[1]
 	require.Equal(t, videoIDs[1].String(), second.GetVideoId())
 	require.False(t, second.GetState().GetHasLiked())
 	require.True(t, second.GetState().GetHasBookmarked())
 	require.Equal(t, "Lesson 2", second.GetVideo().GetTitle())
+	require.Nil(t, second.GetContext())
 }
 
 func TestProfileHandler_ListWatchHistory_MetadataMissing(t *testing.T) {
@@ -543,3 +689,83 @@ func TestProfileHandler_ListWatchHistory_TimeoutPropagation(t *testing.T) {
 	require.Equal(t, codes.Internal, st.Code())
 	require.Contains(t, st.Message(), context.DeadlineExceeded.Error())
 }
+
+func TestProfileHandler_UpdateProfile_LocaleMaskPaths(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	profiles := &profileServiceStub{
+		updateProfileFn: func(_ context.Context, input services.UpdateProfileInput) (*vo.Profile, error) {
+			require.Nil(t, input.DisplayName)
+			require.NotNil(t, input.PreferredLocale)
+			require.Equal(t, "pt-BR", *input.PreferredLocale)
+			require.NotNil(t, input.PreferredTimezone)
+			require.Empty(t, *input.PreferredTimezone)
+			return nil, services.ErrInvalidLocale
+		},
+	}
+	handler := controllers.NewProfileHandler(
+		profiles,
+		&engagementServiceStub{},
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+	)
+
+	ctx := metadataContextWithUser(t, userID)
+	_, err := handler.UpdateProfile(ctx, &profilev1.UpdateProfileRequest{
+		Profile:    &profilev1.Profile{DisplayName: "Alice", PreferredLocale: "pt-BR"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"profile.preferred_locale", "profile.preferred_timezone"}},
+	})
+	require.Error(t, err)
+	st, _ := status.FromError(err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestProfileHandler_UpdateProfile_DisplayNameReasons(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		err    error
+		code   codes.Code
+		reason string
+	}{
+		{fmt.Errorf("%w: 1 < 2", services.ErrDisplayNameTooShort), codes.InvalidArgument, "profile.errors.display_name_too_short"},
+		{services.ErrDisplayNameTooLong, codes.InvalidArgument, "profile.errors.display_name_too_long"},
+		{services.ErrDisplayNameInvalidCharacters, codes.InvalidArgument, "profile.errors.display_name_invalid_characters"},
+		{services.ErrDisplayNameBlocked, codes.InvalidArgument, "profile.errors.display_name_blocked"},
+		{services.ErrDisplayNameRejected, codes.InvalidArgument, "profile.errors.display_name_rejected"},
+		{services.ErrDisplayNameTaken, codes.AlreadyExists, "profile.errors.display_name_taken"},
+	}
+	for _, tc := range cases {
+		handler := controllers.NewProfileHandler(
+			&profileServiceStub{
+				updateProfileFn: func(context.Context, services.UpdateProfileInput) (*vo.Profile, error) {
+					return nil, tc.err
+				},
+			},
+			&engagementServiceStub{},
+			&watchHistoryServiceStub{},
+			&videoProjectionServiceStub{},
+			&videoStatsServiceStub{},
+			controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+		)
+
+		_, err := handler.UpdateProfile(metadataContextWithUser(t, uuid.New()), &profilev1.UpdateProfileRequest{
+			Profile: &profilev1.Profile{DisplayName: "Alice"},
+		})
+		st, _ := status.FromError(err)
+		require.Equal(t, tc.code, st.Code(), tc.reason)
+
+		var info *errdetails.ErrorInfo
+		for _, detail := range st.Details() {
+			if v, ok := detail.(*errdetails.ErrorInfo); ok {
+				info = v
+			}
+		}
+		require.NotNil(t, info, tc.reason)
+		require.Equal(t, tc.reason, info.GetReason())
+		require.Equal(t, "lingo-services-profile", info.GetDomain())
+	}
+}
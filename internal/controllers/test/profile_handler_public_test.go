@@ -0,0 +1,215 @@
+package controllers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	profilev1 "github.com/bionicotaku/lingo-services-profile/api/profile/v1"
+	"github.com/bionicotaku/lingo-services-profile/internal/controllers"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/vo"
+	"github.com/bionicotaku/lingo-services-profile/internal/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestProfileHandler_GetPublicProfile(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	name := "Alice"
+	profiles := &profileServiceStub{
+		getPublicProfileFn: func(_ context.Context, id uuid.UUID) (*vo.PublicProfile, error) {
+			require.Equal(t, userID, id)
+			return &vo.PublicProfile{
+				UserID:      id.String(),
+				DisplayName: &name,
+				Stats:       &vo.PublicProfileStats{LikeCount: 3, BookmarkCount: 2},
+			}, nil
+		},
+	}
+	handler := controllers.NewProfileHandler(
+		profiles,
+		&engagementServiceStub{},
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+	)
+
+	resp, err := handler.GetPublicProfile(context.Background(), &profilev1.GetPublicProfileRequest{UserId: userID.String()})
+	require.NoError(t, err)
+	require.Equal(t, "Alice", resp.GetProfile().GetDisplayName())
+	require.Empty(t, resp.GetProfile().GetAvatarUrl())
+	require.False(t, resp.GetProfile().GetBookmarksPublic())
+	require.Equal(t, int64(3), resp.GetProfile().GetStats().GetLikeCount())
+}
+
+func TestProfileHandler_GetPublicProfile_NotFound(t *testing.T) {
+	t.Parallel()
+
+	profiles := &profileServiceStub{
+		getPublicProfileFn: func(context.Context, uuid.UUID) (*vo.PublicProfile, error) {
+			return nil, services.ErrProfileNotFound
+		},
+	}
+	handler := controllers.NewProfileHandler(
+		profiles,
+		&engagementServiceStub{},
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+	)
+
+	_, err := handler.GetPublicProfile(context.Background(), &profilev1.GetPublicProfileRequest{UserId: uuid.NewString()})
+	require.Error(t, err)
+	st, _ := status.FromError(err)
+	require.Equal(t, codes.NotFound, st.Code())
+}
+
+func TestProfileHandler_UpdateVisibility_RespectsMask(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	profiles := &profileServiceStub{
+		updateVisibilityFn: func(_ context.Context, input services.UpdateVisibilityInput) (*vo.Profile, error) {
+			require.Equal(t, userID, input.UserID)
+			require.Nil(t, input.PublicDisplayName)
+			require.Nil(t, input.PublicAvatar)
+			require.NotNil(t, input.PublicBookmarks)
+			require.True(t, *input.PublicBookmarks)
+			require.Nil(t, input.PublicStats)
+			return &vo.Profile{
+				UserID:         userID.String(),
+				ProfileVersion: 2,
+				Visibility:     vo.ProfileVisibility{PublicDisplayName: true, PublicAvatar: true, PublicBookmarks: true},
+			}, nil
+		},
+	}
+	handler := controllers.NewProfileHandler(
+		profiles,
+		&engagementServiceStub{},
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+	)
+
+	ctx := metadataContextWithUser(t, userID)
+	resp, err := handler.UpdateVisibility(ctx, &profilev1.UpdateVisibilityRequest{
+		Visibility: &profilev1.ProfileVisibility{PublicBookmarks: true, PublicStats: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility.public_bookmarks"}},
+	})
+	require.NoError(t, err)
+	require.True(t, resp.GetProfile().GetVisibility().GetPublicBookmarks())
+	require.False(t, resp.GetProfile().GetVisibility().GetPublicStats())
+}
+
+func TestProfileHandler_UpdateVisibility_UnknownMaskIsInvalidArgument(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	profiles := &profileServiceStub{
+		updateVisibilityFn: func(context.Context, services.UpdateVisibilityInput) (*vo.Profile, error) {
+			return nil, fmt.Errorf("update visibility: %w", services.ErrNoVisibilityFields)
+		},
+	}
+	handler := controllers.NewProfileHandler(
+		profiles,
+		&engagementServiceStub{},
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+	)
+
+	_, err := handler.UpdateVisibility(metadataContextWithUser(t, userID), &profilev1.UpdateVisibilityRequest{
+		Visibility: &profilev1.ProfileVisibility{PublicStats: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility.public_nickname"}},
+	})
+	require.Error(t, err)
+	st, _ := status.FromError(err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestProfileHandler_ListPublicBookmarks_FiltersNonPublicVideos(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	publicVideo := uuid.New()
+	privateVideo := uuid.New()
+	missingVideo := uuid.New()
+	now := time.Now().UTC()
+	public := po.VisibilityPublic
+	private := po.VisibilityPrivate
+
+	engagement := &engagementServiceStub{
+		listFavoritesFn: func(_ context.Context, input services.ListFavoritesInput) ([]*po.ProfileEngagement, error) {
+			require.Equal(t, userID, input.UserID)
+			require.Equal(t, []string{"bookmark"}, input.EngagementTypes)
+			require.False(t, input.IncludeDeleted)
+			return []*po.ProfileEngagement{
+				{UserID: userID, VideoID: publicVideo, EngagementType: "bookmark", CreatedAt: now},
+				{UserID: userID, VideoID: privateVideo, EngagementType: "bookmark", CreatedAt: now},
+				{UserID: userID, VideoID: missingVideo, EngagementType: "bookmark", CreatedAt: now},
+			}, nil
+		},
+	}
+	projections := &videoProjectionServiceStub{
+		listFn: func(context.Context, []uuid.UUID) ([]*po.ProfileVideoProjection, error) {
+			return []*po.ProfileVideoProjection{
+				{VideoID: publicVideo, Title: "Public", VisibilityStatus: &public},
+				{VideoID: privateVideo, Title: "Private", VisibilityStatus: &private},
+			}, nil
+		},
+	}
+	handler := controllers.NewProfileHandler(
+		&profileServiceStub{},
+		engagement,
+		&watchHistoryServiceStub{},
+		projections,
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+	)
+
+	resp, err := handler.ListPublicBookmarks(context.Background(), &profilev1.ListPublicBookmarksRequest{UserId: userID.String()})
+	require.NoError(t, err)
+	require.Len(t, resp.GetBookmarks(), 1)
+	require.Equal(t, publicVideo.String(), resp.GetBookmarks()[0].GetVideoId())
+	require.Equal(t, "Public", resp.GetBookmarks()[0].GetVideo().GetTitle())
+}
+
+func TestProfileHandler_ListPublicBookmarks_NotPublic(t *testing.T) {
+	t.Parallel()
+
+	profiles := &profileServiceStub{
+		ensureBookmarksFn: func(context.Context, uuid.UUID) error {
+			return fmt.Errorf("%w: bookmarks", services.ErrProfileFieldNotPublic)
+		},
+	}
+	engagement := &engagementServiceStub{
+		listFavoritesFn: func(context.Context, services.ListFavoritesInput) ([]*po.ProfileEngagement, error) {
+			t.Fatal("bookmarks must not be listed when not public")
+			return nil, nil
+		},
+	}
+	handler := controllers.NewProfileHandler(
+		profiles,
+		engagement,
+		&watchHistoryServiceStub{},
+		&videoProjectionServiceStub{},
+		&videoStatsServiceStub{},
+		controllers.NewBaseHandler(controllers.HandlerTimeouts{}),
+	)
+
+	_, err := handler.ListPublicBookmarks(context.Background(), &profilev1.ListPublicBookmarksRequest{UserId: uuid.NewString()})
+	require.Error(t, err)
+	st, _ := status.FromError(err)
+	require.Equal(t, codes.PermissionDenied, st.Code())
+}
@@ -0,0 +1,155 @@
+package controllers_test
+
+import (
+	"context"
+	"testing"
+
+	profilev1 "github.com/bionicotaku/lingo-services-profile/api/profile/v1"
+	"github.com/bionicotaku/lingo-services-profile/internal/controllers"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/vo"
+	"github.com/bionicotaku/lingo-services-profile/internal/services"
+	"github.com/bufbuild/protovalidate-go"
+	pvmw "github.com/go-kratos-ecosystem/components/v2/middleware/protovalidate"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// callValidated 模拟服务端中间件链中的 protovalidate 环节，再调用 Handler。
+func callValidated[Req proto.Message, Resp any](t *testing.T, req Req, call func(context.Context, Req) (Resp, error)) error {
+	t.Helper()
+	validator, err := protovalidate.New()
+	require.NoError(t, err)
+	mw := pvmw.Server(pvmw.Validator(validator), pvmw.Handler(controllers.ValidationFailed))
+	_, err = mw(func(ctx context.Context, in any) (any, error) {
+		return call(ctx, in.(Req))
+	})(context.Background(), req)
+	return err
+}
+
+func requireFieldViolation(t *testing.T, err error, field string) {
+	t.Helper()
+	st, details := detailsOf(t, err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Equal(t, controllers.ReasonInvalidArgument, details.info.GetReason())
+	require.Equal(t, field, details.info.GetMetadata()["field"])
+	require.NotEmpty(t, details.badRequest.GetFieldViolations())
+	require.Equal(t, field, details.badRequest.GetFieldViolations()[0].GetField())
+}
+
+func TestProfileHandler_ValidationRejectsMalformedRequests(t *testing.T) {
+	t.Parallel()
+
+	handler := newErrorsHandler(&profileServiceStub{}, &engagementServiceStub{})
+	userID := uuid.NewString()
+	tooMany := make([]string, 101)
+	for i := range tooMany {
+		tooMany[i] = uuid.NewString()
+	}
+
+	cases := []struct {
+		name  string
+		field string
+		call  func() error
+	}{
+		{"user_id uuid", "user_id", func() error {
+			return callValidated(t, &profilev1.GetProfileRequest{UserId: "not-a-uuid"}, handler.GetProfile)
+		}},
+		{"video_id required", "video_id", func() error {
+			return callValidated(t, &profilev1.MutateFavoriteRequest{
+				FavoriteType: profilev1.FavoriteType_FAVORITE_TYPE_LIKE,
+				Action:       profilev1.FavoriteAction_FAVORITE_ACTION_ADD,
+			}, handler.MutateFavorite)
+		}},
+		{"favorite_type unspecified", "favorite_type", func() error {
+			return callValidated(t, &profilev1.MutateFavoriteRequest{
+				VideoId: uuid.NewString(),
+				Action:  profilev1.FavoriteAction_FAVORITE_ACTION_ADD,
+			}, handler.MutateFavorite)
+		}},
+		{"action undefined", "action", func() error {
+			return callValidated(t, &profilev1.MutateFavoriteRequest{
+				VideoId:      uuid.NewString(),
+				FavoriteType: profilev1.FavoriteType_FAVORITE_TYPE_BOOKMARK,
+				Action:       profilev1.FavoriteAction(9),
+			}, handler.MutateFavorite)
+		}},
+		{"video_ids max items", "video_ids", func() error {
+			return callValidated(t, &profilev1.BatchQueryFavoriteRequest{VideoIds: tooMany}, handler.BatchQueryFavorite)
+		}},
+		{"video_ids item uuid", "video_ids[1]", func() error {
+			return callValidated(t, &profilev1.BatchQueryFavoriteRequest{VideoIds: []string{uuid.NewString(), "bad"}}, handler.BatchQueryFavorite)
+		}},
+		{"page_size upper bound", "page_size", func() error {
+			return callValidated(t, &profilev1.ListFavoritesRequest{PageSize: 101}, handler.ListFavorites)
+		}},
+		{"page_size negative", "page_size", func() error {
+			return callValidated(t, &profilev1.ListWatchHistoryRequest{PageSize: -1}, handler.ListWatchHistory)
+		}},
+		{"page_token numeric", "page_token", func() error {
+			return callValidated(t, &profilev1.ListPublicBookmarksRequest{PageToken: "-5"}, handler.ListPublicBookmarks)
+		}},
+		{"progress required", "progress", func() error {
+			return callValidated(t, &profilev1.UpsertWatchProgressRequest{VideoId: uuid.NewString()}, handler.UpsertWatchProgress)
+		}},
+		{"progress_ratio bound", "progress.progress_ratio", func() error {
+			return callValidated(t, &profilev1.UpsertWatchProgressRequest{
+				VideoId:  uuid.NewString(),
+				Progress: &profilev1.WatchProgress{ProgressRatio: 1.5},
+			}, handler.UpsertWatchProgress)
+		}},
+		{"position non-negative", "progress.position_seconds", func() error {
+			return callValidated(t, &profilev1.UpsertWatchProgressRequest{
+				VideoId:  uuid.NewString(),
+				Progress: &profilev1.WatchProgress{PositionSeconds: -1},
+			}, handler.UpsertWatchProgress)
+		}},
+		{"suspend requires user_id", "user_id", func() error {
+			return callValidated(t, &profilev1.SuspendAccountRequest{Reason: "abuse"}, handler.SuspendAccount)
+		}},
+		{"content_type required", "content_type", func() error {
+			return callValidated(t, &profilev1.CreateAvatarUploadRequest{UserId: userID, ContentLength: 10}, handler.CreateAvatarUpload)
+		}},
+		{"content_length non-negative", "content_length", func() error {
+			return callValidated(t, &profilev1.CreateAvatarUploadRequest{ContentType: "image/png", ContentLength: -1}, handler.CreateAvatarUpload)
+		}},
+		{"upload_key required", "upload_key", func() error {
+			return callValidated(t, &profilev1.ConfirmAvatarUploadRequest{UserId: userID}, handler.ConfirmAvatarUpload)
+		}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			requireFieldViolation(t, tc.call(), tc.field)
+		})
+	}
+}
+
+func TestProfileHandler_ValidationPassesWellFormedRequests(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	handler := newErrorsHandler(&profileServiceStub{
+		getProfileFn: func(_ context.Context, got uuid.UUID) (*vo.Profile, error) {
+			return &vo.Profile{UserID: got.String()}, nil
+		},
+	}, &engagementServiceStub{})
+
+	// user_id 为空时交由 Handler 回落到调用方身份，不触发 uuid 规则。
+	err := callValidated(t, &profilev1.GetProfileRequest{}, handler.GetProfile)
+	requireFieldViolation(t, err, "user_id")
+	require.Contains(t, err.Error(), "user_id required")
+
+	require.NoError(t, callValidated(t, &profilev1.GetProfileRequest{UserId: userID.String()}, handler.GetProfile))
+
+	var gotLimit int32
+	handler = newErrorsHandler(&profileServiceStub{}, &engagementServiceStub{
+		listFavoritesFn: func(_ context.Context, input services.ListFavoritesInput) ([]*po.ProfileEngagement, error) {
+			gotLimit = input.Limit
+			return nil, nil
+		},
+	})
+	require.NoError(t, callValidated(t, &profilev1.ListFavoritesRequest{UserId: userID.String(), PageSize: 100}, handler.ListFavorites))
+	require.EqualValues(t, 101, gotLimit) // 多取一条用于判断是否存在下一页
+}
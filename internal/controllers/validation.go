@@ -0,0 +1,34 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bufbuild/protovalidate-go"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+)
+
+// ValidationFailed 作为 protovalidate 中间件的错误处理器，把 proto 注解规则的违例
+// 转换为与 Handler 手写校验一致的 InvalidArgument：ErrorInfo.metadata.field 指向首个违例字段，
+// BadRequest 列出全部违例。规则编译或运行期错误视为服务端缺陷，返回 Internal。
+func ValidationFailed(_ context.Context, _ any, err error) (any, error) {
+	var valErr *protovalidate.ValidationError
+	if !errors.As(err, &valErr) || len(valErr.Violations) == 0 {
+		return nil, internalError("validate request", err)
+	}
+
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(valErr.Violations))
+	for _, v := range valErr.Violations {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       protovalidate.FieldPathString(v.Proto.GetField()),
+			Description: v.Proto.GetMessage(),
+		})
+	}
+	first := violations[0]
+	return nil, problemStatus(codes.InvalidArgument, ReasonInvalidArgument,
+		first.GetField()+": "+first.GetDescription(),
+		map[string]string{"field": first.GetField()},
+		&errdetails.BadRequest{FieldViolations: violations},
+	)
+}
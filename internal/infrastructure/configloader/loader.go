@@ -24,6 +24,7 @@ const (
 	envConfPath           = "CONF_PATH"
 	envDatabaseURL        = "DATABASE_URL"
 	envPort               = "PORT"
+	envHTTPPort           = "HTTP_PORT"
 	envServiceName        = "SERVICE_NAME"
 	envServiceVersion     = "SERVICE_VERSION"
 	envEnvironment        = "APP_ENV"
@@ -200,6 +201,11 @@ func applyEnvOverrides(b *configpb.Bootstrap) {
 			server.Grpc.Addr = replacePort(server.Grpc.GetAddr(), port)
 		}
 	}
+	if port := os.Getenv(envHTTPPort); port != "" {
+		if server := b.GetServer(); server != nil && server.Http != nil {
+			server.Http.Addr = replacePort(server.Http.GetAddr(), port)
+		}
+	}
 }
 
 func replacePort(addr, port string) string {
@@ -1,6 +1,7 @@
 package configloader
 
 import (
+	"strings"
 	"time"
 
 	configpb "github.com/bionicotaku/lingo-services-profile/configs"
@@ -9,10 +10,24 @@ import (
 )
 
 const (
-	defaultHandlerTimeout = 5 * time.Second
-	defaultQueryTimeout   = 3 * time.Second
+	defaultHandlerTimeout      = 5 * time.Second
+	defaultQueryTimeout        = 3 * time.Second
+	defaultHealthCheckInterval = 10 * time.Second
+	defaultHealthProbeTimeout  = 2 * time.Second
+	defaultAvatarUploadTTL     = 15 * time.Minute
+	defaultAvatarMaxBytes      = 2 << 20
+	defaultDisplayNameMin      = 2
+	defaultDisplayNameMax      = 32
+	defaultInboxMaxAttempts    = 10
+	defaultRetentionMode       = "delete"
+	defaultRetentionInterval   = 10 * time.Minute
+	defaultRetentionBatchSize  = 1000
+	defaultMessagingDriver     = "gcp"
+	defaultEventBusRetention   = 24 * time.Hour
 )
 
+var defaultAvatarContentTypes = []string{"image/jpeg", "image/png", "image/webp"}
+
 func fromProto(b *configpb.Bootstrap) RuntimeConfig {
 	if b == nil {
 		return RuntimeConfig{}
@@ -23,6 +38,8 @@ func fromProto(b *configpb.Bootstrap) RuntimeConfig {
 		GRPCClient:    grpcClientFromProto(b.GetData().GetGrpcClient()),
 		Observability: observabilityFromProto(b.GetObservability()),
 		Messaging:     messagingFromProto(b.GetMessaging(), b.GetData()),
+		Storage:       storageFromProto(b.GetStorage()),
+		Moderation:    moderationFromProto(b.GetModeration()),
 	}
 	return rc
 }
@@ -37,6 +54,13 @@ func serverFromProto(s *configpb.Server) ServerConfig {
 		server.Address = grpc.GetAddr()
 		server.Timeout = durationOrZero(grpc.GetTimeout())
 	}
+	if http := s.GetHttp(); http != nil {
+		server.HTTP = HTTPServerConfig{
+			Network: http.GetNetwork(),
+			Address: http.GetAddr(),
+			Timeout: durationOrZero(http.GetTimeout()),
+		}
+	}
 	if jwt := s.GetJwt(); jwt != nil {
 		server.JWT = ServerJWTConfig{
 			ExpectedAudience: jwt.GetExpectedAudience(),
@@ -47,9 +71,47 @@ func serverFromProto(s *configpb.Server) ServerConfig {
 	}
 	server.Handlers = handlerTimeoutFromProto(s.GetHandlers())
 	server.MetadataKeys = append([]string(nil), s.GetMetadataKeys()...)
+	if health := s.GetHealth(); health != nil {
+		server.Health = HealthConfig{
+			CheckInterval:          durationOrZero(health.GetCheckInterval()),
+			ProbeTimeout:           durationOrZero(health.GetProbeTimeout()),
+			OutboxPendingThreshold: health.GetOutboxPendingThreshold(),
+			InboxLagThreshold:      durationOrZero(health.GetInboxLagThreshold()),
+			InboxSourceService:     strings.TrimSpace(health.GetInboxSourceService()),
+		}
+	}
+	server.RateLimit = rateLimitFromProto(s.GetRateLimit())
+	server.Admin = AdminConfig{
+		ServiceAccounts: append([]string(nil), s.GetAdmin().GetServiceAccounts()...),
+		Roles:           append([]string(nil), s.GetAdmin().GetRoles()...),
+	}
 	return server
 }
 
+func rateLimitFromProto(r *configpb.Server_RateLimit) RateLimitConfig {
+	if r == nil {
+		return RateLimitConfig{}
+	}
+	cfg := RateLimitConfig{
+		Enabled: r.GetEnabled(),
+		Default: rateLimitRuleFromProto(r.GetDefaultRule()),
+		IdleTTL: durationOrZero(r.GetIdleTtl()),
+	}
+	if len(r.GetMethods()) > 0 {
+		cfg.Methods = make(map[string]RateLimitRule, len(r.GetMethods()))
+		for name, rule := range r.GetMethods() {
+			if name = strings.TrimSpace(name); name != "" {
+				cfg.Methods[name] = rateLimitRuleFromProto(rule)
+			}
+		}
+	}
+	return cfg
+}
+
+func rateLimitRuleFromProto(r *configpb.Server_RateLimit_Rule) RateLimitRule {
+	return RateLimitRule{RPS: r.GetRps(), Burst: int(r.GetBurst())}
+}
+
 func handlerTimeoutFromProto(h *configpb.Server_Handlers) HandlerTimeoutConfig {
 	cfg := HandlerTimeoutConfig{
 		Default: defaultHandlerTimeout,
@@ -175,9 +237,11 @@ func metricsFromProto(m *configpb.Observability_Metrics) MetricsConfig {
 
 func messagingFromProto(msg *configpb.Messaging, data *configpb.Data) MessagingConfig {
 	cfg := MessagingConfig{
-		Topics:  map[string]PubSubConfig{},
-		Outbox:  outboxFromProto(nil),
-		Inboxes: map[string]InboxConfig{},
+		Topics:   map[string]PubSubConfig{},
+		Outbox:   outboxFromProto(nil),
+		Inboxes:  map[string]InboxConfig{},
+		Driver:   defaultMessagingDriver,
+		EventBus: eventBusFromProto(nil),
 	}
 	if data != nil && data.GetPostgres() != nil {
 		cfg.Schema = data.GetPostgres().GetSchema()
@@ -185,6 +249,10 @@ func messagingFromProto(msg *configpb.Messaging, data *configpb.Data) MessagingC
 	if msg == nil {
 		return cfg
 	}
+	if driver := strings.ToLower(strings.TrimSpace(msg.GetDriver())); driver != "" {
+		cfg.Driver = driver
+	}
+	cfg.EventBus = eventBusFromProto(msg.GetEventBus())
 	if msg.GetOutbox() != nil {
 		cfg.Outbox = outboxFromProto(msg.GetOutbox())
 	}
@@ -203,6 +271,19 @@ func messagingFromProto(msg *configpb.Messaging, data *configpb.Data) MessagingC
 	return cfg
 }
 
+func eventBusFromProto(eb *configpb.EventBus) EventBusConfig {
+	cfg := EventBusConfig{
+		PollInterval: durationOrZero(eb.GetPollInterval()),
+		BatchSize:    int(eb.GetBatchSize()),
+		RetryDelay:   durationOrZero(eb.GetRetryDelay()),
+		Retention:    durationOrZero(eb.GetRetention()),
+	}
+	if cfg.Retention <= 0 {
+		cfg.Retention = defaultEventBusRetention
+	}
+	return cfg
+}
+
 func pubsubFromProto(pb *configpb.PubSub) PubSubConfig {
 	if pb == nil {
 		return PubSubConfig{}
@@ -252,6 +333,32 @@ func outboxFromProto(ob *configpb.OutboxPublisher) OutboxPublisherConfig {
 		val := ob.GetMetricsEnabled()
 		cfg.MetricsEnabled = &val
 	}
+	cfg.Retention = retentionFromProto(ob.GetRetention())
+	for _, route := range ob.GetRoutes() {
+		cfg.Routes = append(cfg.Routes, OutboxRouteConfig{
+			EventType: strings.TrimSpace(route.GetEventType()),
+			Topic:     strings.TrimSpace(route.GetTopic()),
+		})
+	}
+	return cfg
+}
+
+func retentionFromProto(r *configpb.OutboxPublisher_Retention) OutboxRetentionConfig {
+	cfg := OutboxRetentionConfig{
+		MaxAge:    durationOrZero(r.GetMaxAge()),
+		Mode:      strings.ToLower(strings.TrimSpace(r.GetMode())),
+		Interval:  durationOrZero(r.GetInterval()),
+		BatchSize: int(r.GetBatchSize()),
+	}
+	if cfg.Mode == "" {
+		cfg.Mode = defaultRetentionMode
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultRetentionInterval
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultRetentionBatchSize
+	}
 	return cfg
 }
 
@@ -262,6 +369,10 @@ func inboxFromProto(in *configpb.InboxConsumer) InboxConfig {
 	cfg := InboxConfig{
 		SourceService:  in.GetSourceService(),
 		MaxConcurrency: int(in.GetMaxConcurrency()),
+		MaxAttempts:    int(in.GetMaxAttempts()),
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaultInboxMaxAttempts
 	}
 	if in.LoggingEnabled != nil {
 		val := in.GetLoggingEnabled()
@@ -274,6 +385,41 @@ func inboxFromProto(in *configpb.InboxConsumer) InboxConfig {
 	return cfg
 }
 
+func storageFromProto(st *configpb.Storage) StorageConfig {
+	cfg := StorageConfig{}
+	avatar := st.GetAvatar()
+	if avatar == nil {
+		return cfg
+	}
+	cfg.Avatar = AvatarStorageConfig{
+		Driver:              strings.ToLower(strings.TrimSpace(avatar.GetDriver())),
+		LocalDir:            avatar.GetLocalDir(),
+		UploadBaseURL:       strings.TrimRight(avatar.GetUploadBaseUrl(), "/"),
+		PublicBaseURL:       strings.TrimRight(avatar.GetPublicBaseUrl(), "/"),
+		SigningKey:          avatar.GetSigningKey(),
+		UploadTTL:           durationOrZero(avatar.GetUploadTtl()),
+		MaxBytes:            avatar.GetMaxBytes(),
+		AllowedContentTypes: append([]string(nil), avatar.GetAllowedContentTypes()...),
+		AllowedHosts:        append([]string(nil), avatar.GetAllowedHosts()...),
+	}
+	return cfg
+}
+
+func moderationFromProto(m *configpb.Moderation) ModerationConfig {
+	cfg := ModerationConfig{}
+	name := m.GetDisplayName()
+	if name == nil {
+		return cfg
+	}
+	cfg.DisplayName = DisplayNameModerationConfig{
+		MinLength:     int(name.GetMinLength()),
+		MaxLength:     int(name.GetMaxLength()),
+		Blocklist:     append([]string(nil), name.GetBlocklist()...),
+		RequireUnique: name.GetRequireUnique(),
+	}
+	return cfg
+}
+
 func durationOrZero(d *durationpb.Duration) time.Duration {
 	if d == nil {
 		return 0
@@ -321,4 +467,25 @@ func fillDefaults(cfg *RuntimeConfig) {
 	if len(cfg.GRPCClient.MetadataKeys) == 0 {
 		cfg.GRPCClient.MetadataKeys = append([]string(nil), cfg.Server.MetadataKeys...)
 	}
+	if cfg.Server.Health.CheckInterval <= 0 {
+		cfg.Server.Health.CheckInterval = defaultHealthCheckInterval
+	}
+	if cfg.Server.Health.ProbeTimeout <= 0 {
+		cfg.Server.Health.ProbeTimeout = defaultHealthProbeTimeout
+	}
+	if cfg.Storage.Avatar.UploadTTL <= 0 {
+		cfg.Storage.Avatar.UploadTTL = defaultAvatarUploadTTL
+	}
+	if cfg.Storage.Avatar.MaxBytes <= 0 {
+		cfg.Storage.Avatar.MaxBytes = defaultAvatarMaxBytes
+	}
+	if len(cfg.Storage.Avatar.AllowedContentTypes) == 0 {
+		cfg.Storage.Avatar.AllowedContentTypes = append([]string(nil), defaultAvatarContentTypes...)
+	}
+	if cfg.Moderation.DisplayName.MinLength <= 0 {
+		cfg.Moderation.DisplayName.MinLength = defaultDisplayNameMin
+	}
+	if cfg.Moderation.DisplayName.MaxLength <= 0 {
+		cfg.Moderation.DisplayName.MaxLength = defaultDisplayNameMax
+	}
 }
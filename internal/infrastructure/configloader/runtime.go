@@ -11,6 +11,8 @@ type RuntimeConfig struct {
 	GRPCClient    GRPCClientConfig
 	Observability ObservabilityConfig
 	Messaging     MessagingConfig
+	Storage       StorageConfig
+	Moderation    ModerationConfig
 }
 
 // ServiceInfo 描述服务标识与运行环境。
@@ -21,14 +23,25 @@ type ServiceInfo struct {
 	InstanceID  string
 }
 
-// ServerConfig 收敛入站 gRPC 服务所需的网络与鉴权配置。
+// ServerConfig 收敛入站 gRPC/HTTP 服务所需的网络与鉴权配置。
 type ServerConfig struct {
 	Network      string
 	Address      string
 	Timeout      time.Duration
+	HTTP         HTTPServerConfig
 	JWT          ServerJWTConfig
 	Handlers     HandlerTimeoutConfig
 	MetadataKeys []string
+	Health       HealthConfig
+	RateLimit    RateLimitConfig
+	Admin        AdminConfig
+}
+
+// HTTPServerConfig 描述 HTTP/JSON 网关的监听配置，Address 为空表示不启动。
+type HTTPServerConfig struct {
+	Network string
+	Address string
+	Timeout time.Duration
 }
 
 // ServerJWTConfig 管理入站请求的 JWT 校验策略。
@@ -46,6 +59,35 @@ type HandlerTimeoutConfig struct {
 	Query   time.Duration
 }
 
+// HealthConfig 控制 gRPC 健康检查的探测周期与就绪阈值。
+type HealthConfig struct {
+	CheckInterval          time.Duration
+	ProbeTimeout           time.Duration
+	OutboxPendingThreshold int64
+	InboxLagThreshold      time.Duration
+	InboxSourceService     string
+}
+
+// RateLimitConfig 描述按「用户 × RPC」的令牌桶限流规则。
+type RateLimitConfig struct {
+	Enabled bool
+	Default RateLimitRule
+	Methods map[string]RateLimitRule
+	IdleTTL time.Duration
+}
+
+// RateLimitRule 为单个令牌桶的速率与突发容量。
+type RateLimitRule struct {
+	RPS   float64
+	Burst int
+}
+
+// AdminConfig 描述运营/内部 RPC 的调用方授权名单。
+type AdminConfig struct {
+	ServiceAccounts []string
+	Roles           []string
+}
+
 // DatabaseConfig 包含 PostgreSQL 连接池及事务默认值。
 type DatabaseConfig struct {
 	DSN               string
@@ -123,10 +165,20 @@ type MetricsConfig struct {
 
 // MessagingConfig 汇总消息系统相关配置。
 type MessagingConfig struct {
-	Schema  string
-	Topics  map[string]PubSubConfig
-	Outbox  OutboxPublisherConfig
-	Inboxes map[string]InboxConfig
+	Schema   string
+	Topics   map[string]PubSubConfig
+	Outbox   OutboxPublisherConfig
+	Inboxes  map[string]InboxConfig
+	Driver   string
+	EventBus EventBusConfig
+}
+
+// EventBusConfig 为 memory / postgres 事件总线驱动的参数，零值表示使用驱动默认值。
+type EventBusConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	RetryDelay   time.Duration
+	Retention    time.Duration
 }
 
 // PubSubConfig 提供与 GCP Pub/Sub 兼容的设置。
@@ -165,6 +217,22 @@ type OutboxPublisherConfig struct {
 	LockTTL        time.Duration
 	LoggingEnabled *bool
 	MetricsEnabled *bool
+	Retention      OutboxRetentionConfig
+	Routes         []OutboxRouteConfig
+}
+
+// OutboxRouteConfig 将匹配 EventType 模式的事件路由到 Topic（messaging.topics 的 key）。
+type OutboxRouteConfig struct {
+	EventType string
+	Topic     string
+}
+
+// OutboxRetentionConfig 控制已发布 Outbox 事件的保留期清理。
+type OutboxRetentionConfig struct {
+	MaxAge    time.Duration
+	Mode      string
+	Interval  time.Duration
+	BatchSize int
 }
 
 // InboxConfig 配置 Inbox 消费者的行为。
@@ -173,4 +241,36 @@ type InboxConfig struct {
 	MaxConcurrency int
 	LoggingEnabled *bool
 	MetricsEnabled *bool
+	MaxAttempts    int
+}
+
+// StorageConfig 汇总对象存储相关配置。
+type StorageConfig struct {
+	Avatar AvatarStorageConfig
+}
+
+// AvatarStorageConfig 配置头像上传的存储驱动、签名与校验策略。
+type AvatarStorageConfig struct {
+	Driver              string
+	LocalDir            string
+	UploadBaseURL       string
+	PublicBaseURL       string
+	SigningKey          string
+	UploadTTL           time.Duration
+	MaxBytes            int64
+	AllowedContentTypes []string
+	AllowedHosts        []string
+}
+
+// ModerationConfig 汇总用户输入内容的审核配置。
+type ModerationConfig struct {
+	DisplayName DisplayNameModerationConfig
+}
+
+// DisplayNameModerationConfig 配置昵称长度、屏蔽词与唯一性策略。
+type DisplayNameModerationConfig struct {
+	MinLength     int
+	MaxLength     int
+	Blocklist     []string
+	RequireUnique bool
 }
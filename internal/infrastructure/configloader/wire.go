@@ -1,6 +1,10 @@
 package configloader
 
 import (
+	"errors"
+	"net/url"
+	"sort"
+
 	"github.com/bionicotaku/lingo-utils/gcjwt"
 	"github.com/bionicotaku/lingo-utils/gclog"
 	"github.com/bionicotaku/lingo-utils/gcpubsub"
@@ -12,6 +16,13 @@ import (
 	"github.com/google/wire"
 
 	"github.com/bionicotaku/lingo-services-profile/internal/controllers"
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/eventbus"
+	healthcheck "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/health_check"
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/objectstore"
+	ratelimiter "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/rate_limiter"
+	"github.com/bionicotaku/lingo-services-profile/internal/services"
+	cataloginbox "github.com/bionicotaku/lingo-services-profile/internal/tasks/catalog_inbox"
+	outboxtasks "github.com/bionicotaku/lingo-services-profile/internal/tasks/outbox"
 )
 
 // ProviderSet 暴露配置加载相关的依赖注入入口。
@@ -30,8 +41,18 @@ var ProviderSet = wire.NewSet(
 	ProvideMessagingConfig,
 	ProvidePubSubConfig,
 	ProvidePubSubDependencies,
+	ProvideEventBusConfig,
 	ProvideOutboxConfig,
 	ProvideHandlerTimeouts,
+	ProvideHealthConfig,
+	ProvideRateLimitConfig,
+	ProvideAdminPolicy,
+	ProvideAvatarStoreConfig,
+	ProvideAvatarPolicy,
+	ProvideDisplayNamePolicy,
+	ProvideInboxQuarantinePolicy,
+	ProvideOutboxRetentionPolicy,
+	ProvideOutboxRouting,
 )
 
 // LoadRuntimeConfig 调用 Load 并供 Wire 使用。
@@ -163,6 +184,89 @@ func ProvideHandlerTimeouts(cfg RuntimeConfig) controllers.HandlerTimeouts {
 	}
 }
 
+// ProvideHealthConfig 将 Server 层健康检查配置映射为 healthcheck.Config。
+// 未显式指定 inbox_source_service 时沿用 Inbox 消费者的来源服务。
+func ProvideHealthConfig(cfg RuntimeConfig, outbox outboxcfg.Config) healthcheck.Config {
+	h := cfg.Server.Health
+	return healthcheck.Config{
+		CheckInterval:          h.CheckInterval,
+		ProbeTimeout:           h.ProbeTimeout,
+		OutboxPendingThreshold: h.OutboxPendingThreshold,
+		InboxLagThreshold:      h.InboxLagThreshold,
+		InboxSourceService:     firstNonEmpty(h.InboxSourceService, outbox.Inbox.SourceService),
+	}
+}
+
+// ProvideRateLimitConfig 将 Server 层限流配置映射为 ratelimiter.Config。
+func ProvideRateLimitConfig(cfg RuntimeConfig) ratelimiter.Config {
+	r := cfg.Server.RateLimit
+	methods := make(map[string]ratelimiter.Rule, len(r.Methods))
+	for name, rule := range r.Methods {
+		methods[name] = ratelimiter.Rule{RPS: rule.RPS, Burst: rule.Burst}
+	}
+	return ratelimiter.Config{
+		Enabled: r.Enabled,
+		Default: ratelimiter.Rule{RPS: r.Default.RPS, Burst: r.Default.Burst},
+		Methods: methods,
+		IdleTTL: r.IdleTTL,
+	}
+}
+
+// ProvideAvatarStoreConfig 将头像存储配置转换为 objectstore.Config。
+func ProvideAvatarStoreConfig(cfg RuntimeConfig) objectstore.Config {
+	avatar := cfg.Storage.Avatar
+	return objectstore.Config{
+		Driver: avatar.Driver,
+		Local: objectstore.LocalConfig{
+			Dir:           avatar.LocalDir,
+			UploadBaseURL: avatar.UploadBaseURL,
+			PublicBaseURL: avatar.PublicBaseURL,
+			SigningKey:    avatar.SigningKey,
+		},
+	}
+}
+
+// ProvideAvatarPolicy 将头像存储配置映射为业务层使用的校验策略。
+// 配置了域名白名单时自动放行 public_base_url 所在域名，保证直传得到的地址可再次提交。
+func ProvideAvatarPolicy(cfg RuntimeConfig) services.AvatarPolicy {
+	avatar := cfg.Storage.Avatar
+	hosts := append([]string(nil), avatar.AllowedHosts...)
+	if len(hosts) > 0 && avatar.PublicBaseURL != "" {
+		if parsed, err := url.Parse(avatar.PublicBaseURL); err == nil && parsed.Hostname() != "" {
+			hosts = append(hosts, parsed.Hostname())
+		}
+	}
+	return services.AvatarPolicy{
+		AllowedHosts:        hosts,
+		AllowedContentTypes: append([]string(nil), avatar.AllowedContentTypes...),
+		MaxBytes:            avatar.MaxBytes,
+		UploadTTL:           avatar.UploadTTL,
+	}
+}
+
+// ProvideDisplayNamePolicy 将昵称审核配置映射为业务层使用的校验策略。
+func ProvideDisplayNamePolicy(cfg RuntimeConfig) services.DisplayNamePolicy {
+	name := cfg.Moderation.DisplayName
+	return services.DisplayNamePolicy{
+		MinLength:     name.MinLength,
+		MaxLength:     name.MaxLength,
+		Blocklist:     append([]string(nil), name.Blocklist...),
+		RequireUnique: name.RequireUnique,
+	}
+}
+
+// ProvideAdminPolicy 将 server.admin 映射为运营/内部 RPC 的授权名单。
+// 服务账号只能从经校验的 JWT 中识别，配置了 service_accounts 却跳过 JWT 校验时拒绝启动。
+func ProvideAdminPolicy(cfg RuntimeConfig) (controllers.AdminPolicy, error) {
+	if len(cfg.Server.Admin.ServiceAccounts) > 0 && cfg.Server.JWT.SkipValidate {
+		return controllers.AdminPolicy{}, errors.New("server.admin.service_accounts requires server.jwt.skip_validate=false")
+	}
+	return controllers.AdminPolicy{
+		ServiceAccounts: append([]string(nil), cfg.Server.Admin.ServiceAccounts...),
+		Roles:           append([]string(nil), cfg.Server.Admin.Roles...),
+	}, nil
+}
+
 // ProvideJWTConfig 汇总客户端与服务端 JWT 配置。
 func ProvideJWTConfig(cfg RuntimeConfig) gcjwt.Config {
 	var serverCfg *gcjwt.ServerConfig
@@ -202,18 +306,42 @@ func ProvideMessagingConfig(cfg RuntimeConfig) MessagingConfig {
 
 // ProvidePubSubConfig 将 MessagingConfig 转换为 gcpubsub.Config。
 func ProvidePubSubConfig(msg MessagingConfig) gcpubsub.Config {
-	cfg, ok := msg.Topics["default"]
-	if !ok {
-		for _, v := range msg.Topics {
-			cfg = v
-			break
-		}
+	return toGCPubSubConfig(msg.Topics[defaultTopicKey(msg)], msg.Driver)
+}
+
+// ProvideOutboxRouting 将 messaging.outbox.routes 与 messaging.topics 映射为 Outbox 路由配置。
+// 默认 topic 与 ProvidePubSubConfig 选取的 topic 一致。
+func ProvideOutboxRouting(msg MessagingConfig) outboxtasks.RoutingConfig {
+	cfg := outboxtasks.RoutingConfig{
+		DefaultTopic: defaultTopicKey(msg),
+		Topics:       make(map[string]gcpubsub.Config, len(msg.Topics)),
+	}
+	for key, topic := range msg.Topics {
+		cfg.Topics[key] = toGCPubSubConfig(topic, msg.Driver)
+	}
+	for _, route := range msg.Outbox.Routes {
+		cfg.Routes = append(cfg.Routes, outboxtasks.Route{EventType: route.EventType, Topic: route.Topic})
+	}
+	return cfg
+}
+
+// defaultTopicKey 选取 topics.default，未配置时按字典序取第一个 topic。
+func defaultTopicKey(msg MessagingConfig) string {
+	if _, ok := msg.Topics[outboxtasks.DefaultTopic]; ok || len(msg.Topics) == 0 {
+		return outboxtasks.DefaultTopic
+	}
+	keys := make([]string, 0, len(msg.Topics))
+	for key := range msg.Topics {
+		keys = append(keys, key)
 	}
-	return toGCPubSubConfig(cfg)
+	sort.Strings(keys)
+	return keys[0]
 }
 
-func toGCPubSubConfig(cfg PubSubConfig) gcpubsub.Config {
-	if cfg.ProjectID == "" {
+// toGCPubSubConfig 转换单个 topic 配置。gcp 驱动缺少 project_id 时视为未配置；
+// memory / postgres 驱动只使用 topic_id 与 subscription_id，不要求 project_id。
+func toGCPubSubConfig(cfg PubSubConfig, driver string) gcpubsub.Config {
+	if cfg.ProjectID == "" && (driver == "" || driver == eventbus.DriverGCP || cfg.TopicID == "") {
 		return gcpubsub.Config{}
 	}
 	result := gcpubsub.Config{
@@ -237,6 +365,17 @@ func toGCPubSubConfig(cfg PubSubConfig) gcpubsub.Config {
 	return result.Normalize()
 }
 
+// ProvideEventBusConfig 将 messaging.driver 与 messaging.event_bus 映射为事件总线配置。
+func ProvideEventBusConfig(msg MessagingConfig) eventbus.Config {
+	return eventbus.Config{
+		Driver:       msg.Driver,
+		PollInterval: msg.EventBus.PollInterval,
+		BatchSize:    int32(msg.EventBus.BatchSize),
+		RetryDelay:   msg.EventBus.RetryDelay,
+		Retention:    msg.EventBus.Retention,
+	}.Normalize()
+}
+
 // ProvidePubSubDependencies 注入 Pub/Sub 依赖。
 func ProvidePubSubDependencies(logger log.Logger) gcpubsub.Dependencies {
 	return gcpubsub.Dependencies{Logger: logger}
@@ -260,18 +399,7 @@ func ProvideOutboxConfig(msg MessagingConfig) outboxcfg.Config {
 		},
 	}
 
-	defaultInbox := InboxConfig{}
-	if msg.Inboxes != nil {
-		if inbox, ok := msg.Inboxes["default"]; ok {
-			defaultInbox = inbox
-		} else {
-			for _, inbox := range msg.Inboxes {
-				defaultInbox = inbox
-				break
-			}
-		}
-	}
-
+	defaultInbox := defaultInboxConfig(msg)
 	cfg.Inbox = outboxcfg.InboxConfig{
 		SourceService:  defaultInbox.SourceService,
 		MaxConcurrency: defaultInbox.MaxConcurrency,
@@ -286,6 +414,33 @@ func ProvideOutboxConfig(msg MessagingConfig) outboxcfg.Config {
 	return cfg
 }
 
+// ProvideInboxQuarantinePolicy 返回默认 Inbox 消费者的失败隔离策略。
+func ProvideInboxQuarantinePolicy(msg MessagingConfig) cataloginbox.QuarantinePolicy {
+	return cataloginbox.QuarantinePolicy{MaxAttempts: defaultInboxConfig(msg).MaxAttempts}
+}
+
+// ProvideOutboxRetentionPolicy 将 messaging.outbox.retention 映射为已发布事件的保留期策略。
+func ProvideOutboxRetentionPolicy(msg MessagingConfig) outboxtasks.RetentionPolicy {
+	retention := msg.Outbox.Retention
+	return outboxtasks.RetentionPolicy{
+		MaxAge:    retention.MaxAge,
+		Mode:      retention.Mode,
+		Interval:  retention.Interval,
+		BatchSize: int32(retention.BatchSize),
+	}
+}
+
+// defaultInboxConfig 选取 inboxes.default，未配置时取任意一个消费者。
+func defaultInboxConfig(msg MessagingConfig) InboxConfig {
+	if inbox, ok := msg.Inboxes["default"]; ok {
+		return inbox
+	}
+	for _, inbox := range msg.Inboxes {
+		return inbox
+	}
+	return InboxConfig{}
+}
+
 func boolPtr(v bool) *bool {
 	return &v
 }
@@ -0,0 +1,220 @@
+// Package eventbus 为 Outbox 发布与 Inbox 消费提供与传输无关的发布/订阅抽象。
+// 驱动由 messaging.driver 选择：gcp 使用 GCP Pub/Sub；memory 为进程内总线，适用于单进程开发与单元测试；
+// postgres 基于 profile.eventbus_messages 与 LISTEN/NOTIFY，仅依赖业务库即可离线运行。
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/bionicotaku/lingo-utils/gcpubsub"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// 支持的驱动名称，对应 messaging.driver。
+const (
+	DriverGCP      = "gcp"
+	DriverMemory   = "memory"
+	DriverPostgres = "postgres"
+)
+
+// 默认参数，仅作用于 memory / postgres 驱动。
+const (
+	DefaultPollInterval = time.Second
+	DefaultBatchSize    = int32(100)
+	DefaultRetryDelay   = time.Second
+	DefaultRetention    = 24 * time.Hour
+)
+
+// Message 为总线上传递的消息，与 gcpubsub.Message 同一类型，可直接交给共享 Outbox/Inbox Runner。
+type Message = gcpubsub.Message
+
+// Publisher 发布消息并返回消息 ID，方法集与 gcpubsub.Publisher 一致。
+type Publisher interface {
+	Publish(ctx context.Context, msg Message) (string, error)
+}
+
+// Subscriber 阻塞消费订阅中的消息，方法集与 gcpubsub.Subscriber 一致。
+// handler 返回错误时消息会被重新投递；ctx 取消或调用 Stop 后 Receive 返回。
+type Subscriber interface {
+	Receive(ctx context.Context, handler func(context.Context, *Message) error) error
+	Stop()
+}
+
+// Config 描述事件总线驱动。topic 与订阅名沿用 messaging.topics 中的 topic_id / subscription_id。
+type Config struct {
+	// Driver 为 gcp、memory 或 postgres，空值视为 gcp。
+	Driver string
+	// PollInterval 为 postgres 驱动未收到通知时的兜底轮询间隔，亦是备用消费者争抢订阅锁的间隔。
+	PollInterval time.Duration
+	// BatchSize 为 postgres 驱动单次读取的消息数。
+	BatchSize int32
+	// RetryDelay 为 memory / postgres 驱动在 handler 失败后重新投递同一消息前的等待时间。
+	RetryDelay time.Duration
+	// Retention 为 postgres 驱动保留已被全部订阅确认的消息的时长，<=0 时不清理。
+	Retention time.Duration
+}
+
+// Normalize 填充默认值。
+func (c Config) Normalize() Config {
+	if c.Driver == "" {
+		c.Driver = DriverGCP
+	}
+	if c.PollInterval <= 0 {
+		c.PollInterval = DefaultPollInterval
+	}
+	if c.BatchSize <= 0 {
+		c.BatchSize = DefaultBatchSize
+	}
+	if c.RetryDelay <= 0 {
+		c.RetryDelay = DefaultRetryDelay
+	}
+	return c
+}
+
+// Bus 按驱动持有默认 topic 的发布器与订阅器，并可为 Outbox 路由创建其他 topic 的发布器。
+type Bus struct {
+	cfg        Config
+	deps       gcpubsub.Dependencies
+	memory     *memoryBroker
+	pool       *pgxpool.Pool
+	repo       *repositories.EventBusRepository
+	publisher  Publisher
+	subscriber Subscriber
+	log        *log.Helper
+}
+
+// NewBus 根据驱动初始化默认 topic 的发布器与订阅器。topic 未配置 topic_id 时不创建发布器，
+// 未配置 subscription_id 时不创建订阅器；postgres 驱动要求连接池与仓储可用。
+func NewBus(
+	ctx context.Context,
+	cfg Config,
+	topic gcpubsub.Config,
+	deps gcpubsub.Dependencies,
+	pool *pgxpool.Pool,
+	repo *repositories.EventBusRepository,
+	logger log.Logger,
+) (*Bus, func(), error) {
+	cfg = cfg.Normalize()
+	bus := &Bus{
+		cfg:  cfg,
+		deps: deps,
+		pool: pool,
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+	cleanup := func() {}
+
+	switch cfg.Driver {
+	case DriverGCP:
+		component, componentCleanup, err := gcpubsub.NewComponent(ctx, topic, deps)
+		if err != nil {
+			return nil, nil, fmt.Errorf("eventbus: init gcp pubsub: %w", err)
+		}
+		if componentCleanup != nil {
+			cleanup = componentCleanup
+		}
+		if publisher := gcpubsub.ProvidePublisher(component); publisher != nil {
+			bus.publisher = publisher
+		}
+		if subscriber := gcpubsub.ProvideSubscriber(component); subscriber != nil {
+			bus.subscriber = subscriber
+		}
+	case DriverMemory:
+		bus.memory = newMemoryBroker(cfg.RetryDelay)
+		if topic.TopicID != "" {
+			bus.publisher = bus.memory.publisher(topic.TopicID)
+			if topic.SubscriptionID != "" {
+				bus.subscriber = bus.memory.subscription(topic.TopicID, topic.SubscriptionID)
+			}
+		}
+	case DriverPostgres:
+		if pool == nil || repo == nil {
+			return nil, nil, fmt.Errorf("eventbus: postgres driver requires database pool")
+		}
+		if topic.TopicID != "" {
+			bus.publisher = newPostgresPublisher(repo, topic.TopicID)
+			if topic.SubscriptionID != "" {
+				bus.subscriber = newPostgresSubscriber(pool, repo, topic.TopicID, topic.SubscriptionID, cfg, logger)
+			}
+		}
+		if cfg.Retention > 0 {
+			pruneCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				runPostgresPruner(pruneCtx, repo, cfg.Retention, bus.log)
+			}()
+			cleanup = func() {
+				cancel()
+				<-done
+			}
+		}
+	default:
+		return nil, nil, fmt.Errorf("eventbus: unsupported driver %q", cfg.Driver)
+	}
+
+	bus.log.Infof("init eventbus: driver=%s, topic=%s, subscription=%s", cfg.Driver, topic.TopicID, topic.SubscriptionID)
+	return bus, cleanup, nil
+}
+
+// Driver 返回生效的驱动名称。
+func (b *Bus) Driver() string {
+	if b == nil {
+		return ""
+	}
+	return b.cfg.Driver
+}
+
+// NewPublisher 为其他 topic 创建发布器，供 Outbox 按 event_type 路由使用。
+func (b *Bus) NewPublisher(ctx context.Context, topic gcpubsub.Config) (Publisher, func(), error) {
+	if b == nil {
+		return nil, nil, fmt.Errorf("eventbus: bus not initialized")
+	}
+	if topic.TopicID == "" {
+		return nil, nil, fmt.Errorf("eventbus: topic_id is required")
+	}
+	switch b.cfg.Driver {
+	case DriverMemory:
+		return b.memory.publisher(topic.TopicID), func() {}, nil
+	case DriverPostgres:
+		return newPostgresPublisher(b.repo, topic.TopicID), func() {}, nil
+	default:
+		component, cleanup, err := gcpubsub.NewComponent(ctx, topic, b.deps)
+		if err != nil {
+			return nil, nil, err
+		}
+		if cleanup == nil {
+			cleanup = func() {}
+		}
+		publisher := gcpubsub.ProvidePublisher(component)
+		if publisher == nil {
+			cleanup()
+			return nil, nil, fmt.Errorf("eventbus: publisher for topic %q not available", topic.TopicID)
+		}
+		return publisher, cleanup, nil
+	}
+}
+
+// ProvidePublisher 返回默认 topic 的发布器，未配置时为 nil。
+func ProvidePublisher(b *Bus) Publisher {
+	if b == nil {
+		return nil
+	}
+	return b.publisher
+}
+
+// ProvideSubscriber 返回默认订阅的订阅器，未配置时为 nil。
+func ProvideSubscriber(b *Bus) Subscriber {
+	if b == nil {
+		return nil
+	}
+	return b.subscriber
+}
+
+// ProviderSet 替代 gcpubsub.ProviderSet，按 messaging.driver 提供发布器与订阅器。
+var ProviderSet = wire.NewSet(NewBus, ProvidePublisher, ProvideSubscriber)
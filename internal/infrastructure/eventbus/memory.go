@@ -0,0 +1,165 @@
+package eventbus
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// memoryBroker 为进程内总线：每个订阅持有独立队列，发布时向 topic 的全部订阅入队。
+// 订阅在 Bus 初始化时登记，Receive 启动前发布的消息同样会被投递；进程退出后消息丢失。
+type memoryBroker struct {
+	mu         sync.Mutex
+	seq        int64
+	topics     map[string][]*memorySubscription
+	subs       map[string]*memorySubscription
+	retryDelay time.Duration
+}
+
+func newMemoryBroker(retryDelay time.Duration) *memoryBroker {
+	return &memoryBroker{
+		topics:     map[string][]*memorySubscription{},
+		subs:       map[string]*memorySubscription{},
+		retryDelay: retryDelay,
+	}
+}
+
+func (b *memoryBroker) publisher(topic string) Publisher {
+	return memoryPublisher{broker: b, topic: topic}
+}
+
+// subscription 返回指定名称的订阅，不存在时创建并挂到 topic 上。
+func (b *memoryBroker) subscription(topic, name string) *memorySubscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if sub, ok := b.subs[name]; ok {
+		return sub
+	}
+	sub := &memorySubscription{
+		notify:     make(chan struct{}, 1),
+		stop:       make(chan struct{}),
+		retryDelay: b.retryDelay,
+	}
+	b.subs[name] = sub
+	b.topics[topic] = append(b.topics[topic], sub)
+	return sub
+}
+
+func (b *memoryBroker) publish(topic string, msg Message) string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.seq++
+	msg.ID = strconv.FormatInt(b.seq, 10)
+	msg.PublishTime = time.Now().UTC()
+	for _, sub := range b.topics[topic] {
+		delivered := msg
+		delivered.Data = slices.Clone(msg.Data)
+		delivered.Attributes = maps.Clone(msg.Attributes)
+		sub.enqueue(&delivered)
+	}
+	return msg.ID
+}
+
+type memoryPublisher struct {
+	broker *memoryBroker
+	topic  string
+}
+
+func (p memoryPublisher) Publish(ctx context.Context, msg Message) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return p.broker.publish(p.topic, msg), nil
+}
+
+// memorySubscription 按发布顺序逐条投递；handler 失败时等待 retryDelay 后重投同一条消息。
+type memorySubscription struct {
+	mu         sync.Mutex
+	queue      []*Message
+	notify     chan struct{}
+	receiving  sync.Mutex
+	stop       chan struct{}
+	stopOnce   sync.Once
+	retryDelay time.Duration
+}
+
+func (s *memorySubscription) enqueue(msg *Message) {
+	s.mu.Lock()
+	s.queue = append(s.queue, msg)
+	s.mu.Unlock()
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (s *memorySubscription) head() *Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.queue) == 0 {
+		return nil
+	}
+	return s.queue[0]
+}
+
+func (s *memorySubscription) ack() {
+	s.mu.Lock()
+	s.queue[0] = nil
+	s.queue = s.queue[1:]
+	s.mu.Unlock()
+}
+
+// Receive 阻塞投递消息直至 ctx 取消或 Stop；同一订阅同一时刻只允许一个 Receive。
+func (s *memorySubscription) Receive(ctx context.Context, handler func(context.Context, *Message) error) error {
+	s.receiving.Lock()
+	defer s.receiving.Unlock()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.stop:
+			return nil
+		default:
+		}
+		msg := s.head()
+		if msg == nil {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-s.stop:
+				return nil
+			case <-s.notify:
+				continue
+			}
+		}
+		if err := handler(ctx, msg); err != nil {
+			if !wait(ctx, s.stop, s.retryDelay) {
+				return nil
+			}
+			continue
+		}
+		s.ack()
+	}
+}
+
+// Stop 终止 Receive，未确认的消息保留在队列中。
+func (s *memorySubscription) Stop() {
+	s.stopOnce.Do(func() { close(s.stop) })
+}
+
+// wait 等待 d，期间 ctx 取消或 stop 关闭时返回 false。
+func wait(ctx context.Context, stop <-chan struct{}, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-stop:
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,212 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ackTimeout 为处理成功后推进订阅游标的时间预算。
+const ackTimeout = 5 * time.Second
+
+type postgresPublisher struct {
+	repo  *repositories.EventBusRepository
+	topic string
+}
+
+func newPostgresPublisher(repo *repositories.EventBusRepository, topic string) Publisher {
+	return postgresPublisher{repo: repo, topic: topic}
+}
+
+func (p postgresPublisher) Publish(ctx context.Context, msg Message) (string, error) {
+	var eventID *uuid.UUID
+	if id := uuid.UUID(msg.EventID); id != uuid.Nil {
+		eventID = &id
+	}
+	id, _, err := p.repo.Append(ctx, repositories.AppendEventBusMessageInput{
+		Topic:       p.topic,
+		EventID:     eventID,
+		OrderingKey: msg.OrderingKey,
+		Data:        msg.Data,
+		Attributes:  msg.Attributes,
+	})
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(id, 10), nil
+}
+
+// postgresSubscriber 在独占连接上持有订阅的 advisory lock 并 LISTEN，按 id 顺序逐条投递，
+// 处理成功后推进 profile.eventbus_subscriptions 游标。未抢到锁的实例作为备用，按 PollInterval 重试。
+// handler 失败时等待 RetryDelay 后重投同一条消息，毒消息由 Inbox 隔离机制兜底。
+type postgresSubscriber struct {
+	pool         *pgxpool.Pool
+	repo         *repositories.EventBusRepository
+	topic        string
+	subscription string
+	cfg          Config
+	log          *log.Helper
+	stop         chan struct{}
+	stopOnce     sync.Once
+}
+
+func newPostgresSubscriber(pool *pgxpool.Pool, repo *repositories.EventBusRepository, topic, subscription string, cfg Config, logger log.Logger) *postgresSubscriber {
+	return &postgresSubscriber{
+		pool:         pool,
+		repo:         repo,
+		topic:        topic,
+		subscription: subscription,
+		cfg:          cfg.Normalize(),
+		log:          log.NewHelper(logger),
+		stop:         make(chan struct{}),
+	}
+}
+
+// Receive 阻塞消费直至 ctx 取消或 Stop；连接异常时按 PollInterval 重连。
+func (s *postgresSubscriber) Receive(ctx context.Context, handler func(context.Context, *Message) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-s.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		if err := s.consume(ctx, handler); err != nil && ctx.Err() == nil {
+			s.log.WithContext(ctx).Warnw("msg", "eventbus postgres subscriber failed", "subscription", s.subscription, "error", err)
+		}
+		if !wait(ctx, s.stop, s.cfg.PollInterval) {
+			return nil
+		}
+	}
+}
+
+// Stop 终止 Receive。
+func (s *postgresSubscriber) Stop() {
+	s.stopOnce.Do(func() { close(s.stop) })
+}
+
+// consume 持有订阅锁期间持续投递；未抢到锁、ctx 取消时返回 nil。
+func (s *postgresSubscriber) consume(ctx context.Context, handler func(context.Context, *Message) error) error {
+	pooled, err := s.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire listener connection: %w", err)
+	}
+	// 会话级锁与 LISTEN 绑定在连接上，脱离连接池并在退出时关闭，避免状态残留到池中。
+	conn := pooled.Hijack()
+	defer conn.Close(context.WithoutCancel(ctx))
+
+	locked, err := s.repo.Listen(ctx, conn, s.subscription)
+	if err != nil || !locked {
+		return err
+	}
+	lastID, err := s.repo.EnsureSubscription(ctx, s.subscription, s.topic)
+	if err != nil {
+		return err
+	}
+
+	for {
+		messages, err := s.repo.ListAfter(ctx, s.topic, lastID, s.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+		for _, message := range messages {
+			if !s.deliver(ctx, handler, message) {
+				return nil
+			}
+			if err := s.ack(ctx, message.ID); err != nil {
+				return err
+			}
+			lastID = message.ID
+		}
+		if int32(len(messages)) == s.cfg.BatchSize {
+			continue
+		}
+
+		waitCtx, cancel := context.WithTimeout(ctx, s.cfg.PollInterval)
+		_, err = conn.WaitForNotification(waitCtx)
+		cancel()
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil && !errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("wait for notification: %w", err)
+		}
+	}
+}
+
+// deliver 反复调用 handler 直至成功；ctx 取消或 Stop 时返回 false。
+func (s *postgresSubscriber) deliver(ctx context.Context, handler func(context.Context, *Message) error, message *po.EventBusMessage) bool {
+	msg := toMessage(message)
+	for {
+		err := handler(ctx, msg)
+		if err == nil {
+			return true
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+		s.log.WithContext(ctx).Warnw("msg", "eventbus postgres handler failed, redelivering",
+			"subscription", s.subscription, "message_id", msg.ID, "error", err)
+		if !wait(ctx, s.stop, s.cfg.RetryDelay) {
+			return false
+		}
+	}
+}
+
+// ack 推进游标；handler 已成功时即便 ctx 已取消也要落盘，避免停机后重投。
+func (s *postgresSubscriber) ack(ctx context.Context, id int64) error {
+	ackCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), ackTimeout)
+	defer cancel()
+	return s.repo.Advance(ackCtx, s.subscription, id)
+}
+
+func toMessage(message *po.EventBusMessage) *Message {
+	msg := &Message{
+		ID:          strconv.FormatInt(message.ID, 10),
+		Data:        message.Data,
+		Attributes:  message.Attributes,
+		OrderingKey: message.OrderingKey,
+		PublishTime: message.PublishedAt,
+	}
+	if message.EventID != nil {
+		msg.EventID = *message.EventID
+	}
+	return msg
+}
+
+// runPostgresPruner 每隔 retention/24（至少 1 分钟）清理超过保留期且已被全部订阅确认的消息。
+func runPostgresPruner(ctx context.Context, repo *repositories.EventBusRepository, retention time.Duration, logger *log.Helper) {
+	interval := max(retention/24, time.Minute)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			deleted, err := repo.Prune(ctx, now.Add(-retention))
+			if err != nil {
+				if ctx.Err() == nil {
+					logger.WithContext(ctx).Warnw("msg", "eventbus prune failed", "error", err)
+				}
+				continue
+			}
+			if deleted > 0 {
+				logger.WithContext(ctx).Debugw("msg", "eventbus pruned messages", "deleted", deleted)
+			}
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package eventbus_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/eventbus"
+	"github.com/bionicotaku/lingo-utils/gcpubsub"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/require"
+)
+
+func newMemoryBus(t *testing.T, topic gcpubsub.Config) *eventbus.Bus {
+	t.Helper()
+	bus, cleanup, err := eventbus.NewBus(context.Background(),
+		eventbus.Config{Driver: eventbus.DriverMemory, RetryDelay: time.Millisecond},
+		topic, gcpubsub.Dependencies{}, nil, nil, log.NewStdLogger(io.Discard))
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+	return bus
+}
+
+// receiveN 启动 Receive 并在收到 n 次投递（含重投）后停止，返回投递记录。
+func receiveN(t *testing.T, sub eventbus.Subscriber, n int, handler func(*eventbus.Message) error) []*eventbus.Message {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var (
+		mu        sync.Mutex
+		delivered []*eventbus.Message
+	)
+	err := sub.Receive(ctx, func(_ context.Context, msg *eventbus.Message) error {
+		mu.Lock()
+		delivered = append(delivered, msg)
+		done := len(delivered) == n
+		mu.Unlock()
+		err := handler(msg)
+		if done {
+			sub.Stop()
+		}
+		return err
+	})
+	require.NoError(t, err)
+	require.NoError(t, ctx.Err(), "receive timed out")
+	return delivered
+}
+
+func TestMemoryBus_DeliversInOrderAndRedeliversOnError(t *testing.T) {
+	t.Parallel()
+
+	bus := newMemoryBus(t, gcpubsub.Config{TopicID: "profile.engagement.events", SubscriptionID: "profile.engagement.events.test"})
+	publisher := eventbus.ProvidePublisher(bus)
+	subscriber := eventbus.ProvideSubscriber(bus)
+	require.NotNil(t, publisher)
+	require.NotNil(t, subscriber)
+
+	ctx := context.Background()
+	for _, payload := range []string{"first", "second", "third"} {
+		id, err := publisher.Publish(ctx, eventbus.Message{Data: []byte(payload), Attributes: map[string]string{"event_type": payload}})
+		require.NoError(t, err)
+		require.NotEmpty(t, id)
+	}
+
+	failed := false
+	delivered := receiveN(t, subscriber, 4, func(msg *eventbus.Message) error {
+		if string(msg.Data) == "second" && !failed {
+			failed = true
+			return errors.New("transient")
+		}
+		return nil
+	})
+
+	var payloads []string
+	for _, msg := range delivered {
+		payloads = append(payloads, string(msg.Data))
+		require.False(t, msg.PublishTime.IsZero())
+	}
+	require.Equal(t, []string{"first", "second", "second", "third"}, payloads)
+	require.Equal(t, "third", delivered[3].Attributes["event_type"])
+}
+
+func TestMemoryBus_RoutedTopicPublisher(t *testing.T) {
+	t.Parallel()
+
+	bus := newMemoryBus(t, gcpubsub.Config{TopicID: "profile.engagement.events"})
+	require.Nil(t, eventbus.ProvideSubscriber(bus), "subscriber requires subscription_id")
+
+	publisher, cleanup, err := bus.NewPublisher(context.Background(), gcpubsub.Config{TopicID: "profile.watch.events"})
+	require.NoError(t, err)
+	defer cleanup()
+	_, err = publisher.Publish(context.Background(), eventbus.Message{Data: []byte("progress")})
+	require.NoError(t, err)
+
+	_, _, err = bus.NewPublisher(context.Background(), gcpubsub.Config{})
+	require.Error(t, err)
+}
+
+func TestNewBus_RejectsInvalidDriver(t *testing.T) {
+	t.Parallel()
+
+	logger := log.NewStdLogger(io.Discard)
+	_, _, err := eventbus.NewBus(context.Background(), eventbus.Config{Driver: "kafka"}, gcpubsub.Config{}, gcpubsub.Dependencies{}, nil, nil, logger)
+	require.ErrorContains(t, err, "unsupported driver")
+
+	_, _, err = eventbus.NewBus(context.Background(), eventbus.Config{Driver: eventbus.DriverPostgres}, gcpubsub.Config{}, gcpubsub.Dependencies{}, nil, nil, logger)
+	require.ErrorContains(t, err, "requires database pool")
+}
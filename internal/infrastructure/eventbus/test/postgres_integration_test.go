@@ -0,0 +1,157 @@
+package eventbus_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/eventbus"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/bionicotaku/lingo-utils/gcpubsub"
+	"github.com/docker/go-connections/nat"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+func TestPostgresBus_PublishAndReceive(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dsn, terminate := startPostgres(ctx, t)
+	defer terminate()
+
+	pool, err := pgxpool.New(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { pool.Close() })
+	applyMigrations(ctx, t, pool)
+
+	logger := log.NewStdLogger(io.Discard)
+	repo := repositories.NewEventBusRepository(pool, logger)
+	cfg := eventbus.Config{Driver: eventbus.DriverPostgres, PollInterval: 100 * time.Millisecond, RetryDelay: time.Millisecond}
+	topic := gcpubsub.Config{TopicID: "profile.engagement.events", SubscriptionID: "profile.engagement.events.test"}
+	bus, cleanup, err := eventbus.NewBus(ctx, cfg, topic, gcpubsub.Dependencies{}, pool, repo, logger)
+	require.NoError(t, err)
+	defer cleanup()
+
+	publisher := eventbus.ProvidePublisher(bus)
+	subscriber := eventbus.ProvideSubscriber(bus)
+
+	// 订阅登记之前的消息不回放：先让订阅定位到末尾。
+	_, err = publisher.Publish(ctx, eventbus.Message{Data: []byte("before-subscribe")})
+	require.NoError(t, err)
+	_, err = repo.EnsureSubscription(ctx, topic.SubscriptionID, topic.TopicID)
+	require.NoError(t, err)
+
+	eventID := uuid.New()
+	for i, payload := range []string{"first", "second", "third"} {
+		msg := eventbus.Message{Data: []byte(payload), Attributes: map[string]string{"event_type": payload}, OrderingKey: "video"}
+		if i == 0 {
+			msg.EventID = eventID
+		}
+		_, err := publisher.Publish(ctx, msg)
+		require.NoError(t, err)
+	}
+
+	failed := false
+	delivered := receiveN(t, subscriber, 4, func(msg *eventbus.Message) error {
+		if string(msg.Data) == "second" && !failed {
+			failed = true
+			return errors.New("transient")
+		}
+		return nil
+	})
+	var payloads []string
+	for _, msg := range delivered {
+		payloads = append(payloads, string(msg.Data))
+	}
+	require.Equal(t, []string{"first", "second", "second", "third"}, payloads)
+	require.Equal(t, [16]byte(eventID), delivered[0].EventID)
+	require.Equal(t, "video", delivered[0].OrderingKey)
+	require.Equal(t, "first", delivered[0].Attributes["event_type"])
+
+	// 游标已推进到 third，重新订阅只收到新消息。
+	_, err = publisher.Publish(ctx, eventbus.Message{Data: []byte("fourth")})
+	require.NoError(t, err)
+	resumed, cleanupResumed, err := eventbus.NewBus(ctx, cfg, topic, gcpubsub.Dependencies{}, pool, repo, logger)
+	require.NoError(t, err)
+	defer cleanupResumed()
+	delivered = receiveN(t, eventbus.ProvideSubscriber(resumed), 1, func(*eventbus.Message) error { return nil })
+	require.Equal(t, "fourth", string(delivered[0].Data))
+
+	pruned, err := repo.Prune(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.EqualValues(t, 5, pruned)
+}
+
+func startPostgres(ctx context.Context, t *testing.T) (string, func()) {
+	t.Helper()
+
+	req := testcontainers.ContainerRequest{
+		Image:        "postgres:16-alpine",
+		ExposedPorts: []string{"5432/tcp"},
+		Env: map[string]string{
+			"POSTGRES_PASSWORD": "postgres",
+			"POSTGRES_USER":     "postgres",
+			"POSTGRES_DB":       "profile",
+		},
+		WaitingFor: wait.ForSQL("5432/tcp", "postgres", func(host string, port nat.Port) string {
+			return fmt.Sprintf("postgres://postgres:postgres@%s:%s/profile?sslmode=disable", host, port.Port())
+		}).WithStartupTimeout(60 * time.Second),
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		t.Skipf("skip eventbus integration tests: cannot start postgres container: %v", err)
+	}
+
+	host, err := container.Host(ctx)
+	require.NoError(t, err)
+
+	port, err := container.MappedPort(ctx, "5432")
+	require.NoError(t, err)
+
+	dsn := fmt.Sprintf("postgres://postgres:postgres@%s:%s/profile?sslmode=disable", host, port.Port())
+	cleanup := func() {
+		termCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = container.Terminate(termCtx)
+	}
+	return dsn, cleanup
+}
+
+func applyMigrations(ctx context.Context, t *testing.T, pool *pgxpool.Pool) {
+	t.Helper()
+
+	migrationsDir := filepath.Join("..", "..", "..", "..", "migrations")
+	files, err := os.ReadDir(migrationsDir)
+	require.NoError(t, err)
+
+	var paths []string
+	for _, f := range files {
+		if f.IsDir() || filepath.Ext(f.Name()) != ".sql" {
+			continue
+		}
+		paths = append(paths, filepath.Join(migrationsDir, f.Name()))
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		sqlBytes, readErr := os.ReadFile(path)
+		require.NoError(t, readErr)
+		_, execErr := pool.Exec(ctx, string(sqlBytes))
+		require.NoErrorf(t, execErr, "apply migration %s", filepath.Base(path))
+	}
+}
@@ -6,39 +6,35 @@ import (
 	profilev1 "github.com/bionicotaku/lingo-services-profile/api/profile/v1"
 	"github.com/bionicotaku/lingo-services-profile/internal/controllers"
 	configloader "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/configloader"
+	healthcheck "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/health_check"
+	ratelimiter "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/rate_limiter"
+	servermiddleware "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/server_middleware"
 
 	"github.com/bionicotaku/lingo-utils/gcjwt"
 	"github.com/bionicotaku/lingo-utils/observability"
-	obsTrace "github.com/bionicotaku/lingo-utils/observability/tracing"
-	pvmw "github.com/go-kratos-ecosystem/components/v2/middleware/protovalidate"
+	"github.com/bufbuild/protovalidate-go"
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/go-kratos/kratos/v2/middleware"
-	"github.com/go-kratos/kratos/v2/middleware/logging"
-	"github.com/go-kratos/kratos/v2/middleware/metadata"
-	"github.com/go-kratos/kratos/v2/middleware/ratelimit"
-	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	otelgrpcfilters "go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc/filters"
 	"go.opentelemetry.io/otel"
 	stdgrpc "google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/stats"
 )
 
 // NewGRPCServer 构造配置完整的 Kratos gRPC Server 实例。
 //
-// 中间件链（按执行顺序）：
-// 1. obsTrace.Server() - OpenTelemetry 追踪，自动创建 Span
-// 2. recovery.Recovery() - Panic 恢复，防止服务崩溃
-// 3. metadata.Server() - 元数据传播，转发 x-template- 前缀的 header
-// 4. ratelimit.Server() - 限流保护
-// 5. pvmw.Server() - protovalidate 运行时参数校验（基于反射，无需代码生成）
-// 6. logging.Server() - 结构化日志记录（含 trace_id/span_id）
+// 中间件链与 HTTP Server 共用，详见 servermiddleware.Chain：
+// 追踪 → 恢复 → metadata 传播 → JWT（可选）→ 限流 → protovalidate → 日志。
 //
 // 可选指标采集：
 // - 根据 metricsCfg.GRPCEnabled 决定是否启用 otelgrpc.StatsHandler
 // - 可通过 metricsCfg.GRPCIncludeHealth 控制是否采集健康检查指标
-func NewGRPCServer(cfg configloader.ServerConfig, metricsCfg *observability.MetricsConfig, jwt gcjwt.ServerMiddleware, profile *controllers.ProfileHandler, logger log.Logger) *grpc.Server {
+//
+// 健康检查：
+// - monitor 非空时替换 Kratos 内置的常驻 SERVING 实现，状态由 healthcheck.Monitor 按探测结果维护
+func NewGRPCServer(cfg configloader.ServerConfig, metricsCfg *observability.MetricsConfig, jwt gcjwt.ServerMiddleware, validator *protovalidate.Validator, limiter *ratelimiter.Limiter, profile *controllers.ProfileHandler, monitor *healthcheck.Monitor, logger log.Logger) *grpc.Server {
 	// metricsCfg 为可选参数，默认启用指标采集以保持向后兼容。
 	// 调用方可通过配置显式控制指标行为。
 	metricsEnabled := true
@@ -48,22 +44,7 @@ func NewGRPCServer(cfg configloader.ServerConfig, metricsCfg *observability.Metr
 		includeHealth = metricsCfg.GRPCIncludeHealth
 	}
 
-	// 构造基础中间件链：追踪、panic 恢复与 metadata 传播。
-	mws := []middleware.Middleware{
-		obsTrace.Server(),
-		recovery.Recovery(),
-		metadata.Server(metadata.WithPropagatedPrefix(cfg.MetadataKeys...)),
-	}
-	// 根据配置决定是否挂载 JWT 校验，默认置于限流之前。
-	if jwt != nil {
-		mws = append(mws, middleware.Middleware(jwt))
-	}
-	// 其余中间件保持原有顺序，保护限流、参数校验与结构化日志逻辑。
-	mws = append(mws,
-		ratelimit.Server(),
-		pvmw.Server(), // protovalidate 运行时验证（无需代码生成）
-		logging.Server(logger),
-	)
+	mws := servermiddleware.Chain(cfg, jwt, validator, limiter, logger)
 
 	opts := []grpc.ServerOption{
 		grpc.Middleware(mws...),
@@ -81,10 +62,16 @@ func NewGRPCServer(cfg configloader.ServerConfig, metricsCfg *observability.Metr
 	if cfg.Timeout > 0 {
 		opts = append(opts, grpc.Timeout(cfg.Timeout))
 	}
+	if monitor != nil {
+		opts = append(opts, grpc.CustomHealth())
+	}
 	srv := grpc.NewServer(opts...)
 	if profile != nil {
 		profilev1.RegisterProfileServiceServer(srv, profile)
 	}
+	if monitor != nil {
+		healthpb.RegisterHealthServer(srv, monitor.Server())
+	}
 	return srv
 }
 
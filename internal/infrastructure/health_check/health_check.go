@@ -0,0 +1,246 @@
+// Package healthcheck 实现 grpc.health.v1.Health 服务的状态探测。
+//
+// 状态约定：
+//   - 服务名 ""（整体/存活探针）：仅当 pgx 连接池可 Ping 通时为 SERVING。
+//   - 服务名 "profile.v1.ProfileService"（就绪探针）：在数据库可用的基础上，
+//     Outbox 积压或 Catalog Inbox 滞后超过阈值时降级为 NOT_SERVING，摘除流量但不触发重启。
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	profilev1 "github.com/bionicotaku/lingo-services-profile/api/profile/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// ProfileServiceName 为就绪探针使用的服务名。
+var ProfileServiceName = profilev1.ProfileService_ServiceDesc.ServiceName
+
+// ErrDatabaseUnavailable 表示数据库探测失败。
+var ErrDatabaseUnavailable = errors.New("healthcheck: database unavailable")
+
+const (
+	defaultCheckInterval = 10 * time.Second
+	defaultProbeTimeout  = 2 * time.Second
+)
+
+// Config 描述探测周期与就绪阈值，阈值为 0 表示不检查对应指标。
+type Config struct {
+	CheckInterval          time.Duration
+	ProbeTimeout           time.Duration
+	OutboxPendingThreshold int64
+	InboxLagThreshold      time.Duration
+	InboxSourceService     string
+}
+
+// DatabasePinger 抽象数据库连通性探测，由 *pgxpool.Pool 实现。
+type DatabasePinger interface {
+	Ping(ctx context.Context) error
+}
+
+// OutboxBacklog 提供 Outbox 未发布事件数量。
+type OutboxBacklog interface {
+	CountPending(ctx context.Context) (int64, error)
+}
+
+// InboxBacklog 提供 Inbox 最早未处理事件的接收时间。
+type InboxBacklog interface {
+	OldestPendingReceivedAt(ctx context.Context, sourceService string) (*time.Time, error)
+}
+
+// Report 记录一次探测的结果。
+type Report struct {
+	CheckedAt     time.Time
+	DatabaseErr   error
+	OutboxPending int64
+	InboxLag      time.Duration
+	Degraded      []string
+}
+
+// Live 表示数据库可用。
+func (r Report) Live() bool {
+	return r.DatabaseErr == nil
+}
+
+// Ready 表示数据库可用且未触发任何降级条件。
+func (r Report) Ready() bool {
+	return r.Live() && len(r.Degraded) == 0
+}
+
+// Monitor 周期性执行探测，并将结果同步到 grpc health Server。
+type Monitor struct {
+	cfg    Config
+	db     DatabasePinger
+	outbox OutboxBacklog
+	inbox  InboxBacklog
+	server *health.Server
+	log    *log.Helper
+	now    func() time.Time
+
+	mu   sync.RWMutex
+	last Report
+}
+
+// NewMonitor 构造健康检查监视器。首次探测完成前所有服务均为 NOT_SERVING。
+func NewMonitor(cfg Config, db DatabasePinger, outbox OutboxBacklog, inbox InboxBacklog, logger log.Logger) *Monitor {
+	if cfg.CheckInterval <= 0 {
+		cfg.CheckInterval = defaultCheckInterval
+	}
+	if cfg.ProbeTimeout <= 0 {
+		cfg.ProbeTimeout = defaultProbeTimeout
+	}
+	server := health.NewServer()
+	server.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	server.SetServingStatus(ProfileServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+	return &Monitor{
+		cfg:    cfg,
+		db:     db,
+		outbox: outbox,
+		inbox:  inbox,
+		server: server,
+		log:    log.NewHelper(logger),
+		now:    time.Now,
+	}
+}
+
+// WithClock 提供测试替换时间。
+func (m *Monitor) WithClock(fn func() time.Time) {
+	if m == nil || fn == nil {
+		return
+	}
+	m.now = fn
+}
+
+// Server 返回需要注册到 gRPC Server 的 Health 实现。
+func (m *Monitor) Server() healthpb.HealthServer {
+	return m.server
+}
+
+// Last 返回最近一次探测结果。
+func (m *Monitor) Last() Report {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.last
+}
+
+// Check 立即执行一次探测并更新各服务状态。
+func (m *Monitor) Check(ctx context.Context) Report {
+	report := Report{CheckedAt: m.now().UTC()}
+	report.DatabaseErr = m.pingDatabase(ctx)
+	if report.DatabaseErr == nil {
+		m.probeOutbox(ctx, &report)
+		m.probeInbox(ctx, &report)
+	}
+	m.apply(ctx, report)
+	return report
+}
+
+// Run 按配置周期执行探测，直到 ctx 取消；退出时将所有服务置为 NOT_SERVING。
+func (m *Monitor) Run(ctx context.Context) error {
+	defer m.server.Shutdown()
+
+	m.Check(ctx)
+	ticker := time.NewTicker(m.cfg.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			m.Check(ctx)
+		}
+	}
+}
+
+func (m *Monitor) pingDatabase(ctx context.Context) error {
+	if m.db == nil {
+		return fmt.Errorf("%w: pinger not configured", ErrDatabaseUnavailable)
+	}
+	probeCtx, cancel := context.WithTimeout(ctx, m.cfg.ProbeTimeout)
+	defer cancel()
+	if err := m.db.Ping(probeCtx); err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseUnavailable, err)
+	}
+	return nil
+}
+
+func (m *Monitor) probeOutbox(ctx context.Context, report *Report) {
+	if m.outbox == nil || m.cfg.OutboxPendingThreshold <= 0 {
+		return
+	}
+	probeCtx, cancel := context.WithTimeout(ctx, m.cfg.ProbeTimeout)
+	defer cancel()
+	pending, err := m.outbox.CountPending(probeCtx)
+	if err != nil {
+		report.Degraded = append(report.Degraded, fmt.Sprintf("outbox probe failed: %v", err))
+		return
+	}
+	report.OutboxPending = pending
+	if pending > m.cfg.OutboxPendingThreshold {
+		report.Degraded = append(report.Degraded,
+			fmt.Sprintf("outbox pending %d exceeds threshold %d", pending, m.cfg.OutboxPendingThreshold))
+	}
+}
+
+func (m *Monitor) probeInbox(ctx context.Context, report *Report) {
+	if m.inbox == nil || m.cfg.InboxLagThreshold <= 0 {
+		return
+	}
+	probeCtx, cancel := context.WithTimeout(ctx, m.cfg.ProbeTimeout)
+	defer cancel()
+	oldest, err := m.inbox.OldestPendingReceivedAt(probeCtx, m.cfg.InboxSourceService)
+	if err != nil {
+		report.Degraded = append(report.Degraded, fmt.Sprintf("inbox probe failed: %v", err))
+		return
+	}
+	if oldest == nil {
+		return
+	}
+	if lag := report.CheckedAt.Sub(*oldest); lag > 0 {
+		report.InboxLag = lag
+	}
+	if report.InboxLag > m.cfg.InboxLagThreshold {
+		report.Degraded = append(report.Degraded,
+			fmt.Sprintf("inbox lag %s exceeds threshold %s", report.InboxLag.Truncate(time.Second), m.cfg.InboxLagThreshold))
+	}
+}
+
+func (m *Monitor) apply(ctx context.Context, report Report) {
+	overall := healthpb.HealthCheckResponse_NOT_SERVING
+	if report.Live() {
+		overall = healthpb.HealthCheckResponse_SERVING
+	}
+	ready := healthpb.HealthCheckResponse_NOT_SERVING
+	if report.Ready() {
+		ready = healthpb.HealthCheckResponse_SERVING
+	}
+
+	m.mu.Lock()
+	previous := m.last
+	m.last = report
+	m.mu.Unlock()
+
+	m.server.SetServingStatus("", overall)
+	m.server.SetServingStatus(ProfileServiceName, ready)
+
+	if previous.CheckedAt.IsZero() || previous.Ready() != report.Ready() || previous.Live() != report.Live() {
+		helper := m.log.WithContext(ctx)
+		switch {
+		case !report.Live():
+			helper.Warnw("msg", "health: database unavailable", "error", report.DatabaseErr)
+		case !report.Ready():
+			helper.Warnw("msg", "health: readiness degraded", "reasons", report.Degraded,
+				"outbox_pending", report.OutboxPending, "inbox_lag", report.InboxLag.String())
+		default:
+			helper.Infow("msg", "health: serving", "outbox_pending", report.OutboxPending,
+				"inbox_lag", report.InboxLag.String())
+		}
+	}
+}
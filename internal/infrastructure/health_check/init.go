@@ -0,0 +1,6 @@
+package healthcheck
+
+import "github.com/google/wire"
+
+// ProviderSet 暴露健康检查监视器的构造函数供 Wire 依赖注入使用。
+var ProviderSet = wire.NewSet(NewMonitor)
@@ -0,0 +1,160 @@
+package healthcheck_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	healthcheck "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/health_check"
+
+	"github.com/go-kratos/kratos/v2/log"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type fakePinger struct{ err error }
+
+func (f *fakePinger) Ping(context.Context) error { return f.err }
+
+type fakeOutbox struct {
+	pending int64
+	err     error
+}
+
+func (f *fakeOutbox) CountPending(context.Context) (int64, error) { return f.pending, f.err }
+
+type fakeInbox struct {
+	oldest *time.Time
+	source string
+}
+
+func (f *fakeInbox) OldestPendingReceivedAt(_ context.Context, source string) (*time.Time, error) {
+	f.source = source
+	return f.oldest, nil
+}
+
+func statusOf(t *testing.T, monitor *healthcheck.Monitor, service string) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+	resp, err := monitor.Server().Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		t.Fatalf("health check %q: %v", service, err)
+	}
+	return resp.GetStatus()
+}
+
+func TestMonitor_NotServingBeforeFirstCheck(t *testing.T) {
+	monitor := healthcheck.NewMonitor(healthcheck.Config{}, &fakePinger{}, nil, nil, log.DefaultLogger)
+
+	if got := statusOf(t, monitor, ""); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("overall status = %v, want NOT_SERVING", got)
+	}
+	if got := statusOf(t, monitor, healthcheck.ProfileServiceName); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("profile status = %v, want NOT_SERVING", got)
+	}
+}
+
+func TestMonitor_ServingWhenDatabaseReachable(t *testing.T) {
+	monitor := healthcheck.NewMonitor(healthcheck.Config{OutboxPendingThreshold: 10}, &fakePinger{}, &fakeOutbox{pending: 3}, nil, log.DefaultLogger)
+
+	report := monitor.Check(context.Background())
+	if !report.Ready() || report.OutboxPending != 3 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	if got := statusOf(t, monitor, ""); got != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("overall status = %v, want SERVING", got)
+	}
+	if got := statusOf(t, monitor, healthcheck.ProfileServiceName); got != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("profile status = %v, want SERVING", got)
+	}
+}
+
+func TestMonitor_DatabaseUnavailable(t *testing.T) {
+	pinger := &fakePinger{err: errors.New("connection refused")}
+	monitor := healthcheck.NewMonitor(healthcheck.Config{}, pinger, nil, nil, log.DefaultLogger)
+
+	report := monitor.Check(context.Background())
+	if !errors.Is(report.DatabaseErr, healthcheck.ErrDatabaseUnavailable) {
+		t.Fatalf("expected ErrDatabaseUnavailable, got %v", report.DatabaseErr)
+	}
+	if got := statusOf(t, monitor, ""); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("overall status = %v, want NOT_SERVING", got)
+	}
+
+	pinger.err = nil
+	monitor.Check(context.Background())
+	if got := statusOf(t, monitor, ""); got != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("overall status after recovery = %v, want SERVING", got)
+	}
+}
+
+func TestMonitor_DegradesOnOutboxBacklog(t *testing.T) {
+	monitor := healthcheck.NewMonitor(healthcheck.Config{OutboxPendingThreshold: 100}, &fakePinger{}, &fakeOutbox{pending: 101}, nil, log.DefaultLogger)
+
+	report := monitor.Check(context.Background())
+	if report.Ready() || len(report.Degraded) != 1 {
+		t.Fatalf("expected degraded report, got %+v", report)
+	}
+	if got := statusOf(t, monitor, ""); got != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("overall status = %v, want SERVING", got)
+	}
+	if got := statusOf(t, monitor, healthcheck.ProfileServiceName); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("profile status = %v, want NOT_SERVING", got)
+	}
+}
+
+func TestMonitor_DegradesOnOutboxProbeError(t *testing.T) {
+	monitor := healthcheck.NewMonitor(healthcheck.Config{OutboxPendingThreshold: 100}, &fakePinger{}, &fakeOutbox{err: errors.New("timeout")}, nil, log.DefaultLogger)
+
+	if report := monitor.Check(context.Background()); report.Ready() {
+		t.Fatalf("expected probe error to degrade readiness: %+v", report)
+	}
+}
+
+func TestMonitor_DegradesOnInboxLag(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	oldest := now.Add(-5 * time.Minute)
+	inbox := &fakeInbox{oldest: &oldest}
+	cfg := healthcheck.Config{InboxLagThreshold: time.Minute, InboxSourceService: "catalog"}
+	monitor := healthcheck.NewMonitor(cfg, &fakePinger{}, nil, inbox, log.DefaultLogger)
+	monitor.WithClock(func() time.Time { return now })
+
+	report := monitor.Check(context.Background())
+	if report.InboxLag != 5*time.Minute {
+		t.Fatalf("inbox lag = %s, want 5m", report.InboxLag)
+	}
+	if inbox.source != "catalog" {
+		t.Fatalf("inbox probe source = %q, want catalog", inbox.source)
+	}
+	if got := statusOf(t, monitor, healthcheck.ProfileServiceName); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("profile status = %v, want NOT_SERVING", got)
+	}
+
+	inbox.oldest = nil
+	monitor.Check(context.Background())
+	if got := statusOf(t, monitor, healthcheck.ProfileServiceName); got != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("profile status after drain = %v, want SERVING", got)
+	}
+}
+
+func TestMonitor_RunShutsDownOnCancel(t *testing.T) {
+	monitor := healthcheck.NewMonitor(healthcheck.Config{CheckInterval: time.Hour}, &fakePinger{}, nil, nil, log.DefaultLogger)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- monitor.Run(ctx) }()
+
+	deadline := time.Now().Add(time.Second)
+	for monitor.Last().CheckedAt.IsZero() {
+		if time.Now().After(deadline) {
+			t.Fatal("monitor did not run initial check")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("run returned %v, want context.Canceled", err)
+	}
+	if got := statusOf(t, monitor, ""); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("overall status after shutdown = %v, want NOT_SERVING", got)
+	}
+}
@@ -0,0 +1,81 @@
+// Package httpserver 负责装配入站 HTTP/JSON Server。
+// 路由由 google.api.http 注解生成，与 gRPC Server 共用同一套中间件与 Handler。
+package httpserver
+
+import (
+	nethttp "net/http"
+	"net/url"
+	"strings"
+
+	profilev1 "github.com/bionicotaku/lingo-services-profile/api/profile/v1"
+	"github.com/bionicotaku/lingo-services-profile/internal/controllers"
+	configloader "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/configloader"
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/objectstore"
+	ratelimiter "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/rate_limiter"
+	servermiddleware "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/server_middleware"
+
+	"github.com/bionicotaku/lingo-utils/gcjwt"
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+const (
+	defaultUploadPrefix = "/uploads"
+	defaultPublicPrefix = "/avatars"
+)
+
+// publicObjectServer 由支持直接读取对象的存储实现（如 LocalStore）提供。
+type publicObjectServer interface {
+	PublicHandler() nethttp.Handler
+}
+
+// NewHTTPServer 构造 Kratos HTTP Server；cfg.HTTP.Address 为空时返回 nil，表示不启用 HTTP 网关。
+//
+// 除 ProfileService 的 REST 路由外，若头像存储实现了 net/http.Handler（如 LocalStore），
+// 会按 upload_base_url 的路径（默认 /uploads）挂载签名直传端点，供本地开发直接 PUT 文件；
+// 若实现了 PublicHandler，再按 public_base_url 的路径（默认 /avatars）挂载只读访问端点。
+func NewHTTPServer(cfg configloader.ServerConfig, jwt gcjwt.ServerMiddleware, validator *protovalidate.Validator, limiter *ratelimiter.Limiter, profile *controllers.ProfileHandler, store objectstore.Store, storeCfg objectstore.Config, logger log.Logger) *http.Server {
+	if strings.TrimSpace(cfg.HTTP.Address) == "" {
+		return nil
+	}
+
+	opts := []http.ServerOption{
+		http.Middleware(servermiddleware.Chain(cfg, jwt, validator, limiter, logger)...),
+		http.Address(cfg.HTTP.Address),
+	}
+	if cfg.HTTP.Network != "" {
+		opts = append(opts, http.Network(cfg.HTTP.Network))
+	}
+	if cfg.HTTP.Timeout > 0 {
+		opts = append(opts, http.Timeout(cfg.HTTP.Timeout))
+	}
+	srv := http.NewServer(opts...)
+	if profile != nil {
+		profilev1.RegisterProfileServiceHTTPServer(srv, profile)
+	}
+	uploadPath := pathPrefix(storeCfg.Local.UploadBaseURL, defaultUploadPrefix)
+	if handler, ok := store.(nethttp.Handler); ok {
+		srv.HandlePrefix(uploadPath+"/", nethttp.StripPrefix(uploadPath, handler))
+	}
+	if public, ok := store.(publicObjectServer); ok {
+		// 与上传路径相同时无法区分读写，保留上传端点。
+		if publicPath := pathPrefix(storeCfg.Local.PublicBaseURL, defaultPublicPrefix); publicPath != uploadPath {
+			srv.HandlePrefix(publicPath+"/", nethttp.StripPrefix(publicPath, public.PublicHandler()))
+		}
+	}
+	return srv
+}
+
+// pathPrefix 从基础地址中提取挂载路径，解析失败或路径为空时回落到 fallback。
+func pathPrefix(baseURL, fallback string) string {
+	parsed, err := url.Parse(strings.TrimSpace(baseURL))
+	if err != nil {
+		return fallback
+	}
+	prefix := strings.TrimRight(parsed.Path, "/")
+	if prefix == "" {
+		return fallback
+	}
+	return prefix
+}
@@ -0,0 +1,68 @@
+package httpserver_test
+
+import (
+	"bytes"
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	configloader "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/configloader"
+	httpserver "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/http_server"
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/objectstore"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHTTPServer_DisabledWithoutAddress(t *testing.T) {
+	srv := httpserver.NewHTTPServer(configloader.ServerConfig{}, nil, nil, nil, nil, nil, objectstore.Config{}, log.DefaultLogger)
+	require.Nil(t, srv)
+}
+
+func TestNewHTTPServer_MountsLocalUploads(t *testing.T) {
+	storeCfg := objectstore.Config{
+		Driver: "local",
+		Local: objectstore.LocalConfig{
+			Dir:           t.TempDir(),
+			UploadBaseURL: "http://localhost:8000/media/uploads",
+			PublicBaseURL: "http://localhost:8000/avatars",
+			SigningKey:    "test-key",
+		},
+	}
+	store, err := objectstore.ProvideStore(storeCfg)
+	require.NoError(t, err)
+
+	cfg := configloader.ServerConfig{HTTP: configloader.HTTPServerConfig{Address: "127.0.0.1:0"}}
+	srv := httpserver.NewHTTPServer(cfg, nil, nil, nil, nil, store, storeCfg, log.DefaultLogger)
+	require.NotNil(t, srv)
+
+	target, err := store.SignUpload(context.Background(), objectstore.SignUploadInput{
+		Key:         "avatars/u1/a.png",
+		ContentType: "image/png",
+		MaxBytes:    1024,
+		ExpiresAt:   time.Now().Add(time.Minute),
+	})
+	require.NoError(t, err)
+	parsed, err := url.Parse(target.URL)
+	require.NoError(t, err)
+	require.Equal(t, "/media/uploads/avatars/u1/a.png", parsed.Path)
+
+	req := httptest.NewRequest(target.Method, parsed.RequestURI(), bytes.NewReader([]byte("not-an-image")))
+	req.Header.Set("Content-Type", "image/png")
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+	require.NotEqual(t, nethttp.StatusNotFound, rec.Code, "upload handler should be mounted under upload_base_url path")
+
+	rec = httptest.NewRecorder()
+	srv.ServeHTTP(rec, httptest.NewRequest(nethttp.MethodHead, "/avatars/avatars/u1/missing.png", nil))
+	require.Equal(t, nethttp.StatusNotFound, rec.Code)
+	rec = httptest.NewRecorder()
+	srv.ServeHTTP(rec, httptest.NewRequest(nethttp.MethodPut, "/avatars/avatars/u1/a.png", nil))
+	require.Equal(t, nethttp.StatusMethodNotAllowed, rec.Code, "read handler should be mounted under public_base_url path")
+
+	rec = httptest.NewRecorder()
+	srv.ServeHTTP(rec, httptest.NewRequest(nethttp.MethodGet, "/api/v1/user/me", nil))
+	require.Equal(t, nethttp.StatusNotFound, rec.Code, "profile routes are not registered without a handler")
+}
@@ -0,0 +1,28 @@
+package objectstore
+
+import (
+	"fmt"
+
+	"github.com/google/wire"
+)
+
+// ProviderSet 暴露对象存储构造函数供 Wire 依赖注入使用。
+var ProviderSet = wire.NewSet(ProvideStore)
+
+// Config 描述对象存储驱动选择；Driver 为空或 none 时不启用存储。
+type Config struct {
+	Driver string
+	Local  LocalConfig
+}
+
+// ProvideStore 根据配置选择存储驱动；未启用时返回 nil，由业务层降级处理。
+func ProvideStore(cfg Config) (Store, error) {
+	switch cfg.Driver {
+	case "", "none":
+		return nil, nil
+	case "local":
+		return NewLocalStore(cfg.Local)
+	default:
+		return nil, fmt.Errorf("objectstore: unsupported driver %q", cfg.Driver)
+	}
+}
@@ -0,0 +1,273 @@
+package objectstore
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const sniffLen = 512
+
+// LocalConfig 配置本地文件系统对象存储。
+type LocalConfig struct {
+	Dir           string
+	UploadBaseURL string
+	PublicBaseURL string
+	SigningKey    string
+}
+
+// LocalStore 使用本地目录模拟对象存储，供开发与测试环境替代云存储。
+// 上传通过 ServeHTTP 接收带 HMAC 签名的 PUT 请求完成，读取通过 PublicHandler 提供。
+type LocalStore struct {
+	dir           string
+	uploadBaseURL string
+	publicBaseURL string
+	key           []byte
+	now           func() time.Time
+}
+
+var _ Store = (*LocalStore)(nil)
+
+// NewLocalStore 构造 LocalStore；未配置签名密钥时随机生成（仅适用于单实例）。
+func NewLocalStore(cfg LocalConfig) (*LocalStore, error) {
+	if strings.TrimSpace(cfg.Dir) == "" {
+		return nil, errors.New("objectstore: local dir required")
+	}
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("objectstore: create local dir: %w", err)
+	}
+	key := []byte(cfg.SigningKey)
+	if len(key) == 0 {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("objectstore: generate signing key: %w", err)
+		}
+	}
+	return &LocalStore{
+		dir:           cfg.Dir,
+		uploadBaseURL: strings.TrimRight(cfg.UploadBaseURL, "/"),
+		publicBaseURL: strings.TrimRight(cfg.PublicBaseURL, "/"),
+		key:           key,
+		now:           time.Now,
+	}, nil
+}
+
+// SignUpload 生成带过期时间、类型与大小约束签名的 PUT 上传地址。
+func (s *LocalStore) SignUpload(_ context.Context, input SignUploadInput) (*UploadTarget, error) {
+	if err := validateKey(input.Key); err != nil {
+		return nil, err
+	}
+	expires := input.ExpiresAt.UTC().Unix()
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	query.Set("content_type", input.ContentType)
+	query.Set("max_bytes", strconv.FormatInt(input.MaxBytes, 10))
+	query.Set("signature", s.sign(input.Key, input.ContentType, input.MaxBytes, expires))
+
+	return &UploadTarget{
+		URL:       s.uploadBaseURL + "/" + input.Key + "?" + query.Encode(),
+		Method:    http.MethodPut,
+		Headers:   map[string]string{"Content-Type": input.ContentType},
+		ExpiresAt: time.Unix(expires, 0).UTC(),
+	}, nil
+}
+
+// Stat 返回对象大小与嗅探得到的内容类型。
+func (s *LocalStore) Stat(_ context.Context, key string) (*ObjectInfo, error) {
+	fp, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(fp)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("objectstore: open %s: %w", key, err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("objectstore: stat %s: %w", key, err)
+	}
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(f, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("objectstore: read %s: %w", key, err)
+	}
+	return &ObjectInfo{
+		Key:         key,
+		Size:        info.Size(),
+		ContentType: http.DetectContentType(head[:n]),
+	}, nil
+}
+
+// PublicURL 返回对象的公开访问地址。
+func (s *LocalStore) PublicURL(key string) string {
+	return s.publicBaseURL + "/" + key
+}
+
+// ServeHTTP 接收签名上传请求；调用方需通过 http.StripPrefix 去掉挂载前缀，使 URL.Path 即对象键。
+func (s *LocalStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	key := strings.TrimPrefix(path.Clean("/"+r.URL.Path), "/")
+	query := r.URL.Query()
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid expires", http.StatusBadRequest)
+		return
+	}
+	maxBytes, err := strconv.ParseInt(query.Get("max_bytes"), 10, 64)
+	if err != nil || maxBytes <= 0 {
+		http.Error(w, "invalid max_bytes", http.StatusBadRequest)
+		return
+	}
+	contentType := query.Get("content_type")
+	expected := s.sign(key, contentType, maxBytes, expires)
+	if !hmac.Equal([]byte(expected), []byte(query.Get("signature"))) {
+		http.Error(w, "invalid signature", http.StatusForbidden)
+		return
+	}
+	if s.now().UTC().Unix() > expires {
+		http.Error(w, "upload url expired", http.StatusForbidden)
+		return
+	}
+	if r.Header.Get("Content-Type") != contentType {
+		http.Error(w, "content type mismatch", http.StatusUnsupportedMediaType)
+		return
+	}
+	if r.ContentLength > maxBytes {
+		http.Error(w, "object too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err := s.write(key, io.LimitReader(r.Body, maxBytes+1), maxBytes); err != nil {
+		if errors.Is(err, errTooLarge) {
+			http.Error(w, "object too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "store object failed", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// PublicHandler 返回只读访问已上传对象的处理器，挂载在 public_base_url 路径下；
+// 调用方需通过 http.StripPrefix 去掉挂载前缀。仅提供嗅探为图片的对象，避免在服务域名下返回任意内容。
+func (s *LocalStore) PublicHandler() http.Handler {
+	return http.HandlerFunc(s.servePublic)
+}
+
+func (s *LocalStore) servePublic(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	key := strings.TrimPrefix(path.Clean("/"+r.URL.Path), "/")
+	if strings.HasPrefix(path.Base(key), ".") {
+		// 隐藏文件包括写入中的 .upload-* 临时文件。
+		http.NotFound(w, r)
+		return
+	}
+	fp, err := s.path(key)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	f, err := os.Open(fp)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil || info.IsDir() {
+		http.NotFound(w, r)
+		return
+	}
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(f, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		http.Error(w, "read object failed", http.StatusInternalServerError)
+		return
+	}
+	contentType := http.DetectContentType(head[:n])
+	if !strings.HasPrefix(contentType, "image/") {
+		http.NotFound(w, r)
+		return
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		http.Error(w, "read object failed", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	http.ServeContent(w, r, "", info.ModTime(), f)
+}
+
+var errTooLarge = errors.New("object too large")
+
+func (s *LocalStore) write(key string, body io.Reader, maxBytes int64) error {
+	fp, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(fp), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(fp), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	n, err := io.Copy(tmp, body)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if n > maxBytes {
+		return errTooLarge
+	}
+	return os.Rename(tmp.Name(), fp)
+}
+
+func (s *LocalStore) path(key string) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
+
+func (s *LocalStore) sign(key, contentType string, maxBytes, expires int64) string {
+	mac := hmac.New(sha256.New, s.key)
+	fmt.Fprintf(mac, "%s\n%s\n%d\n%d", key, contentType, maxBytes, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || path.Clean(key) != key || strings.HasPrefix(key, "..") {
+		return fmt.Errorf("%w: %q", ErrInvalidKey, key)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+// Package objectstore 定义用户上传文件（头像等）所需的对象存储抽象，以及本地文件系统实现。
+// 业务层只依赖 Store 接口，生产环境可替换为 GCS/S3 等带签名 URL 的实现。
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrObjectNotFound 表示对象不存在（尚未上传或已过期清理）。
+	ErrObjectNotFound = errors.New("object not found")
+	// ErrInvalidKey 表示对象键非法（为空、绝对路径或包含 ..）。
+	ErrInvalidKey = errors.New("invalid object key")
+)
+
+// SignUploadInput 描述签发上传目标所需的约束。
+type SignUploadInput struct {
+	Key         string
+	ContentType string
+	MaxBytes    int64
+	ExpiresAt   time.Time
+}
+
+// UploadTarget 表示客户端直传所需的信息。
+type UploadTarget struct {
+	URL       string
+	Method    string
+	Headers   map[string]string
+	ExpiresAt time.Time
+}
+
+// ObjectInfo 表示已上传对象的元信息；ContentType 基于内容嗅探而非客户端声明。
+type ObjectInfo struct {
+	Key         string
+	Size        int64
+	ContentType string
+}
+
+// Store 抽象对象存储能力：签发上传目标、查询对象与生成公开访问地址。
+type Store interface {
+	SignUpload(ctx context.Context, input SignUploadInput) (*UploadTarget, error)
+	Stat(ctx context.Context, key string) (*ObjectInfo, error)
+	PublicURL(key string) string
+}
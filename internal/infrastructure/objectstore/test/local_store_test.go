@@ -0,0 +1,181 @@
+package objectstore_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/objectstore"
+	"github.com/stretchr/testify/require"
+)
+
+func newLocalStore(t *testing.T) (*objectstore.LocalStore, *httptest.Server) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	store, err := objectstore.NewLocalStore(objectstore.LocalConfig{
+		Dir:           t.TempDir(),
+		UploadBaseURL: srv.URL + "/uploads",
+		PublicBaseURL: "https://cdn.example.com/avatars",
+		SigningKey:    "test-key",
+	})
+	require.NoError(t, err)
+	mux.Handle("/uploads/", http.StripPrefix("/uploads", store))
+	return store, srv
+}
+
+func pngBytes(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 2, 2))))
+	return buf.Bytes()
+}
+
+func put(t *testing.T, target *objectstore.UploadTarget, contentType string, body []byte) int {
+	t.Helper()
+	req, err := http.NewRequest(target.Method, target.URL, bytes.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", contentType)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestLocalStore_SignedUploadRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	store, _ := newLocalStore(t)
+	ctx := context.Background()
+	key := "avatars/u1/a.png"
+
+	target, err := store.SignUpload(ctx, objectstore.SignUploadInput{
+		Key:         key,
+		ContentType: "image/png",
+		MaxBytes:    1024,
+		ExpiresAt:   time.Now().Add(time.Minute),
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.MethodPut, target.Method)
+	require.Equal(t, "image/png", target.Headers["Content-Type"])
+
+	_, err = store.Stat(ctx, key)
+	require.True(t, errors.Is(err, objectstore.ErrObjectNotFound))
+
+	body := pngBytes(t)
+	require.Equal(t, http.StatusOK, put(t, target, "image/png", body))
+
+	info, err := store.Stat(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(body)), info.Size)
+	require.Equal(t, "image/png", info.ContentType)
+	require.Equal(t, "https://cdn.example.com/avatars/"+key, store.PublicURL(key))
+}
+
+func TestLocalStore_RejectsInvalidUploads(t *testing.T) {
+	t.Parallel()
+
+	store, _ := newLocalStore(t)
+	ctx := context.Background()
+	sign := func(expires time.Time, maxBytes int64) *objectstore.UploadTarget {
+		target, err := store.SignUpload(ctx, objectstore.SignUploadInput{
+			Key:         "avatars/u1/b.png",
+			ContentType: "image/png",
+			MaxBytes:    maxBytes,
+			ExpiresAt:   expires,
+		})
+		require.NoError(t, err)
+		return target
+	}
+	body := pngBytes(t)
+
+	valid := sign(time.Now().Add(time.Minute), 1024)
+	require.Equal(t, http.StatusUnsupportedMediaType, put(t, valid, "image/jpeg", body))
+
+	tampered := *valid
+	tampered.URL = strings.Replace(valid.URL, "max_bytes=1024", "max_bytes=4096", 1)
+	require.Equal(t, http.StatusForbidden, put(t, &tampered, "image/png", body))
+
+	expired := sign(time.Now().Add(-time.Minute), 1024)
+	require.Equal(t, http.StatusForbidden, put(t, expired, "image/png", body))
+
+	small := sign(time.Now().Add(time.Minute), 8)
+	require.Equal(t, http.StatusRequestEntityTooLarge, put(t, small, "image/png", body))
+
+	_, err := store.Stat(ctx, "avatars/u1/b.png")
+	require.True(t, errors.Is(err, objectstore.ErrObjectNotFound))
+}
+
+func TestLocalStore_PublicHandlerServesImagesOnly(t *testing.T) {
+	t.Parallel()
+
+	store, _ := newLocalStore(t)
+	ctx := context.Background()
+	upload := func(key string, body []byte) {
+		target, err := store.SignUpload(ctx, objectstore.SignUploadInput{
+			Key:         key,
+			ContentType: "image/png",
+			MaxBytes:    1024,
+			ExpiresAt:   time.Now().Add(time.Minute),
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, put(t, target, "image/png", body))
+	}
+	body := pngBytes(t)
+	upload("avatars/u1/a.png", body)
+	upload("avatars/u1/page.png", []byte("<html><script>alert(1)</script></html>"))
+
+	handler := http.StripPrefix("/avatars", store.PublicHandler())
+	get := func(method, target string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(method, target, nil))
+		return rec
+	}
+
+	rec := get(http.MethodGet, "/avatars/avatars/u1/a.png")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+	require.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+	require.Equal(t, body, rec.Body.Bytes())
+
+	require.Equal(t, http.StatusOK, get(http.MethodHead, "/avatars/avatars/u1/a.png").Code)
+	require.Equal(t, http.StatusNotFound, get(http.MethodGet, "/avatars/avatars/u1/page.png").Code, "non-image content is not served")
+	require.Equal(t, http.StatusNotFound, get(http.MethodGet, "/avatars/avatars/u1/missing.png").Code)
+	require.Equal(t, http.StatusNotFound, get(http.MethodGet, "/avatars/avatars/u1").Code, "directories are not listed")
+	require.Equal(t, http.StatusMethodNotAllowed, get(http.MethodPut, "/avatars/avatars/u1/a.png").Code)
+}
+
+func TestLocalStore_RejectsTraversalKeys(t *testing.T) {
+	t.Parallel()
+
+	store, _ := newLocalStore(t)
+	for _, key := range []string{"", "/etc/passwd", "../escape", "avatars/../../escape"} {
+		_, err := store.SignUpload(context.Background(), objectstore.SignUploadInput{Key: key, ExpiresAt: time.Now()})
+		require.True(t, errors.Is(err, objectstore.ErrInvalidKey), key)
+	}
+}
+
+func TestProvideStore_SelectsDriver(t *testing.T) {
+	t.Parallel()
+
+	store, err := objectstore.ProvideStore(objectstore.Config{})
+	require.NoError(t, err)
+	require.Nil(t, store)
+
+	store, err = objectstore.ProvideStore(objectstore.Config{Driver: "local", Local: objectstore.LocalConfig{Dir: t.TempDir()}})
+	require.NoError(t, err)
+	require.NotNil(t, store)
+
+	_, err = objectstore.ProvideStore(objectstore.Config{Driver: "s3"})
+	require.Error(t, err)
+}
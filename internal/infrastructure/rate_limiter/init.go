@@ -0,0 +1,6 @@
+package ratelimiter
+
+import "github.com/google/wire"
+
+// ProviderSet 暴露令牌桶限流器的构造函数供 Wire 依赖注入使用。
+var ProviderSet = wire.NewSet(NewLimiter)
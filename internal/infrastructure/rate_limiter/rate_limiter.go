@@ -0,0 +1,185 @@
+// Package ratelimiter 实现按「用户 × RPC」维度的令牌桶限流。
+//
+// 每个 (user, operation) 组合持有独立令牌桶，速率与突发量来自 server.rate_limit 配置：
+// 方法级规则优先，未配置的方法回落到默认规则。匿名请求共享 anonymous 桶。
+// 长时间未访问的桶会被惰性清理，避免键空间无限增长。
+package ratelimiter
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"golang.org/x/time/rate"
+)
+
+// AnonymousKey 为无法解析用户身份时使用的限流键。
+const AnonymousKey = "anonymous"
+
+// HeaderRetryAfter 为拒绝请求时写入的回复头（gRPC 下为同名 header metadata）。
+const HeaderRetryAfter = "Retry-After"
+
+const defaultIdleTTL = 10 * time.Minute
+
+// Rule 描述单个令牌桶的速率（每秒令牌数）与突发容量；RPS <= 0 表示不限流。
+type Rule struct {
+	RPS   float64
+	Burst int
+}
+
+// Config 描述限流开关、默认规则与方法级覆盖。
+// Methods 的键可以是方法名（如 UpsertWatchProgress）或完整 operation（如 /profile.v1.ProfileService/UpsertWatchProgress）。
+type Config struct {
+	Enabled bool
+	Default Rule
+	Methods map[string]Rule
+	IdleTTL time.Duration
+}
+
+// KeyFunc 从请求上下文解析限流维度的用户标识，返回空串表示匿名。
+type KeyFunc func(ctx context.Context, req any) string
+
+// RejectFunc 构造被限流时返回给调用方的错误。
+type RejectFunc func(operation string, retryAfter time.Duration) error
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// Limiter 维护所有令牌桶。
+type Limiter struct {
+	cfg Config
+	now func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// NewLimiter 根据配置构造限流器；未启用时返回 nil，Middleware 对 nil 直接放行。
+func NewLimiter(cfg Config) *Limiter {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.IdleTTL <= 0 {
+		cfg.IdleTTL = defaultIdleTTL
+	}
+	return &Limiter{
+		cfg:     cfg,
+		now:     time.Now,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// WithClock 提供测试替换时间。
+func (l *Limiter) WithClock(fn func() time.Time) {
+	if l == nil || fn == nil {
+		return
+	}
+	l.now = fn
+}
+
+// Allow 为 (user, operation) 消耗一个令牌；拒绝时返回需要等待的时长。
+func (l *Limiter) Allow(user, operation string) (bool, time.Duration) {
+	if l == nil {
+		return true, 0
+	}
+	rule := l.ruleFor(operation)
+	if rule.RPS <= 0 {
+		return true, 0
+	}
+	if strings.TrimSpace(user) == "" {
+		user = AnonymousKey
+	}
+	now := l.now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sweepLocked(now)
+
+	key := operation + "|" + user
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(rule.RPS), max(rule.Burst, 1))}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+
+	reservation := b.limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		return false, time.Second
+	}
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return false, delay
+	}
+	return true, 0
+}
+
+// Size 返回当前持有的令牌桶数量。
+func (l *Limiter) Size() int {
+	if l == nil {
+		return 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.buckets)
+}
+
+func (l *Limiter) ruleFor(operation string) Rule {
+	if rule, ok := l.cfg.Methods[operation]; ok {
+		return rule
+	}
+	if idx := strings.LastIndex(operation, "/"); idx >= 0 {
+		if rule, ok := l.cfg.Methods[operation[idx+1:]]; ok {
+			return rule
+		}
+	}
+	return l.cfg.Default
+}
+
+// sweepLocked 每隔 IdleTTL 清理一次超过 IdleTTL 未访问的桶。
+func (l *Limiter) sweepLocked(now time.Time) {
+	if now.Sub(l.lastSweep) < l.cfg.IdleTTL {
+		return
+	}
+	l.lastSweep = now
+	for key, b := range l.buckets {
+		if now.Sub(b.lastSeen) >= l.cfg.IdleTTL {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// Middleware 返回 Kratos 服务端中间件：按 key 解析用户、按 transport operation 区分 RPC，
+// 超限时写入 Retry-After 回复头（向上取整到秒）并返回 reject 构造的错误。
+func Middleware(l *Limiter, key KeyFunc, reject RejectFunc) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			if l == nil {
+				return handler(ctx, req)
+			}
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			user := ""
+			if key != nil {
+				user = key(ctx, req)
+			}
+			allowed, retryAfter := l.Allow(user, tr.Operation())
+			if allowed {
+				return handler(ctx, req)
+			}
+			seconds := int64(math.Ceil(retryAfter.Seconds()))
+			tr.ReplyHeader().Set(HeaderRetryAfter, strconv.FormatInt(max(seconds, 1), 10))
+			return nil, reject(tr.Operation(), retryAfter)
+		}
+	}
+}
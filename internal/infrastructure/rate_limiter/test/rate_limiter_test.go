@@ -0,0 +1,171 @@
+package ratelimiter_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ratelimiter "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/rate_limiter"
+
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+const (
+	opUpsert = "/profile.v1.ProfileService/UpsertWatchProgress"
+	opGet    = "/profile.v1.ProfileService/GetProfile"
+)
+
+type fakeClock struct{ now time.Time }
+
+func (c *fakeClock) Now() time.Time          { return c.now }
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func newLimiter(cfg ratelimiter.Config) (*ratelimiter.Limiter, *fakeClock) {
+	cfg.Enabled = true
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	l := ratelimiter.NewLimiter(cfg)
+	l.WithClock(clock.Now)
+	return l, clock
+}
+
+func TestNewLimiter_DisabledReturnsNil(t *testing.T) {
+	if l := ratelimiter.NewLimiter(ratelimiter.Config{Default: ratelimiter.Rule{RPS: 1}}); l != nil {
+		t.Fatalf("expected nil limiter when disabled")
+	}
+	var l *ratelimiter.Limiter
+	if ok, _ := l.Allow("u1", opGet); !ok {
+		t.Fatalf("nil limiter must allow")
+	}
+}
+
+func TestLimiter_BucketsArePerUserAndMethod(t *testing.T) {
+	l, clock := newLimiter(ratelimiter.Config{
+		Default: ratelimiter.Rule{RPS: 10, Burst: 10},
+		Methods: map[string]ratelimiter.Rule{"UpsertWatchProgress": {RPS: 1, Burst: 2}},
+	})
+
+	for i := 0; i < 2; i++ {
+		if ok, _ := l.Allow("u1", opUpsert); !ok {
+			t.Fatalf("request %d within burst should pass", i)
+		}
+	}
+	ok, retryAfter := l.Allow("u1", opUpsert)
+	if ok {
+		t.Fatalf("third heartbeat should be limited")
+	}
+	if retryAfter <= 0 || retryAfter > time.Second {
+		t.Fatalf("retry after = %s, want (0, 1s]", retryAfter)
+	}
+
+	if ok, _ := l.Allow("u2", opUpsert); !ok {
+		t.Fatalf("other users must not share the bucket")
+	}
+	if ok, _ := l.Allow("u1", opGet); !ok {
+		t.Fatalf("other methods must not share the bucket")
+	}
+
+	clock.Advance(time.Second)
+	if ok, _ := l.Allow("u1", opUpsert); !ok {
+		t.Fatalf("bucket should refill after 1s")
+	}
+}
+
+func TestLimiter_ZeroRateIsUnlimited(t *testing.T) {
+	l, _ := newLimiter(ratelimiter.Config{
+		Default: ratelimiter.Rule{RPS: 1, Burst: 1},
+		Methods: map[string]ratelimiter.Rule{opGet: {}},
+	})
+	for i := 0; i < 100; i++ {
+		if ok, _ := l.Allow("u1", opGet); !ok {
+			t.Fatalf("request %d should pass for unlimited method", i)
+		}
+	}
+	if l.Size() != 0 {
+		t.Fatalf("unlimited methods must not allocate buckets, got %d", l.Size())
+	}
+}
+
+func TestLimiter_AnonymousShareBucket(t *testing.T) {
+	l, _ := newLimiter(ratelimiter.Config{Default: ratelimiter.Rule{RPS: 1, Burst: 1}})
+	if ok, _ := l.Allow("", opGet); !ok {
+		t.Fatalf("first anonymous request should pass")
+	}
+	if ok, _ := l.Allow(" ", opGet); ok {
+		t.Fatalf("anonymous callers should share one bucket")
+	}
+}
+
+func TestLimiter_SweepsIdleBuckets(t *testing.T) {
+	l, clock := newLimiter(ratelimiter.Config{
+		Default: ratelimiter.Rule{RPS: 1, Burst: 1},
+		IdleTTL: time.Minute,
+	})
+	l.Allow("u1", opGet)
+	l.Allow("u2", opGet)
+	if l.Size() != 2 {
+		t.Fatalf("size = %d, want 2", l.Size())
+	}
+	clock.Advance(2 * time.Minute)
+	l.Allow("u3", opGet)
+	if l.Size() != 1 {
+		t.Fatalf("idle buckets should be evicted, size = %d", l.Size())
+	}
+}
+
+type fakeTransport struct {
+	operation string
+	reply     headerCarrier
+}
+
+type headerCarrier map[string]string
+
+func (h headerCarrier) Get(key string) string      { return h[key] }
+func (h headerCarrier) Set(key, value string)      { h[key] = value }
+func (h headerCarrier) Add(key, value string)      { h[key] = value }
+func (h headerCarrier) Keys() []string             { return nil }
+func (h headerCarrier) Values(key string) []string { return []string{h[key]} }
+
+func (t *fakeTransport) Kind() transport.Kind            { return transport.KindGRPC }
+func (t *fakeTransport) Endpoint() string                { return "" }
+func (t *fakeTransport) Operation() string               { return t.operation }
+func (t *fakeTransport) RequestHeader() transport.Header { return headerCarrier{} }
+func (t *fakeTransport) ReplyHeader() transport.Header   { return t.reply }
+
+var errLimited = errors.New("limited")
+
+func TestMiddleware_RejectsWithRetryAfter(t *testing.T) {
+	l, _ := newLimiter(ratelimiter.Config{Default: ratelimiter.Rule{RPS: 0.5, Burst: 1}})
+	var gotOp string
+	var gotDelay time.Duration
+	mw := ratelimiter.Middleware(l,
+		func(context.Context, any) string { return "u1" },
+		func(operation string, retryAfter time.Duration) error {
+			gotOp, gotDelay = operation, retryAfter
+			return errLimited
+		},
+	)
+	calls := 0
+	handler := mw(func(context.Context, any) (any, error) {
+		calls++
+		return "ok", nil
+	})
+
+	tr := &fakeTransport{operation: opUpsert, reply: headerCarrier{}}
+	ctx := transport.NewServerContext(context.Background(), tr)
+	if _, err := handler(ctx, nil); err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+	if _, err := handler(ctx, nil); !errors.Is(err, errLimited) {
+		t.Fatalf("second call err = %v, want errLimited", err)
+	}
+	if calls != 1 {
+		t.Fatalf("handler calls = %d, want 1", calls)
+	}
+	if gotOp != opUpsert || gotDelay != 2*time.Second {
+		t.Fatalf("reject got op=%q delay=%s", gotOp, gotDelay)
+	}
+	if got := tr.reply[ratelimiter.HeaderRetryAfter]; got != "2" {
+		t.Fatalf("Retry-After = %q, want 2", got)
+	}
+}
@@ -0,0 +1,6 @@
+package servermiddleware
+
+import "github.com/google/wire"
+
+// ProviderSet 暴露请求校验器的构造函数供 Wire 依赖注入使用。
+var ProviderSet = wire.NewSet(NewValidator)
@@ -0,0 +1,117 @@
+// Package servermiddleware 组装入站 gRPC 与 HTTP Server 共用的 Kratos 中间件链，
+// 保证两种传输在追踪、鉴权、限流与参数校验上的行为一致。
+package servermiddleware
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/controllers"
+	configloader "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/configloader"
+	ratelimiter "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/rate_limiter"
+	profilemd "github.com/bionicotaku/lingo-services-profile/internal/metadata"
+
+	"github.com/bionicotaku/lingo-utils/gcjwt"
+	obsTrace "github.com/bionicotaku/lingo-utils/observability/tracing"
+	"github.com/bufbuild/protovalidate-go"
+	pvmw "github.com/go-kratos-ecosystem/components/v2/middleware/protovalidate"
+	"github.com/go-kratos/kratos/v2/log"
+	kmetadata "github.com/go-kratos/kratos/v2/metadata"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/logging"
+	"github.com/go-kratos/kratos/v2/middleware/metadata"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	grpcmetadata "google.golang.org/grpc/metadata"
+)
+
+// untrustedHeaders 为客户端不得自带的身份 Header：操作者只取自经校验的凭证，
+// 这些 Header 若被 x-md- 前缀透传，会被下游误当作可信身份。
+var untrustedHeaders = []string{"x-md-actor-type", "x-md-actor-id"}
+
+// Chain 返回服务端中间件链（按执行顺序）：
+// 1. obsTrace.Server() - OpenTelemetry 追踪，自动创建 Span
+// 2. recovery.Recovery() - Panic 恢复，防止服务崩溃
+// 3. metadata.Server() - 元数据传播，转发配置的 header 前缀，随后丢弃客户端自带的 x-md-actor-* 身份头
+// 4. jwt（可选）- 入站 JWT 校验，置于限流之前；仅在启用校验（skip_validate=false）时将 email 声明作为服务账号写入 Context
+// 5. ratelimiter.Middleware() - 按「用户 × RPC」的令牌桶限流，超限返回 ResourceExhausted 与 Retry-After
+// 6. pvmw.Server() - 按 profile.proto 中的 buf.validate 注解校验请求，违例经 controllers.ValidationFailed 转为 InvalidArgument
+// 7. logging.Server() - 结构化日志记录（含 trace_id/span_id）
+//
+// validator 为空时跳过声明式校验（仅用于测试），请求只经过 Handler 内的类型转换检查；
+// limiter 为空（server.rate_limit.enabled=false）时不限流。限流键按 controllers.CallerID 解析：
+// 网关认证的用户、经校验的服务账号 + 请求 user_id，匿名请求按对端地址；限流位于参数校验之前，非法请求同样消耗配额。
+func Chain(cfg configloader.ServerConfig, jwt gcjwt.ServerMiddleware, validator *protovalidate.Validator, limiter *ratelimiter.Limiter, logger log.Logger) []middleware.Middleware {
+	// 构造基础中间件链：追踪、panic 恢复与 metadata 传播。
+	mws := []middleware.Middleware{
+		obsTrace.Server(),
+		recovery.Recovery(),
+		metadata.Server(metadata.WithPropagatedPrefix(cfg.MetadataKeys...)),
+		dropHeaders(untrustedHeaders...),
+	}
+	// 根据配置决定是否挂载 JWT 校验，默认置于限流之前。
+	if jwt != nil {
+		mws = append(mws, middleware.Middleware(jwt))
+		if !cfg.JWT.SkipValidate {
+			mws = append(mws, verifiedServiceAccount(cfg.JWT.HeaderKey))
+		}
+	}
+	// 其余中间件保持原有顺序，保护限流、参数校验与结构化日志逻辑。
+	mws = append(mws,
+		ratelimiter.Middleware(limiter, controllers.CallerID, controllers.RateLimited),
+		pvmw.Server(pvmw.Validator(validator), pvmw.Handler(controllers.ValidationFailed)),
+		logging.Server(logger),
+	)
+	return mws
+}
+
+// NewValidator 构造共享的 protovalidate 校验器；规则在首次校验某消息时编译并缓存。
+func NewValidator() (*protovalidate.Validator, error) {
+	return protovalidate.New()
+}
+
+// verifiedServiceAccount 在 gcjwt 校验通过后读取 Token 的 email 声明并写入 Context；
+// 必须紧跟 JWT 中间件挂载，未经校验的 Token 不得到达此处。
+func verifiedServiceAccount(headerKey string) middleware.Middleware {
+	if strings.TrimSpace(headerKey) == "" {
+		headerKey = "authorization"
+	}
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				if account, err := profilemd.ExtractServiceAccountFromBearer(tr.RequestHeader().Get(headerKey)); err == nil {
+					ctx = profilemd.WithVerifiedServiceAccount(ctx, account)
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// dropHeaders 从 gRPC incoming metadata、HTTP 请求头与 Kratos 透传 metadata 中移除指定 Header。
+func dropHeaders(keys ...string) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			if md, ok := grpcmetadata.FromIncomingContext(ctx); ok {
+				for _, key := range keys {
+					md.Delete(key)
+				}
+				ctx = grpcmetadata.NewIncomingContext(ctx, md)
+			}
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				if ht, ok := tr.(khttp.Transporter); ok {
+					for _, key := range keys {
+						ht.Request().Header.Del(key)
+					}
+				}
+			}
+			if md, ok := kmetadata.FromServerContext(ctx); ok {
+				for _, key := range keys {
+					delete(md, strings.ToLower(key))
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package servermiddleware_test
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"testing"
+
+	configloader "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/configloader"
+	servermiddleware "github.com/bionicotaku/lingo-services-profile/internal/infrastructure/server_middleware"
+	profilemd "github.com/bionicotaku/lingo-services-profile/internal/metadata"
+	"github.com/bionicotaku/lingo-utils/gcjwt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTransport 提供最小的服务端 Transport，只用于携带请求头。
+type fakeTransport struct {
+	header headerCarrier
+}
+
+func (t *fakeTransport) Kind() transport.Kind            { return transport.KindGRPC }
+func (t *fakeTransport) Endpoint() string                { return "" }
+func (t *fakeTransport) Operation() string               { return "/profile.v1.ProfileService/ListAuditEntries" }
+func (t *fakeTransport) RequestHeader() transport.Header { return t.header }
+func (t *fakeTransport) ReplyHeader() transport.Header   { return headerCarrier{} }
+
+type headerCarrier map[string]string
+
+func (h headerCarrier) Get(key string) string      { return h[key] }
+func (h headerCarrier) Set(key, value string)      { h[key] = value }
+func (h headerCarrier) Add(key, value string)      { h[key] = value }
+func (h headerCarrier) Keys() []string             { return nil }
+func (h headerCarrier) Values(key string) []string { return []string{h[key]} }
+
+// passthroughJWT 代表已通过 gcjwt 校验的请求。
+var passthroughJWT = gcjwt.ServerMiddleware(func(next middleware.Handler) middleware.Handler { return next })
+
+func serviceAccountSeenByHandler(t *testing.T, jwtCfg configloader.ServerJWTConfig) string {
+	t.Helper()
+
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"email":"ops@proj.iam.gserviceaccount.com"}`))
+	tr := &fakeTransport{header: headerCarrier{"authorization": "Bearer e30." + payload + ".sig"}}
+	ctx := transport.NewServerContext(context.Background(), tr)
+
+	cfg := configloader.ServerConfig{JWT: jwtCfg}
+	handler := middleware.Chain(servermiddleware.Chain(cfg, passthroughJWT, nil, nil, log.NewStdLogger(io.Discard))...)(
+		func(ctx context.Context, _ any) (any, error) {
+			return profilemd.VerifiedServiceAccount(ctx), nil
+		})
+	account, err := handler(ctx, nil)
+	require.NoError(t, err)
+	return account.(string)
+}
+
+func TestChain_SetsServiceAccountOnlyWhenJWTIsValidated(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "ops@proj.iam.gserviceaccount.com", serviceAccountSeenByHandler(t, configloader.ServerJWTConfig{HeaderKey: "authorization"}))
+	require.Empty(t, serviceAccountSeenByHandler(t, configloader.ServerJWTConfig{SkipValidate: true, HeaderKey: "authorization"}))
+}
@@ -19,6 +19,14 @@ type HandlerMetadata struct {
 	UserID          string
 	RawUserInfo     string
 	InvalidUserInfo bool
+	// Locale/Timezone 来自 userinfo 的 locale、zoneinfo 声明，作为档案未设置时的默认值。
+	Locale   string
+	Timezone string
+	// Roles 来自 userinfo 的 app_metadata.role / app_metadata.roles（仅服务端可写），用于运营接口授权。
+	Roles []string
+	// ServiceAccount 来自经 gcjwt 校验的入站 JWT 的 email 声明（见 VerifiedServiceAccount），
+	// 标识直接调用本服务的服务账号；未启用 JWT 校验时始终为空。
+	ServiceAccount string
 }
 
 // IsZero 判断 Metadata 是否为空。
@@ -28,7 +36,11 @@ func (m HandlerMetadata) IsZero() bool {
 		m.IfNoneMatch == "" &&
 		m.UserID == "" &&
 		m.RawUserInfo == "" &&
-		!m.InvalidUserInfo
+		!m.InvalidUserInfo &&
+		m.Locale == "" &&
+		m.Timezone == "" &&
+		len(m.Roles) == 0 &&
+		m.ServiceAccount == ""
 }
 
 // UserUUID 尝试解析 user_id 为 UUID。
@@ -62,6 +74,27 @@ func FromContext(ctx context.Context) (HandlerMetadata, bool) {
 	return meta, ok
 }
 
+type serviceAccountKey struct{}
+
+// WithVerifiedServiceAccount 记录入站 JWT 中的服务账号。
+// 仅供服务端中间件在 gcjwt 校验通过之后调用，业务代码不得据请求内容自行写入。
+func WithVerifiedServiceAccount(ctx context.Context, account string) context.Context {
+	account = strings.TrimSpace(account)
+	if account == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, serviceAccountKey{}, account)
+}
+
+// VerifiedServiceAccount 返回经校验的服务账号；未启用 JWT 校验或请求未携带 Token 时返回空串。
+func VerifiedServiceAccount(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	account, _ := ctx.Value(serviceAccountKey{}).(string)
+	return account
+}
+
 // ExtractUserIDFromUserInfo 尝试从 X-Apigateway-Api-Userinfo 头中解析用户标识。
 func ExtractUserIDFromUserInfo(raw string) (string, error) {
 	raw = strings.TrimSpace(raw)
@@ -88,6 +121,98 @@ func ExtractUserIDFromUserInfo(raw string) (string, error) {
 	return "", nil
 }
 
+// ExtractLocaleFromUserInfo 从 userinfo 头中读取 OIDC 标准的 locale 与 zoneinfo 声明，
+// 顶层缺失时回落到 Supabase 的 user_metadata；不做格式校验。
+func ExtractLocaleFromUserInfo(raw string) (locale, timezone string, err error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", "", nil
+	}
+	payload, err := decodeUserInfo(raw)
+	if err != nil {
+		return "", "", err
+	}
+	var claims map[string]any
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", "", err
+	}
+	userMeta, _ := claims["user_metadata"].(map[string]any)
+	return claimString(claims, userMeta, "locale"), claimString(claims, userMeta, "zoneinfo"), nil
+}
+
+// ExtractRolesFromUserInfo 从 userinfo 头的 app_metadata 读取角色（role 字符串与 roles 数组）。
+// 不读取顶层 role：Supabase 将其固定为数据库角色（authenticated），也不读取用户可自行修改的 user_metadata。
+func ExtractRolesFromUserInfo(raw string) ([]string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+	payload, err := decodeUserInfo(raw)
+	if err != nil {
+		return nil, err
+	}
+	var claims struct {
+		AppMetadata struct {
+			Role  any `json:"role"`
+			Roles any `json:"roles"`
+		} `json:"app_metadata"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, err
+	}
+	var roles []string
+	for _, value := range []any{claims.AppMetadata.Role, claims.AppMetadata.Roles} {
+		switch v := value.(type) {
+		case string:
+			if role := strings.TrimSpace(v); role != "" {
+				roles = append(roles, role)
+			}
+		case []any:
+			for _, item := range v {
+				if role, ok := item.(string); ok && strings.TrimSpace(role) != "" {
+					roles = append(roles, strings.TrimSpace(role))
+				}
+			}
+		}
+	}
+	return roles, nil
+}
+
+// ExtractServiceAccountFromBearer 从 Authorization 头的 Bearer JWT 中读取 email 声明。
+// 仅解码 payload、不校验签名，只能在 gcjwt 校验通过后调用（见 WithVerifiedServiceAccount）；
+// 非 JWT 或缺少 email 时返回空串。
+func ExtractServiceAccountFromBearer(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if len(raw) < len("bearer ") || !strings.EqualFold(raw[:len("bearer ")], "bearer ") {
+		return "", nil
+	}
+	parts := strings.Split(strings.TrimSpace(raw[len("bearer "):]), ".")
+	if len(parts) != 3 {
+		return "", errors.New("malformed bearer token")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", err
+	}
+	var claims struct {
+		Email string `json:"email"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(claims.Email), nil
+}
+
+func claimString(claims, fallback map[string]any, key string) string {
+	if value, ok := claims[key].(string); ok && strings.TrimSpace(value) != "" {
+		return strings.TrimSpace(value)
+	}
+	if value, ok := fallback[key].(string); ok && strings.TrimSpace(value) != "" {
+		return strings.TrimSpace(value)
+	}
+	return ""
+}
+
 func decodeUserInfo(raw string) ([]byte, error) {
 	decoders := []func(string) ([]byte, error){
 		func(s string) ([]byte, error) { return base64.RawURLEncoding.DecodeString(s) },
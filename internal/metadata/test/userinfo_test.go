@@ -48,3 +48,29 @@ func TestExtractUserIDFromUserInfo_UserIDFallback(t *testing.T) {
 		t.Fatalf("expected fallback user_id %q, got %q", claims["user_id"], userID)
 	}
 }
+
+func TestExtractLocaleFromUserInfo(t *testing.T) {
+	claims := map[string]any{
+		"sub":      "f2c9f4f8-4a4b-4e28-9c5b-4d3b2190f155",
+		"zoneinfo": "Asia/Shanghai",
+		"user_metadata": map[string]any{
+			"locale": "zh-CN",
+		},
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatalf("marshal claims: %v", err)
+	}
+	header := base64.RawURLEncoding.EncodeToString(payload)
+
+	locale, timezone, err := metadata.ExtractLocaleFromUserInfo(header)
+	if err != nil {
+		t.Fatalf("extract locale: %v", err)
+	}
+	if locale != "zh-CN" {
+		t.Fatalf("expected user_metadata locale zh-CN, got %q", locale)
+	}
+	if timezone != "Asia/Shanghai" {
+		t.Fatalf("expected zoneinfo Asia/Shanghai, got %q", timezone)
+	}
+}
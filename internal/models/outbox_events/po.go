@@ -21,6 +21,10 @@ const (
 	KindProfileEngagementRemoved
 	// KindProfileWatchProgressed 表示观看进度更新事件。
 	KindProfileWatchProgressed
+	// KindProfileCollectionItemAdded 表示视频加入收藏夹事件。
+	KindProfileCollectionItemAdded
+	// KindProfileCollectionItemRemoved 表示视频移出收藏夹事件。
+	KindProfileCollectionItemRemoved
 )
 
 func (k Kind) String() string {
@@ -31,6 +35,10 @@ func (k Kind) String() string {
 		return "profile.engagement.removed"
 	case KindProfileWatchProgressed:
 		return "profile.watch.progressed"
+	case KindProfileCollectionItemAdded:
+		return "profile.collection.item_added"
+	case KindProfileCollectionItemRemoved:
+		return "profile.collection.item_removed"
 	default:
 		return "profile.event.unknown"
 	}
@@ -54,7 +62,9 @@ type ProfileEngagementAdded struct {
 	EngagementType string
 	OccurredAt     time.Time
 	Source         *string
-	Stats          *po.ProfileVideoStats
+	// Metadata 为互动上下文（entry_point、device 等），与 profile.engagements.metadata 一致。
+	Metadata map[string]any
+	Stats    *po.ProfileVideoStats
 }
 
 // ProfileEngagementRemoved 描述互动删除事件载荷。
@@ -77,6 +87,31 @@ type ProfileWatchProgressed struct {
 	Context   map[string]any
 }
 
+// ProfileCollectionItemAdded 描述视频加入收藏夹事件载荷。
+type ProfileCollectionItemAdded struct {
+	UserID       uuid.UUID
+	CollectionID uuid.UUID
+	VideoID      uuid.UUID
+	Position     int32
+	OccurredAt   time.Time
+}
+
+// ProfileCollectionItemRemoved 描述视频移出收藏夹事件载荷；Reason 区分主动移除、移动与收藏夹删除。
+type ProfileCollectionItemRemoved struct {
+	UserID       uuid.UUID
+	CollectionID uuid.UUID
+	VideoID      uuid.UUID
+	Reason       string
+	OccurredAt   time.Time
+}
+
+// 收藏夹成员移除原因，写入 CollectionItemRemovedEvent.reason。
+const (
+	CollectionItemRemovedByUser     = "removed"
+	CollectionItemRemovedByMove     = "moved"
+	CollectionItemRemovedByDeletion = "collection_deleted"
+)
+
 const (
 	// AggregateTypeProfileUser 标识档案聚合类型。
 	AggregateTypeProfileUser = "profile.user"
@@ -84,6 +119,8 @@ const (
 	AggregateTypeProfileEngagement = "profile.engagement"
 	// AggregateTypeProfileWatchLog 标识观看记录聚合类型。
 	AggregateTypeProfileWatchLog = "profile.watch_log"
+	// AggregateTypeProfileCollection 标识收藏夹聚合类型。
+	AggregateTypeProfileCollection = "profile.collection"
 	// SchemaVersionV1 描述事件载荷的当前 schema 版本。
 	SchemaVersionV1 = "v1"
 )
@@ -9,7 +9,7 @@ import (
 )
 
 // NewProfileEngagementAddedEvent 构造收藏/点赞新增事件。
-func NewProfileEngagementAddedEvent(userID, videoID uuid.UUID, engagementType string, occurredAt time.Time, source *string, stats *po.ProfileVideoStats) (*DomainEvent, error) {
+func NewProfileEngagementAddedEvent(userID, videoID uuid.UUID, engagementType string, occurredAt time.Time, source *string, metadata map[string]any, stats *po.ProfileVideoStats) (*DomainEvent, error) {
 	if userID == uuid.Nil {
 		return nil, fmt.Errorf("engagement event: user_id required")
 	}
@@ -32,6 +32,7 @@ func NewProfileEngagementAddedEvent(userID, videoID uuid.UUID, engagementType st
 			EngagementType: engagementType,
 			OccurredAt:     occurredAt,
 			Source:         source,
+			Metadata:       metadata,
 			Stats:          stats,
 		},
 	}
@@ -106,3 +107,62 @@ func NewProfileWatchProgressedEvent(userID, videoID uuid.UUID, progress *po.Prof
 	}
 	return evt, nil
 }
+
+// NewProfileCollectionItemAddedEvent 构造视频加入收藏夹事件。
+func NewProfileCollectionItemAddedEvent(userID, collectionID, videoID uuid.UUID, position int32, occurredAt time.Time) (*DomainEvent, error) {
+	if err := validateCollectionEventIDs(userID, collectionID, videoID); err != nil {
+		return nil, err
+	}
+	occurredAt = occurredAt.UTC()
+	return &DomainEvent{
+		EventID:       uuid.New(),
+		Kind:          KindProfileCollectionItemAdded,
+		AggregateID:   collectionID,
+		AggregateType: AggregateTypeProfileCollection,
+		Version:       VersionFromTime(occurredAt),
+		OccurredAt:    occurredAt,
+		Payload: &ProfileCollectionItemAdded{
+			UserID:       userID,
+			CollectionID: collectionID,
+			VideoID:      videoID,
+			Position:     position,
+			OccurredAt:   occurredAt,
+		},
+	}, nil
+}
+
+// NewProfileCollectionItemRemovedEvent 构造视频移出收藏夹事件。
+func NewProfileCollectionItemRemovedEvent(userID, collectionID, videoID uuid.UUID, reason string, occurredAt time.Time) (*DomainEvent, error) {
+	if err := validateCollectionEventIDs(userID, collectionID, videoID); err != nil {
+		return nil, err
+	}
+	occurredAt = occurredAt.UTC()
+	return &DomainEvent{
+		EventID:       uuid.New(),
+		Kind:          KindProfileCollectionItemRemoved,
+		AggregateID:   collectionID,
+		AggregateType: AggregateTypeProfileCollection,
+		Version:       VersionFromTime(occurredAt),
+		OccurredAt:    occurredAt,
+		Payload: &ProfileCollectionItemRemoved{
+			UserID:       userID,
+			CollectionID: collectionID,
+			VideoID:      videoID,
+			Reason:       reason,
+			OccurredAt:   occurredAt,
+		},
+	}, nil
+}
+
+func validateCollectionEventIDs(userID, collectionID, videoID uuid.UUID) error {
+	if userID == uuid.Nil {
+		return fmt.Errorf("collection event: user_id required")
+	}
+	if collectionID == uuid.Nil {
+		return fmt.Errorf("collection event: collection_id required")
+	}
+	if videoID == uuid.Nil {
+		return fmt.Errorf("collection event: video_id required")
+	}
+	return nil
+}
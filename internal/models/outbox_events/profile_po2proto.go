@@ -23,6 +23,24 @@ func ToProfileProto(evt *DomainEvent) (proto.Message, error) {
 		return encodeProfileEngagementRemoved(evt, payload), nil
 	case *ProfileWatchProgressed:
 		return encodeProfileWatchProgressed(evt, payload), nil
+	case *ProfileCollectionItemAdded:
+		return &profilev1.CollectionItemAddedEvent{
+			EventId:      evt.EventID.String(),
+			UserId:       payload.UserID.String(),
+			CollectionId: payload.CollectionID.String(),
+			VideoId:      payload.VideoID.String(),
+			Position:     payload.Position,
+			OccurredAt:   timestamppb.New(payload.OccurredAt.UTC()),
+		}, nil
+	case *ProfileCollectionItemRemoved:
+		return &profilev1.CollectionItemRemovedEvent{
+			EventId:      evt.EventID.String(),
+			UserId:       payload.UserID.String(),
+			CollectionId: payload.CollectionID.String(),
+			VideoId:      payload.VideoID.String(),
+			Reason:       payload.Reason,
+			OccurredAt:   timestamppb.New(payload.OccurredAt.UTC()),
+		}, nil
 	default:
 		return nil, fmt.Errorf("events: unsupported profile payload type %T", payload)
 	}
@@ -39,12 +57,25 @@ func encodeProfileEngagementAdded(evt *DomainEvent, payload *ProfileEngagementAd
 	if payload.Source != nil {
 		out.Source = *payload.Source
 	}
+	if len(payload.Metadata) > 0 {
+		out.Context = toEngagementContextProto(payload.Metadata)
+	}
 	if payload.Stats != nil {
 		out.Stats = toProfileStatsProto(payload.Stats)
 	}
 	return out
 }
 
+// toEngagementContextProto 提取互动上下文中的已知字段，未知键不进入事件契约。
+func toEngagementContextProto(metadata map[string]any) *profilev1.EngagementContext {
+	entryPoint, _ := metadata[po.EngagementMetadataEntryPoint].(string)
+	device, _ := metadata[po.EngagementMetadataDevice].(string)
+	if entryPoint == "" && device == "" {
+		return nil
+	}
+	return &profilev1.EngagementContext{EntryPoint: entryPoint, Device: device}
+}
+
 func encodeProfileEngagementRemoved(evt *DomainEvent, payload *ProfileEngagementRemoved) *profilev1.EngagementRemovedEvent {
 	out := &profilev1.EngagementRemovedEvent{
 		EventId:      evt.EventID.String(),
@@ -89,6 +120,8 @@ func toProfileStatsProto(stats *po.ProfileVideoStats) *profilev1.VideoStats {
 	return &profilev1.VideoStats{
 		LikeCount:         stats.LikeCount,
 		BookmarkCount:     stats.BookmarkCount,
+		DislikeCount:      stats.DislikeCount,
+		ShareCount:        stats.ShareCount,
 		UniqueWatchers:    stats.UniqueWatchers,
 		TotalWatchSeconds: stats.TotalWatchSeconds,
 		UpdatedAt:         timestamppb.New(stats.UpdatedAt.UTC()),
@@ -121,6 +154,12 @@ func engagementTypeToProto(v string) profilev1.FavoriteType {
 		return profilev1.FavoriteType_FAVORITE_TYPE_LIKE
 	case "bookmark":
 		return profilev1.FavoriteType_FAVORITE_TYPE_BOOKMARK
+	case "dislike":
+		return profilev1.FavoriteType_FAVORITE_TYPE_DISLIKE
+	case "not_interested":
+		return profilev1.FavoriteType_FAVORITE_TYPE_NOT_INTERESTED
+	case "share":
+		return profilev1.FavoriteType_FAVORITE_TYPE_SHARE
 	default:
 		return profilev1.FavoriteType_FAVORITE_TYPE_UNSPECIFIED
 	}
@@ -0,0 +1,22 @@
+package po
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditEntry 表示 profile.audit_trail 表中的审计记录。
+type AuditEntry struct {
+	AuditID      uuid.UUID
+	UserID       uuid.UUID
+	ActorType    string
+	ActorID      string
+	Action       string
+	ResourceType string
+	ResourceID   string
+	Before       map[string]any
+	After        map[string]any
+	TraceID      *string
+	CreatedAt    time.Time
+}
@@ -0,0 +1,26 @@
+package po
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Collection 表示 profile.collections 表中的用户收藏夹。
+type Collection struct {
+	CollectionID uuid.UUID
+	UserID       uuid.UUID
+	Name         string
+	ItemCount    int64
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// CollectionItem 表示 profile.collection_items 表中的收藏夹成员。
+type CollectionItem struct {
+	CollectionID uuid.UUID
+	VideoID      uuid.UUID
+	UserID       uuid.UUID
+	Position     int32
+	AddedAt      time.Time
+}
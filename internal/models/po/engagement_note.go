@@ -0,0 +1,19 @@
+package po
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EngagementNote 表示 profile.engagement_notes 表中附加在收藏上的私有笔记。
+type EngagementNote struct {
+	NoteID          uuid.UUID
+	UserID          uuid.UUID
+	VideoID         uuid.UUID
+	EngagementType  string
+	Body            string
+	PositionSeconds *int32
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
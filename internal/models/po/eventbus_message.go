@@ -0,0 +1,18 @@
+package po
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventBusMessage 表示 profile.eventbus_messages 中的一条 Postgres 事件总线消息。
+type EventBusMessage struct {
+	ID          int64
+	Topic       string
+	EventID     *uuid.UUID
+	OrderingKey string
+	Data        []byte
+	Attributes  map[string]string
+	PublishedAt time.Time
+}
@@ -0,0 +1,39 @@
+package po
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Inbox 隔离区中事件的状态。
+const (
+	InboxQuarantineFailing     = "failing"
+	InboxQuarantineQuarantined = "quarantined"
+	InboxQuarantineResolved    = "resolved"
+	InboxQuarantineDiscarded   = "discarded"
+)
+
+// InboxQuarantine 表示 profile.inbox_quarantine 中处理失败的 Inbox 事件。
+type InboxQuarantine struct {
+	EventID       uuid.UUID
+	ConsumerGroup string
+	SourceService string
+	EventType     string
+	AggregateID   *string
+	Payload       []byte
+	Status        string
+	Attempts      int32
+	MaxAttempts   int32
+	LastError     *string
+	FirstFailedAt time.Time
+	LastFailedAt  time.Time
+	QuarantinedAt *time.Time
+	ResolvedAt    *time.Time
+	ResolvedBy    *string
+}
+
+// Quarantined 报告事件是否已停止自动重试（隔离或已丢弃）。
+func (q *InboxQuarantine) Quarantined() bool {
+	return q != nil && (q.Status == InboxQuarantineQuarantined || q.Status == InboxQuarantineDiscarded)
+}
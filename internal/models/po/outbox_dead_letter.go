@@ -0,0 +1,23 @@
+package po
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxDeadLetter 表示 profile.outbox_dead_letters 中被移出发布队列的 Outbox 事件。
+type OutboxDeadLetter struct {
+	EventID          uuid.UUID
+	AggregateType    string
+	AggregateID      uuid.UUID
+	EventType        string
+	Payload          []byte
+	Headers          map[string]string
+	OccurredAt       time.Time
+	DeliveryAttempts int32
+	LastError        *string
+	DeadLetteredAt   time.Time
+	Reason           *string
+	DeadLetteredBy   *string
+}
@@ -0,0 +1,18 @@
+package po
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PendingVideoEvent 表示 profile.pending_video_events 中暂存的乱序 Catalog 事件。
+type PendingVideoEvent struct {
+	VideoID    uuid.UUID
+	Version    int64
+	EventID    uuid.UUID
+	EventType  string
+	Payload    []byte
+	OccurredAt *time.Time
+	ParkedAt   time.Time
+}
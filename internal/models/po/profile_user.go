@@ -8,15 +8,38 @@ import (
 
 // ProfileUser 表示 profile.users 表中的档案记录。
 type ProfileUser struct {
-	UserID          uuid.UUID
-	DisplayName     string
-	AvatarURL       *string
-	ProfileVersion  int64
-	PreferencesJSON map[string]any
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
+	UserID            uuid.UUID
+	DisplayName       string
+	AvatarURL         *string
+	ProfileVersion    int64
+	PreferencesJSON   map[string]any
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	AccountStatus     string
+	PendingDeletionAt *time.Time
+	DeletedAt         *time.Time
+	PublicDisplayName bool
+	PublicAvatar      bool
+	PublicBookmarks   bool
+	PublicStats       bool
+	PreferredLocale   *string
+	PreferredTimezone *string
+}
+
+// ProfilePublicStats 表示公开主页展示的用户聚合统计。
+type ProfilePublicStats struct {
+	LikeCount         int64
+	BookmarkCount     int64
+	WatchedVideoCount int64
+	TotalWatchSeconds int64
 }
 
+// 互动 metadata 中的已知键。
+const (
+	EngagementMetadataEntryPoint = "entry_point"
+	EngagementMetadataDevice     = "device"
+)
+
 // ProfileEngagement 表示 profile.engagements 表的行。
 type ProfileEngagement struct {
 	UserID         uuid.UUID
@@ -25,6 +48,8 @@ type ProfileEngagement struct {
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 	DeletedAt      *time.Time
+	Source         string
+	Metadata       map[string]any
 }
 
 // ProfileWatchLog 表示 profile.watch_logs 表的行。
@@ -62,6 +87,8 @@ type ProfileVideoStats struct {
 	VideoID           uuid.UUID
 	LikeCount         int64
 	BookmarkCount     int64
+	DislikeCount      int64
+	ShareCount        int64
 	UniqueWatchers    int64
 	TotalWatchSeconds int64
 	UpdatedAt         time.Time
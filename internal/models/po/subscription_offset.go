@@ -0,0 +1,20 @@
+package po
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SubscriptionOffset 表示 profile.subscription_offsets 中某个消费组的高水位。
+type SubscriptionOffset struct {
+	ConsumerGroup  string
+	Subscription   string
+	MessageID      *string
+	PublishTime    *time.Time
+	LastEventID    *uuid.UUID
+	LastOccurredAt *time.Time
+	LagMillis      int64
+	UpdatedBy      string
+	UpdatedAt      time.Time
+}
@@ -0,0 +1,46 @@
+package vo
+
+import (
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+)
+
+// AuditEntry 表示向上层返回的审计记录视图。
+type AuditEntry struct {
+	AuditID      string
+	UserID       string
+	ActorType    string
+	ActorID      string
+	Action       string
+	ResourceType string
+	ResourceID   string
+	Before       map[string]any
+	After        map[string]any
+	TraceID      string
+	CreatedAt    time.Time
+}
+
+// NewAuditEntryFromPO 将审计记录 PO 转换为 VO。
+func NewAuditEntryFromPO(entry *po.AuditEntry) *AuditEntry {
+	if entry == nil {
+		return nil
+	}
+	traceID := ""
+	if entry.TraceID != nil {
+		traceID = *entry.TraceID
+	}
+	return &AuditEntry{
+		AuditID:      entry.AuditID.String(),
+		UserID:       entry.UserID.String(),
+		ActorType:    entry.ActorType,
+		ActorID:      entry.ActorID,
+		Action:       entry.Action,
+		ResourceType: entry.ResourceType,
+		ResourceID:   entry.ResourceID,
+		Before:       entry.Before,
+		After:        entry.After,
+		TraceID:      traceID,
+		CreatedAt:    entry.CreatedAt,
+	}
+}
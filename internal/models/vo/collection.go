@@ -0,0 +1,51 @@
+package vo
+
+import (
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+)
+
+// Collection 表示向上层返回的收藏夹视图。
+type Collection struct {
+	CollectionID string
+	Name         string
+	ItemCount    int64
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// CollectionItem 表示收藏夹成员，Video 由 videos_projection 补水，缺失时为 nil。
+type CollectionItem struct {
+	VideoID  string
+	Position int32
+	AddedAt  time.Time
+	Video    *ProfileVideoMetadata
+}
+
+// NewCollectionFromPO 将收藏夹 PO 转换为 VO。
+func NewCollectionFromPO(collection *po.Collection) *Collection {
+	if collection == nil {
+		return nil
+	}
+	return &Collection{
+		CollectionID: collection.CollectionID.String(),
+		Name:         collection.Name,
+		ItemCount:    collection.ItemCount,
+		CreatedAt:    collection.CreatedAt,
+		UpdatedAt:    collection.UpdatedAt,
+	}
+}
+
+// NewCollectionItemFromPO 将收藏夹成员 PO 转换为 VO，video 可为 nil。
+func NewCollectionItemFromPO(item *po.CollectionItem, video *ProfileVideoMetadata) *CollectionItem {
+	if item == nil {
+		return nil
+	}
+	return &CollectionItem{
+		VideoID:  item.VideoID.String(),
+		Position: item.Position,
+		AddedAt:  item.AddedAt,
+		Video:    video,
+	}
+}
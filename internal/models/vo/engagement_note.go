@@ -0,0 +1,32 @@
+package vo
+
+import (
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+)
+
+// EngagementNote 表示向上层返回的收藏笔记视图。
+type EngagementNote struct {
+	NoteID          string
+	VideoID         string
+	Body            string
+	PositionSeconds *int32
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// NewEngagementNoteFromPO 将笔记 PO 转换为 VO。
+func NewEngagementNoteFromPO(note *po.EngagementNote) *EngagementNote {
+	if note == nil {
+		return nil
+	}
+	return &EngagementNote{
+		NoteID:          note.NoteID.String(),
+		VideoID:         note.VideoID.String(),
+		Body:            note.Body,
+		PositionSeconds: note.PositionSeconds,
+		CreatedAt:       note.CreatedAt,
+		UpdatedAt:       note.UpdatedAt,
+	}
+}
@@ -9,13 +9,54 @@ import (
 
 // Profile 表示向上层返回的档案视图。
 type Profile struct {
-	UserID         string
-	DisplayName    string
-	AvatarURL      *string
-	ProfileVersion int64
-	Preferences    Preferences
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	UserID            string
+	DisplayName       string
+	AvatarURL         *string
+	ProfileVersion    int64
+	Preferences       Preferences
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	AccountStatus     string
+	PendingDeletionAt *time.Time
+	DeletedAt         *time.Time
+	Visibility        ProfileVisibility
+	PreferredLocale   *string
+	PreferredTimezone *string
+}
+
+// ProfileVisibility 表示公开主页的字段可见性设置。
+type ProfileVisibility struct {
+	PublicDisplayName bool
+	PublicAvatar      bool
+	PublicBookmarks   bool
+	PublicStats       bool
+}
+
+// PublicProfile 表示公开主页视图，未公开的字段为空。
+type PublicProfile struct {
+	UserID          string
+	DisplayName     *string
+	AvatarURL       *string
+	BookmarksPublic bool
+	Stats           *PublicProfileStats
+}
+
+// PublicProfileStats 表示公开主页的聚合统计。
+type PublicProfileStats struct {
+	LikeCount         int64
+	BookmarkCount     int64
+	WatchedVideoCount int64
+	TotalWatchSeconds int64
+}
+
+// AvatarUpload 表示签发给客户端的头像直传目标。
+type AvatarUpload struct {
+	Key       string
+	URL       string
+	Method    string
+	Headers   map[string]string
+	ExpiresAt time.Time
+	MaxBytes  int64
 }
 
 // Preferences 表示结构化的偏好设置。
@@ -29,6 +70,9 @@ type Preferences struct {
 type FavoriteState struct {
 	HasLiked      bool
 	HasBookmarked bool
+	HasDisliked   bool
+	HasShared     bool
+	NotInterested bool
 	LikedAt       *time.Time
 	BookmarkedAt  *time.Time
 }
@@ -37,6 +81,8 @@ type FavoriteState struct {
 type ProfileVideoStats struct {
 	LikeCount         int64
 	BookmarkCount     int64
+	DislikeCount      int64
+	ShareCount        int64
 	UniqueWatchers    int64
 	TotalWatchSeconds int64
 	UpdatedAt         time.Time
@@ -98,12 +144,23 @@ func NewProfileFromPO(poProfile *po.ProfileUser, prefs Preferences) *Profile {
 		return nil
 	}
 	return &Profile{
-		UserID:         poProfile.UserID.String(),
-		DisplayName:    poProfile.DisplayName,
-		AvatarURL:      poProfile.AvatarURL,
-		ProfileVersion: poProfile.ProfileVersion,
-		Preferences:    prefs,
-		CreatedAt:      poProfile.CreatedAt,
-		UpdatedAt:      poProfile.UpdatedAt,
+		UserID:            poProfile.UserID.String(),
+		DisplayName:       poProfile.DisplayName,
+		AvatarURL:         poProfile.AvatarURL,
+		ProfileVersion:    poProfile.ProfileVersion,
+		Preferences:       prefs,
+		CreatedAt:         poProfile.CreatedAt,
+		UpdatedAt:         poProfile.UpdatedAt,
+		AccountStatus:     poProfile.AccountStatus,
+		PendingDeletionAt: poProfile.PendingDeletionAt,
+		DeletedAt:         poProfile.DeletedAt,
+		Visibility: ProfileVisibility{
+			PublicDisplayName: poProfile.PublicDisplayName,
+			PublicAvatar:      poProfile.PublicAvatar,
+			PublicBookmarks:   poProfile.PublicBookmarks,
+			PublicStats:       poProfile.PublicStats,
+		},
+		PreferredLocale:   poProfile.PreferredLocale,
+		PreferredTimezone: poProfile.PreferredTimezone,
 	}
 }
@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories/mappers"
+	profiledb "github.com/bionicotaku/lingo-services-profile/internal/repositories/profiledb"
+
+	"github.com/bionicotaku/lingo-utils/txmanager"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// AuditTrailRepository 访问 profile.audit_trail，只追加不修改。
+type AuditTrailRepository struct {
+	db      *pgxpool.Pool
+	queries *profiledb.Queries
+	log     *log.Helper
+}
+
+// NewAuditTrailRepository 构造仓储实例。
+func NewAuditTrailRepository(db *pgxpool.Pool, logger log.Logger) *AuditTrailRepository {
+	return &AuditTrailRepository{
+		db:      db,
+		queries: profiledb.New(db),
+		log:     log.NewHelper(logger),
+	}
+}
+
+// AppendAuditEntryInput 描述审计记录写入参数。
+type AppendAuditEntryInput struct {
+	UserID       uuid.UUID
+	ActorType    string
+	ActorID      string
+	Action       string
+	ResourceType string
+	ResourceID   string
+	Before       map[string]any
+	After        map[string]any
+	TraceID      string
+}
+
+// ListAuditEntriesFilter 描述审计记录查询条件，零值字段表示不过滤。
+type ListAuditEntriesFilter struct {
+	UserID  *uuid.UUID
+	ActorID string
+	Action  string
+	TraceID string
+	Since   *time.Time
+	Until   *time.Time
+	Limit   int32
+	Offset  int32
+}
+
+// Append 在调用方事务内追加一条审计记录。
+func (r *AuditTrailRepository) Append(ctx context.Context, sess txmanager.Session, input AppendAuditEntryInput) error {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	before, err := marshalJSONObject(input.Before)
+	if err != nil {
+		return fmt.Errorf("marshal audit before: %w", err)
+	}
+	after, err := marshalJSONObject(input.After)
+	if err != nil {
+		return fmt.Errorf("marshal audit after: %w", err)
+	}
+	params := profiledb.InsertAuditEntryParams{
+		UserID:       input.UserID,
+		ActorType:    input.ActorType,
+		ActorID:      input.ActorID,
+		Action:       input.Action,
+		ResourceType: input.ResourceType,
+		ResourceID:   input.ResourceID,
+		Before:       before,
+		After:        after,
+		TraceID:      mappers.ToPgText(nonEmpty(input.TraceID)),
+	}
+	if _, err := queries.InsertAuditEntry(ctx, params); err != nil {
+		r.log.WithContext(ctx).Errorf("append audit entry failed: user=%s action=%s err=%v", input.UserID, input.Action, err)
+		return fmt.Errorf("append audit entry: %w", err)
+	}
+	return nil
+}
+
+// List 按过滤条件倒序返回审计记录。
+func (r *AuditTrailRepository) List(ctx context.Context, sess txmanager.Session, filter ListAuditEntriesFilter) ([]*po.AuditEntry, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	params := profiledb.ListAuditEntriesParams{
+		UserID:      mappers.ToPgUUID(filter.UserID),
+		ActorID:     mappers.ToPgText(nonEmpty(filter.ActorID)),
+		Action:      mappers.ToPgText(nonEmpty(filter.Action)),
+		TraceID:     mappers.ToPgText(nonEmpty(filter.TraceID)),
+		Since:       mappers.ToPgTimestamptzPtr(filter.Since),
+		Until:       mappers.ToPgTimestamptzPtr(filter.Until),
+		LimitCount:  filter.Limit,
+		OffsetCount: filter.Offset,
+	}
+	rows, err := queries.ListAuditEntries(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("list audit entries: %w", err)
+	}
+	result := make([]*po.AuditEntry, 0, len(rows))
+	for _, row := range rows {
+		entry, err := mappers.AuditEntryFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, entry)
+	}
+	return result, nil
+}
+
+func marshalJSONObject(value map[string]any) ([]byte, error) {
+	if value == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(value)
+}
+
+func nonEmpty(value string) *string {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,143 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories/mappers"
+	profiledb "github.com/bionicotaku/lingo-services-profile/internal/repositories/profiledb"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// EventBusChannel 为 Postgres 事件总线使用的 LISTEN/NOTIFY 通道，payload 为 topic。
+const EventBusChannel = "profile_eventbus"
+
+// EventBusRepository 维护 profile.eventbus_messages 与 profile.eventbus_subscriptions，供 Postgres 事件总线驱动使用。
+type EventBusRepository struct {
+	db      *pgxpool.Pool
+	queries *profiledb.Queries
+	log     *log.Helper
+}
+
+// NewEventBusRepository 构造仓储实例。
+func NewEventBusRepository(db *pgxpool.Pool, logger log.Logger) *EventBusRepository {
+	return &EventBusRepository{
+		db:      db,
+		queries: profiledb.New(db),
+		log:     log.NewHelper(logger),
+	}
+}
+
+// AppendEventBusMessageInput 描述一条待追加的事件总线消息。
+type AppendEventBusMessageInput struct {
+	Topic       string
+	EventID     *uuid.UUID
+	OrderingKey string
+	Data        []byte
+	Attributes  map[string]string
+}
+
+// Append 追加消息并通知 EventBusChannel，返回消息 id 与发布时间。
+// 通知失败不影响已提交的消息，订阅端会在下一次轮询时读取。
+func (r *EventBusRepository) Append(ctx context.Context, input AppendEventBusMessageInput) (int64, time.Time, error) {
+	attributes := input.Attributes
+	if attributes == nil {
+		attributes = map[string]string{}
+	}
+	encoded, err := json.Marshal(attributes)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("marshal eventbus attributes: %w", err)
+	}
+	row, err := r.queries.AppendEventBusMessage(ctx, profiledb.AppendEventBusMessageParams{
+		Topic:       input.Topic,
+		EventID:     mappers.ToPgUUID(input.EventID),
+		OrderingKey: input.OrderingKey,
+		Data:        input.Data,
+		Attributes:  encoded,
+	})
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("append eventbus message: %w", err)
+	}
+	if err := r.queries.NotifyEventBus(ctx, input.Topic); err != nil {
+		r.log.WithContext(ctx).Warnw("msg", "eventbus notify failed", "topic", input.Topic, "error", err)
+	}
+	return row.ID, row.PublishedAt.Time.UTC(), nil
+}
+
+// EnsureSubscription 登记订阅并返回其游标；首次登记时游标位于 topic 当前末尾。
+func (r *EventBusRepository) EnsureSubscription(ctx context.Context, subscription, topic string) (int64, error) {
+	lastID, err := r.queries.EnsureEventBusSubscription(ctx, profiledb.EnsureEventBusSubscriptionParams{
+		Subscription: subscription,
+		Topic:        topic,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("ensure eventbus subscription: %w", err)
+	}
+	return lastID, nil
+}
+
+// ListAfter 按 id 顺序返回 topic 中 id 大于 afterID 的最多 limit 条消息。
+func (r *EventBusRepository) ListAfter(ctx context.Context, topic string, afterID int64, limit int32) ([]*po.EventBusMessage, error) {
+	rows, err := r.queries.ListEventBusMessagesAfter(ctx, profiledb.ListEventBusMessagesAfterParams{
+		Topic:      topic,
+		AfterID:    afterID,
+		LimitCount: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list eventbus messages: %w", err)
+	}
+	result := make([]*po.EventBusMessage, 0, len(rows))
+	for _, row := range rows {
+		msg, err := mappers.EventBusMessageFromRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("decode eventbus message %d: %w", row.ID, err)
+		}
+		result = append(result, msg)
+	}
+	return result, nil
+}
+
+// Advance 将订阅游标推进到 lastID，只前进不回退。
+func (r *EventBusRepository) Advance(ctx context.Context, subscription string, lastID int64) error {
+	err := r.queries.AdvanceEventBusSubscription(ctx, profiledb.AdvanceEventBusSubscriptionParams{
+		Subscription: subscription,
+		LastID:       lastID,
+	})
+	if err != nil {
+		return fmt.Errorf("advance eventbus subscription: %w", err)
+	}
+	return nil
+}
+
+// Prune 删除早于 before 且已被所属 topic 全部订阅确认的消息，返回删除数量。
+func (r *EventBusRepository) Prune(ctx context.Context, before time.Time) (int64, error) {
+	affected, err := r.queries.PruneEventBusMessages(ctx, mappers.ToPgTimestamptzPtr(&before))
+	if err != nil {
+		return 0, fmt.Errorf("prune eventbus messages: %w", err)
+	}
+	return affected, nil
+}
+
+// Listen 在专用连接上 LISTEN EventBusChannel，并尝试获取订阅的会话级 advisory lock。
+// 返回 false 表示该订阅已由其他消费者持有；锁与监听随连接关闭释放，调用方不得将连接归还连接池。
+func (r *EventBusRepository) Listen(ctx context.Context, conn *pgx.Conn, subscription string) (bool, error) {
+	locked, err := profiledb.New(conn).TryLockEventBusSubscription(ctx, subscription)
+	if err != nil {
+		return false, fmt.Errorf("lock eventbus subscription: %w", err)
+	}
+	if !locked {
+		return false, nil
+	}
+	// LISTEN 的通道为标识符，无法参数化，这里使用固定常量。
+	if _, err := conn.Exec(ctx, "LISTEN "+EventBusChannel); err != nil {
+		return false, fmt.Errorf("listen eventbus channel: %w", err)
+	}
+	return true, nil
+}
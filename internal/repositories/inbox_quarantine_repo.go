@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories/mappers"
+	profiledb "github.com/bionicotaku/lingo-services-profile/internal/repositories/profiledb"
+
+	"github.com/bionicotaku/lingo-utils/txmanager"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrInboxQuarantineNotFound 表示隔离区中不存在指定事件，或事件已被处理。
+var ErrInboxQuarantineNotFound = errors.New("inbox quarantine entry not found")
+
+// InboxQuarantineRepository 维护 profile.inbox_quarantine。
+type InboxQuarantineRepository struct {
+	db      *pgxpool.Pool
+	queries *profiledb.Queries
+	log     *log.Helper
+}
+
+// NewInboxQuarantineRepository 构造仓储实例。
+func NewInboxQuarantineRepository(db *pgxpool.Pool, logger log.Logger) *InboxQuarantineRepository {
+	return &InboxQuarantineRepository{
+		db:      db,
+		queries: profiledb.New(db),
+		log:     log.NewHelper(logger),
+	}
+}
+
+// RecordInboxFailureInput 描述一次 handler 处理失败。
+type RecordInboxFailureInput struct {
+	EventID       uuid.UUID
+	ConsumerGroup string
+	SourceService string
+	EventType     string
+	AggregateID   *string
+	Payload       []byte
+	MaxAttempts   int
+	LastError     string
+}
+
+// RecordFailure 累加失败次数并返回最新状态，达到 MaxAttempts 时转为 quarantined。
+// 调用方应传入 nil 会话，使计数不随 handler 事务回滚。
+func (r *InboxQuarantineRepository) RecordFailure(ctx context.Context, sess txmanager.Session, input RecordInboxFailureInput) (*po.InboxQuarantine, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	lastErr := input.LastError
+	row, err := queries.RecordInboxFailure(ctx, profiledb.RecordInboxFailureParams{
+		EventID:       input.EventID,
+		ConsumerGroup: input.ConsumerGroup,
+		SourceService: input.SourceService,
+		EventType:     input.EventType,
+		AggregateID:   mappers.ToPgText(input.AggregateID),
+		Payload:       input.Payload,
+		MaxAttempts:   int32(input.MaxAttempts),
+		LastError:     mappers.ToPgText(&lastErr),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("record inbox failure: %w", err)
+	}
+	return mappers.InboxQuarantineFromRow(row), nil
+}
+
+// Get 返回隔离区中的事件。
+func (r *InboxQuarantineRepository) Get(ctx context.Context, sess txmanager.Session, eventID uuid.UUID) (*po.InboxQuarantine, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	row, err := queries.GetInboxQuarantine(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInboxQuarantineNotFound
+		}
+		return nil, fmt.Errorf("get inbox quarantine: %w", err)
+	}
+	return mappers.InboxQuarantineFromRow(row), nil
+}
+
+// ListInboxQuarantineInput 描述隔离区查询条件，Status 为空时返回全部状态。
+type ListInboxQuarantineInput struct {
+	ConsumerGroup string
+	Status        string
+	Limit         int32
+}
+
+// List 按最近失败时间倒序列出隔离区事件。
+func (r *InboxQuarantineRepository) List(ctx context.Context, sess txmanager.Session, input ListInboxQuarantineInput) ([]*po.InboxQuarantine, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	rows, err := queries.ListInboxQuarantine(ctx, profiledb.ListInboxQuarantineParams{
+		ConsumerGroup: input.ConsumerGroup,
+		Status:        input.Status,
+		LimitCount:    input.Limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list inbox quarantine: %w", err)
+	}
+	result := make([]*po.InboxQuarantine, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, mappers.InboxQuarantineFromRow(row))
+	}
+	return result, nil
+}
+
+// Resolve 将 failing/quarantined 事件标记为 resolved 或 discarded；事件不存在或已处理时返回 ErrInboxQuarantineNotFound。
+func (r *InboxQuarantineRepository) Resolve(ctx context.Context, sess txmanager.Session, eventID uuid.UUID, status string, resolvedBy *string) error {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	affected, err := queries.ResolveInboxQuarantine(ctx, profiledb.ResolveInboxQuarantineParams{
+		Status:     status,
+		ResolvedBy: mappers.ToPgText(resolvedBy),
+		EventID:    eventID,
+	})
+	if err != nil {
+		return fmt.Errorf("resolve inbox quarantine: %w", err)
+	}
+	if affected == 0 {
+		return ErrInboxQuarantineNotFound
+	}
+	return nil
+}
@@ -3,17 +3,26 @@ package repositories
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories/mappers"
+	profiledb "github.com/bionicotaku/lingo-services-profile/internal/repositories/profiledb"
+
 	outboxpkg "github.com/bionicotaku/lingo-utils/outbox"
 	outboxcfg "github.com/bionicotaku/lingo-utils/outbox/config"
 	"github.com/bionicotaku/lingo-utils/outbox/store"
 	"github.com/bionicotaku/lingo-utils/txmanager"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// ErrInboxEventNotFound 表示 Inbox 中不存在指定事件。
+var ErrInboxEventNotFound = errors.New("inbox event not found")
+
 // InboxMessage 表示需要记录的外部事件。
 type InboxMessage = store.InboxMessage
 
@@ -23,6 +32,7 @@ type InboxEvent = store.InboxEvent
 // InboxRepository 封装共享 Inbox 仓储实现。
 type InboxRepository struct {
 	delegate *store.Repository
+	queries  *profiledb.Queries
 }
 
 // NewInboxRepository 构建 Inbox 仓储，内部复用 lingo-utils/outbox 仓储。
@@ -30,9 +40,9 @@ func NewInboxRepository(db *pgxpool.Pool, logger log.Logger, cfg outboxcfg.Confi
 	storeRepo, err := outboxpkg.NewRepository(db, logger, outboxpkg.RepositoryOptions{Schema: cfg.Schema})
 	if err != nil {
 		log.NewHelper(logger).Errorw("msg", "init inbox repository failed", "error", err)
-		return &InboxRepository{delegate: store.NewRepository(db, logger)}
+		return &InboxRepository{delegate: store.NewRepository(db, logger), queries: profiledb.New(db)}
 	}
-	return &InboxRepository{delegate: storeRepo}
+	return &InboxRepository{delegate: storeRepo, queries: profiledb.New(db)}
 }
 
 // Insert 在事务内记录 Inbox 事件。
@@ -50,6 +60,66 @@ func (r *InboxRepository) RecordError(ctx context.Context, sess txmanager.Sessio
 	return r.delegate.RecordInboxError(ctx, sess, eventID, lastErr)
 }
 
+// OldestPendingReceivedAt 返回指定来源最早一条未处理事件的接收时间，sourceService 为空时统计全部来源。
+// 没有未处理事件时返回 nil。
+func (r *InboxRepository) OldestPendingReceivedAt(ctx context.Context, sourceService string) (*time.Time, error) {
+	oldest, err := r.queries.GetOldestPendingInboxReceivedAt(ctx, sourceService)
+	if err != nil {
+		return nil, fmt.Errorf("query oldest pending inbox event: %w", err)
+	}
+	if !oldest.Valid {
+		return nil, nil
+	}
+	ts := oldest.Time.UTC()
+	return &ts, nil
+}
+
+// Get 按 event_id 读取 Inbox 事件（含原始载荷）。
+func (r *InboxRepository) Get(ctx context.Context, eventID uuid.UUID) (*InboxEvent, error) {
+	row, err := r.queries.GetInboxEvent(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInboxEventNotFound
+		}
+		return nil, fmt.Errorf("get inbox event: %w", err)
+	}
+	return mappers.InboxEventFromRow(row), nil
+}
+
+// InboxCursor 以 (received_at, event_id) 定位 Inbox 事件的全序位置。
+type InboxCursor struct {
+	ReceivedAt time.Time
+	EventID    uuid.UUID
+}
+
+// ListInboxForReplayInput 描述按位置区间读取 Inbox 事件的条件，区间为 (After, Until]。
+type ListInboxForReplayInput struct {
+	SourceService string
+	After         InboxCursor
+	Until         InboxCursor
+	Limit         int32
+}
+
+// ListForReplay 按 (received_at, event_id) 升序读取区间内的 Inbox 事件，不区分是否已处理。
+func (r *InboxRepository) ListForReplay(ctx context.Context, input ListInboxForReplayInput) ([]*InboxEvent, error) {
+	rows, err := r.queries.ListInboxEventsForReplay(ctx, profiledb.ListInboxEventsForReplayParams{
+		SourceService:   input.SourceService,
+		AfterReceivedAt: mappers.ToPgTimestamptzPtr(&input.After.ReceivedAt),
+		AfterEventID:    input.After.EventID,
+		UntilReceivedAt: mappers.ToPgTimestamptzPtr(&input.Until.ReceivedAt),
+		UntilEventID:    input.Until.EventID,
+		BatchSize:       input.Limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list inbox events for replay: %w", err)
+	}
+	result := make([]*InboxEvent, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, mappers.InboxEventFromRow(row))
+	}
+	return result, nil
+}
+
 // Shared 暴露底层共享仓储，供 inbox runner 使用。
 func (r *InboxRepository) Shared() *store.Repository {
 	return r.delegate
@@ -12,4 +12,11 @@ var ProviderSet = wire.NewSet(
 	NewProfileWatchLogsRepository,
 	NewProfileVideoProjectionRepository,
 	NewProfileVideoStatsRepository,
+	NewAuditTrailRepository,
+	NewProfileCollectionsRepository,
+	NewProfileEngagementNotesRepository,
+	NewPendingVideoEventsRepository,
+	NewSubscriptionOffsetsRepository,
+	NewInboxQuarantineRepository,
+	NewEventBusRepository,
 )
@@ -9,6 +9,7 @@ import (
 	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
 	profiledb "github.com/bionicotaku/lingo-services-profile/internal/repositories/profiledb"
 
+	"github.com/bionicotaku/lingo-utils/outbox/store"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -40,18 +41,31 @@ func ProfileUserFromRow(row profiledb.ProfileUser) (*po.ProfileUser, error) {
 		}
 	}
 	return &po.ProfileUser{
-		UserID:          row.UserID,
-		DisplayName:     row.DisplayName,
-		AvatarURL:       textPtr(row.AvatarUrl),
-		ProfileVersion:  row.ProfileVersion,
-		PreferencesJSON: prefs,
-		CreatedAt:       mustTimestamp(row.CreatedAt),
-		UpdatedAt:       mustTimestamp(row.UpdatedAt),
+		UserID:            row.UserID,
+		DisplayName:       row.DisplayName,
+		AvatarURL:         textPtr(row.AvatarUrl),
+		ProfileVersion:    row.ProfileVersion,
+		PreferencesJSON:   prefs,
+		CreatedAt:         mustTimestamp(row.CreatedAt),
+		UpdatedAt:         mustTimestamp(row.UpdatedAt),
+		AccountStatus:     row.AccountStatus,
+		PendingDeletionAt: timestampPtr(row.PendingDeletionAt),
+		DeletedAt:         timestampPtr(row.DeletedAt),
+		PublicDisplayName: row.PublicDisplayName,
+		PublicAvatar:      row.PublicAvatar,
+		PublicBookmarks:   row.PublicBookmarks,
+		PublicStats:       row.PublicStats,
+		PreferredLocale:   textPtr(row.PreferredLocale),
+		PreferredTimezone: textPtr(row.PreferredTimezone),
 	}, nil
 }
 
-// ProfileEngagementFromRow 转换互动记录。
+// ProfileEngagementFromRow 转换互动记录；metadata 非 JSON 对象时降级为空映射。
 func ProfileEngagementFromRow(row profiledb.ProfileEngagement) *po.ProfileEngagement {
+	metadata, err := decodeJSONObject(row.Metadata)
+	if err != nil {
+		metadata = map[string]any{}
+	}
 	return &po.ProfileEngagement{
 		UserID:         row.UserID,
 		VideoID:        row.VideoID,
@@ -59,6 +73,8 @@ func ProfileEngagementFromRow(row profiledb.ProfileEngagement) *po.ProfileEngage
 		CreatedAt:      mustTimestamp(row.CreatedAt),
 		UpdatedAt:      mustTimestamp(row.UpdatedAt),
 		DeletedAt:      timestampPtr(row.DeletedAt),
+		Source:         row.Source,
+		Metadata:       metadata,
 	}
 }
 
@@ -102,6 +118,8 @@ func ProfileVideoStatsFromRow(row profiledb.ProfileVideoStat) *po.ProfileVideoSt
 		VideoID:           row.VideoID,
 		LikeCount:         row.LikeCount,
 		BookmarkCount:     row.BookmarkCount,
+		DislikeCount:      row.DislikeCount,
+		ShareCount:        row.ShareCount,
 		UniqueWatchers:    row.UniqueWatchers,
 		TotalWatchSeconds: row.TotalWatchSeconds,
 		UpdatedAt:         mustTimestamp(row.UpdatedAt),
@@ -185,3 +203,245 @@ func int8Ptr(value pgtype.Int8) *int64 {
 	v := value.Int64
 	return &v
 }
+
+// AuditEntryFromRow 转换审计记录，before/after 解码为字段映射。
+func AuditEntryFromRow(row profiledb.ProfileAuditTrail) (*po.AuditEntry, error) {
+	before, err := decodeJSONObject(row.Before)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal audit before: %w", err)
+	}
+	after, err := decodeJSONObject(row.After)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal audit after: %w", err)
+	}
+	return &po.AuditEntry{
+		AuditID:      row.AuditID,
+		UserID:       row.UserID,
+		ActorType:    row.ActorType,
+		ActorID:      row.ActorID,
+		Action:       row.Action,
+		ResourceType: row.ResourceType,
+		ResourceID:   row.ResourceID,
+		Before:       before,
+		After:        after,
+		TraceID:      textPtr(row.TraceID),
+		CreatedAt:    mustTimestamp(row.CreatedAt),
+	}, nil
+}
+
+// ToPgUUID 将 *uuid.UUID 转换为 pgtype.UUID，nil 表示 SQL NULL。
+func ToPgUUID(value *uuid.UUID) pgtype.UUID {
+	if value == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: *value, Valid: true}
+}
+
+func decodeJSONObject(data []byte) (map[string]any, error) {
+	out := map[string]any{}
+	if len(data) == 0 {
+		return out, nil
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CollectionFromRow 转换收藏夹记录；itemCount 由查询聚合得出。
+func CollectionFromRow(row profiledb.ProfileCollection, itemCount int64) *po.Collection {
+	return &po.Collection{
+		CollectionID: row.CollectionID,
+		UserID:       row.UserID,
+		Name:         row.Name,
+		ItemCount:    itemCount,
+		CreatedAt:    mustTimestamp(row.CreatedAt),
+		UpdatedAt:    mustTimestamp(row.UpdatedAt),
+	}
+}
+
+// CollectionItemFromRow 转换收藏夹成员记录。
+func CollectionItemFromRow(row profiledb.ProfileCollectionItem) *po.CollectionItem {
+	return &po.CollectionItem{
+		CollectionID: row.CollectionID,
+		VideoID:      row.VideoID,
+		UserID:       row.UserID,
+		Position:     row.Position,
+		AddedAt:      mustTimestamp(row.AddedAt),
+	}
+}
+
+// EngagementNoteFromRow 将 sqlc 行转换为笔记 PO。
+func EngagementNoteFromRow(row profiledb.ProfileEngagementNote) *po.EngagementNote {
+	return &po.EngagementNote{
+		NoteID:          row.NoteID,
+		UserID:          row.UserID,
+		VideoID:         row.VideoID,
+		EngagementType:  row.EngagementType,
+		Body:            row.Body,
+		PositionSeconds: int4Ptr(row.PositionSeconds),
+		CreatedAt:       mustTimestamp(row.CreatedAt),
+		UpdatedAt:       mustTimestamp(row.UpdatedAt),
+	}
+}
+
+// ToPgInt4 将 *int32 转换为 pgtype.Int4。
+func ToPgInt4(value *int32) pgtype.Int4 {
+	if value == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: *value, Valid: true}
+}
+
+func int4Ptr(value pgtype.Int4) *int32 {
+	if !value.Valid {
+		return nil
+	}
+	v := value.Int32
+	return &v
+}
+
+// PendingVideoEventFromRow 将 sqlc 生成的暂存事件行转换为 po.PendingVideoEvent。
+func PendingVideoEventFromRow(row profiledb.ProfilePendingVideoEvent) *po.PendingVideoEvent {
+	return &po.PendingVideoEvent{
+		VideoID:    row.VideoID,
+		Version:    row.Version,
+		EventID:    row.EventID,
+		EventType:  row.EventType,
+		Payload:    row.Payload,
+		OccurredAt: timestampPtr(row.OccurredAt),
+		ParkedAt:   mustTimestamp(row.ParkedAt),
+	}
+}
+
+// SubscriptionOffsetFromRow 将 sqlc 生成的位点行转换为 po.SubscriptionOffset。
+func SubscriptionOffsetFromRow(row profiledb.ProfileSubscriptionOffset) *po.SubscriptionOffset {
+	return &po.SubscriptionOffset{
+		ConsumerGroup:  row.ConsumerGroup,
+		Subscription:   row.Subscription,
+		MessageID:      textPtr(row.MessageID),
+		PublishTime:    timestampPtr(row.PublishTime),
+		LastEventID:    uuidPtr(row.LastEventID),
+		LastOccurredAt: timestampPtr(row.LastOccurredAt),
+		LagMillis:      row.LagMillis,
+		UpdatedBy:      row.UpdatedBy,
+		UpdatedAt:      mustTimestamp(row.UpdatedAt),
+	}
+}
+
+func uuidPtr(value pgtype.UUID) *uuid.UUID {
+	if !value.Valid {
+		return nil
+	}
+	id := uuid.UUID(value.Bytes)
+	return &id
+}
+
+// InboxEventFromRow 将 sqlc 生成的 Inbox 行转换为共享仓储的 store.InboxEvent。
+func InboxEventFromRow(row profiledb.ProfileInboxEvent) *store.InboxEvent {
+	return &store.InboxEvent{
+		EventID:       row.EventID,
+		SourceService: row.SourceService,
+		EventType:     row.EventType,
+		AggregateType: textPtr(row.AggregateType),
+		AggregateID:   textPtr(row.AggregateID),
+		Payload:       row.Payload,
+		ReceivedAt:    mustTimestamp(row.ReceivedAt),
+		ProcessedAt:   timestampPtr(row.ProcessedAt),
+		LastError:     textPtr(row.LastError),
+	}
+}
+
+// InboxQuarantineFromRow 将 sqlc 生成的隔离区行转换为 po.InboxQuarantine。
+func InboxQuarantineFromRow(row profiledb.ProfileInboxQuarantine) *po.InboxQuarantine {
+	return &po.InboxQuarantine{
+		EventID:       row.EventID,
+		ConsumerGroup: row.ConsumerGroup,
+		SourceService: row.SourceService,
+		EventType:     row.EventType,
+		AggregateID:   textPtr(row.AggregateID),
+		Payload:       row.Payload,
+		Status:        row.Status,
+		Attempts:      row.Attempts,
+		MaxAttempts:   row.MaxAttempts,
+		LastError:     textPtr(row.LastError),
+		FirstFailedAt: mustTimestamp(row.FirstFailedAt),
+		LastFailedAt:  mustTimestamp(row.LastFailedAt),
+		QuarantinedAt: timestampPtr(row.QuarantinedAt),
+		ResolvedAt:    timestampPtr(row.ResolvedAt),
+		ResolvedBy:    textPtr(row.ResolvedBy),
+	}
+}
+
+// OutboxEventFromRow 将 sqlc 生成的 Outbox 行转换为共享仓储的 store.Event。
+func OutboxEventFromRow(row profiledb.ProfileOutboxEvent) (*store.Event, error) {
+	headers, err := decodeHeaders(row.Headers)
+	if err != nil {
+		return nil, err
+	}
+	return &store.Event{
+		EventID:          row.EventID,
+		AggregateType:    row.AggregateType,
+		AggregateID:      row.AggregateID,
+		EventType:        row.EventType,
+		Payload:          row.Payload,
+		Headers:          headers,
+		OccurredAt:       mustTimestamp(row.OccurredAt),
+		AvailableAt:      mustTimestamp(row.AvailableAt),
+		PublishedAt:      timestampPtr(row.PublishedAt),
+		DeliveryAttempts: row.DeliveryAttempts,
+		LastError:        textPtr(row.LastError),
+		LockToken:        textPtr(row.LockToken),
+		LockedAt:         timestampPtr(row.LockedAt),
+	}, nil
+}
+
+// OutboxDeadLetterFromRow 将 sqlc 生成的死信行转换为 po.OutboxDeadLetter。
+func OutboxDeadLetterFromRow(row profiledb.ProfileOutboxDeadLetter) (*po.OutboxDeadLetter, error) {
+	headers, err := decodeHeaders(row.Headers)
+	if err != nil {
+		return nil, err
+	}
+	return &po.OutboxDeadLetter{
+		EventID:          row.EventID,
+		AggregateType:    row.AggregateType,
+		AggregateID:      row.AggregateID,
+		EventType:        row.EventType,
+		Payload:          row.Payload,
+		Headers:          headers,
+		OccurredAt:       mustTimestamp(row.OccurredAt),
+		DeliveryAttempts: row.DeliveryAttempts,
+		LastError:        textPtr(row.LastError),
+		DeadLetteredAt:   mustTimestamp(row.DeadLetteredAt),
+		Reason:           textPtr(row.Reason),
+		DeadLetteredBy:   textPtr(row.DeadLetteredBy),
+	}, nil
+}
+
+// EventBusMessageFromRow 将 sqlc 生成的事件总线消息行转换为 po.EventBusMessage。
+func EventBusMessageFromRow(row profiledb.ProfileEventbusMessage) (*po.EventBusMessage, error) {
+	attributes, err := decodeHeaders(row.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	return &po.EventBusMessage{
+		ID:          row.ID,
+		Topic:       row.Topic,
+		EventID:     uuidPtr(row.EventID),
+		OrderingKey: row.OrderingKey,
+		Data:        row.Data,
+		Attributes:  attributes,
+		PublishedAt: mustTimestamp(row.PublishedAt),
+	}, nil
+}
+
+func decodeHeaders(data []byte) (map[string]string, error) {
+	headers := map[string]string{}
+	if len(data) == 0 {
+		return headers, nil
+	}
+	if err := json.Unmarshal(data, &headers); err != nil {
+		return nil, fmt.Errorf("unmarshal headers: %w", err)
+	}
+	return headers, nil
+}
@@ -2,14 +2,21 @@ package repositories
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories/mappers"
+	profiledb "github.com/bionicotaku/lingo-services-profile/internal/repositories/profiledb"
+
 	outboxpkg "github.com/bionicotaku/lingo-utils/outbox"
 	outboxcfg "github.com/bionicotaku/lingo-utils/outbox/config"
 	"github.com/bionicotaku/lingo-utils/outbox/store"
 	"github.com/bionicotaku/lingo-utils/txmanager"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -19,9 +26,24 @@ type OutboxMessage = store.Message
 // OutboxEvent 表示从数据库读取的待发布事件。
 type OutboxEvent = store.Event
 
-// OutboxRepository 封装共享仓储实现，维持原有依赖注入接口。
+// ErrOutboxEventNotFound 表示 outbox_events 中不存在指定事件，或事件已发布。
+var ErrOutboxEventNotFound = errors.New("outbox event not found")
+
+// ErrOutboxDeadLetterNotFound 表示死信表中不存在指定事件。
+var ErrOutboxDeadLetterNotFound = errors.New("outbox dead letter not found")
+
+// Outbox 事件列表的状态过滤条件。
+const (
+	OutboxStatusAll       = ""
+	OutboxStatusPending   = "pending"
+	OutboxStatusFailing   = "failing"
+	OutboxStatusPublished = "published"
+)
+
+// OutboxRepository 封装共享仓储实现，维持原有依赖注入接口；运维查询与保留期清理直接使用 sqlc 查询。
 type OutboxRepository struct {
 	delegate *store.Repository
+	queries  *profiledb.Queries
 }
 
 // NewOutboxRepository 构建 Outbox 仓储，内部复用 lingo-utils/outbox/repository。
@@ -29,9 +51,9 @@ func NewOutboxRepository(db *pgxpool.Pool, logger log.Logger, cfg outboxcfg.Conf
 	storeRepo, err := outboxpkg.NewRepository(db, logger, outboxpkg.RepositoryOptions{Schema: cfg.Schema})
 	if err != nil {
 		log.NewHelper(logger).Errorw("msg", "init outbox repository failed", "error", err)
-		return &OutboxRepository{delegate: store.NewRepository(db, logger)}
+		return &OutboxRepository{delegate: store.NewRepository(db, logger), queries: profiledb.New(db)}
 	}
-	return &OutboxRepository{delegate: storeRepo}
+	return &OutboxRepository{delegate: storeRepo, queries: profiledb.New(db)}
 }
 
 // Enqueue 在事务内插入 Outbox 事件。
@@ -59,7 +81,219 @@ func (r *OutboxRepository) CountPending(ctx context.Context) (int64, error) {
 	return r.delegate.CountPending(ctx)
 }
 
+// OutboxBacklogStats 汇总未发布事件的积压情况。
+type OutboxBacklogStats struct {
+	Pending int64
+	// OldestAvailableAt 为未发布事件中最早的 available_at，无积压时为 nil。
+	OldestAvailableAt *time.Time
+	// OverMaxAttempts 为投递次数达到上限、发布器不再重试的事件数。
+	OverMaxAttempts int64
+	// LeaseExpired 为租约早于 leaseExpiredBefore 仍未释放的事件数。
+	LeaseExpired int64
+}
+
+// BacklogStats 在一次扫描内统计未发布事件的数量、最早可发布时间、超出重试上限与租约过期的数量。
+func (r *OutboxRepository) BacklogStats(ctx context.Context, maxAttempts int32, leaseExpiredBefore time.Time) (OutboxBacklogStats, error) {
+	row, err := r.queries.GetOutboxBacklogStats(ctx, profiledb.GetOutboxBacklogStatsParams{
+		MaxAttempts:        maxAttempts,
+		LeaseExpiredBefore: mappers.ToPgTimestamptzPtr(&leaseExpiredBefore),
+	})
+	if err != nil {
+		return OutboxBacklogStats{}, fmt.Errorf("query outbox backlog stats: %w", err)
+	}
+	stats := OutboxBacklogStats{
+		Pending:         row.Pending,
+		OverMaxAttempts: row.OverMaxAttempts,
+		LeaseExpired:    row.LeaseExpired,
+	}
+	if row.OldestAvailableAt.Valid {
+		ts := row.OldestAvailableAt.Time.UTC()
+		stats.OldestAvailableAt = &ts
+	}
+	return stats, nil
+}
+
 // Shared 返回底层通用实现，供共享任务使用。
 func (r *OutboxRepository) Shared() *store.Repository {
 	return r.delegate
 }
+
+// ListOutboxEventsInput 描述 Outbox 事件查询条件。
+type ListOutboxEventsInput struct {
+	// Status 取 OutboxStatus* 常量，空值表示全部。
+	Status string
+	// MinAttempts 仅返回投递次数不少于该值的事件。
+	MinAttempts int32
+	Limit       int32
+}
+
+// List 按 occurred_at 倒序列出 Outbox 事件。
+func (r *OutboxRepository) List(ctx context.Context, sess txmanager.Session, input ListOutboxEventsInput) ([]*OutboxEvent, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	rows, err := queries.ListOutboxEvents(ctx, profiledb.ListOutboxEventsParams{
+		Status:      input.Status,
+		MinAttempts: input.MinAttempts,
+		LimitCount:  input.Limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list outbox events: %w", err)
+	}
+	result := make([]*OutboxEvent, 0, len(rows))
+	for _, row := range rows {
+		event, err := mappers.OutboxEventFromRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("map outbox event %s: %w", row.EventID, err)
+		}
+		result = append(result, event)
+	}
+	return result, nil
+}
+
+// Get 返回指定 Outbox 事件。
+func (r *OutboxRepository) Get(ctx context.Context, sess txmanager.Session, eventID uuid.UUID) (*OutboxEvent, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	row, err := queries.GetOutboxEvent(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrOutboxEventNotFound
+		}
+		return nil, fmt.Errorf("get outbox event: %w", err)
+	}
+	return mappers.OutboxEventFromRow(row)
+}
+
+// Retry 将未发布事件立即放回发布队列并释放租约；resetAttempts 为 true 时清零投递次数。
+// 事件不存在或已发布时返回 ErrOutboxEventNotFound。
+func (r *OutboxRepository) Retry(ctx context.Context, sess txmanager.Session, eventID uuid.UUID, resetAttempts bool) error {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	affected, err := queries.RetryOutboxEvent(ctx, profiledb.RetryOutboxEventParams{
+		ResetAttempts: resetAttempts,
+		EventID:       eventID,
+	})
+	if err != nil {
+		return fmt.Errorf("retry outbox event: %w", err)
+	}
+	if affected == 0 {
+		return ErrOutboxEventNotFound
+	}
+	return nil
+}
+
+// DeadLetter 将未发布事件移入 profile.outbox_dead_letters，发布器不再扫描该事件。
+// 事件不存在或已发布时返回 ErrOutboxEventNotFound。
+func (r *OutboxRepository) DeadLetter(ctx context.Context, sess txmanager.Session, eventID uuid.UUID, reason, operator *string) error {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	affected, err := queries.DeadLetterOutboxEvent(ctx, profiledb.DeadLetterOutboxEventParams{
+		Reason:         mappers.ToPgText(reason),
+		DeadLetteredBy: mappers.ToPgText(operator),
+		EventID:        eventID,
+	})
+	if err != nil {
+		return fmt.Errorf("dead-letter outbox event: %w", err)
+	}
+	if affected == 0 {
+		return ErrOutboxEventNotFound
+	}
+	return nil
+}
+
+// ListDeadLetters 按移入时间倒序列出死信事件。
+func (r *OutboxRepository) ListDeadLetters(ctx context.Context, sess txmanager.Session, limit int32) ([]*po.OutboxDeadLetter, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	rows, err := queries.ListOutboxDeadLetters(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list outbox dead letters: %w", err)
+	}
+	result := make([]*po.OutboxDeadLetter, 0, len(rows))
+	for _, row := range rows {
+		item, err := mappers.OutboxDeadLetterFromRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("map outbox dead letter %s: %w", row.EventID, err)
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+// GetDeadLetter 返回指定死信事件。
+func (r *OutboxRepository) GetDeadLetter(ctx context.Context, sess txmanager.Session, eventID uuid.UUID) (*po.OutboxDeadLetter, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	row, err := queries.GetOutboxDeadLetter(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrOutboxDeadLetterNotFound
+		}
+		return nil, fmt.Errorf("get outbox dead letter: %w", err)
+	}
+	return mappers.OutboxDeadLetterFromRow(row)
+}
+
+// RequeueDeadLetter 将死信事件移回 outbox_events 并立即可发布。
+func (r *OutboxRepository) RequeueDeadLetter(ctx context.Context, sess txmanager.Session, eventID uuid.UUID, resetAttempts bool) error {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	affected, err := queries.RequeueOutboxDeadLetter(ctx, profiledb.RequeueOutboxDeadLetterParams{
+		ResetAttempts: resetAttempts,
+		EventID:       eventID,
+	})
+	if err != nil {
+		return fmt.Errorf("requeue outbox dead letter: %w", err)
+	}
+	if affected == 0 {
+		return ErrOutboxDeadLetterNotFound
+	}
+	return nil
+}
+
+// DeletePublishedBefore 删除最多 batchSize 条 published_at 早于 before 的事件，返回删除数量。
+func (r *OutboxRepository) DeletePublishedBefore(ctx context.Context, sess txmanager.Session, before time.Time, batchSize int32) (int64, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	affected, err := queries.DeletePublishedOutboxEvents(ctx, profiledb.DeletePublishedOutboxEventsParams{
+		PublishedBefore: mappers.ToPgTimestamptzPtr(&before),
+		BatchSize:       batchSize,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("delete published outbox events: %w", err)
+	}
+	return affected, nil
+}
+
+// ArchivePublishedBefore 将最多 batchSize 条 published_at 早于 before 的事件移入 profile.outbox_events_archive，
+// 返回从 outbox_events 移出的数量（归档表中已存在的事件不重复写入，但同样计入），调用方可据此循环直至为 0。
+func (r *OutboxRepository) ArchivePublishedBefore(ctx context.Context, sess txmanager.Session, before time.Time, batchSize int32) (int64, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	moved, err := queries.ArchivePublishedOutboxEvents(ctx, profiledb.ArchivePublishedOutboxEventsParams{
+		PublishedBefore: mappers.ToPgTimestamptzPtr(&before),
+		BatchSize:       batchSize,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("archive published outbox events: %w", err)
+	}
+	return moved, nil
+}
@@ -0,0 +1,93 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories/mappers"
+	profiledb "github.com/bionicotaku/lingo-services-profile/internal/repositories/profiledb"
+
+	"github.com/bionicotaku/lingo-utils/txmanager"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PendingVideoEventsRepository 维护 profile.pending_video_events。
+type PendingVideoEventsRepository struct {
+	db      *pgxpool.Pool
+	queries *profiledb.Queries
+	log     *log.Helper
+}
+
+// NewPendingVideoEventsRepository 构造仓储实例。
+func NewPendingVideoEventsRepository(db *pgxpool.Pool, logger log.Logger) *PendingVideoEventsRepository {
+	return &PendingVideoEventsRepository{
+		db:      db,
+		queries: profiledb.New(db),
+		log:     log.NewHelper(logger),
+	}
+}
+
+// ParkVideoEventInput 描述暂存事件写入参数。
+type ParkVideoEventInput struct {
+	VideoID    uuid.UUID
+	Version    int64
+	EventID    uuid.UUID
+	EventType  string
+	Payload    []byte
+	OccurredAt *time.Time
+}
+
+// Park 暂存一条乱序事件；同一事件（event_id）重复暂存时忽略，返回是否新写入。
+func (r *PendingVideoEventsRepository) Park(ctx context.Context, sess txmanager.Session, input ParkVideoEventInput) (bool, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	affected, err := queries.InsertPendingVideoEvent(ctx, profiledb.InsertPendingVideoEventParams{
+		VideoID:    input.VideoID,
+		Version:    input.Version,
+		EventID:    input.EventID,
+		EventType:  input.EventType,
+		Payload:    input.Payload,
+		OccurredAt: mappers.ToPgTimestamptzPtr(input.OccurredAt),
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("park video event failed: video=%s event=%s version=%d err=%v", input.VideoID, input.EventID, input.Version, err)
+		return false, fmt.Errorf("park video event: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// ListByVideo 按版本号、发生时间升序返回视频的全部暂存事件；版本号相同（含未携带版本号的 0）时按发生时间回放。
+func (r *PendingVideoEventsRepository) ListByVideo(ctx context.Context, sess txmanager.Session, videoID uuid.UUID) ([]*po.PendingVideoEvent, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	rows, err := queries.ListPendingVideoEvents(ctx, videoID)
+	if err != nil {
+		return nil, fmt.Errorf("list pending video events: %w", err)
+	}
+	result := make([]*po.PendingVideoEvent, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, mappers.PendingVideoEventFromRow(row))
+	}
+	return result, nil
+}
+
+// DeleteByVideo 删除视频的全部暂存事件，返回删除行数。
+func (r *PendingVideoEventsRepository) DeleteByVideo(ctx context.Context, sess txmanager.Session, videoID uuid.UUID) (int64, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	affected, err := queries.DeletePendingVideoEvents(ctx, videoID)
+	if err != nil {
+		return 0, fmt.Errorf("delete pending video events: %w", err)
+	}
+	return affected, nil
+}
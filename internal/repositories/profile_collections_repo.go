@@ -0,0 +1,298 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories/mappers"
+	profiledb "github.com/bionicotaku/lingo-services-profile/internal/repositories/profiledb"
+
+	"github.com/bionicotaku/lingo-utils/txmanager"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	pgUniqueViolation          = "23505"
+	collectionsNameUniqueIndex = "collections_user_name_uidx"
+)
+
+var (
+	// ErrCollectionNotFound 表示收藏夹不存在。
+	ErrCollectionNotFound = errors.New("collection not found")
+	// ErrCollectionItemNotFound 表示视频不在收藏夹中。
+	ErrCollectionItemNotFound = errors.New("collection item not found")
+	// ErrCollectionNameConflict 表示同一用户下已存在同名（大小写不敏感）收藏夹。
+	ErrCollectionNameConflict = errors.New("collection name conflict")
+)
+
+// ProfileCollectionsRepository 维护 profile.collections 与 profile.collection_items。
+type ProfileCollectionsRepository struct {
+	db      *pgxpool.Pool
+	queries *profiledb.Queries
+	log     *log.Helper
+}
+
+// NewProfileCollectionsRepository 构造仓储实例。
+func NewProfileCollectionsRepository(db *pgxpool.Pool, logger log.Logger) *ProfileCollectionsRepository {
+	return &ProfileCollectionsRepository{
+		db:      db,
+		queries: profiledb.New(db),
+		log:     log.NewHelper(logger),
+	}
+}
+
+// AddCollectionItemInput 描述收藏夹成员写入参数。
+type AddCollectionItemInput struct {
+	CollectionID uuid.UUID
+	UserID       uuid.UUID
+	VideoID      uuid.UUID
+	AddedAt      *time.Time
+}
+
+// Create 新建收藏夹。
+func (r *ProfileCollectionsRepository) Create(ctx context.Context, sess txmanager.Session, userID uuid.UUID, name string) (*po.Collection, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	row, err := queries.InsertCollection(ctx, profiledb.InsertCollectionParams{
+		UserID: userID,
+		Name:   name,
+	})
+	if err != nil {
+		if isCollectionNameConflict(err) {
+			return nil, ErrCollectionNameConflict
+		}
+		r.log.WithContext(ctx).Errorf("create collection failed: user=%s err=%v", userID, err)
+		return nil, fmt.Errorf("create collection: %w", err)
+	}
+	return mappers.CollectionFromRow(row, 0), nil
+}
+
+// Get 返回收藏夹及其成员数量。
+func (r *ProfileCollectionsRepository) Get(ctx context.Context, sess txmanager.Session, collectionID uuid.UUID) (*po.Collection, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	row, err := queries.GetCollection(ctx, collectionID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCollectionNotFound
+		}
+		return nil, fmt.Errorf("get collection: %w", err)
+	}
+	return mappers.CollectionFromRow(profiledb.ProfileCollection{
+		CollectionID: row.CollectionID,
+		UserID:       row.UserID,
+		Name:         row.Name,
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+	}, row.ItemCount), nil
+}
+
+// Lock 在事务内锁定收藏夹行并返回所属用户，用于串行化成员变更与排序。
+func (r *ProfileCollectionsRepository) Lock(ctx context.Context, sess txmanager.Session, collectionID uuid.UUID) (uuid.UUID, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	row, err := queries.LockCollection(ctx, collectionID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, ErrCollectionNotFound
+		}
+		return uuid.Nil, fmt.Errorf("lock collection: %w", err)
+	}
+	return row.UserID, nil
+}
+
+// ListByUser 按创建时间升序返回用户的收藏夹。
+func (r *ProfileCollectionsRepository) ListByUser(ctx context.Context, sess txmanager.Session, userID uuid.UUID, limit, offset int32) ([]*po.Collection, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	rows, err := queries.ListCollectionsByUser(ctx, profiledb.ListCollectionsByUserParams{
+		UserID: userID,
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list collections: %w", err)
+	}
+	result := make([]*po.Collection, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, mappers.CollectionFromRow(profiledb.ProfileCollection{
+			CollectionID: row.CollectionID,
+			UserID:       row.UserID,
+			Name:         row.Name,
+			CreatedAt:    row.CreatedAt,
+			UpdatedAt:    row.UpdatedAt,
+		}, row.ItemCount))
+	}
+	return result, nil
+}
+
+// Rename 修改收藏夹名称。
+func (r *ProfileCollectionsRepository) Rename(ctx context.Context, sess txmanager.Session, collectionID uuid.UUID, name string) (*po.Collection, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	row, err := queries.RenameCollection(ctx, profiledb.RenameCollectionParams{
+		CollectionID: collectionID,
+		Name:         name,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCollectionNotFound
+		}
+		if isCollectionNameConflict(err) {
+			return nil, ErrCollectionNameConflict
+		}
+		return nil, fmt.Errorf("rename collection: %w", err)
+	}
+	return mappers.CollectionFromRow(profiledb.ProfileCollection{
+		CollectionID: row.CollectionID,
+		UserID:       row.UserID,
+		Name:         row.Name,
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+	}, row.ItemCount), nil
+}
+
+// Delete 删除收藏夹，成员随外键级联删除。
+func (r *ProfileCollectionsRepository) Delete(ctx context.Context, sess txmanager.Session, collectionID uuid.UUID) error {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	affected, err := queries.DeleteCollection(ctx, collectionID)
+	if err != nil {
+		return fmt.Errorf("delete collection: %w", err)
+	}
+	if affected == 0 {
+		return ErrCollectionNotFound
+	}
+	return nil
+}
+
+// AddItem 将视频追加到收藏夹末尾；视频已在收藏夹中时返回 (nil, false, nil)。
+func (r *ProfileCollectionsRepository) AddItem(ctx context.Context, sess txmanager.Session, input AddCollectionItemInput) (*po.CollectionItem, bool, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	row, err := queries.InsertCollectionItem(ctx, profiledb.InsertCollectionItemParams{
+		CollectionID: input.CollectionID,
+		UserID:       input.UserID,
+		VideoID:      input.VideoID,
+		AddedAt:      mappers.ToPgTimestamptzPtr(input.AddedAt),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, false, nil
+		}
+		r.log.WithContext(ctx).Errorf("add collection item failed: collection=%s video=%s err=%v", input.CollectionID, input.VideoID, err)
+		return nil, false, fmt.Errorf("add collection item: %w", err)
+	}
+	return mappers.CollectionItemFromRow(row), true, nil
+}
+
+// GetItem 返回收藏夹中的单个成员。
+func (r *ProfileCollectionsRepository) GetItem(ctx context.Context, sess txmanager.Session, collectionID, videoID uuid.UUID) (*po.CollectionItem, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	row, err := queries.GetCollectionItem(ctx, profiledb.GetCollectionItemParams{
+		CollectionID: collectionID,
+		VideoID:      videoID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCollectionItemNotFound
+		}
+		return nil, fmt.Errorf("get collection item: %w", err)
+	}
+	return mappers.CollectionItemFromRow(row), nil
+}
+
+// RemoveItem 从收藏夹移除视频，返回是否实际删除。
+func (r *ProfileCollectionsRepository) RemoveItem(ctx context.Context, sess txmanager.Session, collectionID, videoID uuid.UUID) (bool, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	affected, err := queries.DeleteCollectionItem(ctx, profiledb.DeleteCollectionItemParams{
+		CollectionID: collectionID,
+		VideoID:      videoID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("remove collection item: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// ListItems 按 position 升序分页返回收藏夹成员。
+func (r *ProfileCollectionsRepository) ListItems(ctx context.Context, sess txmanager.Session, collectionID uuid.UUID, limit, offset int32) ([]*po.CollectionItem, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	rows, err := queries.ListCollectionItems(ctx, profiledb.ListCollectionItemsParams{
+		CollectionID: collectionID,
+		Limit:        limit,
+		Offset:       offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list collection items: %w", err)
+	}
+	result := make([]*po.CollectionItem, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, mappers.CollectionItemFromRow(row))
+	}
+	return result, nil
+}
+
+// ListItemVideoIDs 按当前顺序返回收藏夹内全部视频 ID。
+func (r *ProfileCollectionsRepository) ListItemVideoIDs(ctx context.Context, sess txmanager.Session, collectionID uuid.UUID) ([]uuid.UUID, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	ids, err := queries.ListCollectionItemVideoIDs(ctx, collectionID)
+	if err != nil {
+		return nil, fmt.Errorf("list collection item ids: %w", err)
+	}
+	return ids, nil
+}
+
+// Reorder 按 videoIDs 的顺序将成员 position 重写为 1..n，返回更新行数。
+func (r *ProfileCollectionsRepository) Reorder(ctx context.Context, sess txmanager.Session, collectionID uuid.UUID, videoIDs []uuid.UUID) (int64, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	affected, err := queries.ReorderCollectionItems(ctx, profiledb.ReorderCollectionItemsParams{
+		CollectionID: collectionID,
+		VideoIds:     videoIDs,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("reorder collection items: %w", err)
+	}
+	return affected, nil
+}
+
+func isCollectionNameConflict(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation && pgErr.ConstraintName == collectionsNameUniqueIndex
+}
@@ -0,0 +1,158 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories/mappers"
+	profiledb "github.com/bionicotaku/lingo-services-profile/internal/repositories/profiledb"
+
+	"github.com/bionicotaku/lingo-utils/txmanager"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrEngagementNoteNotFound 表示笔记不存在。
+var ErrEngagementNoteNotFound = errors.New("engagement note not found")
+
+// ProfileEngagementNotesRepository 维护 profile.engagement_notes。
+type ProfileEngagementNotesRepository struct {
+	db      *pgxpool.Pool
+	queries *profiledb.Queries
+	log     *log.Helper
+}
+
+// NewProfileEngagementNotesRepository 构造仓储实例。
+func NewProfileEngagementNotesRepository(db *pgxpool.Pool, logger log.Logger) *ProfileEngagementNotesRepository {
+	return &ProfileEngagementNotesRepository{
+		db:      db,
+		queries: profiledb.New(db),
+		log:     log.NewHelper(logger),
+	}
+}
+
+// InsertEngagementNoteInput 描述笔记写入参数。
+type InsertEngagementNoteInput struct {
+	UserID          uuid.UUID
+	VideoID         uuid.UUID
+	EngagementType  string
+	Body            string
+	PositionSeconds *int32
+}
+
+// Insert 新增笔记；关联的互动行必须存在（外键约束）。
+func (r *ProfileEngagementNotesRepository) Insert(ctx context.Context, sess txmanager.Session, input InsertEngagementNoteInput) (*po.EngagementNote, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	row, err := queries.InsertEngagementNote(ctx, profiledb.InsertEngagementNoteParams{
+		UserID:          input.UserID,
+		VideoID:         input.VideoID,
+		EngagementType:  input.EngagementType,
+		Body:            input.Body,
+		PositionSeconds: mappers.ToPgInt4(input.PositionSeconds),
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("insert engagement note failed: user=%s video=%s err=%v", input.UserID, input.VideoID, err)
+		return nil, fmt.Errorf("insert engagement note: %w", err)
+	}
+	return mappers.EngagementNoteFromRow(row), nil
+}
+
+// Get 返回单条笔记。
+func (r *ProfileEngagementNotesRepository) Get(ctx context.Context, sess txmanager.Session, noteID uuid.UUID) (*po.EngagementNote, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	row, err := queries.GetEngagementNote(ctx, noteID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrEngagementNoteNotFound
+		}
+		return nil, fmt.Errorf("get engagement note: %w", err)
+	}
+	return mappers.EngagementNoteFromRow(row), nil
+}
+
+// Update 整体替换笔记正文与时间点，positionSeconds 为 nil 时清空时间点。
+func (r *ProfileEngagementNotesRepository) Update(ctx context.Context, sess txmanager.Session, noteID uuid.UUID, body string, positionSeconds *int32) (*po.EngagementNote, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	row, err := queries.UpdateEngagementNote(ctx, profiledb.UpdateEngagementNoteParams{
+		NoteID:          noteID,
+		Body:            body,
+		PositionSeconds: mappers.ToPgInt4(positionSeconds),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrEngagementNoteNotFound
+		}
+		return nil, fmt.Errorf("update engagement note: %w", err)
+	}
+	return mappers.EngagementNoteFromRow(row), nil
+}
+
+// Delete 删除笔记。
+func (r *ProfileEngagementNotesRepository) Delete(ctx context.Context, sess txmanager.Session, noteID uuid.UUID) error {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	affected, err := queries.DeleteEngagementNote(ctx, noteID)
+	if err != nil {
+		return fmt.Errorf("delete engagement note: %w", err)
+	}
+	if affected == 0 {
+		return ErrEngagementNoteNotFound
+	}
+	return nil
+}
+
+// Count 返回用户在某视频某互动类型下的笔记数量。
+func (r *ProfileEngagementNotesRepository) Count(ctx context.Context, sess txmanager.Session, userID, videoID uuid.UUID, engagementType string) (int64, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	count, err := queries.CountEngagementNotes(ctx, profiledb.CountEngagementNotesParams{
+		UserID:         userID,
+		VideoID:        videoID,
+		EngagementType: engagementType,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("count engagement notes: %w", err)
+	}
+	return count, nil
+}
+
+// ListByVideos 批量返回用户在给定视频上的笔记，按视频内时间点、创建时间排序（无时间点的笔记靠后）。
+func (r *ProfileEngagementNotesRepository) ListByVideos(ctx context.Context, sess txmanager.Session, userID uuid.UUID, engagementType string, videoIDs []uuid.UUID) ([]*po.EngagementNote, error) {
+	if len(videoIDs) == 0 {
+		return []*po.EngagementNote{}, nil
+	}
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	rows, err := queries.ListEngagementNotesByVideos(ctx, profiledb.ListEngagementNotesByVideosParams{
+		UserID:         userID,
+		EngagementType: engagementType,
+		VideoIds:       videoIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list engagement notes: %w", err)
+	}
+	result := make([]*po.EngagementNote, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, mappers.EngagementNoteFromRow(row))
+	}
+	return result, nil
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+const defaultEngagementSource = "manual"
+
 // ProfileEngagementsRepository 维护用户互动记录。
 type ProfileEngagementsRepository struct {
 	db      *pgxpool.Pool
@@ -39,6 +41,9 @@ type UpsertProfileEngagementInput struct {
 	VideoID        uuid.UUID
 	EngagementType string
 	OccurredAt     *time.Time
+	// Source 为空时按 manual 写入；Metadata 覆盖上一次写入的上下文。
+	Source   string
+	Metadata map[string]any
 }
 
 // SoftDeleteProfileEngagementInput 描述互动删除参数。
@@ -59,11 +64,21 @@ func (r *ProfileEngagementsRepository) Upsert(ctx context.Context, sess txmanage
 	if input.OccurredAt != nil {
 		occurred = *input.OccurredAt
 	}
+	source := input.Source
+	if source == "" {
+		source = defaultEngagementSource
+	}
+	metadata, err := marshalJSONObject(input.Metadata)
+	if err != nil {
+		return fmt.Errorf("marshal engagement metadata: %w", err)
+	}
 	params := profiledb.UpsertEngagementParams{
 		UserID:         input.UserID,
 		VideoID:        input.VideoID,
 		EngagementType: input.EngagementType,
 		Column4:        occurred,
+		Source:         source,
+		Metadata:       metadata,
 	}
 	if err := queries.UpsertEngagement(ctx, params); err != nil {
 		r.log.WithContext(ctx).Errorf("upsert engagement failed: user=%s video=%s type=%s err=%v", input.UserID, input.VideoID, input.EngagementType, err)
@@ -111,19 +126,18 @@ func (r *ProfileEngagementsRepository) Get(ctx context.Context, sess txmanager.S
 	return mappers.ProfileEngagementFromRow(row), nil
 }
 
-// ListByUser 返回用户互动列表。
-func (r *ProfileEngagementsRepository) ListByUser(ctx context.Context, sess txmanager.Session, userID uuid.UUID, engagementType *string, includeDeleted bool, limit, offset int32) ([]*po.ProfileEngagement, error) {
+// ListByUser 返回用户互动列表；engagementTypes 为空时不按类型过滤。
+func (r *ProfileEngagementsRepository) ListByUser(ctx context.Context, sess txmanager.Session, userID uuid.UUID, engagementTypes []string, includeDeleted bool, limit, offset int32) ([]*po.ProfileEngagement, error) {
 	queries := r.queries
 	if sess != nil {
 		queries = queries.WithTx(sess.Tx())
 	}
-	filterType := ""
-	if engagementType != nil {
-		filterType = *engagementType
+	if engagementTypes == nil {
+		engagementTypes = []string{}
 	}
 	params := profiledb.ListEngagementsByUserParams{
 		UserID:  userID,
-		Column2: filterType,
+		Column2: engagementTypes,
 		Column3: !includeDeleted,
 		Limit:   limit,
 		Offset:  offset,
@@ -139,5 +153,25 @@ func (r *ProfileEngagementsRepository) ListByUser(ctx context.Context, sess txma
 	return result, nil
 }
 
+// ListByUserVideo 返回用户对单个视频的全部互动记录（含已取消），按类型排序。
+func (r *ProfileEngagementsRepository) ListByUserVideo(ctx context.Context, sess txmanager.Session, userID, videoID uuid.UUID) ([]*po.ProfileEngagement, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	rows, err := queries.ListEngagementsByUserVideo(ctx, profiledb.ListEngagementsByUserVideoParams{
+		UserID:  userID,
+		VideoID: videoID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list engagements by video: %w", err)
+	}
+	result := make([]*po.ProfileEngagement, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, mappers.ProfileEngagementFromRow(row))
+	}
+	return result, nil
+}
+
 // ErrProfileEngagementNotFound 表示互动不存在。
 var ErrProfileEngagementNotFound = errors.New("profile engagement not found")
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
 	"github.com/bionicotaku/lingo-services-profile/internal/repositories/mappers"
@@ -37,11 +38,13 @@ func NewProfileUsersRepository(db *pgxpool.Pool, logger log.Logger) *ProfileUser
 
 // UpsertProfileUserInput 描述档案写入参数。
 type UpsertProfileUserInput struct {
-	UserID         uuid.UUID
-	DisplayName    string
-	AvatarURL      *string
-	ProfileVersion int64
-	Preferences    map[string]any
+	UserID            uuid.UUID
+	DisplayName       string
+	AvatarURL         *string
+	ProfileVersion    int64
+	Preferences       map[string]any
+	PreferredLocale   *string
+	PreferredTimezone *string
 }
 
 // Upsert 写入或更新档案记录。
@@ -51,6 +54,8 @@ func (r *ProfileUsersRepository) Upsert(ctx context.Context, sess txmanager.Sess
 		r.log.WithContext(ctx).Errorf("upsert profile user: marshal preferences failed: user=%s err=%v", input.UserID, err)
 		return nil, fmt.Errorf("marshal preferences: %w", err)
 	}
+	params.PreferredLocale = mappers.ToPgText(input.PreferredLocale)
+	params.PreferredTimezone = mappers.ToPgText(input.PreferredTimezone)
 
 	queries := r.queries
 	if sess != nil {
@@ -91,3 +96,112 @@ func (r *ProfileUsersRepository) Get(ctx context.Context, sess txmanager.Session
 	}
 	return profile, nil
 }
+
+// UpdateAccountStatusInput 描述账户状态变更参数。
+type UpdateAccountStatusInput struct {
+	UserID            uuid.UUID
+	AccountStatus     string
+	PendingDeletionAt *time.Time
+	DeletedAt         *time.Time
+	ProfileVersion    int64
+}
+
+// UpdateAccountStatus 更新账户生命周期字段，并写入新的 profile_version。
+func (r *ProfileUsersRepository) UpdateAccountStatus(ctx context.Context, sess txmanager.Session, input UpdateAccountStatusInput) (*po.ProfileUser, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+
+	row, err := queries.UpdateProfileUserAccountStatus(ctx, profiledb.UpdateProfileUserAccountStatusParams{
+		UserID:            input.UserID,
+		AccountStatus:     input.AccountStatus,
+		PendingDeletionAt: mappers.ToPgTimestamptzPtr(input.PendingDeletionAt),
+		DeletedAt:         mappers.ToPgTimestamptzPtr(input.DeletedAt),
+		ProfileVersion:    input.ProfileVersion,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrProfileUserNotFound
+		}
+		r.log.WithContext(ctx).Errorf("update account status failed: user=%s status=%s err=%v", input.UserID, input.AccountStatus, err)
+		return nil, fmt.Errorf("update account status: %w", err)
+	}
+	return mappers.ProfileUserFromRow(row)
+}
+
+// UpdateVisibilityInput 描述公开主页字段可见性更新参数。
+type UpdateVisibilityInput struct {
+	UserID            uuid.UUID
+	PublicDisplayName bool
+	PublicAvatar      bool
+	PublicBookmarks   bool
+	PublicStats       bool
+	ProfileVersion    int64
+}
+
+// UpdateVisibility 覆盖写入字段可见性设置，并写入新的 profile_version。
+func (r *ProfileUsersRepository) UpdateVisibility(ctx context.Context, sess txmanager.Session, input UpdateVisibilityInput) (*po.ProfileUser, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+
+	row, err := queries.UpdateProfileUserVisibility(ctx, profiledb.UpdateProfileUserVisibilityParams{
+		UserID:            input.UserID,
+		PublicDisplayName: input.PublicDisplayName,
+		PublicAvatar:      input.PublicAvatar,
+		PublicBookmarks:   input.PublicBookmarks,
+		PublicStats:       input.PublicStats,
+		ProfileVersion:    input.ProfileVersion,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrProfileUserNotFound
+		}
+		r.log.WithContext(ctx).Errorf("update visibility failed: user=%s err=%v", input.UserID, err)
+		return nil, fmt.Errorf("update visibility: %w", err)
+	}
+	return mappers.ProfileUserFromRow(row)
+}
+
+// GetPublicStats 汇总用户的点赞、收藏与观看统计，用于公开主页展示。
+func (r *ProfileUsersRepository) GetPublicStats(ctx context.Context, sess txmanager.Session, userID uuid.UUID) (*po.ProfilePublicStats, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+
+	row, err := queries.GetProfileUserPublicStats(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get profile public stats: %w", err)
+	}
+	return &po.ProfilePublicStats{
+		LikeCount:         row.LikeCount,
+		BookmarkCount:     row.BookmarkCount,
+		WatchedVideoCount: row.WatchedVideoCount,
+		TotalWatchSeconds: row.TotalWatchSeconds,
+	}, nil
+}
+
+// IsDisplayNameTaken 检查昵称（大小写不敏感）是否已被其他未删除账户占用。
+// 在事务内调用时会先获取基于昵称的 advisory 锁，保证并发改名时检查与写入串行化。
+// 数据库侧没有 unique 约束，唯一性仅对经过本检查的写入成立。
+func (r *ProfileUsersRepository) IsDisplayNameTaken(ctx context.Context, sess txmanager.Session, userID uuid.UUID, displayName string) (bool, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+		if err := queries.LockProfileDisplayName(ctx, displayName); err != nil {
+			return false, fmt.Errorf("lock display name: %w", err)
+		}
+	}
+
+	taken, err := queries.ExistsProfileDisplayName(ctx, profiledb.ExistsProfileDisplayNameParams{
+		DisplayName: displayName,
+		UserID:      userID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("check display name: %w", err)
+	}
+	return taken, nil
+}
@@ -104,3 +104,16 @@ func (r *ProfileVideoProjectionRepository) ListByIDs(ctx context.Context, sess t
 	}
 	return result, nil
 }
+
+// CountStale 统计 updated_at 早于 before 的投影行数（命中 profile_videos_projection_updated_idx）。
+func (r *ProfileVideoProjectionRepository) CountStale(ctx context.Context, sess txmanager.Session, before time.Time) (int64, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	count, err := queries.CountStaleVideoProjections(ctx, mappers.ToPgTimestamptzPtr(&before))
+	if err != nil {
+		return 0, fmt.Errorf("count stale video projections: %w", err)
+	}
+	return count, nil
+}
@@ -30,19 +30,31 @@ func NewProfileVideoStatsRepository(db *pgxpool.Pool, logger log.Logger) *Profil
 	}
 }
 
+// VideoStatsDelta 描述 profile.video_stats 各计数列的增量，零值列保持不变。
+type VideoStatsDelta struct {
+	Likes        int64
+	Bookmarks    int64
+	Dislikes     int64
+	Shares       int64
+	Watchers     int64
+	WatchSeconds int64
+}
+
 // Increment 以增量方式更新统计。
-func (r *ProfileVideoStatsRepository) Increment(ctx context.Context, sess txmanager.Session, videoID uuid.UUID, likeDelta, bookmarkDelta, watcherDelta, watchSecondsDelta int64) error {
+func (r *ProfileVideoStatsRepository) Increment(ctx context.Context, sess txmanager.Session, videoID uuid.UUID, delta VideoStatsDelta) error {
 	queries := r.queries
 	if sess != nil {
 		queries = queries.WithTx(sess.Tx())
 	}
 	params := profiledb.UpsertVideoStatsParams{
 		VideoID:           videoID,
-		LikeCount:         likeDelta,
-		BookmarkCount:     bookmarkDelta,
-		UniqueWatchers:    watcherDelta,
-		TotalWatchSeconds: watchSecondsDelta,
-		Column6:           nil,
+		LikeCount:         delta.Likes,
+		BookmarkCount:     delta.Bookmarks,
+		DislikeCount:      delta.Dislikes,
+		ShareCount:        delta.Shares,
+		UniqueWatchers:    delta.Watchers,
+		TotalWatchSeconds: delta.WatchSeconds,
+		Column8:           nil,
 	}
 	if err := queries.UpsertVideoStats(ctx, params); err != nil {
 		r.log.WithContext(ctx).Errorf("increment video stats failed: video=%s err=%v", videoID, err)
@@ -61,6 +73,8 @@ func (r *ProfileVideoStatsRepository) Set(ctx context.Context, sess txmanager.Se
 		VideoID:           stats.VideoID,
 		LikeCount:         stats.LikeCount,
 		BookmarkCount:     stats.BookmarkCount,
+		DislikeCount:      stats.DislikeCount,
+		ShareCount:        stats.ShareCount,
 		UniqueWatchers:    stats.UniqueWatchers,
 		TotalWatchSeconds: stats.TotalWatchSeconds,
 		UpdatedAt:         mappers.ToPgTimestamptzPtr(&stats.UpdatedAt),
@@ -0,0 +1,38 @@
+-- name: InsertAuditEntry :one
+INSERT INTO profile.audit_trail (
+    user_id,
+    actor_type,
+    actor_id,
+    action,
+    resource_type,
+    resource_id,
+    before,
+    after,
+    trace_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+)
+RETURNING audit_id, created_at;
+
+-- name: ListAuditEntries :many
+SELECT
+    audit_id,
+    user_id,
+    actor_type,
+    actor_id,
+    action,
+    resource_type,
+    resource_id,
+    before,
+    after,
+    trace_id,
+    created_at
+FROM profile.audit_trail
+WHERE (sqlc.narg(user_id)::uuid IS NULL OR user_id = sqlc.narg(user_id)::uuid)
+  AND (sqlc.narg(actor_id)::text IS NULL OR actor_id = sqlc.narg(actor_id)::text)
+  AND (sqlc.narg(action)::text IS NULL OR action = sqlc.narg(action)::text)
+  AND (sqlc.narg(trace_id)::text IS NULL OR trace_id = sqlc.narg(trace_id)::text)
+  AND (sqlc.narg(since)::timestamptz IS NULL OR created_at >= sqlc.narg(since)::timestamptz)
+  AND (sqlc.narg(until)::timestamptz IS NULL OR created_at < sqlc.narg(until)::timestamptz)
+ORDER BY created_at DESC, audit_id DESC
+LIMIT sqlc.arg(limit_count) OFFSET sqlc.arg(offset_count);
@@ -0,0 +1,114 @@
+-- name: InsertCollection :one
+INSERT INTO profile.collections (
+    user_id,
+    name
+) VALUES (
+    $1, $2
+)
+RETURNING collection_id, user_id, name, created_at, updated_at;
+
+-- name: GetCollection :one
+SELECT
+    c.collection_id,
+    c.user_id,
+    c.name,
+    c.created_at,
+    c.updated_at,
+    (SELECT COUNT(*) FROM profile.collection_items ci WHERE ci.collection_id = c.collection_id)::bigint AS item_count
+FROM profile.collections c
+WHERE c.collection_id = $1;
+
+-- name: LockCollection :one
+SELECT collection_id, user_id
+FROM profile.collections
+WHERE collection_id = $1
+FOR UPDATE;
+
+-- name: ListCollectionsByUser :many
+SELECT
+    c.collection_id,
+    c.user_id,
+    c.name,
+    c.created_at,
+    c.updated_at,
+    (SELECT COUNT(*) FROM profile.collection_items ci WHERE ci.collection_id = c.collection_id)::bigint AS item_count
+FROM profile.collections c
+WHERE c.user_id = $1
+ORDER BY c.created_at ASC, c.collection_id ASC
+LIMIT $2 OFFSET $3;
+
+-- name: RenameCollection :one
+UPDATE profile.collections c
+SET name = $2
+WHERE c.collection_id = $1
+RETURNING
+    c.collection_id,
+    c.user_id,
+    c.name,
+    c.created_at,
+    c.updated_at,
+    (SELECT COUNT(*) FROM profile.collection_items ci WHERE ci.collection_id = c.collection_id)::bigint AS item_count;
+
+-- name: DeleteCollection :execrows
+DELETE FROM profile.collections
+WHERE collection_id = $1;
+
+-- name: InsertCollectionItem :one
+INSERT INTO profile.collection_items (
+    collection_id,
+    user_id,
+    video_id,
+    position,
+    added_at
+)
+SELECT
+    sqlc.arg('collection_id')::uuid,
+    sqlc.arg('user_id')::uuid,
+    sqlc.arg('video_id')::uuid,
+    COALESCE(MAX(ci.position), 0) + 1,
+    COALESCE(sqlc.narg('added_at')::timestamptz, now())
+FROM profile.collection_items ci
+WHERE ci.collection_id = sqlc.arg('collection_id')::uuid
+ON CONFLICT (collection_id, video_id) DO NOTHING
+RETURNING collection_id, video_id, user_id, position, added_at;
+
+-- name: GetCollectionItem :one
+SELECT
+    collection_id,
+    video_id,
+    user_id,
+    position,
+    added_at
+FROM profile.collection_items
+WHERE collection_id = $1
+  AND video_id = $2;
+
+-- name: DeleteCollectionItem :execrows
+DELETE FROM profile.collection_items
+WHERE collection_id = $1
+  AND video_id = $2;
+
+-- name: ListCollectionItems :many
+SELECT
+    collection_id,
+    video_id,
+    user_id,
+    position,
+    added_at
+FROM profile.collection_items
+WHERE collection_id = $1
+ORDER BY position ASC, added_at ASC
+LIMIT $2 OFFSET $3;
+
+-- name: ListCollectionItemVideoIDs :many
+SELECT video_id
+FROM profile.collection_items
+WHERE collection_id = $1
+ORDER BY position ASC, added_at ASC;
+
+-- name: ReorderCollectionItems :execrows
+UPDATE profile.collection_items ci
+SET position = o.ord::integer
+FROM unnest(sqlc.arg('video_ids')::uuid[]) WITH ORDINALITY AS o(video_id, ord)
+WHERE ci.collection_id = sqlc.arg('collection_id')::uuid
+  AND ci.video_id = o.video_id;
@@ -0,0 +1,42 @@
+-- name: InsertEngagementNote :one
+INSERT INTO profile.engagement_notes (
+    user_id,
+    video_id,
+    engagement_type,
+    body,
+    position_seconds
+) VALUES (
+    $1, $2, $3, $4, sqlc.narg('position_seconds')
+)
+RETURNING note_id, user_id, video_id, engagement_type, body, position_seconds, created_at, updated_at;
+
+-- name: GetEngagementNote :one
+SELECT note_id, user_id, video_id, engagement_type, body, position_seconds, created_at, updated_at
+FROM profile.engagement_notes
+WHERE note_id = $1;
+
+-- name: UpdateEngagementNote :one
+UPDATE profile.engagement_notes
+SET body = $2,
+    position_seconds = sqlc.narg('position_seconds')
+WHERE note_id = $1
+RETURNING note_id, user_id, video_id, engagement_type, body, position_seconds, created_at, updated_at;
+
+-- name: DeleteEngagementNote :execrows
+DELETE FROM profile.engagement_notes
+WHERE note_id = $1;
+
+-- name: CountEngagementNotes :one
+SELECT COUNT(*)::bigint
+FROM profile.engagement_notes
+WHERE user_id = $1
+  AND video_id = $2
+  AND engagement_type = $3;
+
+-- name: ListEngagementNotesByVideos :many
+SELECT note_id, user_id, video_id, engagement_type, body, position_seconds, created_at, updated_at
+FROM profile.engagement_notes
+WHERE user_id = $1
+  AND engagement_type = $2
+  AND video_id = ANY(sqlc.arg('video_ids')::uuid[])
+ORDER BY video_id, position_seconds NULLS LAST, created_at, note_id;
@@ -5,14 +5,18 @@ INSERT INTO profile.engagements (
     engagement_type,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    source,
+    metadata
 ) VALUES (
-    $1, $2, $3, COALESCE($4, now()), COALESCE($4, now()), NULL
+    $1, $2, $3, COALESCE($4, now()), COALESCE($4, now()), NULL, $5, $6
 )
 ON CONFLICT (user_id, video_id, engagement_type) DO UPDATE
 SET deleted_at = NULL,
     updated_at = COALESCE($4, now()),
-    created_at = profile.engagements.created_at;
+    created_at = profile.engagements.created_at,
+    source = EXCLUDED.source,
+    metadata = EXCLUDED.metadata;
 
 -- name: SoftDeleteEngagement :exec
 UPDATE profile.engagements
@@ -29,7 +33,9 @@ SELECT
     engagement_type,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    source,
+    metadata
 FROM profile.engagements
 WHERE user_id = $1
   AND video_id = $2
@@ -42,10 +48,27 @@ SELECT
     engagement_type,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    source,
+    metadata
 FROM profile.engagements
 WHERE user_id = $1
-  AND ($2 = '' OR engagement_type = $2)
+  AND (cardinality($2::text[]) = 0 OR engagement_type = ANY($2::text[]))
   AND (deleted_at IS NULL OR $3 = false)
 ORDER BY created_at DESC
 LIMIT $4 OFFSET $5;
+
+-- name: ListEngagementsByUserVideo :many
+SELECT
+    user_id,
+    video_id,
+    engagement_type,
+    created_at,
+    updated_at,
+    deleted_at,
+    source,
+    metadata
+FROM profile.engagements
+WHERE user_id = $1
+  AND video_id = $2
+ORDER BY engagement_type;
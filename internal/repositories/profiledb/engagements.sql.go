@@ -19,7 +19,9 @@ SELECT
     engagement_type,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    source,
+    metadata
 FROM profile.engagements
 WHERE user_id = $1
   AND video_id = $2
@@ -42,6 +44,8 @@ func (q *Queries) GetEngagement(ctx context.Context, arg GetEngagementParams) (P
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Source,
+		&i.Metadata,
 	)
 	return i, err
 }
@@ -53,10 +57,12 @@ SELECT
     engagement_type,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    source,
+    metadata
 FROM profile.engagements
 WHERE user_id = $1
-  AND ($2 = '' OR engagement_type = $2)
+  AND (cardinality($2::text[]) = 0 OR engagement_type = ANY($2::text[]))
   AND (deleted_at IS NULL OR $3 = false)
 ORDER BY created_at DESC
 LIMIT $4 OFFSET $5
@@ -64,7 +70,7 @@ LIMIT $4 OFFSET $5
 
 type ListEngagementsByUserParams struct {
 	UserID  uuid.UUID   `json:"user_id"`
-	Column2 interface{} `json:"column_2"`
+	Column2 []string    `json:"column_2"`
 	Column3 interface{} `json:"column_3"`
 	Limit   int32       `json:"limit"`
 	Offset  int32       `json:"offset"`
@@ -92,6 +98,58 @@ func (q *Queries) ListEngagementsByUser(ctx context.Context, arg ListEngagements
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Source,
+			&i.Metadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEngagementsByUserVideo = `-- name: ListEngagementsByUserVideo :many
+SELECT
+    user_id,
+    video_id,
+    engagement_type,
+    created_at,
+    updated_at,
+    deleted_at,
+    source,
+    metadata
+FROM profile.engagements
+WHERE user_id = $1
+  AND video_id = $2
+ORDER BY engagement_type
+`
+
+type ListEngagementsByUserVideoParams struct {
+	UserID  uuid.UUID `json:"user_id"`
+	VideoID uuid.UUID `json:"video_id"`
+}
+
+func (q *Queries) ListEngagementsByUserVideo(ctx context.Context, arg ListEngagementsByUserVideoParams) ([]ProfileEngagement, error) {
+	rows, err := q.db.Query(ctx, listEngagementsByUserVideo, arg.UserID, arg.VideoID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ProfileEngagement{}
+	for rows.Next() {
+		var i ProfileEngagement
+		if err := rows.Scan(
+			&i.UserID,
+			&i.VideoID,
+			&i.EngagementType,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Source,
+			&i.Metadata,
 		); err != nil {
 			return nil, err
 		}
@@ -136,14 +194,18 @@ INSERT INTO profile.engagements (
     engagement_type,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    source,
+    metadata
 ) VALUES (
-    $1, $2, $3, COALESCE($4, now()), COALESCE($4, now()), NULL
+    $1, $2, $3, COALESCE($4, now()), COALESCE($4, now()), NULL, $5, $6
 )
 ON CONFLICT (user_id, video_id, engagement_type) DO UPDATE
 SET deleted_at = NULL,
     updated_at = COALESCE($4, now()),
-    created_at = profile.engagements.created_at
+    created_at = profile.engagements.created_at,
+    source = EXCLUDED.source,
+    metadata = EXCLUDED.metadata
 `
 
 type UpsertEngagementParams struct {
@@ -151,6 +213,8 @@ type UpsertEngagementParams struct {
 	VideoID        uuid.UUID   `json:"video_id"`
 	EngagementType string      `json:"engagement_type"`
 	Column4        interface{} `json:"column_4"`
+	Source         string      `json:"source"`
+	Metadata       []byte      `json:"metadata"`
 }
 
 func (q *Queries) UpsertEngagement(ctx context.Context, arg UpsertEngagementParams) error {
@@ -159,6 +223,8 @@ func (q *Queries) UpsertEngagement(ctx context.Context, arg UpsertEngagementPara
 		arg.VideoID,
 		arg.EngagementType,
 		arg.Column4,
+		arg.Source,
+		arg.Metadata,
 	)
 	return err
 }
@@ -0,0 +1,74 @@
+-- name: AppendEventBusMessage :one
+-- 同一 topic 的追加通过事务级 advisory lock 串行化，保证 id 按提交顺序递增，订阅端按 id 读取不会越过未提交的消息。
+WITH topic_lock AS (
+    SELECT pg_advisory_xact_lock(hashtext('profile.eventbus.topic'), hashtext(sqlc.arg(topic)::text))
+)
+INSERT INTO profile.eventbus_messages (
+    topic,
+    event_id,
+    ordering_key,
+    data,
+    attributes
+)
+SELECT
+    sqlc.arg(topic)::text,
+    sqlc.narg(event_id)::uuid,
+    sqlc.arg(ordering_key)::text,
+    sqlc.arg(data)::bytea,
+    sqlc.arg(attributes)::jsonb
+FROM topic_lock
+RETURNING id, published_at;
+
+-- name: NotifyEventBus :exec
+SELECT pg_notify('profile_eventbus', sqlc.arg(topic)::text);
+
+-- name: EnsureEventBusSubscription :one
+-- 首次订阅时游标定位到当前末尾，不回放订阅建立之前的历史消息。
+INSERT INTO profile.eventbus_subscriptions (
+    subscription,
+    topic,
+    last_id
+) VALUES (
+    sqlc.arg(subscription),
+    sqlc.arg(topic),
+    COALESCE((SELECT max(m.id) FROM profile.eventbus_messages m WHERE m.topic = sqlc.arg(topic)), 0)::bigint
+)
+ON CONFLICT (subscription) DO UPDATE
+SET topic      = EXCLUDED.topic,
+    updated_at = now()
+RETURNING last_id;
+
+-- name: TryLockEventBusSubscription :one
+SELECT pg_try_advisory_lock(hashtext('profile.eventbus.subscription'), hashtext(sqlc.arg(subscription)::text)) AS locked;
+
+-- name: ListEventBusMessagesAfter :many
+SELECT
+    id,
+    topic,
+    event_id,
+    ordering_key,
+    data,
+    attributes,
+    published_at
+FROM profile.eventbus_messages
+WHERE topic = sqlc.arg(topic)
+  AND id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg(limit_count);
+
+-- name: AdvanceEventBusSubscription :exec
+UPDATE profile.eventbus_subscriptions
+SET last_id    = GREATEST(last_id, sqlc.arg(last_id)),
+    updated_at = now()
+WHERE subscription = sqlc.arg(subscription);
+
+-- name: PruneEventBusMessages :execrows
+-- 删除早于 published_before 且已被该 topic 全部订阅确认的消息。
+DELETE FROM profile.eventbus_messages m
+WHERE m.published_at < sqlc.arg(published_before)
+  AND NOT EXISTS (
+      SELECT 1
+      FROM profile.eventbus_subscriptions s
+      WHERE s.topic = m.topic
+        AND s.last_id < m.id
+  );
@@ -0,0 +1,37 @@
+-- name: GetOldestPendingInboxReceivedAt :one
+SELECT min(received_at)::timestamptz AS oldest_received_at
+FROM profile.inbox_events
+WHERE processed_at IS NULL
+  AND (sqlc.arg(source_service)::text = '' OR source_service = sqlc.arg(source_service)::text);
+
+-- name: GetInboxEvent :one
+SELECT
+    event_id,
+    source_service,
+    event_type,
+    aggregate_type,
+    aggregate_id,
+    payload,
+    received_at,
+    processed_at,
+    last_error
+FROM profile.inbox_events
+WHERE event_id = $1;
+
+-- name: ListInboxEventsForReplay :many
+SELECT
+    event_id,
+    source_service,
+    event_type,
+    aggregate_type,
+    aggregate_id,
+    payload,
+    received_at,
+    processed_at,
+    last_error
+FROM profile.inbox_events
+WHERE source_service = sqlc.arg(source_service)
+  AND (received_at, event_id) > (sqlc.arg(after_received_at)::timestamptz, sqlc.arg(after_event_id)::uuid)
+  AND (received_at, event_id) <= (sqlc.arg(until_received_at)::timestamptz, sqlc.arg(until_event_id)::uuid)
+ORDER BY received_at, event_id
+LIMIT sqlc.arg(batch_size);
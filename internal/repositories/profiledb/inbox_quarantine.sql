@@ -0,0 +1,113 @@
+-- name: RecordInboxFailure :one
+INSERT INTO profile.inbox_quarantine (
+    event_id,
+    consumer_group,
+    source_service,
+    event_type,
+    aggregate_id,
+    payload,
+    status,
+    attempts,
+    max_attempts,
+    last_error,
+    quarantined_at
+) VALUES (
+    sqlc.arg(event_id),
+    sqlc.arg(consumer_group),
+    sqlc.arg(source_service),
+    sqlc.arg(event_type),
+    sqlc.narg(aggregate_id),
+    sqlc.arg(payload),
+    CASE WHEN sqlc.arg(max_attempts)::int <= 1 THEN 'quarantined' ELSE 'failing' END,
+    1,
+    sqlc.arg(max_attempts)::int,
+    sqlc.arg(last_error),
+    CASE WHEN sqlc.arg(max_attempts)::int <= 1 THEN now() END
+)
+ON CONFLICT (event_id) DO UPDATE
+SET attempts       = profile.inbox_quarantine.attempts + 1,
+    max_attempts   = EXCLUDED.max_attempts,
+    last_error     = EXCLUDED.last_error,
+    last_failed_at = now(),
+    status         = CASE
+                         WHEN profile.inbox_quarantine.status IN ('quarantined', 'discarded')
+                             THEN profile.inbox_quarantine.status
+                         WHEN profile.inbox_quarantine.attempts + 1 >= EXCLUDED.max_attempts
+                             THEN 'quarantined'
+                         ELSE 'failing'
+                     END,
+    quarantined_at = CASE
+                         WHEN profile.inbox_quarantine.quarantined_at IS NOT NULL
+                             THEN profile.inbox_quarantine.quarantined_at
+                         WHEN profile.inbox_quarantine.attempts + 1 >= EXCLUDED.max_attempts
+                             THEN now()
+                     END,
+    resolved_at    = NULL,
+    resolved_by    = NULL
+RETURNING
+    event_id,
+    consumer_group,
+    source_service,
+    event_type,
+    aggregate_id,
+    payload,
+    status,
+    attempts,
+    max_attempts,
+    last_error,
+    first_failed_at,
+    last_failed_at,
+    quarantined_at,
+    resolved_at,
+    resolved_by;
+
+-- name: GetInboxQuarantine :one
+SELECT
+    event_id,
+    consumer_group,
+    source_service,
+    event_type,
+    aggregate_id,
+    payload,
+    status,
+    attempts,
+    max_attempts,
+    last_error,
+    first_failed_at,
+    last_failed_at,
+    quarantined_at,
+    resolved_at,
+    resolved_by
+FROM profile.inbox_quarantine
+WHERE event_id = $1;
+
+-- name: ListInboxQuarantine :many
+SELECT
+    event_id,
+    consumer_group,
+    source_service,
+    event_type,
+    aggregate_id,
+    payload,
+    status,
+    attempts,
+    max_attempts,
+    last_error,
+    first_failed_at,
+    last_failed_at,
+    quarantined_at,
+    resolved_at,
+    resolved_by
+FROM profile.inbox_quarantine
+WHERE consumer_group = sqlc.arg(consumer_group)
+  AND (sqlc.arg(status)::text = '' OR status = sqlc.arg(status)::text)
+ORDER BY last_failed_at DESC, event_id
+LIMIT sqlc.arg(limit_count);
+
+-- name: ResolveInboxQuarantine :execrows
+UPDATE profile.inbox_quarantine
+SET status      = sqlc.arg(status),
+    resolved_at = now(),
+    resolved_by = sqlc.narg(resolved_by)
+WHERE event_id = sqlc.arg(event_id)
+  AND status IN ('failing', 'quarantined');
@@ -9,6 +9,45 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+// 写操作审计轨迹，与业务写入同事务追加，只增不改
+type ProfileAuditTrail struct {
+	AuditID uuid.UUID `json:"audit_id"`
+	UserID  uuid.UUID `json:"user_id"`
+	// 操作者类型：user（终端用户）或 service（运营/内部服务身份）
+	ActorType    string `json:"actor_type"`
+	ActorID      string `json:"actor_id"`
+	Action       string `json:"action"`
+	ResourceType string `json:"resource_type"`
+	ResourceID   string `json:"resource_id"`
+	// 变更前的字段快照，仅包含发生变化的字段
+	Before []byte `json:"before"`
+	// 变更后的字段快照，仅包含发生变化的字段
+	After []byte `json:"after"`
+	// 写入时的 OpenTelemetry trace_id
+	TraceID   pgtype.Text        `json:"trace_id"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+// 用户自定义收藏夹，按 created_at 升序展示
+type ProfileCollection struct {
+	CollectionID uuid.UUID `json:"collection_id"`
+	UserID       uuid.UUID `json:"user_id"`
+	// 收藏夹名称，1-64 个字符，同一用户内大小写不敏感唯一
+	Name      string             `json:"name"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+// 收藏夹成员，视频元数据通过 profile.videos_projection 补水
+type ProfileCollectionItem struct {
+	CollectionID uuid.UUID `json:"collection_id"`
+	VideoID      uuid.UUID `json:"video_id"`
+	UserID       uuid.UUID `json:"user_id"`
+	// 排序位置，重排时按请求顺序重写为 1..n
+	Position int32              `json:"position"`
+	AddedAt  pgtype.Timestamptz `json:"added_at"`
+}
+
 // 用户对视频的互动记录（点赞、收藏等）
 type ProfileEngagement struct {
 	// 互动所属用户 ID
@@ -23,6 +62,49 @@ type ProfileEngagement struct {
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 	// 软删除标记，表示互动被撤销
 	DeletedAt pgtype.Timestamptz `json:"deleted_at"`
+	// 互动来源：manual（用户主动）/recommendation（推荐位）/system（系统代操作），默认 manual
+	Source string `json:"source"`
+	// 互动上下文，如 entry_point（入口）、device（终端），最近一次写入覆盖
+	Metadata []byte `json:"metadata"`
+}
+
+// 收藏上的私有笔记，仅本人可见，不出现在公开收藏列表
+type ProfileEngagementNote struct {
+	NoteID         uuid.UUID `json:"note_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	VideoID        uuid.UUID `json:"video_id"`
+	EngagementType string    `json:"engagement_type"`
+	Body           string    `json:"body"`
+	// 笔记对应的视频时间点（秒），为空表示不关联时间点
+	PositionSeconds pgtype.Int4        `json:"position_seconds"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+}
+
+// 互动类型注册表，新增类型需同步服务端注册表（services.engagementTypeRegistry）
+type ProfileEngagementType struct {
+	EngagementType string             `json:"engagement_type"`
+	Description    string             `json:"description"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+}
+
+// Postgres 事件总线消息日志，按 topic 追加、按 id 顺序消费
+type ProfileEventbusMessage struct {
+	ID          int64              `json:"id"`
+	Topic       string             `json:"topic"`
+	EventID     pgtype.UUID        `json:"event_id"`
+	OrderingKey string             `json:"ordering_key"`
+	Data        []byte             `json:"data"`
+	Attributes  []byte             `json:"attributes"`
+	PublishedAt pgtype.Timestamptz `json:"published_at"`
+}
+
+// Postgres 事件总线订阅游标，同一订阅同一时刻仅一个消费者持有 advisory lock
+type ProfileEventbusSubscription struct {
+	Subscription string             `json:"subscription"`
+	Topic        string             `json:"topic"`
+	LastID       int64              `json:"last_id"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
 }
 
 // Inbox 表：记录已消费的外部事件，保障处理幂等性
@@ -43,6 +125,42 @@ type ProfileInboxEvent struct {
 	LastError   pgtype.Text        `json:"last_error"`
 }
 
+// Inbox 失败事件与隔离区：failing 仍会重试，quarantined 停止重试待人工处理
+type ProfileInboxQuarantine struct {
+	EventID       uuid.UUID   `json:"event_id"`
+	ConsumerGroup string      `json:"consumer_group"`
+	SourceService string      `json:"source_service"`
+	EventType     string      `json:"event_type"`
+	AggregateID   pgtype.Text `json:"aggregate_id"`
+	Payload       []byte      `json:"payload"`
+	Status        string      `json:"status"`
+	// 累计处理失败次数，达到 max_attempts 时转为 quarantined
+	Attempts      int32              `json:"attempts"`
+	MaxAttempts   int32              `json:"max_attempts"`
+	LastError     pgtype.Text        `json:"last_error"`
+	FirstFailedAt pgtype.Timestamptz `json:"first_failed_at"`
+	LastFailedAt  pgtype.Timestamptz `json:"last_failed_at"`
+	QuarantinedAt pgtype.Timestamptz `json:"quarantined_at"`
+	ResolvedAt    pgtype.Timestamptz `json:"resolved_at"`
+	ResolvedBy    pgtype.Text        `json:"resolved_by"`
+}
+
+// Outbox 死信：人工移出发布队列的事件，可重新入队
+type ProfileOutboxDeadLetter struct {
+	EventID          uuid.UUID          `json:"event_id"`
+	AggregateType    string             `json:"aggregate_type"`
+	AggregateID      uuid.UUID          `json:"aggregate_id"`
+	EventType        string             `json:"event_type"`
+	Payload          []byte             `json:"payload"`
+	Headers          []byte             `json:"headers"`
+	OccurredAt       pgtype.Timestamptz `json:"occurred_at"`
+	DeliveryAttempts int32              `json:"delivery_attempts"`
+	LastError        pgtype.Text        `json:"last_error"`
+	DeadLetteredAt   pgtype.Timestamptz `json:"dead_lettered_at"`
+	Reason           pgtype.Text        `json:"reason"`
+	DeadLetteredBy   pgtype.Text        `json:"dead_lettered_by"`
+}
+
 // Outbox 表：与业务事务同库写入，后台扫描发布到事件总线
 type ProfileOutboxEvent struct {
 	EventID uuid.UUID `json:"event_id"`
@@ -71,6 +189,46 @@ type ProfileOutboxEvent struct {
 	LockedAt pgtype.Timestamptz `json:"locked_at"`
 }
 
+// Outbox 归档：超过保留期的已发布事件
+type ProfileOutboxEventsArchive struct {
+	EventID          uuid.UUID          `json:"event_id"`
+	AggregateType    string             `json:"aggregate_type"`
+	AggregateID      uuid.UUID          `json:"aggregate_id"`
+	EventType        string             `json:"event_type"`
+	Payload          []byte             `json:"payload"`
+	Headers          []byte             `json:"headers"`
+	OccurredAt       pgtype.Timestamptz `json:"occurred_at"`
+	PublishedAt      pgtype.Timestamptz `json:"published_at"`
+	DeliveryAttempts int32              `json:"delivery_attempts"`
+	ArchivedAt       pgtype.Timestamptz `json:"archived_at"`
+}
+
+// 投影尚未创建时到达的 Catalog 事件，created 到达后按版本回放
+type ProfilePendingVideoEvent struct {
+	EventID   uuid.UUID `json:"event_id"`
+	VideoID   uuid.UUID `json:"video_id"`
+	Version   int64     `json:"version"`
+	EventType string    `json:"event_type"`
+	// videov1.Event 的 protobuf 编码，回放时重新解码
+	Payload    []byte             `json:"payload"`
+	OccurredAt pgtype.Timestamptz `json:"occurred_at"`
+	ParkedAt   pgtype.Timestamptz `json:"parked_at"`
+}
+
+// Inbox 消费组高水位：最大 publish_time / occurred_at，只前进不回退
+type ProfileSubscriptionOffset struct {
+	ConsumerGroup  string             `json:"consumer_group"`
+	Subscription   string             `json:"subscription"`
+	MessageID      pgtype.Text        `json:"message_id"`
+	PublishTime    pgtype.Timestamptz `json:"publish_time"`
+	LastEventID    pgtype.UUID        `json:"last_event_id"`
+	LastOccurredAt pgtype.Timestamptz `json:"last_occurred_at"`
+	// 最近一次处理的消息从发布到处理完成的毫秒数
+	LagMillis int64              `json:"lag_millis"`
+	UpdatedBy string             `json:"updated_by"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
 // Profile 档案主表，MVP 合并偏好字段
 type ProfileUser struct {
 	// 用户主键，复用 Supabase sub
@@ -87,6 +245,24 @@ type ProfileUser struct {
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 	// 最近更新时间（触发器维护）
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	// 账户状态：active/suspended/pending_deletion/deleted
+	AccountStatus string `json:"account_status"`
+	// 进入待删除状态的时间，撤销删除后清空
+	PendingDeletionAt pgtype.Timestamptz `json:"pending_deletion_at"`
+	// 账号删除完成时间，删除后仅保留公开字段
+	DeletedAt pgtype.Timestamptz `json:"deleted_at"`
+	// 公开主页是否展示昵称，默认公开
+	PublicDisplayName bool `json:"public_display_name"`
+	// 公开主页是否展示头像，默认公开
+	PublicAvatar bool `json:"public_avatar"`
+	// 是否允许匿名访问收藏列表（ListPublicBookmarks），默认关闭
+	PublicBookmarks bool `json:"public_bookmarks"`
+	// 公开主页是否展示点赞/收藏/观看聚合统计，默认关闭
+	PublicStats bool `json:"public_stats"`
+	// 首选语言，BCP47 规范化标签（如 en-US、zh-Hans-CN），为空时回落到网关 userinfo 的 locale
+	PreferredLocale pgtype.Text `json:"preferred_locale"`
+	// 首选时区，IANA TZ 名称（如 Asia/Shanghai），为空时回落到网关 userinfo 的 zoneinfo
+	PreferredTimezone pgtype.Text `json:"preferred_timezone"`
 }
 
 // 视频全局互动/观看统计（MVP 由 Profile 同步维护）
@@ -103,6 +279,10 @@ type ProfileVideoStat struct {
 	TotalWatchSeconds int64 `json:"total_watch_seconds"`
 	// 统计更新时间
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	// 点踩数，由 dislike 互动增减
+	DislikeCount int64 `json:"dislike_count"`
+	// 分享数，由 share 互动累加
+	ShareCount int64 `json:"share_count"`
 }
 
 // Catalog 视频元数据投影（Profile 消费 catalog.video.* 事件）
@@ -0,0 +1,165 @@
+-- name: ListOutboxEvents :many
+SELECT
+    event_id,
+    aggregate_type,
+    aggregate_id,
+    event_type,
+    payload,
+    headers,
+    occurred_at,
+    available_at,
+    published_at,
+    delivery_attempts,
+    last_error,
+    lock_token,
+    locked_at
+FROM profile.outbox_events
+WHERE CASE sqlc.arg(status)::text
+          WHEN 'pending' THEN published_at IS NULL
+          WHEN 'failing' THEN published_at IS NULL AND last_error IS NOT NULL
+          WHEN 'published' THEN published_at IS NOT NULL
+          ELSE TRUE
+      END
+  AND delivery_attempts >= sqlc.arg(min_attempts)::int
+ORDER BY occurred_at DESC, event_id
+LIMIT sqlc.arg(limit_count);
+
+-- name: GetOutboxEvent :one
+SELECT
+    event_id,
+    aggregate_type,
+    aggregate_id,
+    event_type,
+    payload,
+    headers,
+    occurred_at,
+    available_at,
+    published_at,
+    delivery_attempts,
+    last_error,
+    lock_token,
+    locked_at
+FROM profile.outbox_events
+WHERE event_id = $1;
+
+-- name: RetryOutboxEvent :execrows
+UPDATE profile.outbox_events
+SET available_at      = now(),
+    lock_token        = NULL,
+    locked_at         = NULL,
+    delivery_attempts = CASE WHEN sqlc.arg(reset_attempts)::bool THEN 0 ELSE delivery_attempts END
+WHERE event_id = sqlc.arg(event_id)
+  AND published_at IS NULL;
+
+-- name: DeadLetterOutboxEvent :execrows
+WITH moved AS (
+    DELETE FROM profile.outbox_events AS oe
+    WHERE oe.event_id = sqlc.arg(event_id)
+      AND oe.published_at IS NULL
+    RETURNING oe.event_id, oe.aggregate_type, oe.aggregate_id, oe.event_type, oe.payload, oe.headers,
+              oe.occurred_at, oe.delivery_attempts, oe.last_error
+)
+INSERT INTO profile.outbox_dead_letters (
+    event_id, aggregate_type, aggregate_id, event_type, payload, headers,
+    occurred_at, delivery_attempts, last_error, reason, dead_lettered_by
+)
+SELECT event_id, aggregate_type, aggregate_id, event_type, payload, headers,
+       occurred_at, delivery_attempts, last_error, sqlc.narg(reason)::text, sqlc.narg(dead_lettered_by)::text
+FROM moved;
+
+-- name: ListOutboxDeadLetters :many
+SELECT
+    event_id,
+    aggregate_type,
+    aggregate_id,
+    event_type,
+    payload,
+    headers,
+    occurred_at,
+    delivery_attempts,
+    last_error,
+    dead_lettered_at,
+    reason,
+    dead_lettered_by
+FROM profile.outbox_dead_letters
+ORDER BY dead_lettered_at DESC, event_id
+LIMIT sqlc.arg(limit_count);
+
+-- name: GetOutboxDeadLetter :one
+SELECT
+    event_id,
+    aggregate_type,
+    aggregate_id,
+    event_type,
+    payload,
+    headers,
+    occurred_at,
+    delivery_attempts,
+    last_error,
+    dead_lettered_at,
+    reason,
+    dead_lettered_by
+FROM profile.outbox_dead_letters
+WHERE event_id = $1;
+
+-- name: RequeueOutboxDeadLetter :execrows
+WITH moved AS (
+    DELETE FROM profile.outbox_dead_letters AS dl
+    WHERE dl.event_id = sqlc.arg(event_id)
+    RETURNING dl.event_id, dl.aggregate_type, dl.aggregate_id, dl.event_type, dl.payload, dl.headers,
+              dl.occurred_at, dl.delivery_attempts, dl.last_error
+)
+INSERT INTO profile.outbox_events (
+    event_id, aggregate_type, aggregate_id, event_type, payload, headers,
+    occurred_at, available_at, delivery_attempts, last_error
+)
+SELECT event_id, aggregate_type, aggregate_id, event_type, payload, headers,
+       occurred_at, now(),
+       CASE WHEN sqlc.arg(reset_attempts)::bool THEN 0 ELSE delivery_attempts END,
+       last_error
+FROM moved;
+
+-- name: DeletePublishedOutboxEvents :execrows
+DELETE FROM profile.outbox_events
+WHERE event_id IN (
+    SELECT oe.event_id
+    FROM profile.outbox_events AS oe
+    WHERE oe.published_at < sqlc.arg(published_before)::timestamptz
+    ORDER BY oe.published_at
+    LIMIT sqlc.arg(batch_size)
+);
+
+-- name: ArchivePublishedOutboxEvents :one
+WITH moved AS (
+    DELETE FROM profile.outbox_events AS oe
+    WHERE oe.event_id IN (
+        SELECT o.event_id
+        FROM profile.outbox_events AS o
+        WHERE o.published_at < sqlc.arg(published_before)::timestamptz
+        ORDER BY o.published_at
+        LIMIT sqlc.arg(batch_size)
+    )
+    RETURNING oe.event_id, oe.aggregate_type, oe.aggregate_id, oe.event_type, oe.payload, oe.headers,
+              oe.occurred_at, oe.published_at, oe.delivery_attempts
+),
+archived AS (
+    INSERT INTO profile.outbox_events_archive (
+        event_id, aggregate_type, aggregate_id, event_type, payload, headers,
+        occurred_at, published_at, delivery_attempts
+    )
+    SELECT event_id, aggregate_type, aggregate_id, event_type, payload, headers,
+           occurred_at, published_at, delivery_attempts
+    FROM moved
+    ON CONFLICT (event_id) DO NOTHING
+)
+SELECT count(*)::bigint AS moved_count
+FROM moved;
+
+-- name: GetOutboxBacklogStats :one
+SELECT
+    count(*)::bigint AS pending,
+    min(available_at)::timestamptz AS oldest_available_at,
+    count(*) FILTER (WHERE delivery_attempts >= sqlc.arg(max_attempts)::int)::bigint AS over_max_attempts,
+    count(*) FILTER (WHERE locked_at IS NOT NULL AND locked_at < sqlc.arg(lease_expired_before)::timestamptz)::bigint AS lease_expired
+FROM profile.outbox_events
+WHERE published_at IS NULL;
@@ -0,0 +1,29 @@
+-- name: InsertPendingVideoEvent :execrows
+INSERT INTO profile.pending_video_events (
+    video_id,
+    version,
+    event_id,
+    event_type,
+    payload,
+    occurred_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (event_id) DO NOTHING;
+
+-- name: ListPendingVideoEvents :many
+SELECT
+    video_id,
+    version,
+    event_id,
+    event_type,
+    payload,
+    occurred_at,
+    parked_at
+FROM profile.pending_video_events
+WHERE video_id = $1
+ORDER BY version ASC, occurred_at ASC NULLS LAST, parked_at ASC;
+
+-- name: DeletePendingVideoEvents :execrows
+DELETE FROM profile.pending_video_events
+WHERE video_id = $1;
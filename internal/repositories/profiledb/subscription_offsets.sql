@@ -0,0 +1,63 @@
+-- name: AdvanceSubscriptionOffset :exec
+INSERT INTO profile.subscription_offsets (
+    consumer_group,
+    subscription,
+    message_id,
+    publish_time,
+    last_event_id,
+    last_occurred_at,
+    lag_millis,
+    updated_by,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, now()
+)
+ON CONFLICT (consumer_group) DO UPDATE
+SET subscription     = EXCLUDED.subscription,
+    message_id       = CASE
+                           WHEN EXCLUDED.publish_time IS NOT NULL
+                                AND (profile.subscription_offsets.publish_time IS NULL
+                                     OR EXCLUDED.publish_time >= profile.subscription_offsets.publish_time)
+                           THEN EXCLUDED.message_id
+                           ELSE profile.subscription_offsets.message_id
+                       END,
+    publish_time     = GREATEST(profile.subscription_offsets.publish_time, EXCLUDED.publish_time),
+    last_event_id    = CASE
+                           WHEN EXCLUDED.last_occurred_at IS NOT NULL
+                                AND (profile.subscription_offsets.last_occurred_at IS NULL
+                                     OR EXCLUDED.last_occurred_at >= profile.subscription_offsets.last_occurred_at)
+                           THEN EXCLUDED.last_event_id
+                           ELSE profile.subscription_offsets.last_event_id
+                       END,
+    last_occurred_at = GREATEST(profile.subscription_offsets.last_occurred_at, EXCLUDED.last_occurred_at),
+    lag_millis       = EXCLUDED.lag_millis,
+    updated_by       = EXCLUDED.updated_by,
+    updated_at       = now();
+
+-- name: GetSubscriptionOffset :one
+SELECT
+    consumer_group,
+    subscription,
+    message_id,
+    publish_time,
+    last_event_id,
+    last_occurred_at,
+    lag_millis,
+    updated_by,
+    updated_at
+FROM profile.subscription_offsets
+WHERE consumer_group = $1;
+
+-- name: ListSubscriptionOffsets :many
+SELECT
+    consumer_group,
+    subscription,
+    message_id,
+    publish_time,
+    last_event_id,
+    last_occurred_at,
+    lag_millis,
+    updated_by,
+    updated_at
+FROM profile.subscription_offsets
+ORDER BY consumer_group;
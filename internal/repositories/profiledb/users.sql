@@ -6,7 +6,16 @@ SELECT
     profile_version,
     preferences_json,
     created_at,
-    updated_at
+    updated_at,
+    account_status,
+    pending_deletion_at,
+    deleted_at,
+    public_display_name,
+    public_avatar,
+    public_bookmarks,
+    public_stats,
+    preferred_locale,
+    preferred_timezone
 FROM profile.users
 WHERE user_id = $1;
 
@@ -16,16 +25,46 @@ INSERT INTO profile.users (
     display_name,
     avatar_url,
     profile_version,
-    preferences_json
+    preferences_json,
+    preferred_locale,
+    preferred_timezone
 ) VALUES (
-    $1, $2, $3, $4, $5
+    $1, $2, $3, $4, $5, $6, $7
 )
 ON CONFLICT (user_id) DO UPDATE
 SET display_name = EXCLUDED.display_name,
     avatar_url = EXCLUDED.avatar_url,
     profile_version = EXCLUDED.profile_version,
     preferences_json = EXCLUDED.preferences_json,
+    preferred_locale = EXCLUDED.preferred_locale,
+    preferred_timezone = EXCLUDED.preferred_timezone,
+    updated_at = now()
+RETURNING
+    user_id,
+    display_name,
+    avatar_url,
+    profile_version,
+    preferences_json,
+    created_at,
+    updated_at,
+    account_status,
+    pending_deletion_at,
+    deleted_at,
+    public_display_name,
+    public_avatar,
+    public_bookmarks,
+    public_stats,
+    preferred_locale,
+    preferred_timezone;
+
+-- name: UpdateProfileUserAccountStatus :one
+UPDATE profile.users
+SET account_status = $2,
+    pending_deletion_at = $3,
+    deleted_at = $4,
+    profile_version = $5,
     updated_at = now()
+WHERE user_id = $1
 RETURNING
     user_id,
     display_name,
@@ -33,4 +72,73 @@ RETURNING
     profile_version,
     preferences_json,
     created_at,
-    updated_at;
+    updated_at,
+    account_status,
+    pending_deletion_at,
+    deleted_at,
+    public_display_name,
+    public_avatar,
+    public_bookmarks,
+    public_stats,
+    preferred_locale,
+    preferred_timezone;
+
+-- name: UpdateProfileUserVisibility :one
+UPDATE profile.users
+SET public_display_name = $2,
+    public_avatar = $3,
+    public_bookmarks = $4,
+    public_stats = $5,
+    profile_version = $6,
+    updated_at = now()
+WHERE user_id = $1
+RETURNING
+    user_id,
+    display_name,
+    avatar_url,
+    profile_version,
+    preferences_json,
+    created_at,
+    updated_at,
+    account_status,
+    pending_deletion_at,
+    deleted_at,
+    public_display_name,
+    public_avatar,
+    public_bookmarks,
+    public_stats,
+    preferred_locale,
+    preferred_timezone;
+
+-- name: GetProfileUserPublicStats :one
+SELECT
+    (SELECT count(*)
+       FROM profile.engagements e
+      WHERE e.user_id = $1
+        AND e.engagement_type = 'like'
+        AND e.deleted_at IS NULL)::bigint AS like_count,
+    (SELECT count(*)
+       FROM profile.engagements e
+      WHERE e.user_id = $1
+        AND e.engagement_type = 'bookmark'
+        AND e.deleted_at IS NULL)::bigint AS bookmark_count,
+    (SELECT count(*)
+       FROM profile.watch_logs w
+      WHERE w.user_id = $1
+        AND w.redacted_at IS NULL)::bigint AS watched_video_count,
+    (SELECT COALESCE(sum(w.total_watch_seconds), 0)
+       FROM profile.watch_logs w
+      WHERE w.user_id = $1
+        AND w.redacted_at IS NULL)::bigint AS total_watch_seconds;
+
+-- name: LockProfileDisplayName :exec
+SELECT pg_advisory_xact_lock(hashtextextended(lower(sqlc.arg(display_name)::text), 0));
+
+-- name: ExistsProfileDisplayName :one
+SELECT EXISTS (
+    SELECT 1
+    FROM profile.users
+    WHERE lower(display_name) = lower(sqlc.arg(display_name)::text)
+      AND user_id <> sqlc.arg(user_id)
+      AND deleted_at IS NULL
+) AS taken;
@@ -12,6 +12,28 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const existsProfileDisplayName = `-- name: ExistsProfileDisplayName :one
+SELECT EXISTS (
+    SELECT 1
+    FROM profile.users
+    WHERE lower(display_name) = lower($1::text)
+      AND user_id <> $2
+      AND deleted_at IS NULL
+) AS taken
+`
+
+type ExistsProfileDisplayNameParams struct {
+	DisplayName string    `json:"display_name"`
+	UserID      uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) ExistsProfileDisplayName(ctx context.Context, arg ExistsProfileDisplayNameParams) (bool, error) {
+	row := q.db.QueryRow(ctx, existsProfileDisplayName, arg.DisplayName, arg.UserID)
+	var taken bool
+	err := row.Scan(&taken)
+	return taken, err
+}
+
 const getProfileUser = `-- name: GetProfileUser :one
 SELECT
     user_id,
@@ -20,7 +42,16 @@ SELECT
     profile_version,
     preferences_json,
     created_at,
-    updated_at
+    updated_at,
+    account_status,
+    pending_deletion_at,
+    deleted_at,
+    public_display_name,
+    public_avatar,
+    public_bookmarks,
+    public_stats,
+    preferred_locale,
+    preferred_timezone
 FROM profile.users
 WHERE user_id = $1
 `
@@ -36,6 +67,198 @@ func (q *Queries) GetProfileUser(ctx context.Context, userID uuid.UUID) (Profile
 		&i.PreferencesJson,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.AccountStatus,
+		&i.PendingDeletionAt,
+		&i.DeletedAt,
+		&i.PublicDisplayName,
+		&i.PublicAvatar,
+		&i.PublicBookmarks,
+		&i.PublicStats,
+		&i.PreferredLocale,
+		&i.PreferredTimezone,
+	)
+	return i, err
+}
+
+const getProfileUserPublicStats = `-- name: GetProfileUserPublicStats :one
+SELECT
+    (SELECT count(*)
+       FROM profile.engagements e
+      WHERE e.user_id = $1
+        AND e.engagement_type = 'like'
+        AND e.deleted_at IS NULL)::bigint AS like_count,
+    (SELECT count(*)
+       FROM profile.engagements e
+      WHERE e.user_id = $1
+        AND e.engagement_type = 'bookmark'
+        AND e.deleted_at IS NULL)::bigint AS bookmark_count,
+    (SELECT count(*)
+       FROM profile.watch_logs w
+      WHERE w.user_id = $1
+        AND w.redacted_at IS NULL)::bigint AS watched_video_count,
+    (SELECT COALESCE(sum(w.total_watch_seconds), 0)
+       FROM profile.watch_logs w
+      WHERE w.user_id = $1
+        AND w.redacted_at IS NULL)::bigint AS total_watch_seconds
+`
+
+type GetProfileUserPublicStatsRow struct {
+	LikeCount         int64 `json:"like_count"`
+	BookmarkCount     int64 `json:"bookmark_count"`
+	WatchedVideoCount int64 `json:"watched_video_count"`
+	TotalWatchSeconds int64 `json:"total_watch_seconds"`
+}
+
+func (q *Queries) GetProfileUserPublicStats(ctx context.Context, userID uuid.UUID) (GetProfileUserPublicStatsRow, error) {
+	row := q.db.QueryRow(ctx, getProfileUserPublicStats, userID)
+	var i GetProfileUserPublicStatsRow
+	err := row.Scan(
+		&i.LikeCount,
+		&i.BookmarkCount,
+		&i.WatchedVideoCount,
+		&i.TotalWatchSeconds,
+	)
+	return i, err
+}
+
+const lockProfileDisplayName = `-- name: LockProfileDisplayName :exec
+SELECT pg_advisory_xact_lock(hashtextextended(lower($1::text), 0))
+`
+
+func (q *Queries) LockProfileDisplayName(ctx context.Context, displayName string) error {
+	_, err := q.db.Exec(ctx, lockProfileDisplayName, displayName)
+	return err
+}
+
+const updateProfileUserAccountStatus = `-- name: UpdateProfileUserAccountStatus :one
+UPDATE profile.users
+SET account_status = $2,
+    pending_deletion_at = $3,
+    deleted_at = $4,
+    profile_version = $5,
+    updated_at = now()
+WHERE user_id = $1
+RETURNING
+    user_id,
+    display_name,
+    avatar_url,
+    profile_version,
+    preferences_json,
+    created_at,
+    updated_at,
+    account_status,
+    pending_deletion_at,
+    deleted_at,
+    public_display_name,
+    public_avatar,
+    public_bookmarks,
+    public_stats,
+    preferred_locale,
+    preferred_timezone
+`
+
+type UpdateProfileUserAccountStatusParams struct {
+	UserID            uuid.UUID          `json:"user_id"`
+	AccountStatus     string             `json:"account_status"`
+	PendingDeletionAt pgtype.Timestamptz `json:"pending_deletion_at"`
+	DeletedAt         pgtype.Timestamptz `json:"deleted_at"`
+	ProfileVersion    int64              `json:"profile_version"`
+}
+
+func (q *Queries) UpdateProfileUserAccountStatus(ctx context.Context, arg UpdateProfileUserAccountStatusParams) (ProfileUser, error) {
+	row := q.db.QueryRow(ctx, updateProfileUserAccountStatus,
+		arg.UserID,
+		arg.AccountStatus,
+		arg.PendingDeletionAt,
+		arg.DeletedAt,
+		arg.ProfileVersion,
+	)
+	var i ProfileUser
+	err := row.Scan(
+		&i.UserID,
+		&i.DisplayName,
+		&i.AvatarUrl,
+		&i.ProfileVersion,
+		&i.PreferencesJson,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AccountStatus,
+		&i.PendingDeletionAt,
+		&i.DeletedAt,
+		&i.PublicDisplayName,
+		&i.PublicAvatar,
+		&i.PublicBookmarks,
+		&i.PublicStats,
+		&i.PreferredLocale,
+		&i.PreferredTimezone,
+	)
+	return i, err
+}
+
+const updateProfileUserVisibility = `-- name: UpdateProfileUserVisibility :one
+UPDATE profile.users
+SET public_display_name = $2,
+    public_avatar = $3,
+    public_bookmarks = $4,
+    public_stats = $5,
+    profile_version = $6,
+    updated_at = now()
+WHERE user_id = $1
+RETURNING
+    user_id,
+    display_name,
+    avatar_url,
+    profile_version,
+    preferences_json,
+    created_at,
+    updated_at,
+    account_status,
+    pending_deletion_at,
+    deleted_at,
+    public_display_name,
+    public_avatar,
+    public_bookmarks,
+    public_stats,
+    preferred_locale,
+    preferred_timezone
+`
+
+type UpdateProfileUserVisibilityParams struct {
+	UserID            uuid.UUID `json:"user_id"`
+	PublicDisplayName bool      `json:"public_display_name"`
+	PublicAvatar      bool      `json:"public_avatar"`
+	PublicBookmarks   bool      `json:"public_bookmarks"`
+	PublicStats       bool      `json:"public_stats"`
+	ProfileVersion    int64     `json:"profile_version"`
+}
+
+func (q *Queries) UpdateProfileUserVisibility(ctx context.Context, arg UpdateProfileUserVisibilityParams) (ProfileUser, error) {
+	row := q.db.QueryRow(ctx, updateProfileUserVisibility,
+		arg.UserID,
+		arg.PublicDisplayName,
+		arg.PublicAvatar,
+		arg.PublicBookmarks,
+		arg.PublicStats,
+		arg.ProfileVersion,
+	)
+	var i ProfileUser
+	err := row.Scan(
+		&i.UserID,
+		&i.DisplayName,
+		&i.AvatarUrl,
+		&i.ProfileVersion,
+		&i.PreferencesJson,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AccountStatus,
+		&i.PendingDeletionAt,
+		&i.DeletedAt,
+		&i.PublicDisplayName,
+		&i.PublicAvatar,
+		&i.PublicBookmarks,
+		&i.PublicStats,
+		&i.PreferredLocale,
+		&i.PreferredTimezone,
 	)
 	return i, err
 }
@@ -46,15 +269,19 @@ INSERT INTO profile.users (
     display_name,
     avatar_url,
     profile_version,
-    preferences_json
+    preferences_json,
+    preferred_locale,
+    preferred_timezone
 ) VALUES (
-    $1, $2, $3, $4, $5
+    $1, $2, $3, $4, $5, $6, $7
 )
 ON CONFLICT (user_id) DO UPDATE
 SET display_name = EXCLUDED.display_name,
     avatar_url = EXCLUDED.avatar_url,
     profile_version = EXCLUDED.profile_version,
     preferences_json = EXCLUDED.preferences_json,
+    preferred_locale = EXCLUDED.preferred_locale,
+    preferred_timezone = EXCLUDED.preferred_timezone,
     updated_at = now()
 RETURNING
     user_id,
@@ -63,15 +290,26 @@ RETURNING
     profile_version,
     preferences_json,
     created_at,
-    updated_at
+    updated_at,
+    account_status,
+    pending_deletion_at,
+    deleted_at,
+    public_display_name,
+    public_avatar,
+    public_bookmarks,
+    public_stats,
+    preferred_locale,
+    preferred_timezone
 `
 
 type UpsertProfileUserParams struct {
-	UserID          uuid.UUID   `json:"user_id"`
-	DisplayName     string      `json:"display_name"`
-	AvatarUrl       pgtype.Text `json:"avatar_url"`
-	ProfileVersion  int64       `json:"profile_version"`
-	PreferencesJson []byte      `json:"preferences_json"`
+	UserID            uuid.UUID   `json:"user_id"`
+	DisplayName       string      `json:"display_name"`
+	AvatarUrl         pgtype.Text `json:"avatar_url"`
+	ProfileVersion    int64       `json:"profile_version"`
+	PreferencesJson   []byte      `json:"preferences_json"`
+	PreferredLocale   pgtype.Text `json:"preferred_locale"`
+	PreferredTimezone pgtype.Text `json:"preferred_timezone"`
 }
 
 func (q *Queries) UpsertProfileUser(ctx context.Context, arg UpsertProfileUserParams) (ProfileUser, error) {
@@ -81,6 +319,8 @@ func (q *Queries) UpsertProfileUser(ctx context.Context, arg UpsertProfileUserPa
 		arg.AvatarUrl,
 		arg.ProfileVersion,
 		arg.PreferencesJson,
+		arg.PreferredLocale,
+		arg.PreferredTimezone,
 	)
 	var i ProfileUser
 	err := row.Scan(
@@ -91,6 +331,15 @@ func (q *Queries) UpsertProfileUser(ctx context.Context, arg UpsertProfileUserPa
 		&i.PreferencesJson,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.AccountStatus,
+		&i.PendingDeletionAt,
+		&i.DeletedAt,
+		&i.PublicDisplayName,
+		&i.PublicAvatar,
+		&i.PublicBookmarks,
+		&i.PublicStats,
+		&i.PreferredLocale,
+		&i.PreferredTimezone,
 	)
 	return i, err
 }
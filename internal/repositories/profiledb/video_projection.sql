@@ -57,3 +57,8 @@ SELECT
     updated_at
 FROM profile.videos_projection
 WHERE video_id = ANY($1::uuid[]);
+
+-- name: CountStaleVideoProjections :one
+SELECT count(*)::bigint
+FROM profile.videos_projection
+WHERE updated_at < sqlc.arg(updated_before);
@@ -12,6 +12,19 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countStaleVideoProjections = `-- name: CountStaleVideoProjections :one
+SELECT count(*)::bigint
+FROM profile.videos_projection
+WHERE updated_at < $1
+`
+
+func (q *Queries) CountStaleVideoProjections(ctx context.Context, updatedBefore pgtype.Timestamptz) (int64, error) {
+	row := q.db.QueryRow(ctx, countStaleVideoProjections, updatedBefore)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
 const getVideoProjection = `-- name: GetVideoProjection :one
 SELECT
     video_id,
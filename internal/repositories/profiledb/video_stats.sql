@@ -3,26 +3,32 @@ INSERT INTO profile.video_stats (
     video_id,
     like_count,
     bookmark_count,
+    dislike_count,
+    share_count,
     unique_watchers,
     total_watch_seconds,
     updated_at
 ) VALUES (
-    $1, $2, $3, $4, $5, COALESCE($6, now())
+    $1, $2, $3, $4, $5, $6, $7, COALESCE($8, now())
 )
 ON CONFLICT (video_id) DO UPDATE
 SET like_count          = profile.video_stats.like_count + $2,
     bookmark_count      = profile.video_stats.bookmark_count + $3,
-    unique_watchers     = profile.video_stats.unique_watchers + $4,
-    total_watch_seconds = profile.video_stats.total_watch_seconds + $5,
-    updated_at          = COALESCE($6, now());
+    dislike_count       = profile.video_stats.dislike_count + $4,
+    share_count         = profile.video_stats.share_count + $5,
+    unique_watchers     = profile.video_stats.unique_watchers + $6,
+    total_watch_seconds = profile.video_stats.total_watch_seconds + $7,
+    updated_at          = COALESCE($8, now());
 
 -- name: SetVideoStats :exec
 UPDATE profile.video_stats
 SET like_count          = $2,
     bookmark_count      = $3,
-    unique_watchers     = $4,
-    total_watch_seconds = $5,
-    updated_at          = COALESCE($6, now())
+    dislike_count       = $4,
+    share_count         = $5,
+    unique_watchers     = $6,
+    total_watch_seconds = $7,
+    updated_at          = COALESCE($8, now())
 WHERE video_id = $1;
 
 -- name: GetVideoStats :one
@@ -32,7 +38,9 @@ SELECT
     bookmark_count,
     unique_watchers,
     total_watch_seconds,
-    updated_at
+    updated_at,
+    dislike_count,
+    share_count
 FROM profile.video_stats
 WHERE video_id = $1;
 
@@ -43,6 +51,8 @@ SELECT
     bookmark_count,
     unique_watchers,
     total_watch_seconds,
-    updated_at
+    updated_at,
+    dislike_count,
+    share_count
 FROM profile.video_stats
 WHERE video_id = ANY($1::uuid[]);
@@ -19,7 +19,9 @@ SELECT
     bookmark_count,
     unique_watchers,
     total_watch_seconds,
-    updated_at
+    updated_at,
+    dislike_count,
+    share_count
 FROM profile.video_stats
 WHERE video_id = $1
 `
@@ -34,6 +36,8 @@ func (q *Queries) GetVideoStats(ctx context.Context, videoID uuid.UUID) (Profile
 		&i.UniqueWatchers,
 		&i.TotalWatchSeconds,
 		&i.UpdatedAt,
+		&i.DislikeCount,
+		&i.ShareCount,
 	)
 	return i, err
 }
@@ -45,7 +49,9 @@ SELECT
     bookmark_count,
     unique_watchers,
     total_watch_seconds,
-    updated_at
+    updated_at,
+    dislike_count,
+    share_count
 FROM profile.video_stats
 WHERE video_id = ANY($1::uuid[])
 `
@@ -66,6 +72,8 @@ func (q *Queries) ListVideoStats(ctx context.Context, dollar_1 []uuid.UUID) ([]P
 			&i.UniqueWatchers,
 			&i.TotalWatchSeconds,
 			&i.UpdatedAt,
+			&i.DislikeCount,
+			&i.ShareCount,
 		); err != nil {
 			return nil, err
 		}
@@ -81,9 +89,11 @@ const setVideoStats = `-- name: SetVideoStats :exec
 UPDATE profile.video_stats
 SET like_count          = $2,
     bookmark_count      = $3,
-    unique_watchers     = $4,
-    total_watch_seconds = $5,
-    updated_at          = COALESCE($6, now())
+    dislike_count       = $4,
+    share_count         = $5,
+    unique_watchers     = $6,
+    total_watch_seconds = $7,
+    updated_at          = COALESCE($8, now())
 WHERE video_id = $1
 `
 
@@ -91,6 +101,8 @@ type SetVideoStatsParams struct {
 	VideoID           uuid.UUID          `json:"video_id"`
 	LikeCount         int64              `json:"like_count"`
 	BookmarkCount     int64              `json:"bookmark_count"`
+	DislikeCount      int64              `json:"dislike_count"`
+	ShareCount        int64              `json:"share_count"`
 	UniqueWatchers    int64              `json:"unique_watchers"`
 	TotalWatchSeconds int64              `json:"total_watch_seconds"`
 	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
@@ -101,6 +113,8 @@ func (q *Queries) SetVideoStats(ctx context.Context, arg SetVideoStatsParams) er
 		arg.VideoID,
 		arg.LikeCount,
 		arg.BookmarkCount,
+		arg.DislikeCount,
+		arg.ShareCount,
 		arg.UniqueWatchers,
 		arg.TotalWatchSeconds,
 		arg.UpdatedAt,
@@ -113,27 +127,33 @@ INSERT INTO profile.video_stats (
     video_id,
     like_count,
     bookmark_count,
+    dislike_count,
+    share_count,
     unique_watchers,
     total_watch_seconds,
     updated_at
 ) VALUES (
-    $1, $2, $3, $4, $5, COALESCE($6, now())
+    $1, $2, $3, $4, $5, $6, $7, COALESCE($8, now())
 )
 ON CONFLICT (video_id) DO UPDATE
 SET like_count          = profile.video_stats.like_count + $2,
     bookmark_count      = profile.video_stats.bookmark_count + $3,
-    unique_watchers     = profile.video_stats.unique_watchers + $4,
-    total_watch_seconds = profile.video_stats.total_watch_seconds + $5,
-    updated_at          = COALESCE($6, now())
+    dislike_count       = profile.video_stats.dislike_count + $4,
+    share_count         = profile.video_stats.share_count + $5,
+    unique_watchers     = profile.video_stats.unique_watchers + $6,
+    total_watch_seconds = profile.video_stats.total_watch_seconds + $7,
+    updated_at          = COALESCE($8, now())
 `
 
 type UpsertVideoStatsParams struct {
 	VideoID           uuid.UUID   `json:"video_id"`
 	LikeCount         int64       `json:"like_count"`
 	BookmarkCount     int64       `json:"bookmark_count"`
+	DislikeCount      int64       `json:"dislike_count"`
+	ShareCount        int64       `json:"share_count"`
 	UniqueWatchers    int64       `json:"unique_watchers"`
 	TotalWatchSeconds int64       `json:"total_watch_seconds"`
-	Column6           interface{} `json:"column_6"`
+	Column8           interface{} `json:"column_8"`
 }
 
 func (q *Queries) UpsertVideoStats(ctx context.Context, arg UpsertVideoStatsParams) error {
@@ -141,9 +161,11 @@ func (q *Queries) UpsertVideoStats(ctx context.Context, arg UpsertVideoStatsPara
 		arg.VideoID,
 		arg.LikeCount,
 		arg.BookmarkCount,
+		arg.DislikeCount,
+		arg.ShareCount,
 		arg.UniqueWatchers,
 		arg.TotalWatchSeconds,
-		arg.Column6,
+		arg.Column8,
 	)
 	return err
 }
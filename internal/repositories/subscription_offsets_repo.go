@@ -0,0 +1,108 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories/mappers"
+	profiledb "github.com/bionicotaku/lingo-services-profile/internal/repositories/profiledb"
+
+	"github.com/bionicotaku/lingo-utils/txmanager"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrSubscriptionOffsetNotFound 表示消费组尚未记录位点。
+var ErrSubscriptionOffsetNotFound = errors.New("subscription offset not found")
+
+// SubscriptionOffsetsRepository 维护 profile.subscription_offsets。
+type SubscriptionOffsetsRepository struct {
+	db      *pgxpool.Pool
+	queries *profiledb.Queries
+	log     *log.Helper
+}
+
+// NewSubscriptionOffsetsRepository 构造仓储实例。
+func NewSubscriptionOffsetsRepository(db *pgxpool.Pool, logger log.Logger) *SubscriptionOffsetsRepository {
+	return &SubscriptionOffsetsRepository{
+		db:      db,
+		queries: profiledb.New(db),
+		log:     log.NewHelper(logger),
+	}
+}
+
+// AdvanceSubscriptionOffsetInput 描述一条消息处理完成后的位点推进。
+type AdvanceSubscriptionOffsetInput struct {
+	ConsumerGroup  string
+	Subscription   string
+	MessageID      *string
+	PublishTime    *time.Time
+	LastEventID    *uuid.UUID
+	LastOccurredAt *time.Time
+	Lag            time.Duration
+	UpdatedBy      string
+}
+
+// Advance 推进消费组高水位；publish_time / occurred_at 只前进不回退，应与事件处理处于同一事务。
+func (r *SubscriptionOffsetsRepository) Advance(ctx context.Context, sess txmanager.Session, input AdvanceSubscriptionOffsetInput) error {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	lag := input.Lag
+	if lag < 0 {
+		lag = 0
+	}
+	err := queries.AdvanceSubscriptionOffset(ctx, profiledb.AdvanceSubscriptionOffsetParams{
+		ConsumerGroup:  input.ConsumerGroup,
+		Subscription:   input.Subscription,
+		MessageID:      mappers.ToPgText(input.MessageID),
+		PublishTime:    mappers.ToPgTimestamptzPtr(input.PublishTime),
+		LastEventID:    mappers.ToPgUUID(input.LastEventID),
+		LastOccurredAt: mappers.ToPgTimestamptzPtr(input.LastOccurredAt),
+		LagMillis:      lag.Milliseconds(),
+		UpdatedBy:      input.UpdatedBy,
+	})
+	if err != nil {
+		return fmt.Errorf("advance subscription offset: %w", err)
+	}
+	return nil
+}
+
+// Get 返回消费组位点。
+func (r *SubscriptionOffsetsRepository) Get(ctx context.Context, sess txmanager.Session, consumerGroup string) (*po.SubscriptionOffset, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	row, err := queries.GetSubscriptionOffset(ctx, consumerGroup)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrSubscriptionOffsetNotFound
+		}
+		return nil, fmt.Errorf("get subscription offset: %w", err)
+	}
+	return mappers.SubscriptionOffsetFromRow(row), nil
+}
+
+// List 返回全部消费组位点，按 consumer_group 排序。
+func (r *SubscriptionOffsetsRepository) List(ctx context.Context, sess txmanager.Session) ([]*po.SubscriptionOffset, error) {
+	queries := r.queries
+	if sess != nil {
+		queries = queries.WithTx(sess.Tx())
+	}
+	rows, err := queries.ListSubscriptionOffsets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list subscription offsets: %w", err)
+	}
+	result := make([]*po.SubscriptionOffset, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, mappers.SubscriptionOffsetFromRow(row))
+	}
+	return result, nil
+}
@@ -0,0 +1,82 @@
+package repositories_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/bionicotaku/lingo-utils/txmanager"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditTrailRepositoryIntegration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dsn, terminate := startPostgres(ctx, t)
+	defer terminate()
+
+	pool, err := pgxpool.New(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { pool.Close() })
+
+	applyMigrations(ctx, t, pool)
+
+	repo := repositories.NewAuditTrailRepository(pool, log.NewStdLogger(io.Discard))
+	txMgr := newTxManager(t, pool)
+
+	userID := uuid.New()
+	otherUser := uuid.New()
+	inputs := []repositories.AppendAuditEntryInput{
+		{
+			UserID: userID, ActorType: "user", ActorID: userID.String(),
+			Action: "profile.update", ResourceType: "profile", ResourceID: userID.String(),
+			Before: map[string]any{"display_name": "Old"}, After: map[string]any{"display_name": "New"},
+			TraceID: "0a0b0c0d0102030405060708090a0b0c",
+		},
+		{
+			UserID: userID, ActorType: "service", ActorID: "ops-console",
+			Action: "profile.account_status.transition", ResourceType: "profile", ResourceID: userID.String(),
+			After: map[string]any{"account_status": "suspended"},
+		},
+		{
+			UserID: otherUser, ActorType: "user", ActorID: otherUser.String(),
+			Action: "engagement.add", ResourceType: "engagement:like", ResourceID: uuid.NewString(),
+		},
+	}
+	err = txMgr.WithinTx(ctx, txmanager.TxOptions{}, func(txCtx context.Context, sess txmanager.Session) error {
+		for _, input := range inputs {
+			if err := repo.Append(txCtx, sess, input); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	entries, err := repo.List(ctx, nil, repositories.ListAuditEntriesFilter{UserID: &userID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	entries, err = repo.List(ctx, nil, repositories.ListAuditEntriesFilter{ActorID: "ops-console", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "suspended", entries[0].After["account_status"])
+	require.Empty(t, entries[0].Before)
+	require.Nil(t, entries[0].TraceID)
+
+	entries, err = repo.List(ctx, nil, repositories.ListAuditEntriesFilter{TraceID: "0a0b0c0d0102030405060708090a0b0c", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "Old", entries[0].Before["display_name"])
+
+	future := time.Now().Add(time.Hour)
+	entries, err = repo.List(ctx, nil, repositories.ListAuditEntriesFilter{Since: &future, Limit: 10})
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
@@ -0,0 +1,62 @@
+package repositories_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	outboxcfg "github.com/bionicotaku/lingo-utils/outbox/config"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInboxRepositoryOldestPendingIntegration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dsn, terminate := startPostgres(ctx, t)
+	defer terminate()
+
+	pool, err := pgxpool.New(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { pool.Close() })
+
+	applyMigrations(ctx, t, pool)
+
+	repo := repositories.NewInboxRepository(pool, log.NewStdLogger(io.Discard), outboxcfg.Config{Schema: "profile"})
+
+	oldest, err := repo.OldestPendingReceivedAt(ctx, "catalog")
+	require.NoError(t, err)
+	require.Nil(t, oldest)
+
+	base := time.Now().UTC().Add(-time.Hour).Truncate(time.Microsecond)
+	insert := func(source string, receivedAt time.Time, processed bool) {
+		var processedAt *time.Time
+		if processed {
+			processedAt = &receivedAt
+		}
+		_, err := pool.Exec(ctx, `insert into profile.inbox_events
+			(event_id, source_service, event_type, payload, received_at, processed_at)
+			values ($1, $2, 'catalog.video.updated', '\x00', $3, $4)`,
+			uuid.New(), source, receivedAt, processedAt)
+		require.NoError(t, err)
+	}
+	insert("catalog", base, true)
+	insert("catalog", base.Add(10*time.Minute), false)
+	insert("catalog", base.Add(20*time.Minute), false)
+	insert("billing", base.Add(5*time.Minute), false)
+
+	oldest, err = repo.OldestPendingReceivedAt(ctx, "catalog")
+	require.NoError(t, err)
+	require.NotNil(t, oldest)
+	require.True(t, oldest.Equal(base.Add(10*time.Minute)), "oldest=%s", oldest)
+
+	oldest, err = repo.OldestPendingReceivedAt(ctx, "")
+	require.NoError(t, err)
+	require.NotNil(t, oldest)
+	require.True(t, oldest.Equal(base.Add(5*time.Minute)), "oldest=%s", oldest)
+}
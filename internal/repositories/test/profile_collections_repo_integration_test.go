@@ -0,0 +1,100 @@
+package repositories_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileCollectionsRepositoryIntegration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dsn, terminate := startPostgres(ctx, t)
+	defer terminate()
+
+	pool, err := pgxpool.New(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { pool.Close() })
+
+	applyMigrations(ctx, t, pool)
+
+	repo := repositories.NewProfileCollectionsRepository(pool, log.NewStdLogger(io.Discard))
+	userID := uuid.New()
+
+	collection, err := repo.Create(ctx, nil, userID, "Watch later")
+	require.NoError(t, err)
+	require.Equal(t, userID, collection.UserID)
+
+	_, err = repo.Create(ctx, nil, userID, "watch LATER")
+	require.ErrorIs(t, err, repositories.ErrCollectionNameConflict)
+
+	videos := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	for i, videoID := range videos {
+		item, inserted, err := repo.AddItem(ctx, nil, repositories.AddCollectionItemInput{
+			CollectionID: collection.CollectionID,
+			UserID:       userID,
+			VideoID:      videoID,
+		})
+		require.NoError(t, err)
+		require.True(t, inserted)
+		require.Equal(t, int32(i+1), item.Position)
+	}
+	item, inserted, err := repo.AddItem(ctx, nil, repositories.AddCollectionItemInput{
+		CollectionID: collection.CollectionID,
+		UserID:       userID,
+		VideoID:      videos[0],
+	})
+	require.NoError(t, err)
+	require.False(t, inserted)
+	require.Nil(t, item)
+
+	owner, err := repo.Lock(ctx, nil, collection.CollectionID)
+	require.NoError(t, err)
+	require.Equal(t, userID, owner)
+
+	reversed := []uuid.UUID{videos[2], videos[1], videos[0]}
+	affected, err := repo.Reorder(ctx, nil, collection.CollectionID, reversed)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), affected)
+
+	ids, err := repo.ListItemVideoIDs(ctx, nil, collection.CollectionID)
+	require.NoError(t, err)
+	require.Equal(t, reversed, ids)
+
+	page, err := repo.ListItems(ctx, nil, collection.CollectionID, 2, 1)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Equal(t, videos[1], page[0].VideoID)
+	require.Equal(t, int32(2), page[0].Position)
+
+	removed, err := repo.RemoveItem(ctx, nil, collection.CollectionID, videos[1])
+	require.NoError(t, err)
+	require.True(t, removed)
+	_, err = repo.GetItem(ctx, nil, collection.CollectionID, videos[1])
+	require.ErrorIs(t, err, repositories.ErrCollectionItemNotFound)
+
+	renamed, err := repo.Rename(ctx, nil, collection.CollectionID, "Later")
+	require.NoError(t, err)
+	require.Equal(t, "Later", renamed.Name)
+	require.Equal(t, int64(2), renamed.ItemCount)
+
+	listed, err := repo.ListByUser(ctx, nil, userID, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	require.Equal(t, int64(2), listed[0].ItemCount)
+
+	require.NoError(t, repo.Delete(ctx, nil, collection.CollectionID))
+	ids, err = repo.ListItemVideoIDs(ctx, nil, collection.CollectionID)
+	require.NoError(t, err)
+	require.Empty(t, ids)
+	_, err = repo.Get(ctx, nil, collection.CollectionID)
+	require.ErrorIs(t, err, repositories.ErrCollectionNotFound)
+	require.ErrorIs(t, repo.Delete(ctx, nil, collection.CollectionID), repositories.ErrCollectionNotFound)
+}
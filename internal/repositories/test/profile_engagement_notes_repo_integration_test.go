@@ -0,0 +1,81 @@
+package repositories_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileEngagementNotesRepositoryIntegration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dsn, terminate := startPostgres(ctx, t)
+	defer terminate()
+
+	pool, err := pgxpool.New(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { pool.Close() })
+
+	applyMigrations(ctx, t, pool)
+
+	logger := log.NewStdLogger(io.Discard)
+	engagements := repositories.NewProfileEngagementsRepository(pool, logger)
+	repo := repositories.NewProfileEngagementNotesRepository(pool, logger)
+
+	userID := uuid.New()
+	videoID := uuid.New()
+
+	// 未收藏时外键拒绝写入。
+	_, err = repo.Insert(ctx, nil, repositories.InsertEngagementNoteInput{
+		UserID: userID, VideoID: videoID, EngagementType: "bookmark", Body: "orphan",
+	})
+	require.Error(t, err)
+
+	require.NoError(t, engagements.Upsert(ctx, nil, repositories.UpsertProfileEngagementInput{
+		UserID: userID, VideoID: videoID, EngagementType: "bookmark",
+	}))
+
+	late := int32(300)
+	early := int32(192)
+	first, err := repo.Insert(ctx, nil, repositories.InsertEngagementNoteInput{
+		UserID: userID, VideoID: videoID, EngagementType: "bookmark", Body: "no timestamp",
+	})
+	require.NoError(t, err)
+	_, err = repo.Insert(ctx, nil, repositories.InsertEngagementNoteInput{
+		UserID: userID, VideoID: videoID, EngagementType: "bookmark", Body: "later", PositionSeconds: &late,
+	})
+	require.NoError(t, err)
+
+	updated, err := repo.Update(ctx, nil, first.NoteID, "idiom at 03:12", &early)
+	require.NoError(t, err)
+	require.Equal(t, early, *updated.PositionSeconds)
+
+	count, err := repo.Count(ctx, nil, userID, videoID, "bookmark")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	notes, err := repo.ListByVideos(ctx, nil, userID, "bookmark", []uuid.UUID{videoID, uuid.New()})
+	require.NoError(t, err)
+	require.Len(t, notes, 2)
+	require.Equal(t, "idiom at 03:12", notes[0].Body)
+	require.Equal(t, "later", notes[1].Body)
+
+	require.NoError(t, repo.Delete(ctx, nil, first.NoteID))
+	require.ErrorIs(t, repo.Delete(ctx, nil, first.NoteID), repositories.ErrEngagementNoteNotFound)
+	_, err = repo.Get(ctx, nil, first.NoteID)
+	require.ErrorIs(t, err, repositories.ErrEngagementNoteNotFound)
+
+	// 互动行物理删除时笔记级联删除。
+	_, err = pool.Exec(ctx, `DELETE FROM profile.engagements WHERE user_id = $1`, userID)
+	require.NoError(t, err)
+	count, err = repo.Count(ctx, nil, userID, videoID, "bookmark")
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
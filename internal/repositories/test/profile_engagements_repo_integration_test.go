@@ -40,6 +40,8 @@ func TestProfileEngagementsRepositoryIntegration(t *testing.T) {
 			VideoID:        videoID,
 			EngagementType: "like",
 			OccurredAt:     &occurred,
+			Source:         "recommendation",
+			Metadata:       map[string]any{"entry_point": "feed", "device": "ios"},
 		})
 	})
 	require.NoError(t, err)
@@ -48,11 +50,22 @@ func TestProfileEngagementsRepositoryIntegration(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "like", record.EngagementType)
 	require.Nil(t, record.DeletedAt)
+	require.Equal(t, "recommendation", record.Source)
+	require.Equal(t, "feed", record.Metadata["entry_point"])
+	require.Equal(t, "ios", record.Metadata["device"])
 
 	list, err := repo.ListByUser(ctx, nil, userID, nil, false, 10, 0)
 	require.NoError(t, err)
 	require.Len(t, list, 1)
 
+	// 按类型过滤
+	list, err = repo.ListByUser(ctx, nil, userID, []string{"bookmark"}, false, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, list, 0)
+	list, err = repo.ListByUser(ctx, nil, userID, []string{"like", "bookmark"}, false, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+
 	deletedAt := time.Now().UTC()
 	err = txMgr.WithinTx(ctx, txmanager.TxOptions{}, func(txCtx context.Context, sess txmanager.Session) error {
 		return repo.SoftDelete(txCtx, sess, repositories.SoftDeleteProfileEngagementInput{
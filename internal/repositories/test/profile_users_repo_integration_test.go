@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
 	"github.com/bionicotaku/lingo-utils/txmanager"
@@ -65,4 +66,65 @@ func TestProfileUsersRepositoryIntegration(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "Updated User", updated.DisplayName)
 	require.Equal(t, int64(2), updated.ProfileVersion)
+	require.Equal(t, "active", updated.AccountStatus)
+	require.True(t, updated.PublicDisplayName)
+	require.True(t, updated.PublicAvatar)
+	require.False(t, updated.PublicBookmarks)
+	require.False(t, updated.PublicStats)
+
+	visible, err := repo.UpdateVisibility(ctx, nil, repositories.UpdateVisibilityInput{
+		UserID:            userID,
+		PublicDisplayName: true,
+		PublicAvatar:      false,
+		PublicBookmarks:   true,
+		PublicStats:       true,
+		ProfileVersion:    2,
+	})
+	require.NoError(t, err)
+	require.False(t, visible.PublicAvatar)
+	require.True(t, visible.PublicBookmarks)
+	require.True(t, visible.PublicStats)
+
+	taken, err := repo.IsDisplayNameTaken(ctx, nil, uuid.New(), "UPDATED user")
+	require.NoError(t, err)
+	require.True(t, taken, "display name lookup should be case-insensitive")
+	err = txMgr.WithinTx(ctx, txmanager.TxOptions{}, func(txCtx context.Context, sess txmanager.Session) error {
+		taken, err = repo.IsDisplayNameTaken(txCtx, sess, userID, "updated user")
+		return err
+	})
+	require.NoError(t, err)
+	require.False(t, taken, "own display name should not count as taken")
+
+	stats, err := repo.GetPublicStats(ctx, nil, userID)
+	require.NoError(t, err)
+	require.Zero(t, stats.LikeCount)
+	require.Zero(t, stats.TotalWatchSeconds)
+
+	pendingAt := time.Now().UTC().Truncate(time.Microsecond)
+	pending, err := repo.UpdateAccountStatus(ctx, nil, repositories.UpdateAccountStatusInput{
+		UserID:            userID,
+		AccountStatus:     "pending_deletion",
+		PendingDeletionAt: &pendingAt,
+		ProfileVersion:    3,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "pending_deletion", pending.AccountStatus)
+	require.Equal(t, int64(3), pending.ProfileVersion)
+	require.NotNil(t, pending.PendingDeletionAt)
+	require.True(t, pendingAt.Equal(*pending.PendingDeletionAt))
+	require.Nil(t, pending.DeletedAt)
+
+	_, err = repo.UpdateAccountStatus(ctx, nil, repositories.UpdateAccountStatusInput{
+		UserID:         userID,
+		AccountStatus:  "banned",
+		ProfileVersion: 4,
+	})
+	require.Error(t, err, "check constraint should reject unknown status")
+
+	_, err = repo.UpdateAccountStatus(ctx, nil, repositories.UpdateAccountStatusInput{
+		UserID:         uuid.New(),
+		AccountStatus:  "suspended",
+		ProfileVersion: 1,
+	})
+	require.ErrorIs(t, err, repositories.ErrProfileUserNotFound)
 }
@@ -33,7 +33,7 @@ func TestProfileVideoStatsRepositoryIntegration(t *testing.T) {
 	videoID := uuid.New()
 
 	err = txMgr.WithinTx(ctx, txmanager.TxOptions{}, func(txCtx context.Context, sess txmanager.Session) error {
-		return repo.Increment(txCtx, sess, videoID, 1, 1, 1, 120)
+		return repo.Increment(txCtx, sess, videoID, repositories.VideoStatsDelta{Likes: 1, Bookmarks: 1, Dislikes: 1, Shares: 2, Watchers: 1, WatchSeconds: 120})
 	})
 	require.NoError(t, err)
 
@@ -41,11 +41,13 @@ func TestProfileVideoStatsRepositoryIntegration(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, int64(1), stats.LikeCount)
 	require.Equal(t, int64(1), stats.BookmarkCount)
+	require.Equal(t, int64(1), stats.DislikeCount)
+	require.Equal(t, int64(2), stats.ShareCount)
 	require.Equal(t, int64(1), stats.UniqueWatchers)
 	require.Equal(t, int64(120), stats.TotalWatchSeconds)
 
 	err = txMgr.WithinTx(ctx, txmanager.TxOptions{}, func(txCtx context.Context, sess txmanager.Session) error {
-		return repo.Increment(txCtx, sess, videoID, 2, 0, 0, 30)
+		return repo.Increment(txCtx, sess, videoID, repositories.VideoStatsDelta{Likes: 2, Dislikes: -1, WatchSeconds: 30})
 	})
 	require.NoError(t, err)
 
@@ -53,6 +55,8 @@ func TestProfileVideoStatsRepositoryIntegration(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, int64(3), stats.LikeCount)
 	require.Equal(t, int64(1), stats.BookmarkCount)
+	require.Equal(t, int64(0), stats.DislikeCount)
+	require.Equal(t, int64(2), stats.ShareCount)
 	require.Equal(t, int64(1), stats.UniqueWatchers)
 	require.Equal(t, int64(150), stats.TotalWatchSeconds)
 
@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/vo"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/bionicotaku/lingo-utils/txmanager"
+	"github.com/google/uuid"
+)
+
+// AccountStatus 表示账户生命周期状态，与 profile.users.account_status 取值一致。
+type AccountStatus string
+
+// 账户生命周期状态常量。
+const (
+	// AccountStatusActive 表示账户正常可用。
+	AccountStatusActive AccountStatus = "active"
+	// AccountStatusSuspended 表示账户被运营冻结，禁止写操作。
+	AccountStatusSuspended AccountStatus = "suspended"
+	// AccountStatusPendingDeletion 表示用户已申请删除，等待清理。
+	AccountStatusPendingDeletion AccountStatus = "pending_deletion"
+	// AccountStatusDeleted 表示账户已删除，仅保留公开字段。
+	AccountStatusDeleted AccountStatus = "deleted"
+)
+
+var (
+	// ErrAccountNotActive 表示账户当前状态不允许写操作。
+	ErrAccountNotActive = errors.New("account not active")
+	// ErrInvalidAccountStatusTransition 表示状态机不允许的状态迁移。
+	ErrInvalidAccountStatusTransition = errors.New("invalid account status transition")
+)
+
+// accountTransitions 定义账户状态机允许的迁移路径；deleted 为终态。
+var accountTransitions = map[AccountStatus][]AccountStatus{
+	AccountStatusActive:          {AccountStatusSuspended, AccountStatusPendingDeletion},
+	AccountStatusSuspended:       {AccountStatusActive, AccountStatusPendingDeletion},
+	AccountStatusPendingDeletion: {AccountStatusActive, AccountStatusDeleted},
+	AccountStatusDeleted:         {},
+}
+
+// CanTransitionTo 判断当前状态是否允许迁移到目标状态。
+func (s AccountStatus) CanTransitionTo(target AccountStatus) bool {
+	for _, next := range accountTransitions[s] {
+		if next == target {
+			return true
+		}
+	}
+	return false
+}
+
+// Valid 判断状态值是否为已知状态。
+func (s AccountStatus) Valid() bool {
+	_, ok := accountTransitions[s]
+	return ok
+}
+
+// accountStatusOf 读取记录中的账户状态，空值视为 active（兼容迁移前数据）。
+func accountStatusOf(record *po.ProfileUser) AccountStatus {
+	if record == nil || record.AccountStatus == "" {
+		return AccountStatusActive
+	}
+	return AccountStatus(record.AccountStatus)
+}
+
+// TransitionAccountStatusInput 描述账户状态迁移参数。
+type TransitionAccountStatusInput struct {
+	UserID          uuid.UUID
+	Target          AccountStatus
+	Reason          string
+	ExpectedVersion *int64
+}
+
+// TransitionAccountStatus 按状态机迁移账户状态并递增 profile_version。
+// 目标状态与当前状态一致时视为幂等操作，直接返回当前档案。
+func (s *ProfileService) TransitionAccountStatus(ctx context.Context, input TransitionAccountStatusInput) (*vo.Profile, error) {
+	if !input.Target.Valid() {
+		return nil, fmt.Errorf("%w: unknown status %q", ErrInvalidAccountStatusTransition, input.Target)
+	}
+
+	var result *vo.Profile
+	err := s.txManager.WithinTx(ctx, txmanager.TxOptions{}, func(txCtx context.Context, sess txmanager.Session) error {
+		record, err := s.repo.Get(txCtx, sess, input.UserID)
+		if err != nil {
+			if errors.Is(err, repositories.ErrProfileUserNotFound) {
+				return ErrProfileNotFound
+			}
+			return fmt.Errorf("load profile: %w", err)
+		}
+		if input.ExpectedVersion != nil && *input.ExpectedVersion != record.ProfileVersion {
+			return ErrProfileVersionConflict
+		}
+
+		current := accountStatusOf(record)
+		if current == input.Target {
+			result = vo.NewProfileFromPO(record, toPreferencesVO(record.PreferencesJSON))
+			return nil
+		}
+		if !current.CanTransitionTo(input.Target) {
+			return fmt.Errorf("%w: %s -> %s", ErrInvalidAccountStatusTransition, current, input.Target)
+		}
+
+		now := time.Now().UTC()
+		var pendingDeletionAt, deletedAt *time.Time
+		switch input.Target {
+		case AccountStatusPendingDeletion:
+			pendingDeletionAt = &now
+		case AccountStatusDeleted:
+			pendingDeletionAt = record.PendingDeletionAt
+			deletedAt = &now
+		}
+
+		updated, err := s.repo.UpdateAccountStatus(txCtx, sess, repositories.UpdateAccountStatusInput{
+			UserID:            input.UserID,
+			AccountStatus:     string(input.Target),
+			PendingDeletionAt: pendingDeletionAt,
+			DeletedAt:         deletedAt,
+			ProfileVersion:    record.ProfileVersion + 1,
+		})
+		if err != nil {
+			return err
+		}
+		after := profileAuditSnapshot(updated)
+		if input.Reason != "" {
+			after["reason"] = input.Reason
+		}
+		if err := s.audit.Record(txCtx, sess, AuditRecord{
+			UserID:       input.UserID,
+			Action:       AuditActionAccountStatus,
+			ResourceType: auditResourceProfile,
+			ResourceID:   input.UserID.String(),
+			Before:       profileAuditSnapshot(record),
+			After:        after,
+		}); err != nil {
+			return err
+		}
+
+		s.log.WithContext(txCtx).Infow("msg", "account status changed",
+			"user_id", input.UserID,
+			"from", string(current),
+			"to", string(input.Target),
+			"reason", input.Reason,
+		)
+		result = vo.NewProfileFromPO(updated, toPreferencesVO(updated.PreferencesJSON))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// EnsureAccountWritable 校验账户是否允许写入互动与观看数据；档案尚未创建时视为 active。
+func (s *ProfileService) EnsureAccountWritable(ctx context.Context, userID uuid.UUID) error {
+	record, err := s.repo.Get(ctx, nil, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProfileUserNotFound) {
+			return nil
+		}
+		return fmt.Errorf("load account status: %w", err)
+	}
+	return ensureAccountActive(record)
+}
+
+// ensureAccountActive 要求账户处于 active：冻结、待删除与已删除账户均不允许写入。
+func ensureAccountActive(record *po.ProfileUser) error {
+	if current := accountStatusOf(record); current != AccountStatusActive {
+		return fmt.Errorf("%w: %s", ErrAccountNotActive, current)
+	}
+	return nil
+}
+
+// redactDeletedProfile 对已删除账户仅保留公开字段（user_id、账户状态与删除时间）。
+func redactDeletedProfile(profile *vo.Profile) *vo.Profile {
+	if profile == nil {
+		return nil
+	}
+	return &vo.Profile{
+		UserID:         profile.UserID,
+		ProfileVersion: profile.ProfileVersion,
+		Preferences:    vo.Preferences{Extra: map[string]any{}},
+		UpdatedAt:      profile.UpdatedAt,
+		AccountStatus:  profile.AccountStatus,
+		DeletedAt:      profile.DeletedAt,
+	}
+}
@@ -0,0 +1,244 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/metadata"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/vo"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/bionicotaku/lingo-utils/txmanager"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AuditTrailRepository 抽象审计记录的追加与查询。
+type AuditTrailRepository interface {
+	Append(ctx context.Context, sess txmanager.Session, input repositories.AppendAuditEntryInput) error
+	List(ctx context.Context, sess txmanager.Session, filter repositories.ListAuditEntriesFilter) ([]*po.AuditEntry, error)
+}
+
+// 审计动作常量，写入 profile.audit_trail.action。
+// 观看进度心跳写入频繁且不改变档案语义，不纳入审计。
+const (
+	AuditActionProfileUpdate        = "profile.update"
+	AuditActionPreferencesUpdate    = "profile.preferences.update"
+	AuditActionVisibilityUpdate     = "profile.visibility.update"
+	AuditActionAvatarConfirm        = "profile.avatar.confirm"
+	AuditActionAccountStatus        = "profile.account_status.transition"
+	AuditActionEngagementAdd        = "engagement.add"
+	AuditActionEngagementRemove     = "engagement.remove"
+	AuditActionCollectionCreate     = "collection.create"
+	AuditActionCollectionRename     = "collection.rename"
+	AuditActionCollectionDelete     = "collection.delete"
+	AuditActionCollectionItemAdd    = "collection.item.add"
+	AuditActionCollectionItemRemove = "collection.item.remove"
+	AuditActionCollectionItemMove   = "collection.item.move"
+	AuditActionNoteAdd              = "engagement_note.add"
+	AuditActionNoteUpdate           = "engagement_note.update"
+	AuditActionNoteDelete           = "engagement_note.delete"
+	auditResourceProfile            = "profile"
+	auditResourceEngagementPrefix   = "engagement:"
+	auditResourceEngagementNote     = "engagement_note"
+	auditResourceCollection         = "collection"
+)
+
+// 审计操作者类型，与 profile.audit_trail.actor_type 的 check 约束一致。
+const (
+	AuditActorUser    = "user"
+	AuditActorService = "service"
+)
+
+const unknownAuditActor = "unknown"
+
+// AuditRecord 描述一次写操作的审计内容；Before/After 为快照，写入前仅保留发生变化的键。
+type AuditRecord struct {
+	UserID       uuid.UUID
+	Action       string
+	ResourceType string
+	ResourceID   string
+	Before       map[string]any
+	After        map[string]any
+}
+
+// AuditRecorder 在调用方事务内写入审计记录，nil 接收者视为未启用。
+type AuditRecorder struct {
+	repo AuditTrailRepository
+}
+
+// NewAuditRecorder 构造 AuditRecorder。
+func NewAuditRecorder(repo AuditTrailRepository) *AuditRecorder {
+	return &AuditRecorder{repo: repo}
+}
+
+// WithAuditTrail 为 ProfileService 启用审计；recorder 为 nil 时不记录。
+func WithAuditTrail(recorder *AuditRecorder) ProfileServiceOption {
+	return func(s *ProfileService) {
+		s.audit = recorder
+	}
+}
+
+// Record 解析操作者与 trace_id 后追加审计记录，失败时返回错误以回滚整个事务。
+func (r *AuditRecorder) Record(ctx context.Context, sess txmanager.Session, record AuditRecord) error {
+	if r == nil || r.repo == nil {
+		return nil
+	}
+	before, after := diffSnapshots(record.Before, record.After)
+	actorType, actorID := auditActorFromContext(ctx)
+	input := repositories.AppendAuditEntryInput{
+		UserID:       record.UserID,
+		ActorType:    actorType,
+		ActorID:      actorID,
+		Action:       record.Action,
+		ResourceType: record.ResourceType,
+		ResourceID:   record.ResourceID,
+		Before:       before,
+		After:        after,
+		TraceID:      traceIDFromContext(ctx),
+	}
+	if err := r.repo.Append(ctx, sess, input); err != nil {
+		return fmt.Errorf("record audit %s: %w", record.Action, err)
+	}
+	return nil
+}
+
+// ListAuditEntriesInput 描述审计记录查询条件。
+type ListAuditEntriesInput struct {
+	UserID  *uuid.UUID
+	ActorID string
+	Action  string
+	TraceID string
+	Since   *time.Time
+	Until   *time.Time
+	Limit   int32
+	Offset  int32
+}
+
+// List 按条件倒序返回审计记录。
+func (r *AuditRecorder) List(ctx context.Context, input ListAuditEntriesInput) ([]*po.AuditEntry, error) {
+	if r == nil || r.repo == nil {
+		return []*po.AuditEntry{}, nil
+	}
+	entries, err := r.repo.List(ctx, nil, repositories.ListAuditEntriesFilter{
+		UserID:  input.UserID,
+		ActorID: input.ActorID,
+		Action:  input.Action,
+		TraceID: input.TraceID,
+		Since:   input.Since,
+		Until:   input.Until,
+		Limit:   input.Limit,
+		Offset:  input.Offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list audit entries: %w", err)
+	}
+	return entries, nil
+}
+
+// ListAuditEntries 返回审计记录，供运营排查使用；未启用审计时返回空列表。
+func (s *ProfileService) ListAuditEntries(ctx context.Context, input ListAuditEntriesInput) ([]*vo.AuditEntry, error) {
+	entries, err := s.audit.List(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*vo.AuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, vo.NewAuditEntryFromPO(entry))
+	}
+	return result, nil
+}
+
+// auditActorFromContext 解析操作者：userinfo 中经网关认证的终端用户优先，
+// 否则为入站 JWT 中经校验的服务账号；两者均缺失时记为未知服务。
+func auditActorFromContext(ctx context.Context) (actorType, actorID string) {
+	meta, ok := metadata.FromContext(ctx)
+	if ok {
+		if strings.TrimSpace(meta.UserID) != "" {
+			return AuditActorUser, meta.UserID
+		}
+		if meta.ServiceAccount != "" {
+			return AuditActorService, meta.ServiceAccount
+		}
+	}
+	return AuditActorService, unknownAuditActor
+}
+
+func traceIDFromContext(ctx context.Context) string {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.HasTraceID() {
+		return ""
+	}
+	return spanCtx.TraceID().String()
+}
+
+// profileAuditSnapshot 提取档案中参与审计比对的字段；record 为 nil 时返回空快照（首次建档）。
+func profileAuditSnapshot(record *po.ProfileUser) map[string]any {
+	if record == nil {
+		return map[string]any{}
+	}
+	prefs := make(map[string]any, len(record.PreferencesJSON))
+	for k, v := range record.PreferencesJSON {
+		prefs[k] = v
+	}
+	return map[string]any{
+		"display_name":        record.DisplayName,
+		"avatar_url":          record.AvatarURL,
+		"preferences":         prefs,
+		"preferred_locale":    record.PreferredLocale,
+		"preferred_timezone":  record.PreferredTimezone,
+		"account_status":      string(accountStatusOf(record)),
+		"public_display_name": record.PublicDisplayName,
+		"public_avatar":       record.PublicAvatar,
+		"public_bookmarks":    record.PublicBookmarks,
+		"public_stats":        record.PublicStats,
+		"profile_version":     record.ProfileVersion,
+	}
+}
+
+// diffSnapshots 仅保留前后取值不同的键，指针字段按指向的值比较。
+func diffSnapshots(before, after map[string]any) (map[string]any, map[string]any) {
+	outBefore := map[string]any{}
+	outAfter := map[string]any{}
+	for key, next := range after {
+		prev, ok := before[key]
+		if ok && reflect.DeepEqual(auditValue(prev), auditValue(next)) {
+			continue
+		}
+		if ok {
+			outBefore[key] = auditValue(prev)
+		}
+		outAfter[key] = auditValue(next)
+	}
+	for key, prev := range before {
+		if _, ok := after[key]; !ok {
+			outBefore[key] = auditValue(prev)
+		}
+	}
+	return outBefore, outAfter
+}
+
+func auditValue(value any) any {
+	switch v := value.(type) {
+	case *string:
+		if v == nil {
+			return nil
+		}
+		return *v
+	case *int64:
+		if v == nil {
+			return nil
+		}
+		return *v
+	case *time.Time:
+		if v == nil {
+			return nil
+		}
+		return v.UTC().Format(time.RFC3339Nano)
+	default:
+		return value
+	}
+}
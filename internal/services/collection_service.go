@@ -0,0 +1,466 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	outboxevents "github.com/bionicotaku/lingo-services-profile/internal/models/outbox_events"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/vo"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/bionicotaku/lingo-utils/txmanager"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// CollectionsRepository 抽象收藏夹及其成员的持久化。
+type CollectionsRepository interface {
+	Create(ctx context.Context, sess txmanager.Session, userID uuid.UUID, name string) (*po.Collection, error)
+	Get(ctx context.Context, sess txmanager.Session, collectionID uuid.UUID) (*po.Collection, error)
+	Lock(ctx context.Context, sess txmanager.Session, collectionID uuid.UUID) (uuid.UUID, error)
+	ListByUser(ctx context.Context, sess txmanager.Session, userID uuid.UUID, limit, offset int32) ([]*po.Collection, error)
+	Rename(ctx context.Context, sess txmanager.Session, collectionID uuid.UUID, name string) (*po.Collection, error)
+	Delete(ctx context.Context, sess txmanager.Session, collectionID uuid.UUID) error
+	AddItem(ctx context.Context, sess txmanager.Session, input repositories.AddCollectionItemInput) (*po.CollectionItem, bool, error)
+	GetItem(ctx context.Context, sess txmanager.Session, collectionID, videoID uuid.UUID) (*po.CollectionItem, error)
+	RemoveItem(ctx context.Context, sess txmanager.Session, collectionID, videoID uuid.UUID) (bool, error)
+	ListItems(ctx context.Context, sess txmanager.Session, collectionID uuid.UUID, limit, offset int32) ([]*po.CollectionItem, error)
+	ListItemVideoIDs(ctx context.Context, sess txmanager.Session, collectionID uuid.UUID) ([]uuid.UUID, error)
+	Reorder(ctx context.Context, sess txmanager.Session, collectionID uuid.UUID, videoIDs []uuid.UUID) (int64, error)
+}
+
+const (
+	// MaxCollectionNameLength 为收藏夹名称的最大字符数，与 collections_name_check 一致。
+	MaxCollectionNameLength = 64
+	// MaxCollectionItems 为单个收藏夹可容纳的视频数量上限。
+	MaxCollectionItems = 1000
+)
+
+var (
+	// ErrCollectionNotFound 表示收藏夹不存在或不属于当前用户。
+	ErrCollectionNotFound = errors.New("collection not found")
+	// ErrCollectionItemNotFound 表示视频不在收藏夹中。
+	ErrCollectionItemNotFound = errors.New("collection item not found")
+	// ErrCollectionNameTaken 表示用户已有同名收藏夹（大小写不敏感）。
+	ErrCollectionNameTaken = errors.New("collection name already taken")
+	// ErrInvalidCollectionName 表示收藏夹名称为空或超长。
+	ErrInvalidCollectionName = errors.New("invalid collection name")
+	// ErrCollectionFull 表示收藏夹已达成员上限。
+	ErrCollectionFull = errors.New("collection is full")
+	// ErrCollectionOrderMismatch 表示排序请求未完整覆盖收藏夹当前成员。
+	ErrCollectionOrderMismatch = errors.New("collection order does not match current items")
+	// ErrCollectionMoveSameTarget 表示移动的源与目标为同一收藏夹。
+	ErrCollectionMoveSameTarget = errors.New("move target must differ from source collection")
+)
+
+// CollectionService 处理收藏夹的增删改与成员排序，成员变更写入 outbox 事件。
+type CollectionService struct {
+	repo      CollectionsRepository
+	outbox    OutboxEnqueuer
+	txManager txmanager.Manager
+	log       *log.Helper
+	metrics   *outboxMetrics
+	audit     *AuditRecorder
+}
+
+// CollectionServiceOption 定制 CollectionService 的可选依赖。
+type CollectionServiceOption func(*CollectionService)
+
+// WithCollectionAudit 为收藏夹写入启用审计；recorder 为 nil 时不记录。
+func WithCollectionAudit(recorder *AuditRecorder) CollectionServiceOption {
+	return func(s *CollectionService) {
+		s.audit = recorder
+	}
+}
+
+// NewCollectionService 构造 CollectionService。
+func NewCollectionService(
+	repo CollectionsRepository,
+	outbox OutboxEnqueuer,
+	tx txmanager.Manager,
+	logger log.Logger,
+	opts ...CollectionServiceOption,
+) *CollectionService {
+	svc := &CollectionService{
+		repo:      repo,
+		outbox:    outbox,
+		txManager: tx,
+		log:       log.NewHelper(logger),
+		metrics:   newOutboxMetrics("collection"),
+	}
+	for _, opt := range opts {
+		opt(svc)
+	}
+	return svc
+}
+
+// ProvideCollectionService 供 Wire 使用，注入审计记录器。
+func ProvideCollectionService(
+	repo CollectionsRepository,
+	outbox OutboxEnqueuer,
+	tx txmanager.Manager,
+	logger log.Logger,
+	audit *AuditRecorder,
+) *CollectionService {
+	return NewCollectionService(repo, outbox, tx, logger, WithCollectionAudit(audit))
+}
+
+// CreateCollection 新建收藏夹。
+func (s *CollectionService) CreateCollection(ctx context.Context, userID uuid.UUID, name string) (*vo.Collection, error) {
+	name, err := normalizeCollectionName(name)
+	if err != nil {
+		return nil, err
+	}
+	var created *po.Collection
+	err = s.txManager.WithinTx(ctx, txmanager.TxOptions{}, func(txCtx context.Context, sess txmanager.Session) error {
+		record, err := s.repo.Create(txCtx, sess, userID, name)
+		if err != nil {
+			return mapCollectionRepoError(err)
+		}
+		created = record
+		return s.recordAudit(txCtx, sess, userID, AuditActionCollectionCreate, record.CollectionID,
+			map[string]any{}, map[string]any{"name": record.Name})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return vo.NewCollectionFromPO(created), nil
+}
+
+// ListCollections 按创建时间升序分页返回用户的收藏夹。
+func (s *CollectionService) ListCollections(ctx context.Context, userID uuid.UUID, limit, offset int32) ([]*vo.Collection, error) {
+	records, err := s.repo.ListByUser(ctx, nil, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*vo.Collection, 0, len(records))
+	for _, record := range records {
+		result = append(result, vo.NewCollectionFromPO(record))
+	}
+	return result, nil
+}
+
+// RenameCollection 修改收藏夹名称。
+func (s *CollectionService) RenameCollection(ctx context.Context, userID, collectionID uuid.UUID, name string) (*vo.Collection, error) {
+	name, err := normalizeCollectionName(name)
+	if err != nil {
+		return nil, err
+	}
+	var renamed *po.Collection
+	err = s.txManager.WithinTx(ctx, txmanager.TxOptions{}, func(txCtx context.Context, sess txmanager.Session) error {
+		current, err := s.loadOwned(txCtx, sess, userID, collectionID)
+		if err != nil {
+			return err
+		}
+		record, err := s.repo.Rename(txCtx, sess, collectionID, name)
+		if err != nil {
+			return mapCollectionRepoError(err)
+		}
+		renamed = record
+		return s.recordAudit(txCtx, sess, userID, AuditActionCollectionRename, collectionID,
+			map[string]any{"name": current.Name}, map[string]any{"name": record.Name})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return vo.NewCollectionFromPO(renamed), nil
+}
+
+// DeleteCollection 删除收藏夹，并为其中每个成员写入 collection_deleted 原因的 removed 事件。
+func (s *CollectionService) DeleteCollection(ctx context.Context, userID, collectionID uuid.UUID) error {
+	return s.txManager.WithinTx(ctx, txmanager.TxOptions{}, func(txCtx context.Context, sess txmanager.Session) error {
+		if err := s.lockOwned(txCtx, sess, userID, collectionID); err != nil {
+			return err
+		}
+		current, err := s.repo.Get(txCtx, sess, collectionID)
+		if err != nil {
+			return mapCollectionRepoError(err)
+		}
+		videoIDs, err := s.repo.ListItemVideoIDs(txCtx, sess, collectionID)
+		if err != nil {
+			return err
+		}
+		if err := s.repo.Delete(txCtx, sess, collectionID); err != nil {
+			return mapCollectionRepoError(err)
+		}
+		occurredAt := time.Now().UTC()
+		for _, videoID := range videoIDs {
+			if err := s.emitRemoved(txCtx, sess, userID, collectionID, videoID, outboxevents.CollectionItemRemovedByDeletion, occurredAt); err != nil {
+				return err
+			}
+		}
+		return s.recordAudit(txCtx, sess, userID, AuditActionCollectionDelete, collectionID,
+			map[string]any{"name": current.Name, "item_count": current.ItemCount}, map[string]any{})
+	})
+}
+
+// AddCollectionItemInput 描述收藏夹成员新增参数。
+type AddCollectionItemInput struct {
+	UserID       uuid.UUID
+	CollectionID uuid.UUID
+	VideoID      uuid.UUID
+	OccurredAt   *time.Time
+}
+
+// AddItem 将视频追加到收藏夹末尾；视频已在收藏夹中时幂等返回现有成员且不写事件。
+func (s *CollectionService) AddItem(ctx context.Context, input AddCollectionItemInput) (*po.CollectionItem, error) {
+	if input.VideoID == uuid.Nil {
+		return nil, fmt.Errorf("add collection item: missing video_id")
+	}
+	var item *po.CollectionItem
+	err := s.txManager.WithinTx(ctx, txmanager.TxOptions{}, func(txCtx context.Context, sess txmanager.Session) error {
+		if err := s.lockOwned(txCtx, sess, input.UserID, input.CollectionID); err != nil {
+			return err
+		}
+		occurredAt := time.Now().UTC()
+		if input.OccurredAt != nil {
+			occurredAt = input.OccurredAt.UTC()
+		}
+		added, err := s.addLocked(txCtx, sess, input.UserID, input.CollectionID, input.VideoID, occurredAt)
+		if err != nil {
+			return err
+		}
+		item = added
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// RemoveItem 将视频移出收藏夹。
+func (s *CollectionService) RemoveItem(ctx context.Context, userID, collectionID, videoID uuid.UUID) error {
+	return s.txManager.WithinTx(ctx, txmanager.TxOptions{}, func(txCtx context.Context, sess txmanager.Session) error {
+		if err := s.lockOwned(txCtx, sess, userID, collectionID); err != nil {
+			return err
+		}
+		removed, err := s.repo.RemoveItem(txCtx, sess, collectionID, videoID)
+		if err != nil {
+			return err
+		}
+		if !removed {
+			return ErrCollectionItemNotFound
+		}
+		occurredAt := time.Now().UTC()
+		if err := s.emitRemoved(txCtx, sess, userID, collectionID, videoID, outboxevents.CollectionItemRemovedByUser, occurredAt); err != nil {
+			return err
+		}
+		return s.recordAudit(txCtx, sess, userID, AuditActionCollectionItemRemove, collectionID,
+			map[string]any{"video_id": videoID.String()}, map[string]any{})
+	})
+}
+
+// ReorderItems 按 videoIDs 顺序重写成员位置；videoIDs 必须恰好覆盖当前全部成员。
+// 排序不改变成员关系，不写 outbox 事件与审计。
+func (s *CollectionService) ReorderItems(ctx context.Context, userID, collectionID uuid.UUID, videoIDs []uuid.UUID) error {
+	return s.txManager.WithinTx(ctx, txmanager.TxOptions{}, func(txCtx context.Context, sess txmanager.Session) error {
+		if err := s.lockOwned(txCtx, sess, userID, collectionID); err != nil {
+			return err
+		}
+		current, err := s.repo.ListItemVideoIDs(txCtx, sess, collectionID)
+		if err != nil {
+			return err
+		}
+		if !sameVideoSet(current, videoIDs) {
+			return ErrCollectionOrderMismatch
+		}
+		if _, err := s.repo.Reorder(txCtx, sess, collectionID, videoIDs); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+// MoveItemInput 描述成员跨收藏夹移动参数。
+type MoveItemInput struct {
+	UserID             uuid.UUID
+	SourceCollectionID uuid.UUID
+	TargetCollectionID uuid.UUID
+	VideoID            uuid.UUID
+}
+
+// MoveItem 将视频从源收藏夹移动到目标收藏夹末尾，写入 moved 原因的 removed 事件与目标的 added 事件。
+// 两个收藏夹按 ID 排序加锁，避免并发反向移动死锁。
+func (s *CollectionService) MoveItem(ctx context.Context, input MoveItemInput) (*po.CollectionItem, error) {
+	if input.SourceCollectionID == input.TargetCollectionID {
+		return nil, ErrCollectionMoveSameTarget
+	}
+	var item *po.CollectionItem
+	err := s.txManager.WithinTx(ctx, txmanager.TxOptions{}, func(txCtx context.Context, sess txmanager.Session) error {
+		first, second := input.SourceCollectionID, input.TargetCollectionID
+		if bytes.Compare(first[:], second[:]) > 0 {
+			first, second = second, first
+		}
+		if err := s.lockOwned(txCtx, sess, input.UserID, first); err != nil {
+			return err
+		}
+		if err := s.lockOwned(txCtx, sess, input.UserID, second); err != nil {
+			return err
+		}
+		removed, err := s.repo.RemoveItem(txCtx, sess, input.SourceCollectionID, input.VideoID)
+		if err != nil {
+			return err
+		}
+		if !removed {
+			return ErrCollectionItemNotFound
+		}
+		occurredAt := time.Now().UTC()
+		if err := s.emitRemoved(txCtx, sess, input.UserID, input.SourceCollectionID, input.VideoID, outboxevents.CollectionItemRemovedByMove, occurredAt); err != nil {
+			return err
+		}
+		added, err := s.addLocked(txCtx, sess, input.UserID, input.TargetCollectionID, input.VideoID, occurredAt)
+		if err != nil {
+			return err
+		}
+		item = added
+		return s.recordAudit(txCtx, sess, input.UserID, AuditActionCollectionItemMove, input.SourceCollectionID,
+			map[string]any{"collection_id": input.SourceCollectionID.String()},
+			map[string]any{"collection_id": input.TargetCollectionID.String(), "video_id": input.VideoID.String()})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// ListCollectionItems 返回收藏夹概要及按 position 排序的成员分页。
+func (s *CollectionService) ListCollectionItems(ctx context.Context, userID, collectionID uuid.UUID, limit, offset int32) (*vo.Collection, []*po.CollectionItem, error) {
+	collection, err := s.loadOwned(ctx, nil, userID, collectionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	items, err := s.repo.ListItems(ctx, nil, collectionID, limit, offset)
+	if err != nil {
+		return nil, nil, err
+	}
+	return vo.NewCollectionFromPO(collection), items, nil
+}
+
+// addLocked 在已锁定收藏夹的前提下追加成员；已存在时返回现有成员，新增时写入 added 事件与审计。
+func (s *CollectionService) addLocked(ctx context.Context, sess txmanager.Session, userID, collectionID, videoID uuid.UUID, occurredAt time.Time) (*po.CollectionItem, error) {
+	existing, err := s.repo.GetItem(ctx, sess, collectionID, videoID)
+	if err == nil {
+		return existing, nil
+	}
+	if !errors.Is(err, repositories.ErrCollectionItemNotFound) {
+		return nil, err
+	}
+	current, err := s.repo.ListItemVideoIDs(ctx, sess, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if len(current) >= MaxCollectionItems {
+		return nil, ErrCollectionFull
+	}
+	item, inserted, err := s.repo.AddItem(ctx, sess, repositories.AddCollectionItemInput{
+		CollectionID: collectionID,
+		UserID:       userID,
+		VideoID:      videoID,
+		AddedAt:      &occurredAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !inserted {
+		return s.repo.GetItem(ctx, sess, collectionID, videoID)
+	}
+	event, err := outboxevents.NewProfileCollectionItemAddedEvent(userID, collectionID, videoID, item.Position, occurredAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := enqueueDomainEvent(ctx, sess, s.outbox, s.metrics, event); err != nil {
+		return nil, err
+	}
+	if err := s.recordAudit(ctx, sess, userID, AuditActionCollectionItemAdd, collectionID,
+		map[string]any{}, map[string]any{"video_id": videoID.String()}); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func (s *CollectionService) emitRemoved(ctx context.Context, sess txmanager.Session, userID, collectionID, videoID uuid.UUID, reason string, occurredAt time.Time) error {
+	event, err := outboxevents.NewProfileCollectionItemRemovedEvent(userID, collectionID, videoID, reason, occurredAt)
+	if err != nil {
+		return err
+	}
+	return enqueueDomainEvent(ctx, sess, s.outbox, s.metrics, event)
+}
+
+// lockOwned 锁定收藏夹并校验归属；他人收藏夹按不存在处理，避免泄露 ID。
+func (s *CollectionService) lockOwned(ctx context.Context, sess txmanager.Session, userID, collectionID uuid.UUID) error {
+	owner, err := s.repo.Lock(ctx, sess, collectionID)
+	if err != nil {
+		return mapCollectionRepoError(err)
+	}
+	if owner != userID {
+		return ErrCollectionNotFound
+	}
+	return nil
+}
+
+func (s *CollectionService) loadOwned(ctx context.Context, sess txmanager.Session, userID, collectionID uuid.UUID) (*po.Collection, error) {
+	record, err := s.repo.Get(ctx, sess, collectionID)
+	if err != nil {
+		return nil, mapCollectionRepoError(err)
+	}
+	if record.UserID != userID {
+		return nil, ErrCollectionNotFound
+	}
+	return record, nil
+}
+
+func (s *CollectionService) recordAudit(ctx context.Context, sess txmanager.Session, userID uuid.UUID, action string, collectionID uuid.UUID, before, after map[string]any) error {
+	return s.audit.Record(ctx, sess, AuditRecord{
+		UserID:       userID,
+		Action:       action,
+		ResourceType: auditResourceCollection,
+		ResourceID:   collectionID.String(),
+		Before:       before,
+		After:        after,
+	})
+}
+
+// normalizeCollectionName 去除首尾空白并校验长度（按字符计）。
+func normalizeCollectionName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > MaxCollectionNameLength {
+		return "", ErrInvalidCollectionName
+	}
+	return name, nil
+}
+
+func mapCollectionRepoError(err error) error {
+	switch {
+	case errors.Is(err, repositories.ErrCollectionNotFound):
+		return ErrCollectionNotFound
+	case errors.Is(err, repositories.ErrCollectionItemNotFound):
+		return ErrCollectionItemNotFound
+	case errors.Is(err, repositories.ErrCollectionNameConflict):
+		return ErrCollectionNameTaken
+	default:
+		return err
+	}
+}
+
+func sameVideoSet(current, requested []uuid.UUID) bool {
+	if len(current) != len(requested) {
+		return false
+	}
+	seen := make(map[uuid.UUID]struct{}, len(current))
+	for _, id := range current {
+		seen[id] = struct{}{}
+	}
+	for _, id := range requested {
+		if _, ok := seen[id]; !ok {
+			return false
+		}
+		delete(seen, id)
+	}
+	return len(seen) == 0
+}
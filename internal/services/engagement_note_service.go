@@ -0,0 +1,264 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/bionicotaku/lingo-utils/txmanager"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// EngagementNotesRepository 抽象收藏笔记的持久化。
+type EngagementNotesRepository interface {
+	Insert(ctx context.Context, sess txmanager.Session, input repositories.InsertEngagementNoteInput) (*po.EngagementNote, error)
+	Get(ctx context.Context, sess txmanager.Session, noteID uuid.UUID) (*po.EngagementNote, error)
+	Update(ctx context.Context, sess txmanager.Session, noteID uuid.UUID, body string, positionSeconds *int32) (*po.EngagementNote, error)
+	Delete(ctx context.Context, sess txmanager.Session, noteID uuid.UUID) error
+	Count(ctx context.Context, sess txmanager.Session, userID, videoID uuid.UUID, engagementType string) (int64, error)
+	ListByVideos(ctx context.Context, sess txmanager.Session, userID uuid.UUID, engagementType string, videoIDs []uuid.UUID) ([]*po.EngagementNote, error)
+}
+
+const (
+	// MaxEngagementNoteLength 为笔记正文的最大字符数，与 engagement_notes_body_length_check 一致。
+	MaxEngagementNoteLength = 2000
+	// MaxNotesPerBookmark 为单个收藏可附加的笔记数量上限。
+	MaxNotesPerBookmark = 50
+)
+
+var (
+	// ErrEngagementNoteNotFound 表示笔记不存在或不属于当前用户。
+	ErrEngagementNoteNotFound = errors.New("engagement note not found")
+	// ErrNoteRequiresBookmark 表示视频当前未被收藏，无法附加笔记。
+	ErrNoteRequiresBookmark = errors.New("video is not bookmarked")
+	// ErrInvalidNoteBody 表示笔记正文为空或超长。
+	ErrInvalidNoteBody = errors.New("invalid note body")
+	// ErrInvalidNotePosition 表示笔记时间点为负数。
+	ErrInvalidNotePosition = errors.New("invalid note position")
+	// ErrTooManyNotes 表示收藏上的笔记数量已达上限。
+	ErrTooManyNotes = errors.New("too many notes on bookmark")
+)
+
+// EngagementNoteService 维护附加在收藏上的私有笔记。
+// 笔记仅挂在 bookmark 互动上；取消收藏（软删除）后笔记保留但不再展示，重新收藏即恢复。
+type EngagementNoteService struct {
+	notes       EngagementNotesRepository
+	engagements EngagementsRepository
+	txManager   txmanager.Manager
+	log         *log.Helper
+	audit       *AuditRecorder
+}
+
+// EngagementNoteServiceOption 定制 EngagementNoteService 的可选依赖。
+type EngagementNoteServiceOption func(*EngagementNoteService)
+
+// WithEngagementNoteAudit 为笔记写入启用审计；recorder 为 nil 时不记录。
+func WithEngagementNoteAudit(recorder *AuditRecorder) EngagementNoteServiceOption {
+	return func(s *EngagementNoteService) {
+		s.audit = recorder
+	}
+}
+
+// NewEngagementNoteService 构造 EngagementNoteService。
+func NewEngagementNoteService(
+	notes EngagementNotesRepository,
+	engagements EngagementsRepository,
+	tx txmanager.Manager,
+	logger log.Logger,
+	opts ...EngagementNoteServiceOption,
+) *EngagementNoteService {
+	svc := &EngagementNoteService{
+		notes:       notes,
+		engagements: engagements,
+		txManager:   tx,
+		log:         log.NewHelper(logger),
+	}
+	for _, opt := range opts {
+		opt(svc)
+	}
+	return svc
+}
+
+// ProvideEngagementNoteService 供 Wire 使用，注入审计记录器。
+func ProvideEngagementNoteService(
+	notes EngagementNotesRepository,
+	engagements EngagementsRepository,
+	tx txmanager.Manager,
+	logger log.Logger,
+	audit *AuditRecorder,
+) *EngagementNoteService {
+	return NewEngagementNoteService(notes, engagements, tx, logger, WithEngagementNoteAudit(audit))
+}
+
+// AddEngagementNoteInput 描述笔记新增参数。
+type AddEngagementNoteInput struct {
+	UserID          uuid.UUID
+	VideoID         uuid.UUID
+	Body            string
+	PositionSeconds *int32
+}
+
+// AddNote 为有效收藏添加笔记。
+func (s *EngagementNoteService) AddNote(ctx context.Context, input AddEngagementNoteInput) (*po.EngagementNote, error) {
+	body, err := normalizeNote(input.Body, input.PositionSeconds)
+	if err != nil {
+		return nil, err
+	}
+	var created *po.EngagementNote
+	err = s.txManager.WithinTx(ctx, txmanager.TxOptions{}, func(txCtx context.Context, sess txmanager.Session) error {
+		bookmark, err := s.engagements.Get(txCtx, sess, input.UserID, input.VideoID, EngagementTypeBookmark)
+		if err != nil {
+			if errors.Is(err, repositories.ErrProfileEngagementNotFound) {
+				return ErrNoteRequiresBookmark
+			}
+			return fmt.Errorf("load bookmark: %w", err)
+		}
+		if bookmark.DeletedAt != nil {
+			return ErrNoteRequiresBookmark
+		}
+		count, err := s.notes.Count(txCtx, sess, input.UserID, input.VideoID, EngagementTypeBookmark)
+		if err != nil {
+			return err
+		}
+		if count >= MaxNotesPerBookmark {
+			return ErrTooManyNotes
+		}
+		note, err := s.notes.Insert(txCtx, sess, repositories.InsertEngagementNoteInput{
+			UserID:          input.UserID,
+			VideoID:         input.VideoID,
+			EngagementType:  EngagementTypeBookmark,
+			Body:            body,
+			PositionSeconds: input.PositionSeconds,
+		})
+		if err != nil {
+			return err
+		}
+		created = note
+		return s.recordAudit(txCtx, sess, AuditActionNoteAdd, nil, note)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// UpdateEngagementNoteInput 描述笔记修改参数；PositionSeconds 为 nil 时清空时间点。
+type UpdateEngagementNoteInput struct {
+	UserID          uuid.UUID
+	NoteID          uuid.UUID
+	Body            string
+	PositionSeconds *int32
+}
+
+// UpdateNote 整体替换笔记正文与时间点。
+func (s *EngagementNoteService) UpdateNote(ctx context.Context, input UpdateEngagementNoteInput) (*po.EngagementNote, error) {
+	body, err := normalizeNote(input.Body, input.PositionSeconds)
+	if err != nil {
+		return nil, err
+	}
+	var updated *po.EngagementNote
+	err = s.txManager.WithinTx(ctx, txmanager.TxOptions{}, func(txCtx context.Context, sess txmanager.Session) error {
+		current, err := s.loadOwned(txCtx, sess, input.UserID, input.NoteID)
+		if err != nil {
+			return err
+		}
+		note, err := s.notes.Update(txCtx, sess, input.NoteID, body, input.PositionSeconds)
+		if err != nil {
+			if errors.Is(err, repositories.ErrEngagementNoteNotFound) {
+				return ErrEngagementNoteNotFound
+			}
+			return err
+		}
+		updated = note
+		return s.recordAudit(txCtx, sess, AuditActionNoteUpdate, current, note)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// DeleteNote 删除笔记。
+func (s *EngagementNoteService) DeleteNote(ctx context.Context, userID, noteID uuid.UUID) error {
+	return s.txManager.WithinTx(ctx, txmanager.TxOptions{}, func(txCtx context.Context, sess txmanager.Session) error {
+		current, err := s.loadOwned(txCtx, sess, userID, noteID)
+		if err != nil {
+			return err
+		}
+		if err := s.notes.Delete(txCtx, sess, noteID); err != nil {
+			if errors.Is(err, repositories.ErrEngagementNoteNotFound) {
+				return ErrEngagementNoteNotFound
+			}
+			return err
+		}
+		return s.recordAudit(txCtx, sess, AuditActionNoteDelete, current, nil)
+	})
+}
+
+// ListBookmarkNotes 批量返回用户在给定视频收藏上的笔记，供收藏列表补水。
+func (s *EngagementNoteService) ListBookmarkNotes(ctx context.Context, userID uuid.UUID, videoIDs []uuid.UUID) ([]*po.EngagementNote, error) {
+	return s.notes.ListByVideos(ctx, nil, userID, EngagementTypeBookmark, videoIDs)
+}
+
+// loadOwned 读取笔记并校验归属；他人笔记按不存在处理。
+func (s *EngagementNoteService) loadOwned(ctx context.Context, sess txmanager.Session, userID, noteID uuid.UUID) (*po.EngagementNote, error) {
+	note, err := s.notes.Get(ctx, sess, noteID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEngagementNoteNotFound) {
+			return nil, ErrEngagementNoteNotFound
+		}
+		return nil, err
+	}
+	if note.UserID != userID {
+		return nil, ErrEngagementNoteNotFound
+	}
+	return note, nil
+}
+
+func (s *EngagementNoteService) recordAudit(ctx context.Context, sess txmanager.Session, action string, before, after *po.EngagementNote) error {
+	ref := after
+	if ref == nil {
+		ref = before
+	}
+	return s.audit.Record(ctx, sess, AuditRecord{
+		UserID:       ref.UserID,
+		Action:       action,
+		ResourceType: auditResourceEngagementNote,
+		ResourceID:   ref.NoteID.String(),
+		Before:       noteAuditSnapshot(before),
+		After:        noteAuditSnapshot(after),
+	})
+}
+
+// noteAuditSnapshot 提取笔记中参与审计比对的字段；正文属于私密内容，仅记录字符数。note 为 nil 时返回空快照。
+func noteAuditSnapshot(note *po.EngagementNote) map[string]any {
+	if note == nil {
+		return map[string]any{}
+	}
+	var position any
+	if note.PositionSeconds != nil {
+		position = *note.PositionSeconds
+	}
+	return map[string]any{
+		"video_id":         note.VideoID.String(),
+		"position_seconds": position,
+		"body_length":      utf8.RuneCountInString(note.Body),
+	}
+}
+
+// normalizeNote 去除正文首尾空白并校验长度（按字符计）与时间点。
+func normalizeNote(body string, positionSeconds *int32) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" || utf8.RuneCountInString(body) > MaxEngagementNoteLength {
+		return "", ErrInvalidNoteBody
+	}
+	if positionSeconds != nil && *positionSeconds < 0 {
+		return "", ErrInvalidNotePosition
+	}
+	return body, nil
+}
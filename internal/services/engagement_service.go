@@ -20,12 +20,13 @@ type EngagementsRepository interface {
 	Upsert(ctx context.Context, sess txmanager.Session, input repositories.UpsertProfileEngagementInput) error
 	SoftDelete(ctx context.Context, sess txmanager.Session, input repositories.SoftDeleteProfileEngagementInput) error
 	Get(ctx context.Context, sess txmanager.Session, userID, videoID uuid.UUID, engagementType string) (*po.ProfileEngagement, error)
-	ListByUser(ctx context.Context, sess txmanager.Session, userID uuid.UUID, engagementType *string, includeDeleted bool, limit, offset int32) ([]*po.ProfileEngagement, error)
+	ListByUser(ctx context.Context, sess txmanager.Session, userID uuid.UUID, engagementTypes []string, includeDeleted bool, limit, offset int32) ([]*po.ProfileEngagement, error)
+	ListByUserVideo(ctx context.Context, sess txmanager.Session, userID, videoID uuid.UUID) ([]*po.ProfileEngagement, error)
 }
 
 // EngagementStatsRepository 抽象视频统计增量行为。
 type EngagementStatsRepository interface {
-	Increment(ctx context.Context, sess txmanager.Session, videoID uuid.UUID, likeDelta, bookmarkDelta, watcherDelta, secondsDelta int64) error
+	Increment(ctx context.Context, sess txmanager.Session, videoID uuid.UUID, delta repositories.VideoStatsDelta) error
 	Get(ctx context.Context, sess txmanager.Session, videoID uuid.UUID) (*po.ProfileVideoStats, error)
 }
 
@@ -39,8 +40,19 @@ const (
 	EngagementActionRemove EngagementAction = "remove"
 )
 
-// ErrUnsupportedEngagementType 表示互动类型不受支持。
-var ErrUnsupportedEngagementType = errors.New("unsupported engagement type")
+// EngagementSource 取值，与 profile.engagements.source 的 check 约束一致。
+const (
+	EngagementSourceManual         = "manual"
+	EngagementSourceRecommendation = "recommendation"
+	EngagementSourceSystem         = "system"
+)
+
+var (
+	// ErrUnsupportedEngagementType 表示互动类型不受支持。
+	ErrUnsupportedEngagementType = errors.New("unsupported engagement type")
+	// ErrUnsupportedEngagementSource 表示互动来源不受支持。
+	ErrUnsupportedEngagementSource = errors.New("unsupported engagement source")
+)
 
 // EngagementService 处理收藏/点赞等互动逻辑。
 type EngagementService struct {
@@ -50,6 +62,17 @@ type EngagementService struct {
 	txManager   txmanager.Manager
 	log         *log.Helper
 	metrics     *outboxMetrics
+	audit       *AuditRecorder
+}
+
+// EngagementServiceOption 定制 EngagementService 的可选依赖。
+type EngagementServiceOption func(*EngagementService)
+
+// WithEngagementAudit 为互动写入启用审计；recorder 为 nil 时不记录。
+func WithEngagementAudit(recorder *AuditRecorder) EngagementServiceOption {
+	return func(s *EngagementService) {
+		s.audit = recorder
+	}
 }
 
 // NewEngagementService 构造 EngagementService。
@@ -59,8 +82,9 @@ func NewEngagementService(
 	outbox OutboxEnqueuer,
 	tx txmanager.Manager,
 	logger log.Logger,
+	opts ...EngagementServiceOption,
 ) *EngagementService {
-	return &EngagementService{
+	svc := &EngagementService{
 		engagements: engagements,
 		stats:       stats,
 		outbox:      outbox,
@@ -68,26 +92,52 @@ func NewEngagementService(
 		log:         log.NewHelper(logger),
 		metrics:     newOutboxMetrics("engagement"),
 	}
+	for _, opt := range opts {
+		opt(svc)
+	}
+	return svc
+}
+
+// ProvideEngagementService 供 Wire 使用，注入审计记录器。
+func ProvideEngagementService(
+	engagements EngagementsRepository,
+	stats EngagementStatsRepository,
+	outbox OutboxEnqueuer,
+	tx txmanager.Manager,
+	logger log.Logger,
+	audit *AuditRecorder,
+) *EngagementService {
+	return NewEngagementService(engagements, stats, outbox, tx, logger, WithEngagementAudit(audit))
 }
 
 // MutateEngagementInput 描述互动变更参数。
 type MutateEngagementInput struct {
 	UserID         uuid.UUID
 	VideoID        uuid.UUID
-	EngagementType string // like | bookmark
+	EngagementType string // 见 engagementTypeRegistry：like | bookmark | dislike | not_interested | share
 	Action         EngagementAction
 	OccurredAt     *time.Time
-	Source         *string
+	Source         *string        // manual | recommendation | system，nil 视为 manual
+	Metadata       map[string]any // 互动上下文（entry_point、device），仅新增时落库
 }
 
-// Mutate 执行点赞/收藏新增或移除，并更新统计聚合。
+// Mutate 执行互动新增或移除，并更新统计聚合；新增时会在同一事务内取消互斥类型（如 like 与 dislike）。
 func (s *EngagementService) Mutate(ctx context.Context, input MutateEngagementInput) error {
-	if !isSupportedEngagement(input.EngagementType) {
+	spec, ok := LookupEngagementType(input.EngagementType)
+	if !ok {
 		return ErrUnsupportedEngagementType
 	}
+	if input.Action == EngagementActionRemove && !spec.Removable {
+		return fmt.Errorf("%w: %s", ErrEngagementNotRemovable, spec.Name)
+	}
 	if input.UserID == uuid.Nil || input.VideoID == uuid.Nil {
 		return fmt.Errorf("mutate engagement: missing identifiers")
 	}
+	source, err := normalizeEngagementSource(input.Source)
+	if err != nil {
+		return err
+	}
+	input.Source = &source
 
 	return s.txManager.WithinTx(ctx, txmanager.TxOptions{}, func(txCtx context.Context, sess txmanager.Session) error {
 		occurredAt := time.Now().UTC()
@@ -95,6 +145,19 @@ func (s *EngagementService) Mutate(ctx context.Context, input MutateEngagementIn
 			occurredAt = input.OccurredAt.UTC()
 		}
 
+		wasActive, err := s.engagementActive(txCtx, sess, input)
+		if err != nil {
+			return err
+		}
+
+		if input.Action == EngagementActionAdd {
+			for _, other := range spec.ExclusiveWith {
+				if err := s.revokeExclusive(txCtx, sess, input, other, occurredAt); err != nil {
+					return err
+				}
+			}
+		}
+
 		var event *outboxevents.DomainEvent
 		var statsSnapshot *po.ProfileVideoStats
 
@@ -117,17 +180,21 @@ func (s *EngagementService) Mutate(ctx context.Context, input MutateEngagementIn
 				VideoID:        input.VideoID,
 				EngagementType: input.EngagementType,
 				OccurredAt:     &occurredAt,
+				Source:         source,
+				Metadata:       input.Metadata,
 			}); err != nil {
 				return err
 			}
-			if err := s.bumpStats(txCtx, sess, input.VideoID, input.EngagementType, 1); err != nil {
+			if err := s.bumpStats(txCtx, sess, input.VideoID, spec, 1); err != nil {
 				return err
 			}
-			fetchStats()
-			var err error
-			event, err = outboxevents.NewProfileEngagementAddedEvent(input.UserID, input.VideoID, input.EngagementType, occurredAt, input.Source, statsSnapshot)
-			if err != nil {
-				return err
+			if spec.EmitEvents {
+				fetchStats()
+				var err error
+				event, err = outboxevents.NewProfileEngagementAddedEvent(input.UserID, input.VideoID, input.EngagementType, occurredAt, input.Source, input.Metadata, statsSnapshot)
+				if err != nil {
+					return err
+				}
 			}
 		case EngagementActionRemove:
 			if err := s.engagements.SoftDelete(txCtx, sess, repositories.SoftDeleteProfileEngagementInput{
@@ -138,65 +205,165 @@ func (s *EngagementService) Mutate(ctx context.Context, input MutateEngagementIn
 			}); err != nil {
 				return err
 			}
-			if err := s.bumpStats(txCtx, sess, input.VideoID, input.EngagementType, -1); err != nil {
+			if err := s.bumpStats(txCtx, sess, input.VideoID, spec, -1); err != nil {
 				return err
 			}
-			fetchStats()
-			var err error
-			event, err = outboxevents.NewProfileEngagementRemovedEvent(input.UserID, input.VideoID, input.EngagementType, occurredAt, &occurredAt, input.Source, statsSnapshot)
-			if err != nil {
-				return err
+			if spec.EmitEvents {
+				fetchStats()
+				var err error
+				event, err = outboxevents.NewProfileEngagementRemovedEvent(input.UserID, input.VideoID, input.EngagementType, occurredAt, &occurredAt, input.Source, statsSnapshot)
+				if err != nil {
+					return err
+				}
 			}
 		default:
 			return fmt.Errorf("mutate engagement: invalid action %q", input.Action)
 		}
 
+		if err := s.recordAudit(txCtx, sess, input, wasActive); err != nil {
+			return err
+		}
 		return s.enqueueEvent(txCtx, sess, event)
 	})
 }
 
-func (s *EngagementService) bumpStats(ctx context.Context, sess txmanager.Session, videoID uuid.UUID, engagementType string, delta int64) error {
+// revokeExclusive 取消与本次新增互斥的有效互动，同步扣减统计、写入审计与 removed 事件。
+func (s *EngagementService) revokeExclusive(ctx context.Context, sess txmanager.Session, input MutateEngagementInput, engagementType string, occurredAt time.Time) error {
+	spec, ok := LookupEngagementType(engagementType)
+	if !ok {
+		return ErrUnsupportedEngagementType
+	}
+	current, err := s.engagements.Get(ctx, sess, input.UserID, input.VideoID, engagementType)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProfileEngagementNotFound) {
+			return nil
+		}
+		return fmt.Errorf("load exclusive engagement: %w", err)
+	}
+	if current.DeletedAt != nil {
+		return nil
+	}
+	if err := s.engagements.SoftDelete(ctx, sess, repositories.SoftDeleteProfileEngagementInput{
+		UserID:         input.UserID,
+		VideoID:        input.VideoID,
+		EngagementType: engagementType,
+		DeletedAt:      &occurredAt,
+	}); err != nil {
+		return err
+	}
+	if err := s.bumpStats(ctx, sess, input.VideoID, spec, -1); err != nil {
+		return err
+	}
+	revoked := input
+	revoked.EngagementType = engagementType
+	revoked.Action = EngagementActionRemove
+	if err := s.recordAudit(ctx, sess, revoked, true); err != nil {
+		return err
+	}
+	if !spec.EmitEvents {
+		return nil
+	}
+	var statsSnapshot *po.ProfileVideoStats
+	if s.stats != nil {
+		statsSnapshot, err = s.stats.Get(ctx, sess, input.VideoID)
+		if err != nil {
+			s.log.WithContext(ctx).Warnf("fetch video stats failed: video=%s err=%v", input.VideoID, err)
+			statsSnapshot = nil
+		}
+	}
+	event, err := outboxevents.NewProfileEngagementRemovedEvent(input.UserID, input.VideoID, engagementType, occurredAt, &occurredAt, input.Source, statsSnapshot)
+	if err != nil {
+		return err
+	}
+	return s.enqueueEvent(ctx, sess, event)
+}
+
+// recordAudit 记录互动开关的前后状态。
+func (s *EngagementService) recordAudit(ctx context.Context, sess txmanager.Session, input MutateEngagementInput, wasActive bool) error {
+	if s.audit == nil {
+		return nil
+	}
+	action := AuditActionEngagementAdd
+	if input.Action == EngagementActionRemove {
+		action = AuditActionEngagementRemove
+	}
+	return s.audit.Record(ctx, sess, AuditRecord{
+		UserID:       input.UserID,
+		Action:       action,
+		ResourceType: auditResourceEngagementPrefix + input.EngagementType,
+		ResourceID:   input.VideoID.String(),
+		Before:       map[string]any{"active": wasActive},
+		After:        map[string]any{"active": input.Action == EngagementActionAdd},
+	})
+}
+
+// engagementActive 读取变更前的互动状态，供审计 before 快照；未启用审计时跳过查询。
+func (s *EngagementService) engagementActive(ctx context.Context, sess txmanager.Session, input MutateEngagementInput) (bool, error) {
+	if s.audit == nil {
+		return false, nil
+	}
+	current, err := s.engagements.Get(ctx, sess, input.UserID, input.VideoID, input.EngagementType)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProfileEngagementNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("load engagement: %w", err)
+	}
+	return current.DeletedAt == nil, nil
+}
+
+// bumpStats 按注册表映射的计数列更新统计，不计入统计的类型直接跳过。
+func (s *EngagementService) bumpStats(ctx context.Context, sess txmanager.Session, videoID uuid.UUID, spec EngagementTypeSpec, delta int64) error {
 	if s.stats == nil {
 		return nil
 	}
-	likeDelta, bookmarkDelta := int64(0), int64(0)
-	switch engagementType {
-	case "like":
-		likeDelta = delta
-	case "bookmark":
-		bookmarkDelta = delta
-	default:
-		return ErrUnsupportedEngagementType
+	statsDelta, ok := spec.statsDelta(delta)
+	if !ok {
+		return nil
 	}
-	if err := s.stats.Increment(ctx, sess, videoID, likeDelta, bookmarkDelta, 0, 0); err != nil {
+	if err := s.stats.Increment(ctx, sess, videoID, statsDelta); err != nil {
 		return fmt.Errorf("update stats: %w", err)
 	}
 	return nil
 }
 
-func isSupportedEngagement(kind string) bool {
-	return kind == "like" || kind == "bookmark"
+// normalizeEngagementSource 校验互动来源，未指定时回落为 manual。
+func normalizeEngagementSource(source *string) (string, error) {
+	if source == nil || *source == "" {
+		return EngagementSourceManual, nil
+	}
+	switch *source {
+	case EngagementSourceManual, EngagementSourceRecommendation, EngagementSourceSystem:
+		return *source, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedEngagementSource, *source)
+	}
 }
 
 func (s *EngagementService) enqueueEvent(ctx context.Context, sess txmanager.Session, evt *outboxevents.DomainEvent) error {
-	if evt == nil || s.outbox == nil {
+	return enqueueDomainEvent(ctx, sess, s.outbox, s.metrics, evt)
+}
+
+// enqueueDomainEvent 在调用方事务内写入 outbox 并记录入队指标；outbox 未配置时跳过。
+func enqueueDomainEvent(ctx context.Context, sess txmanager.Session, outbox OutboxEnqueuer, metrics *outboxMetrics, evt *outboxevents.DomainEvent) error {
+	if evt == nil || outbox == nil {
 		return nil
 	}
 	msg, err := buildOutboxMessage(evt)
 	if err != nil {
-		if s.metrics != nil {
-			s.metrics.recordFailure(ctx, evt.Kind.String(), err)
+		if metrics != nil {
+			metrics.recordFailure(ctx, evt.Kind.String(), err)
 		}
 		return err
 	}
-	if err := s.outbox.Enqueue(ctx, sess, msg); err != nil {
-		if s.metrics != nil {
-			s.metrics.recordFailure(ctx, evt.Kind.String(), err)
+	if err := outbox.Enqueue(ctx, sess, msg); err != nil {
+		if metrics != nil {
+			metrics.recordFailure(ctx, evt.Kind.String(), err)
 		}
 		return err
 	}
-	if s.metrics != nil {
-		s.metrics.recordSuccess(ctx, evt.Kind.String(), evt.OccurredAt)
+	if metrics != nil {
+		metrics.recordSuccess(ctx, evt.Kind.String(), evt.OccurredAt)
 	}
 	return nil
 }
@@ -218,6 +385,7 @@ func buildOutboxMessage(evt *outboxevents.DomainEvent) (repositories.OutboxMessa
 		Payload:       data,
 		Headers: map[string]string{
 			"schema_version": outboxevents.SchemaVersionV1,
+			"event_type":     evt.Kind.String(),
 		},
 		AvailableAt: evt.OccurredAt,
 	}, nil
@@ -227,42 +395,42 @@ func buildOutboxMessage(evt *outboxevents.DomainEvent) (repositories.OutboxMessa
 type FavoriteState struct {
 	HasLiked      bool
 	HasBookmarked bool
+	HasDisliked   bool
+	HasShared     bool
+	NotInterested bool
 }
 
-// GetFavoriteState 返回用户对视频的互动状态。
+// GetFavoriteState 返回用户对视频的互动状态，一次查询取回全部类型。
 func (s *EngagementService) GetFavoriteState(ctx context.Context, userID, videoID uuid.UUID) (FavoriteState, error) {
-	state := FavoriteState{}
-	like, err := s.engagements.Get(ctx, nil, userID, videoID, "like")
-	if err == nil && like.DeletedAt == nil {
-		state.HasLiked = true
-	}
-	if err != nil && !errors.Is(err, repositories.ErrProfileEngagementNotFound) {
-		return state, fmt.Errorf("get like: %w", err)
-	}
-
-	bookmark, err := s.engagements.Get(ctx, nil, userID, videoID, "bookmark")
-	if err == nil && bookmark.DeletedAt == nil {
-		state.HasBookmarked = true
+	items, err := s.engagements.ListByUserVideo(ctx, nil, userID, videoID)
+	if err != nil {
+		return FavoriteState{}, fmt.Errorf("get favorite state: %w", err)
 	}
-	if err != nil && !errors.Is(err, repositories.ErrProfileEngagementNotFound) {
-		return state, fmt.Errorf("get bookmark: %w", err)
+	active := make([]string, 0, len(items))
+	for _, item := range items {
+		if item.DeletedAt == nil {
+			active = append(active, item.EngagementType)
+		}
 	}
-
-	return state, nil
+	return FavoriteStateFromEngagements(active...), nil
 }
 
-// ListFavoritesInput 描述收藏/点赞列表查询参数。
+// ListFavoritesInput 描述收藏/点赞列表查询参数；EngagementTypes 为空时使用 FavoriteEngagementTypes。
 type ListFavoritesInput struct {
-	UserID         uuid.UUID
-	EngagementType *string
-	IncludeDeleted bool
-	Limit          int32
-	Offset         int32
+	UserID          uuid.UUID
+	EngagementTypes []string
+	IncludeDeleted  bool
+	Limit           int32
+	Offset          int32
 }
 
 // ListFavorites 返回用户收藏/点赞列表。
 func (s *EngagementService) ListFavorites(ctx context.Context, input ListFavoritesInput) ([]*po.ProfileEngagement, error) {
-	items, err := s.engagements.ListByUser(ctx, nil, input.UserID, input.EngagementType, input.IncludeDeleted, input.Limit, input.Offset)
+	types := input.EngagementTypes
+	if len(types) == 0 {
+		types = FavoriteEngagementTypes()
+	}
+	items, err := s.engagements.ListByUser(ctx, nil, input.UserID, types, input.IncludeDeleted, input.Limit, input.Offset)
 	if err != nil {
 		return nil, fmt.Errorf("list favorites: %w", err)
 	}
@@ -0,0 +1,156 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+)
+
+// 互动类型标识，与 profile.engagement_types 注册行一致。
+const (
+	EngagementTypeLike          = "like"
+	EngagementTypeBookmark      = "bookmark"
+	EngagementTypeDislike       = "dislike"
+	EngagementTypeNotInterested = "not_interested"
+	EngagementTypeShare         = "share"
+)
+
+// FavoriteEngagementTypes 返回收藏列表默认包含的互动类型；dislike/not_interested/share 属于反馈信号，不出现在收藏列表中。
+func FavoriteEngagementTypes() []string {
+	return []string{EngagementTypeLike, EngagementTypeBookmark}
+}
+
+// ErrEngagementNotRemovable 表示该互动类型不支持取消。
+var ErrEngagementNotRemovable = errors.New("engagement type is not removable")
+
+// EngagementStatsColumn 指定互动计入 profile.video_stats 的计数列。
+type EngagementStatsColumn int
+
+const (
+	// EngagementStatsNone 表示不计入统计。
+	EngagementStatsNone EngagementStatsColumn = iota
+	// EngagementStatsLikes 对应 like_count。
+	EngagementStatsLikes
+	// EngagementStatsBookmarks 对应 bookmark_count。
+	EngagementStatsBookmarks
+	// EngagementStatsDislikes 对应 dislike_count。
+	EngagementStatsDislikes
+	// EngagementStatsShares 对应 share_count。
+	EngagementStatsShares
+)
+
+// EngagementTypeSpec 描述一种互动类型的统计映射、互斥规则与事件发布策略。
+type EngagementTypeSpec struct {
+	Name          string
+	Stats         EngagementStatsColumn
+	ExclusiveWith []string // 新增本类型时自动取消的互斥类型
+	Removable     bool     // 是否允许 remove 动作
+	EmitEvents    bool     // 是否写入 engagement.added/removed 事件
+	markState     func(*FavoriteState)
+}
+
+// statsDelta 将单次互动增减换算为 video_stats 增量。
+func (s EngagementTypeSpec) statsDelta(delta int64) (repositories.VideoStatsDelta, bool) {
+	switch s.Stats {
+	case EngagementStatsLikes:
+		return repositories.VideoStatsDelta{Likes: delta}, true
+	case EngagementStatsBookmarks:
+		return repositories.VideoStatsDelta{Bookmarks: delta}, true
+	case EngagementStatsDislikes:
+		return repositories.VideoStatsDelta{Dislikes: delta}, true
+	case EngagementStatsShares:
+		return repositories.VideoStatsDelta{Shares: delta}, true
+	default:
+		return repositories.VideoStatsDelta{}, false
+	}
+}
+
+// engagementTypeRegistry 为互动类型的唯一定义来源；新增类型需同时在
+// profile.engagement_types 中登记（外键约束）并扩展 FavoriteType 枚举。
+var engagementTypeRegistry = newEngagementTypeRegistry(
+	EngagementTypeSpec{
+		Name:          EngagementTypeLike,
+		Stats:         EngagementStatsLikes,
+		ExclusiveWith: []string{EngagementTypeDislike},
+		Removable:     true,
+		EmitEvents:    true,
+		markState:     func(st *FavoriteState) { st.HasLiked = true },
+	},
+	EngagementTypeSpec{
+		Name:       EngagementTypeBookmark,
+		Stats:      EngagementStatsBookmarks,
+		Removable:  true,
+		EmitEvents: true,
+		markState:  func(st *FavoriteState) { st.HasBookmarked = true },
+	},
+	EngagementTypeSpec{
+		Name:          EngagementTypeDislike,
+		Stats:         EngagementStatsDislikes,
+		ExclusiveWith: []string{EngagementTypeLike},
+		Removable:     true,
+		EmitEvents:    true,
+		markState:     func(st *FavoriteState) { st.HasDisliked = true },
+	},
+	EngagementTypeSpec{
+		Name:       EngagementTypeNotInterested,
+		Stats:      EngagementStatsNone,
+		Removable:  true,
+		EmitEvents: true,
+		markState:  func(st *FavoriteState) { st.NotInterested = true },
+	},
+	EngagementTypeSpec{
+		Name:       EngagementTypeShare,
+		Stats:      EngagementStatsShares,
+		EmitEvents: true,
+		markState:  func(st *FavoriteState) { st.HasShared = true },
+	},
+)
+
+type engagementTypes map[string]EngagementTypeSpec
+
+func newEngagementTypeRegistry(specs ...EngagementTypeSpec) engagementTypes {
+	registry := make(engagementTypes, len(specs))
+	for _, spec := range specs {
+		if _, dup := registry[spec.Name]; dup {
+			panic(fmt.Sprintf("duplicate engagement type %q", spec.Name))
+		}
+		registry[spec.Name] = spec
+	}
+	for _, spec := range specs {
+		for _, other := range spec.ExclusiveWith {
+			if _, ok := registry[other]; !ok {
+				panic(fmt.Sprintf("engagement type %q excludes unknown type %q", spec.Name, other))
+			}
+		}
+	}
+	return registry
+}
+
+// LookupEngagementType 返回互动类型定义。
+func LookupEngagementType(name string) (EngagementTypeSpec, bool) {
+	spec, ok := engagementTypeRegistry[name]
+	return spec, ok
+}
+
+// EngagementTypes 按名称排序返回全部已注册的互动类型。
+func EngagementTypes() []EngagementTypeSpec {
+	specs := make([]EngagementTypeSpec, 0, len(engagementTypeRegistry))
+	for _, spec := range engagementTypeRegistry {
+		specs = append(specs, spec)
+	}
+	sort.Slice(specs, func(i, j int) bool { return specs[i].Name < specs[j].Name })
+	return specs
+}
+
+// FavoriteStateFromEngagements 将用户对同一视频的有效（未取消）互动类型折叠为状态，未注册的类型忽略。
+func FavoriteStateFromEngagements(types ...string) FavoriteState {
+	state := FavoriteState{}
+	for _, name := range types {
+		if spec, ok := engagementTypeRegistry[name]; ok && spec.markState != nil {
+			spec.markState(&state)
+		}
+	}
+	return state
+}
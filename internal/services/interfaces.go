@@ -13,6 +13,14 @@ type ProfileServiceInterface interface {
 	GetProfile(ctx context.Context, userID uuid.UUID) (*vo.Profile, error)
 	UpdateProfile(ctx context.Context, input UpdateProfileInput) (*vo.Profile, error)
 	UpdatePreferences(ctx context.Context, input UpdatePreferencesInput) (*vo.Profile, error)
+	TransitionAccountStatus(ctx context.Context, input TransitionAccountStatusInput) (*vo.Profile, error)
+	EnsureAccountWritable(ctx context.Context, userID uuid.UUID) error
+	UpdateVisibility(ctx context.Context, input UpdateVisibilityInput) (*vo.Profile, error)
+	GetPublicProfile(ctx context.Context, userID uuid.UUID) (*vo.PublicProfile, error)
+	EnsureBookmarksPublic(ctx context.Context, userID uuid.UUID) error
+	CreateAvatarUpload(ctx context.Context, input CreateAvatarUploadInput) (*vo.AvatarUpload, error)
+	ConfirmAvatarUpload(ctx context.Context, input ConfirmAvatarUploadInput) (*vo.Profile, error)
+	ListAuditEntries(ctx context.Context, input ListAuditEntriesInput) ([]*vo.AuditEntry, error)
 }
 
 // EngagementServiceInterface 抽象互动用例。
@@ -28,6 +36,27 @@ type WatchHistoryServiceInterface interface {
 	ListWatchHistory(ctx context.Context, input ListWatchHistoryInput) ([]*po.ProfileWatchLog, error)
 }
 
+// EngagementNoteServiceInterface 抽象收藏笔记用例。
+type EngagementNoteServiceInterface interface {
+	AddNote(ctx context.Context, input AddEngagementNoteInput) (*po.EngagementNote, error)
+	UpdateNote(ctx context.Context, input UpdateEngagementNoteInput) (*po.EngagementNote, error)
+	DeleteNote(ctx context.Context, userID, noteID uuid.UUID) error
+	ListBookmarkNotes(ctx context.Context, userID uuid.UUID, videoIDs []uuid.UUID) ([]*po.EngagementNote, error)
+}
+
+// CollectionServiceInterface 抽象收藏夹用例。
+type CollectionServiceInterface interface {
+	CreateCollection(ctx context.Context, userID uuid.UUID, name string) (*vo.Collection, error)
+	ListCollections(ctx context.Context, userID uuid.UUID, limit, offset int32) ([]*vo.Collection, error)
+	RenameCollection(ctx context.Context, userID, collectionID uuid.UUID, name string) (*vo.Collection, error)
+	DeleteCollection(ctx context.Context, userID, collectionID uuid.UUID) error
+	AddItem(ctx context.Context, input AddCollectionItemInput) (*po.CollectionItem, error)
+	RemoveItem(ctx context.Context, userID, collectionID, videoID uuid.UUID) error
+	ReorderItems(ctx context.Context, userID, collectionID uuid.UUID, videoIDs []uuid.UUID) error
+	MoveItem(ctx context.Context, input MoveItemInput) (*po.CollectionItem, error)
+	ListCollectionItems(ctx context.Context, userID, collectionID uuid.UUID, limit, offset int32) (*vo.Collection, []*po.CollectionItem, error)
+}
+
 // VideoProjectionServiceInterface 抽象视频投影读取。
 type VideoProjectionServiceInterface interface {
 	ListProjections(ctx context.Context, videoIDs []uuid.UUID) ([]*po.ProfileVideoProjection, error)
@@ -43,6 +72,8 @@ var (
 	_ ProfileServiceInterface         = (*ProfileService)(nil)
 	_ EngagementServiceInterface      = (*EngagementService)(nil)
 	_ WatchHistoryServiceInterface    = (*WatchHistoryService)(nil)
+	_ CollectionServiceInterface      = (*CollectionService)(nil)
+	_ EngagementNoteServiceInterface  = (*EngagementNoteService)(nil)
 	_ VideoProjectionServiceInterface = (*VideoProjectionService)(nil)
 	_ VideoStatsServiceInterface      = (*VideoStatsService)(nil)
 )
@@ -8,3 +8,7 @@ package mocks
 //go:generate go run github.com/golang/mock/mockgen -destination=mock_engagements_repository.go -package=mocks github.com/bionicotaku/lingo-services-profile/internal/services EngagementsRepository
 //go:generate go run github.com/golang/mock/mockgen -destination=mock_engagement_stats_repository.go -package=mocks github.com/bionicotaku/lingo-services-profile/internal/services EngagementStatsRepository
 //go:generate go run github.com/golang/mock/mockgen -destination=mock_video_stats_repository.go -package=mocks github.com/bionicotaku/lingo-services-profile/internal/services VideoStatsRepository
+//go:generate go run github.com/golang/mock/mockgen -destination=mock_avatar_object_store.go -package=mocks github.com/bionicotaku/lingo-services-profile/internal/services AvatarObjectStore
+//go:generate go run github.com/golang/mock/mockgen -destination=mock_audit_trail_repository.go -package=mocks github.com/bionicotaku/lingo-services-profile/internal/services AuditTrailRepository
+//go:generate go run github.com/golang/mock/mockgen -destination=mock_collections_repository.go -package=mocks github.com/bionicotaku/lingo-services-profile/internal/services CollectionsRepository
+//go:generate go run github.com/golang/mock/mockgen -destination=mock_engagement_notes_repository.go -package=mocks github.com/bionicotaku/lingo-services-profile/internal/services EngagementNotesRepository
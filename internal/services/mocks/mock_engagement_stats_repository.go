@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	po "github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	repositories "github.com/bionicotaku/lingo-services-profile/internal/repositories"
 	txmanager "github.com/bionicotaku/lingo-utils/txmanager"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -53,15 +54,15 @@ func (mr *MockEngagementStatsRepositoryMockRecorder) Get(arg0, arg1, arg2 interf
 }
 
 // Increment mocks base method.
-func (m *MockEngagementStatsRepository) Increment(arg0 context.Context, arg1 txmanager.Session, arg2 uuid.UUID, arg3, arg4, arg5, arg6 int64) error {
+func (m *MockEngagementStatsRepository) Increment(arg0 context.Context, arg1 txmanager.Session, arg2 uuid.UUID, arg3 repositories.VideoStatsDelta) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Increment", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret := m.ctrl.Call(m, "Increment", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Increment indicates an expected call of Increment.
-func (mr *MockEngagementStatsRepositoryMockRecorder) Increment(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+func (mr *MockEngagementStatsRepositoryMockRecorder) Increment(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increment", reflect.TypeOf((*MockEngagementStatsRepository)(nil).Increment), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increment", reflect.TypeOf((*MockEngagementStatsRepository)(nil).Increment), arg0, arg1, arg2, arg3)
 }
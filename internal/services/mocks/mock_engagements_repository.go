@@ -54,7 +54,7 @@ func (mr *MockEngagementsRepositoryMockRecorder) Get(arg0, arg1, arg2, arg3, arg
 }
 
 // ListByUser mocks base method.
-func (m *MockEngagementsRepository) ListByUser(arg0 context.Context, arg1 txmanager.Session, arg2 uuid.UUID, arg3 *string, arg4 bool, arg5, arg6 int32) ([]*po.ProfileEngagement, error) {
+func (m *MockEngagementsRepository) ListByUser(arg0 context.Context, arg1 txmanager.Session, arg2 uuid.UUID, arg3 []string, arg4 bool, arg5, arg6 int32) ([]*po.ProfileEngagement, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListByUser", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 	ret0, _ := ret[0].([]*po.ProfileEngagement)
@@ -68,6 +68,21 @@ func (mr *MockEngagementsRepositoryMockRecorder) ListByUser(arg0, arg1, arg2, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockEngagementsRepository)(nil).ListByUser), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 }
 
+// ListByUserVideo mocks base method.
+func (m *MockEngagementsRepository) ListByUserVideo(arg0 context.Context, arg1 txmanager.Session, arg2, arg3 uuid.UUID) ([]*po.ProfileEngagement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUserVideo", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*po.ProfileEngagement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUserVideo indicates an expected call of ListByUserVideo.
+func (mr *MockEngagementsRepositoryMockRecorder) ListByUserVideo(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUserVideo", reflect.TypeOf((*MockEngagementsRepository)(nil).ListByUserVideo), arg0, arg1, arg2, arg3)
+}
+
 // SoftDelete mocks base method.
 func (m *MockEngagementsRepository) SoftDelete(arg0 context.Context, arg1 txmanager.Session, arg2 repositories.SoftDeleteProfileEngagementInput) error {
 	m.ctrl.T.Helper()
@@ -53,6 +53,66 @@ func (mr *MockProfileUsersRepositoryMockRecorder) Get(arg0, arg1, arg2 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProfileUsersRepository)(nil).Get), arg0, arg1, arg2)
 }
 
+// GetPublicStats mocks base method.
+func (m *MockProfileUsersRepository) GetPublicStats(arg0 context.Context, arg1 txmanager.Session, arg2 uuid.UUID) (*po.ProfilePublicStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicStats", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*po.ProfilePublicStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublicStats indicates an expected call of GetPublicStats.
+func (mr *MockProfileUsersRepositoryMockRecorder) GetPublicStats(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicStats", reflect.TypeOf((*MockProfileUsersRepository)(nil).GetPublicStats), arg0, arg1, arg2)
+}
+
+// IsDisplayNameTaken mocks base method.
+func (m *MockProfileUsersRepository) IsDisplayNameTaken(arg0 context.Context, arg1 txmanager.Session, arg2 uuid.UUID, arg3 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDisplayNameTaken", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsDisplayNameTaken indicates an expected call of IsDisplayNameTaken.
+func (mr *MockProfileUsersRepositoryMockRecorder) IsDisplayNameTaken(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDisplayNameTaken", reflect.TypeOf((*MockProfileUsersRepository)(nil).IsDisplayNameTaken), arg0, arg1, arg2, arg3)
+}
+
+// UpdateAccountStatus mocks base method.
+func (m *MockProfileUsersRepository) UpdateAccountStatus(arg0 context.Context, arg1 txmanager.Session, arg2 repositories.UpdateAccountStatusInput) (*po.ProfileUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccountStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*po.ProfileUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccountStatus indicates an expected call of UpdateAccountStatus.
+func (mr *MockProfileUsersRepositoryMockRecorder) UpdateAccountStatus(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountStatus", reflect.TypeOf((*MockProfileUsersRepository)(nil).UpdateAccountStatus), arg0, arg1, arg2)
+}
+
+// UpdateVisibility mocks base method.
+func (m *MockProfileUsersRepository) UpdateVisibility(arg0 context.Context, arg1 txmanager.Session, arg2 repositories.UpdateVisibilityInput) (*po.ProfileUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVisibility", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*po.ProfileUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVisibility indicates an expected call of UpdateVisibility.
+func (mr *MockProfileUsersRepositoryMockRecorder) UpdateVisibility(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVisibility", reflect.TypeOf((*MockProfileUsersRepository)(nil).UpdateVisibility), arg0, arg1, arg2)
+}
+
 // Upsert mocks base method.
 func (m *MockProfileUsersRepository) Upsert(arg0 context.Context, arg1 txmanager.Session, arg2 repositories.UpsertProfileUserInput) (*po.ProfileUser, error) {
 	m.ctrl.T.Helper()
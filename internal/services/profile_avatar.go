@@ -0,0 +1,224 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/objectstore"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/vo"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/bionicotaku/lingo-utils/txmanager"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrAvatarURLNotAllowed 表示头像地址不在允许的域名范围内。
+	ErrAvatarURLNotAllowed = errors.New("avatar url not allowed")
+	// ErrAvatarContentTypeNotAllowed 表示头像文件类型不被接受。
+	ErrAvatarContentTypeNotAllowed = errors.New("avatar content type not allowed")
+	// ErrAvatarTooLarge 表示头像文件超过大小上限。
+	ErrAvatarTooLarge = errors.New("avatar too large")
+	// ErrAvatarUploadNotFound 表示上传对象不存在或不属于当前用户。
+	ErrAvatarUploadNotFound = errors.New("avatar upload not found")
+	// ErrAvatarUploadUnavailable 表示未配置对象存储，头像直传不可用。
+	ErrAvatarUploadUnavailable = errors.New("avatar upload unavailable")
+)
+
+const avatarKeyPrefix = "avatars/"
+
+// AvatarObjectStore 抽象头像对象存储，便于测试替换。
+type AvatarObjectStore interface {
+	SignUpload(ctx context.Context, input objectstore.SignUploadInput) (*objectstore.UploadTarget, error)
+	Stat(ctx context.Context, key string) (*objectstore.ObjectInfo, error)
+	PublicURL(key string) string
+}
+
+var _ AvatarObjectStore = (objectstore.Store)(nil)
+
+// AvatarPolicy 描述头像地址与上传文件的校验策略。
+// AllowedHosts 支持精确域名与 "*.example.com" 通配；为空表示不限制域名。
+type AvatarPolicy struct {
+	AllowedHosts        []string
+	AllowedContentTypes []string
+	MaxBytes            int64
+	UploadTTL           time.Duration
+}
+
+// ValidateURL 校验头像地址；空串表示清空头像，始终允许。
+func (p AvatarPolicy) ValidateURL(raw string) error {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Host == "" {
+		return fmt.Errorf("%w: %q", ErrAvatarURLNotAllowed, raw)
+	}
+	if parsed.Scheme != "https" && parsed.Scheme != "http" {
+		return fmt.Errorf("%w: scheme %q", ErrAvatarURLNotAllowed, parsed.Scheme)
+	}
+	if len(p.AllowedHosts) == 0 {
+		return nil
+	}
+	host := strings.ToLower(parsed.Hostname())
+	for _, allowed := range p.AllowedHosts {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+		if allowed == host {
+			return nil
+		}
+		if suffix, ok := strings.CutPrefix(allowed, "*"); ok && strings.HasPrefix(suffix, ".") && strings.HasSuffix(host, suffix) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: host %q", ErrAvatarURLNotAllowed, host)
+}
+
+func (p AvatarPolicy) allowsContentType(contentType string) bool {
+	for _, allowed := range p.AllowedContentTypes {
+		if strings.EqualFold(allowed, contentType) {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateAvatarUploadInput 描述头像上传申请参数。
+type CreateAvatarUploadInput struct {
+	UserID        uuid.UUID
+	ContentType   string
+	ContentLength int64
+}
+
+// CreateAvatarUpload 校验文件类型与大小后签发限时上传地址，对象键归属于当前用户。
+func (s *ProfileService) CreateAvatarUpload(ctx context.Context, input CreateAvatarUploadInput) (*vo.AvatarUpload, error) {
+	if s.avatarStore == nil {
+		return nil, ErrAvatarUploadUnavailable
+	}
+	contentType := strings.ToLower(strings.TrimSpace(input.ContentType))
+	if !s.avatarPolicy.allowsContentType(contentType) {
+		return nil, fmt.Errorf("%w: %q", ErrAvatarContentTypeNotAllowed, input.ContentType)
+	}
+	if s.avatarPolicy.MaxBytes > 0 && input.ContentLength > s.avatarPolicy.MaxBytes {
+		return nil, fmt.Errorf("%w: %d > %d", ErrAvatarTooLarge, input.ContentLength, s.avatarPolicy.MaxBytes)
+	}
+	if err := s.EnsureAccountWritable(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("%s%s/%s%s", avatarKeyPrefix, input.UserID, uuid.NewString(), avatarExtension(contentType))
+	expiresAt := s.now().Add(s.avatarPolicy.UploadTTL).UTC()
+	target, err := s.avatarStore.SignUpload(ctx, objectstore.SignUploadInput{
+		Key:         key,
+		ContentType: contentType,
+		MaxBytes:    s.avatarPolicy.MaxBytes,
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("sign avatar upload: %w", err)
+	}
+	return &vo.AvatarUpload{
+		Key:       key,
+		URL:       target.URL,
+		Method:    target.Method,
+		Headers:   target.Headers,
+		ExpiresAt: target.ExpiresAt,
+		MaxBytes:  s.avatarPolicy.MaxBytes,
+	}, nil
+}
+
+// ConfirmAvatarUploadInput 描述头像上传确认参数。
+type ConfirmAvatarUploadInput struct {
+	UserID          uuid.UUID
+	Key             string
+	ExpectedVersion *int64
+}
+
+// ConfirmAvatarUpload 校验已上传对象的实际大小与嗅探类型，通过后写入档案 avatar_url。
+func (s *ProfileService) ConfirmAvatarUpload(ctx context.Context, input ConfirmAvatarUploadInput) (*vo.Profile, error) {
+	if s.avatarStore == nil {
+		return nil, ErrAvatarUploadUnavailable
+	}
+	if !strings.HasPrefix(input.Key, avatarKeyPrefix+input.UserID.String()+"/") {
+		return nil, fmt.Errorf("%w: %q", ErrAvatarUploadNotFound, input.Key)
+	}
+	info, err := s.avatarStore.Stat(ctx, input.Key)
+	if err != nil {
+		if errors.Is(err, objectstore.ErrObjectNotFound) || errors.Is(err, objectstore.ErrInvalidKey) {
+			return nil, fmt.Errorf("%w: %q", ErrAvatarUploadNotFound, input.Key)
+		}
+		return nil, fmt.Errorf("stat avatar upload: %w", err)
+	}
+	if s.avatarPolicy.MaxBytes > 0 && info.Size > s.avatarPolicy.MaxBytes {
+		return nil, fmt.Errorf("%w: %d > %d", ErrAvatarTooLarge, info.Size, s.avatarPolicy.MaxBytes)
+	}
+	if !s.avatarPolicy.allowsContentType(info.ContentType) {
+		return nil, fmt.Errorf("%w: %q", ErrAvatarContentTypeNotAllowed, info.ContentType)
+	}
+	avatarURL := s.avatarStore.PublicURL(input.Key)
+
+	var result *vo.Profile
+	err = s.txManager.WithinTx(ctx, txmanager.TxOptions{}, func(txCtx context.Context, sess txmanager.Session) error {
+		record, err := s.repo.Get(txCtx, sess, input.UserID)
+		if err != nil {
+			if errors.Is(err, repositories.ErrProfileUserNotFound) {
+				return ErrProfileNotFound
+			}
+			return fmt.Errorf("load profile: %w", err)
+		}
+		if err := ensureAccountActive(record); err != nil {
+			return err
+		}
+		if input.ExpectedVersion != nil && *input.ExpectedVersion != record.ProfileVersion {
+			return ErrProfileVersionConflict
+		}
+
+		updated, err := s.repo.Upsert(txCtx, sess, repositories.UpsertProfileUserInput{
+			UserID:            input.UserID,
+			DisplayName:       record.DisplayName,
+			AvatarURL:         &avatarURL,
+			ProfileVersion:    record.ProfileVersion + 1,
+			Preferences:       ensurePrefs(record.PreferencesJSON),
+			PreferredLocale:   record.PreferredLocale,
+			PreferredTimezone: record.PreferredTimezone,
+		})
+		if err != nil {
+			return err
+		}
+		if err := s.audit.Record(txCtx, sess, AuditRecord{
+			UserID:       input.UserID,
+			Action:       AuditActionAvatarConfirm,
+			ResourceType: auditResourceProfile,
+			ResourceID:   input.UserID.String(),
+			Before:       profileAuditSnapshot(record),
+			After:        profileAuditSnapshot(updated),
+		}); err != nil {
+			return err
+		}
+		result = vo.NewProfileFromPO(updated, toPreferencesVO(updated.PreferencesJSON))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func avatarExtension(contentType string) string {
+	switch contentType {
+	case "image/jpeg":
+		return ".jpg"
+	case "image/png":
+		return ".png"
+	case "image/webp":
+		return ".webp"
+	}
+	if exts, err := mime.ExtensionsByType(contentType); err == nil && len(exts) > 0 {
+		return exts[0]
+	}
+	return ""
+}
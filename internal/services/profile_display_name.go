@@ -0,0 +1,210 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	// ErrDisplayNameTooShort 表示昵称短于最小长度。
+	ErrDisplayNameTooShort = errors.New("display name too short")
+	// ErrDisplayNameTooLong 表示昵称超过最大长度。
+	ErrDisplayNameTooLong = errors.New("display name too long")
+	// ErrDisplayNameInvalidCharacters 表示昵称包含不允许的字符。
+	ErrDisplayNameInvalidCharacters = errors.New("display name contains invalid characters")
+	// ErrDisplayNameBlocked 表示昵称命中屏蔽词。
+	ErrDisplayNameBlocked = errors.New("display name blocked")
+	// ErrDisplayNameTaken 表示昵称已被其他账户占用（大小写不敏感）。
+	ErrDisplayNameTaken = errors.New("display name taken")
+	// ErrDisplayNameRejected 表示昵称被外部审核器拒绝。
+	ErrDisplayNameRejected = errors.New("display name rejected")
+)
+
+// DisplayNameModerator 抽象昵称审核能力；返回 error 表示拒绝，建议包装 ErrDisplayNameBlocked/ErrDisplayNameRejected。
+// 内置实现为屏蔽词匹配，后续可接入外部内容审核服务。
+type DisplayNameModerator interface {
+	ModerateDisplayName(ctx context.Context, userID uuid.UUID, name string) error
+}
+
+// DisplayNamePolicy 描述昵称的长度、屏蔽词与唯一性策略；长度为 0 表示不限制。
+type DisplayNamePolicy struct {
+	MinLength     int
+	MaxLength     int
+	Blocklist     []string
+	RequireUnique bool
+}
+
+// Normalize 规范化昵称（NFC、去除首尾空白、折叠连续空白）并校验长度与字符类别。
+// 允许字母、组合符号、数字、空格及 _ - . '，且至少包含一个字母或数字。
+func (p DisplayNamePolicy) Normalize(raw string) (string, error) {
+	name := strings.Join(strings.Fields(norm.NFC.String(raw)), " ")
+
+	length := utf8.RuneCountInString(name)
+	if p.MinLength > 0 && length < p.MinLength {
+		return "", fmt.Errorf("%w: %d < %d", ErrDisplayNameTooShort, length, p.MinLength)
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		return "", fmt.Errorf("%w: %d > %d", ErrDisplayNameTooLong, length, p.MaxLength)
+	}
+
+	hasAlnum := false
+	for _, r := range name {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			hasAlnum = true
+		case unicode.IsMark(r), r == ' ', r == '_', r == '-', r == '.', r == '\'':
+		default:
+			return "", fmt.Errorf("%w: %q", ErrDisplayNameInvalidCharacters, r)
+		}
+	}
+	if !hasAlnum {
+		return "", fmt.Errorf("%w: no letters or digits", ErrDisplayNameInvalidCharacters)
+	}
+	return name, nil
+}
+
+type blocklistModerator struct {
+	terms []string
+}
+
+// NewBlocklistModerator 构造基于屏蔽词的审核器。匹配前统一 NFKC、转小写并按非字母数字字符切分词元，
+// 屏蔽词须等于若干相邻词元的拼接：既覆盖 "B.a-d" 之类的拆写，又不会误拒 "Badminton" 这类包含屏蔽词的普通单词。
+func NewBlocklistModerator(terms []string) DisplayNameModerator {
+	folded := make([]string, 0, len(terms))
+	for _, term := range terms {
+		if f := foldDisplayName(term); f != "" {
+			folded = append(folded, f)
+		}
+	}
+	return &blocklistModerator{terms: folded}
+}
+
+func (m *blocklistModerator) ModerateDisplayName(_ context.Context, _ uuid.UUID, name string) error {
+	tokens := displayNameTokens(name)
+	folded := strings.Join(tokens, "")
+	for _, term := range m.terms {
+		if matchesBlockedTerm(tokens, folded, term) {
+			return fmt.Errorf("%w: matches %q", ErrDisplayNameBlocked, term)
+		}
+	}
+	return nil
+}
+
+// matchesBlockedTerm 判断屏蔽词是否等于相邻词元的拼接；汉字、假名、泰文等不以空格分词的屏蔽词仍按子串匹配。
+func matchesBlockedTerm(tokens []string, folded, term string) bool {
+	if isUnsegmentedTerm(term) {
+		return strings.Contains(folded, term)
+	}
+	for i := range tokens {
+		joined := ""
+		for j := i; j < len(tokens); j++ {
+			joined += tokens[j]
+			if joined == term {
+				return true
+			}
+			if !strings.HasPrefix(term, joined) {
+				break
+			}
+		}
+	}
+	return false
+}
+
+func isUnsegmentedTerm(term string) bool {
+	for _, r := range term {
+		if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Thai) {
+			return true
+		}
+	}
+	return false
+}
+
+type chainedModerator []DisplayNameModerator
+
+// ChainDisplayNameModerators 依次执行多个审核器，任一拒绝即返回；nil 项会被忽略。
+func ChainDisplayNameModerators(moderators ...DisplayNameModerator) DisplayNameModerator {
+	chain := make(chainedModerator, 0, len(moderators))
+	for _, m := range moderators {
+		if m != nil {
+			chain = append(chain, m)
+		}
+	}
+	return chain
+}
+
+func (c chainedModerator) ModerateDisplayName(ctx context.Context, userID uuid.UUID, name string) error {
+	for _, m := range c {
+		if err := m.ModerateDisplayName(ctx, userID, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ProvideDisplayNameModerator 供 Wire 使用，默认仅启用屏蔽词审核。
+func ProvideDisplayNameModerator(policy DisplayNamePolicy) DisplayNameModerator {
+	return NewBlocklistModerator(policy.Blocklist)
+}
+
+// WithDisplayNameModeration 设置昵称校验策略与审核器。
+func WithDisplayNameModeration(policy DisplayNamePolicy, moderator DisplayNameModerator) ProfileServiceOption {
+	return func(s *ProfileService) {
+		s.namePolicy = policy
+		s.nameModerator = moderator
+	}
+}
+
+// moderateDisplayName 执行无状态的规范化与审核，返回写入用的昵称；唯一性在事务内单独校验。
+func (s *ProfileService) moderateDisplayName(ctx context.Context, userID uuid.UUID, raw string) (string, error) {
+	name, err := s.namePolicy.Normalize(raw)
+	if err != nil {
+		return "", err
+	}
+	if s.nameModerator != nil {
+		if err := s.nameModerator.ModerateDisplayName(ctx, userID, name); err != nil {
+			return "", err
+		}
+	}
+	return name, nil
+}
+
+func foldDisplayName(name string) string {
+	return strings.Join(displayNameTokens(name), "")
+}
+
+// displayNameTokens 将昵称 NFKC 归一后按非字母数字字符与驼峰边界（"SiteAdmin"）切分并转小写；
+// 组合附加符号直接丢弃，不切分词元。
+func displayNameTokens(name string) []string {
+	var tokens []string
+	var b strings.Builder
+	flush := func() {
+		if b.Len() > 0 {
+			tokens = append(tokens, b.String())
+			b.Reset()
+		}
+	}
+	var prev rune
+	for _, r := range norm.NFKC.String(name) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if unicode.IsUpper(r) && unicode.IsLower(prev) {
+				flush()
+			}
+			b.WriteRune(unicode.ToLower(r))
+		case unicode.IsMark(r):
+			continue
+		default:
+			flush()
+		}
+		prev = r
+	}
+	flush()
+	return tokens
+}
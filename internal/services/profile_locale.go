@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	// 内嵌 IANA 时区库，避免运行镜像缺少 zoneinfo 时校验失败。
+	_ "time/tzdata"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/metadata"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/vo"
+	"github.com/google/uuid"
+	"golang.org/x/text/language"
+)
+
+var (
+	// ErrInvalidLocale 表示 preferred_locale 不是合法的 BCP47 标签。
+	ErrInvalidLocale = errors.New("invalid preferred_locale")
+	// ErrInvalidTimezone 表示 preferred_timezone 不是合法的 IANA 时区。
+	ErrInvalidTimezone = errors.New("invalid preferred_timezone")
+)
+
+// NormalizeLocale 校验 BCP47 语言标签并返回规范化形式（如 en-us -> en-US）。
+func NormalizeLocale(raw string) (string, error) {
+	value := strings.TrimSpace(raw)
+	tag, err := language.Parse(value)
+	if err != nil || tag == language.Und {
+		return "", fmt.Errorf("%w: %q", ErrInvalidLocale, raw)
+	}
+	return tag.String(), nil
+}
+
+// NormalizeTimezone 校验 IANA 时区名称；拒绝依赖宿主机配置的 Local。
+func NormalizeTimezone(raw string) (string, error) {
+	value := strings.TrimSpace(raw)
+	if value == "" || strings.EqualFold(value, "local") {
+		return "", fmt.Errorf("%w: %q", ErrInvalidTimezone, raw)
+	}
+	loc, err := time.LoadLocation(value)
+	if err != nil {
+		return "", fmt.Errorf("%w: %q", ErrInvalidTimezone, raw)
+	}
+	return loc.String(), nil
+}
+
+// resolveLocalePatch 计算写入后的 preferred_locale：nil 保持原值，空串清空，其余校验后写入。
+func resolveLocalePatch(patch, current *string) (*string, error) {
+	if patch == nil {
+		return current, nil
+	}
+	if strings.TrimSpace(*patch) == "" {
+		return nil, nil
+	}
+	value, err := NormalizeLocale(*patch)
+	if err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// resolveTimezonePatch 与 resolveLocalePatch 语义一致，作用于 preferred_timezone。
+func resolveTimezonePatch(patch, current *string) (*string, error) {
+	if patch == nil {
+		return current, nil
+	}
+	if strings.TrimSpace(*patch) == "" {
+		return nil, nil
+	}
+	value, err := NormalizeTimezone(*patch)
+	if err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// localeDefaultsFromContext 读取网关 userinfo 中的 locale/zoneinfo，非法值直接忽略。
+// userinfo 描述的是调用者本人，仅当调用者即 userID 时才作为该用户的默认值。
+func localeDefaultsFromContext(ctx context.Context, userID uuid.UUID) (locale, timezone *string) {
+	meta, ok := metadata.FromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	if caller, ok := meta.UserUUID(); !ok || caller != userID {
+		return nil, nil
+	}
+	if meta.Locale != "" {
+		if value, err := NormalizeLocale(meta.Locale); err == nil {
+			locale = &value
+		}
+	}
+	if meta.Timezone != "" {
+		if value, err := NormalizeTimezone(meta.Timezone); err == nil {
+			timezone = &value
+		}
+	}
+	return locale, timezone
+}
+
+// applyLocaleDefaults 为调用者本人未设置区域信息的档案补充 userinfo 默认值（仅用于响应，不落库）。
+func applyLocaleDefaults(ctx context.Context, userID uuid.UUID, profile *vo.Profile) {
+	if profile == nil || (profile.PreferredLocale != nil && profile.PreferredTimezone != nil) {
+		return
+	}
+	locale, timezone := localeDefaultsFromContext(ctx, userID)
+	if profile.PreferredLocale == nil {
+		profile.PreferredLocale = locale
+	}
+	if profile.PreferredTimezone == nil {
+		profile.PreferredTimezone = timezone
+	}
+}
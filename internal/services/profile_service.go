@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
 	"github.com/bionicotaku/lingo-services-profile/internal/models/vo"
@@ -17,6 +19,10 @@ import (
 type ProfileUsersRepository interface {
 	Get(ctx context.Context, sess txmanager.Session, userID uuid.UUID) (*po.ProfileUser, error)
 	Upsert(ctx context.Context, sess txmanager.Session, input repositories.UpsertProfileUserInput) (*po.ProfileUser, error)
+	UpdateAccountStatus(ctx context.Context, sess txmanager.Session, input repositories.UpdateAccountStatusInput) (*po.ProfileUser, error)
+	UpdateVisibility(ctx context.Context, sess txmanager.Session, input repositories.UpdateVisibilityInput) (*po.ProfileUser, error)
+	GetPublicStats(ctx context.Context, sess txmanager.Session, userID uuid.UUID) (*po.ProfilePublicStats, error)
+	IsDisplayNameTaken(ctx context.Context, sess txmanager.Session, userID uuid.UUID, displayName string) (bool, error)
 }
 
 var (
@@ -28,21 +34,61 @@ var (
 
 // ProfileService 负责档案与偏好相关的业务逻辑。
 type ProfileService struct {
-	repo      ProfileUsersRepository
-	txManager txmanager.Manager
-	log       *log.Helper
+	repo          ProfileUsersRepository
+	txManager     txmanager.Manager
+	log           *log.Helper
+	avatarStore   AvatarObjectStore
+	avatarPolicy  AvatarPolicy
+	namePolicy    DisplayNamePolicy
+	nameModerator DisplayNameModerator
+	audit         *AuditRecorder
+	now           func() time.Time
+}
+
+// ProfileServiceOption 定制 ProfileService 的可选依赖。
+type ProfileServiceOption func(*ProfileService)
+
+// WithAvatarUploads 启用头像直传能力并设置头像校验策略；store 为 nil 时仅生效 URL 校验。
+func WithAvatarUploads(store AvatarObjectStore, policy AvatarPolicy) ProfileServiceOption {
+	return func(s *ProfileService) {
+		s.avatarStore = store
+		s.avatarPolicy = policy
+	}
 }
 
 // NewProfileService 构造 ProfileService。
-func NewProfileService(repo ProfileUsersRepository, tx txmanager.Manager, logger log.Logger) *ProfileService {
-	return &ProfileService{
+func NewProfileService(repo ProfileUsersRepository, tx txmanager.Manager, logger log.Logger, opts ...ProfileServiceOption) *ProfileService {
+	svc := &ProfileService{
 		repo:      repo,
 		txManager: tx,
 		log:       log.NewHelper(logger),
+		now:       time.Now,
+	}
+	for _, opt := range opts {
+		opt(svc)
 	}
+	return svc
 }
 
-// GetProfile 返回指定用户的档案信息。
+// ProvideProfileService 供 Wire 使用，注入头像存储、昵称审核策略与审计记录器。
+func ProvideProfileService(
+	repo ProfileUsersRepository,
+	tx txmanager.Manager,
+	logger log.Logger,
+	store AvatarObjectStore,
+	avatarPolicy AvatarPolicy,
+	namePolicy DisplayNamePolicy,
+	moderator DisplayNameModerator,
+	audit *AuditRecorder,
+) *ProfileService {
+	return NewProfileService(repo, tx, logger,
+		WithAvatarUploads(store, avatarPolicy),
+		WithDisplayNameModeration(namePolicy, moderator),
+		WithAuditTrail(audit),
+	)
+}
+
+// GetProfile 返回指定用户的档案信息；已删除账户仅返回公开字段。
 func (s *ProfileService) GetProfile(ctx context.Context, userID uuid.UUID) (*vo.Profile, error) {
 	record, err := s.repo.Get(ctx, nil, userID)
 	if err != nil {
@@ -52,23 +98,44 @@ func (s *ProfileService) GetProfile(ctx context.Context, userID uuid.UUID) (*vo.
 		return nil, fmt.Errorf("get profile: %w", err)
 	}
 	prefs := toPreferencesVO(record.PreferencesJSON)
-	return vo.NewProfileFromPO(record, prefs), nil
+	profile := vo.NewProfileFromPO(record, prefs)
+	if accountStatusOf(record) == AccountStatusDeleted {
+		return redactDeletedProfile(profile), nil
+	}
+	applyLocaleDefaults(ctx, userID, profile)
+	return profile, nil
 }
 
 // UpdateProfileInput 描述档案基础信息更新参数。
+// PreferredLocale/PreferredTimezone 为 nil 表示保持原值，空串表示清空。
 type UpdateProfileInput struct {
-	UserID           uuid.UUID
-	DisplayName      *string
-	AvatarURL        *string
-	ExpectedVersion  *int64
-	PreferencesPatch *vo.Preferences
+	UserID            uuid.UUID
+	DisplayName       *string
+	AvatarURL         *string
+	ExpectedVersion   *int64
+	PreferencesPatch  *vo.Preferences
+	PreferredLocale   *string
+	PreferredTimezone *string
 }
 
 // UpdateProfile 更新档案基础信息，如果不存在则创建。
 func (s *ProfileService) UpdateProfile(ctx context.Context, input UpdateProfileInput) (*vo.Profile, error) {
-	if input.DisplayName == nil && input.AvatarURL == nil && input.PreferencesPatch == nil {
+	if input.DisplayName == nil && input.AvatarURL == nil && input.PreferencesPatch == nil &&
+		input.PreferredLocale == nil && input.PreferredTimezone == nil {
 		return nil, fmt.Errorf("update profile: no changes provided")
 	}
+	if input.AvatarURL != nil {
+		if err := s.avatarPolicy.ValidateURL(*input.AvatarURL); err != nil {
+			return nil, err
+		}
+	}
+	// 审核放在事务外执行，避免外部审核器调用占用事务；结果在事务内结合现有昵称判定。
+	requestedName := valueOrDefault(input.DisplayName, "")
+	var moderatedName string
+	var moderationErr error
+	if requestedName != "" {
+		moderatedName, moderationErr = s.moderateDisplayName(ctx, input.UserID, requestedName)
+	}
 
 	var result *vo.Profile
 	err := s.txManager.WithinTx(ctx, txmanager.TxOptions{}, func(txCtx context.Context, sess txmanager.Session) error {
@@ -77,9 +144,13 @@ func (s *ProfileService) UpdateProfile(ctx context.Context, input UpdateProfileI
 			return fmt.Errorf("load profile: %w", err)
 		}
 
+		before := profileAuditSnapshot(record)
 		prefs := map[string]any{}
 		currentVersion := int64(0)
 		if err == nil {
+			if err := ensureAccountActive(record); err != nil {
+				return err
+			}
 			prefs = record.PreferencesJSON
 			currentVersion = record.ProfileVersion
 			if input.ExpectedVersion != nil && *input.ExpectedVersion != currentVersion {
@@ -94,12 +165,23 @@ func (s *ProfileService) UpdateProfile(ctx context.Context, input UpdateProfileI
 		prefs = ensurePrefs(prefs)
 		updatePreferences(prefs, input.PreferencesPatch)
 
-		displayName := valueOrDefault(input.DisplayName, "")
-		if displayName == "" {
+		displayName := moderatedName
+		switch {
+		case requestedName == "":
 			if record == nil {
 				return fmt.Errorf("update profile: display_name required for creation")
 			}
 			displayName = record.DisplayName
+		case record != nil && requestedName == record.DisplayName:
+			// 未修改的历史昵称不受新规则约束，避免整体回写档案时被拒绝。
+			displayName = record.DisplayName
+		case moderationErr != nil:
+			return moderationErr
+		}
+		if record == nil || !strings.EqualFold(displayName, record.DisplayName) {
+			if err := s.ensureDisplayNameAvailable(txCtx, sess, input.UserID, displayName); err != nil {
+				return err
+			}
 		}
 
 		nextVersion := currentVersion + 1
@@ -108,18 +190,46 @@ func (s *ProfileService) UpdateProfile(ctx context.Context, input UpdateProfileI
 			avatar = record.AvatarURL
 		}
 
+		var currentLocale, currentTimezone *string
+		if record != nil {
+			currentLocale, currentTimezone = record.PreferredLocale, record.PreferredTimezone
+		} else {
+			// 首次建档时以 userinfo 中的 locale/zoneinfo 作为初始值。
+			currentLocale, currentTimezone = localeDefaultsFromContext(txCtx, input.UserID)
+		}
+		locale, err := resolveLocalePatch(input.PreferredLocale, currentLocale)
+		if err != nil {
+			return err
+		}
+		timezone, err := resolveTimezonePatch(input.PreferredTimezone, currentTimezone)
+		if err != nil {
+			return err
+		}
+
 		upsertInput := repositories.UpsertProfileUserInput{
-			UserID:         input.UserID,
-			DisplayName:    displayName,
-			AvatarURL:      avatar,
-			ProfileVersion: nextVersion,
-			Preferences:    prefs,
+			UserID:            input.UserID,
+			DisplayName:       displayName,
+			AvatarURL:         avatar,
+			ProfileVersion:    nextVersion,
+			Preferences:       prefs,
+			PreferredLocale:   locale,
+			PreferredTimezone: timezone,
 		}
 
 		recordUpdated, err := s.repo.Upsert(txCtx, sess, upsertInput)
 		if err != nil {
 			return err
 		}
+		if err := s.audit.Record(txCtx, sess, AuditRecord{
+			UserID:       input.UserID,
+			Action:       AuditActionProfileUpdate,
+			ResourceType: auditResourceProfile,
+			ResourceID:   input.UserID.String(),
+			Before:       before,
+			After:        profileAuditSnapshot(recordUpdated),
+		}); err != nil {
+			return err
+		}
 		result = vo.NewProfileFromPO(recordUpdated, toPreferencesVO(recordUpdated.PreferencesJSON))
 		return nil
 	})
@@ -153,11 +263,15 @@ func (s *ProfileService) UpdatePreferences(ctx context.Context, input UpdatePref
 			}
 			return fmt.Errorf("load profile: %w", err)
 		}
+		if err := ensureAccountActive(record); err != nil {
+			return err
+		}
 
 		if input.ExpectedVersion != nil && *input.ExpectedVersion != record.ProfileVersion {
 			return ErrProfileVersionConflict
 		}
 
+		before := profileAuditSnapshot(record)
 		prefs := ensurePrefs(record.PreferencesJSON)
 		if input.LearningGoal != nil {
 			prefs["learning_goal"] = *input.LearningGoal
@@ -170,17 +284,29 @@ func (s *ProfileService) UpdatePreferences(ctx context.Context, input UpdatePref
 		}
 
 		upsertInput := repositories.UpsertProfileUserInput{
-			UserID:         input.UserID,
-			DisplayName:    record.DisplayName,
-			AvatarURL:      record.AvatarURL,
-			ProfileVersion: record.ProfileVersion + 1,
-			Preferences:    prefs,
+			UserID:            input.UserID,
+			DisplayName:       record.DisplayName,
+			AvatarURL:         record.AvatarURL,
+			ProfileVersion:    record.ProfileVersion + 1,
+			Preferences:       prefs,
+			PreferredLocale:   record.PreferredLocale,
+			PreferredTimezone: record.PreferredTimezone,
 		}
 
 		recordUpdated, err := s.repo.Upsert(txCtx, sess, upsertInput)
 		if err != nil {
 			return err
 		}
+		if err := s.audit.Record(txCtx, sess, AuditRecord{
+			UserID:       input.UserID,
+			Action:       AuditActionPreferencesUpdate,
+			ResourceType: auditResourceProfile,
+			ResourceID:   input.UserID.String(),
+			Before:       before,
+			After:        profileAuditSnapshot(recordUpdated),
+		}); err != nil {
+			return err
+		}
 		result = vo.NewProfileFromPO(recordUpdated, toPreferencesVO(recordUpdated.PreferencesJSON))
 		return nil
 	})
@@ -190,6 +316,21 @@ func (s *ProfileService) UpdatePreferences(ctx context.Context, input UpdatePref
 	return result, nil
 }
 
+// ensureDisplayNameAvailable 在开启唯一性策略时校验昵称未被其他账户占用。
+func (s *ProfileService) ensureDisplayNameAvailable(ctx context.Context, sess txmanager.Session, userID uuid.UUID, name string) error {
+	if !s.namePolicy.RequireUnique {
+		return nil
+	}
+	taken, err := s.repo.IsDisplayNameTaken(ctx, sess, userID, name)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return fmt.Errorf("%w: %q", ErrDisplayNameTaken, name)
+	}
+	return nil
+}
+
 func ensurePrefs(prefs map[string]any) map[string]any {
 	if prefs == nil {
 		return map[string]any{}
@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/vo"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/bionicotaku/lingo-utils/txmanager"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrProfileFieldNotPublic 表示请求的字段未被用户设为公开。
+	ErrProfileFieldNotPublic = errors.New("profile field not public")
+	// ErrNoVisibilityFields 表示可见性更新未包含任何字段（如 update_mask 仅含未知路径）。
+	ErrNoVisibilityFields = errors.New("no visibility fields provided")
+)
+
+// UpdateVisibilityInput 描述字段可见性更新参数；nil 表示保持原值。
+type UpdateVisibilityInput struct {
+	UserID            uuid.UUID
+	PublicDisplayName *bool
+	PublicAvatar      *bool
+	PublicBookmarks   *bool
+	PublicStats       *bool
+	ExpectedVersion   *int64
+}
+
+// UpdateVisibility 局部更新公开主页的字段可见性，并递增 profile_version。
+func (s *ProfileService) UpdateVisibility(ctx context.Context, input UpdateVisibilityInput) (*vo.Profile, error) {
+	if input.PublicDisplayName == nil && input.PublicAvatar == nil && input.PublicBookmarks == nil && input.PublicStats == nil {
+		return nil, fmt.Errorf("update visibility: %w", ErrNoVisibilityFields)
+	}
+
+	var result *vo.Profile
+	err := s.txManager.WithinTx(ctx, txmanager.TxOptions{}, func(txCtx context.Context, sess txmanager.Session) error {
+		record, err := s.repo.Get(txCtx, sess, input.UserID)
+		if err != nil {
+			if errors.Is(err, repositories.ErrProfileUserNotFound) {
+				return ErrProfileNotFound
+			}
+			return fmt.Errorf("load profile: %w", err)
+		}
+		if err := ensureAccountActive(record); err != nil {
+			return err
+		}
+		if input.ExpectedVersion != nil && *input.ExpectedVersion != record.ProfileVersion {
+			return ErrProfileVersionConflict
+		}
+
+		updated, err := s.repo.UpdateVisibility(txCtx, sess, repositories.UpdateVisibilityInput{
+			UserID:            input.UserID,
+			PublicDisplayName: boolOrDefault(input.PublicDisplayName, record.PublicDisplayName),
+			PublicAvatar:      boolOrDefault(input.PublicAvatar, record.PublicAvatar),
+			PublicBookmarks:   boolOrDefault(input.PublicBookmarks, record.PublicBookmarks),
+			PublicStats:       boolOrDefault(input.PublicStats, record.PublicStats),
+			ProfileVersion:    record.ProfileVersion + 1,
+		})
+		if err != nil {
+			return err
+		}
+		if err := s.audit.Record(txCtx, sess, AuditRecord{
+			UserID:       input.UserID,
+			Action:       AuditActionVisibilityUpdate,
+			ResourceType: auditResourceProfile,
+			ResourceID:   input.UserID.String(),
+			Before:       profileAuditSnapshot(record),
+			After:        profileAuditSnapshot(updated),
+		}); err != nil {
+			return err
+		}
+		result = vo.NewProfileFromPO(updated, toPreferencesVO(updated.PreferencesJSON))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetPublicProfile 返回公开主页视图，仅包含用户标记为公开的字段。
+// 非 active 账户对外视为不存在，避免暴露冻结/删除状态。
+func (s *ProfileService) GetPublicProfile(ctx context.Context, userID uuid.UUID) (*vo.PublicProfile, error) {
+	record, err := s.loadPublicRecord(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &vo.PublicProfile{
+		UserID:          record.UserID.String(),
+		BookmarksPublic: record.PublicBookmarks,
+	}
+	if record.PublicDisplayName {
+		name := record.DisplayName
+		profile.DisplayName = &name
+	}
+	if record.PublicAvatar {
+		profile.AvatarURL = record.AvatarURL
+	}
+	if record.PublicStats {
+		stats, err := s.repo.GetPublicStats(ctx, nil, userID)
+		if err != nil {
+			return nil, fmt.Errorf("get public stats: %w", err)
+		}
+		profile.Stats = &vo.PublicProfileStats{
+			LikeCount:         stats.LikeCount,
+			BookmarkCount:     stats.BookmarkCount,
+			WatchedVideoCount: stats.WatchedVideoCount,
+			TotalWatchSeconds: stats.TotalWatchSeconds,
+		}
+	}
+	return profile, nil
+}
+
+// EnsureBookmarksPublic 校验用户是否公开了收藏列表。
+func (s *ProfileService) EnsureBookmarksPublic(ctx context.Context, userID uuid.UUID) error {
+	record, err := s.loadPublicRecord(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !record.PublicBookmarks {
+		return fmt.Errorf("%w: bookmarks", ErrProfileFieldNotPublic)
+	}
+	return nil
+}
+
+func (s *ProfileService) loadPublicRecord(ctx context.Context, userID uuid.UUID) (*po.ProfileUser, error) {
+	record, err := s.repo.Get(ctx, nil, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProfileUserNotFound) {
+			return nil, ErrProfileNotFound
+		}
+		return nil, fmt.Errorf("get profile: %w", err)
+	}
+	if accountStatusOf(record) != AccountStatusActive {
+		return nil, ErrProfileNotFound
+	}
+	return record, nil
+}
+
+func boolOrDefault(ptr *bool, fallback bool) bool {
+	if ptr != nil {
+		return *ptr
+	}
+	return fallback
+}
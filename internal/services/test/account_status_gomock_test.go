@@ -0,0 +1,193 @@
+package services_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/vo"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/bionicotaku/lingo-services-profile/internal/services"
+	"github.com/bionicotaku/lingo-services-profile/internal/services/mocks"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountStatus_Transitions(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		from, to services.AccountStatus
+		allowed  bool
+	}{
+		{services.AccountStatusActive, services.AccountStatusSuspended, true},
+		{services.AccountStatusActive, services.AccountStatusPendingDeletion, true},
+		{services.AccountStatusActive, services.AccountStatusDeleted, false},
+		{services.AccountStatusSuspended, services.AccountStatusActive, true},
+		{services.AccountStatusPendingDeletion, services.AccountStatusActive, true},
+		{services.AccountStatusPendingDeletion, services.AccountStatusDeleted, true},
+		{services.AccountStatusDeleted, services.AccountStatusActive, false},
+	}
+	for _, tc := range cases {
+		require.Equalf(t, tc.allowed, tc.from.CanTransitionTo(tc.to), "%s -> %s", tc.from, tc.to)
+	}
+}
+
+func TestProfileService_TransitionAccountStatus_Suspend(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), userID).Return(&po.ProfileUser{UserID: userID, ProfileVersion: 2, AccountStatus: "active"}, nil)
+	repo.EXPECT().UpdateAccountStatus(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.UpdateAccountStatusInput{})).
+		DoAndReturn(func(_ context.Context, _ any, input repositories.UpdateAccountStatusInput) (*po.ProfileUser, error) {
+			require.Equal(t, "suspended", input.AccountStatus)
+			require.Equal(t, int64(3), input.ProfileVersion)
+			require.Nil(t, input.PendingDeletionAt)
+			require.Nil(t, input.DeletedAt)
+			return &po.ProfileUser{UserID: userID, ProfileVersion: 3, AccountStatus: input.AccountStatus}, nil
+		})
+
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	profile, err := svc.TransitionAccountStatus(context.Background(), services.TransitionAccountStatusInput{
+		UserID: userID,
+		Target: services.AccountStatusSuspended,
+		Reason: "abuse report",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "suspended", profile.AccountStatus)
+	require.Equal(t, int64(3), profile.ProfileVersion)
+}
+
+func TestProfileService_TransitionAccountStatus_SameStatusIsNoop(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&po.ProfileUser{ProfileVersion: 5, AccountStatus: "suspended"}, nil)
+
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	profile, err := svc.TransitionAccountStatus(context.Background(), services.TransitionAccountStatusInput{
+		UserID: uuid.New(),
+		Target: services.AccountStatusSuspended,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), profile.ProfileVersion)
+}
+
+func TestProfileService_TransitionAccountStatus_DeletedIsTerminal(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&po.ProfileUser{ProfileVersion: 5, AccountStatus: "deleted"}, nil)
+
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	_, err := svc.TransitionAccountStatus(context.Background(), services.TransitionAccountStatusInput{
+		UserID: uuid.New(),
+		Target: services.AccountStatusActive,
+	})
+	require.ErrorIs(t, err, services.ErrInvalidAccountStatusTransition)
+}
+
+func TestProfileService_EnsureAccountWritable(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, repositories.ErrProfileUserNotFound)
+	require.NoError(t, svc.EnsureAccountWritable(context.Background(), uuid.New()))
+
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&po.ProfileUser{AccountStatus: "active"}, nil)
+	require.NoError(t, svc.EnsureAccountWritable(context.Background(), uuid.New()))
+
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&po.ProfileUser{AccountStatus: "pending_deletion"}, nil)
+	require.ErrorIs(t, svc.EnsureAccountWritable(context.Background(), uuid.New()), services.ErrAccountNotActive)
+}
+
+func TestProfileService_ProfileWritesRequireActiveAccount(t *testing.T) {
+	t.Parallel()
+
+	goal := "travel"
+	public := true
+	writes := map[string]func(*services.ProfileService, uuid.UUID) error{
+		"UpdateProfile": func(svc *services.ProfileService, userID uuid.UUID) error {
+			_, err := svc.UpdateProfile(context.Background(), services.UpdateProfileInput{
+				UserID:           userID,
+				PreferencesPatch: &vo.Preferences{LearningGoal: &goal},
+			})
+			return err
+		},
+		"UpdatePreferences": func(svc *services.ProfileService, userID uuid.UUID) error {
+			_, err := svc.UpdatePreferences(context.Background(), services.UpdatePreferencesInput{UserID: userID, LearningGoal: &goal})
+			return err
+		},
+		"UpdateVisibility": func(svc *services.ProfileService, userID uuid.UUID) error {
+			_, err := svc.UpdateVisibility(context.Background(), services.UpdateVisibilityInput{UserID: userID, PublicStats: &public})
+			return err
+		},
+	}
+	for name, write := range writes {
+		for _, status := range []services.AccountStatus{services.AccountStatusSuspended, services.AccountStatusPendingDeletion, services.AccountStatusDeleted} {
+			ctrl := gomock.NewController(t)
+			repo := mocks.NewMockProfileUsersRepository(ctrl)
+			userID := uuid.New()
+			repo.EXPECT().Get(gomock.Any(), gomock.Any(), userID).
+				Return(&po.ProfileUser{UserID: userID, ProfileVersion: 1, AccountStatus: string(status)}, nil)
+			svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+			require.ErrorIs(t, write(svc, userID), services.ErrAccountNotActive, "%s on %s account", name, status)
+			ctrl.Finish()
+		}
+	}
+}
+
+func TestProfileService_GetProfile_RedactsDeletedAccount(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	deletedAt := time.Now().UTC()
+	avatar := "https://cdn/avatar.png"
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), userID).Return(&po.ProfileUser{
+		UserID:          userID,
+		DisplayName:     "Alice",
+		AvatarURL:       &avatar,
+		ProfileVersion:  7,
+		PreferencesJSON: map[string]any{"learning_goal": "fluency"},
+		AccountStatus:   "deleted",
+		DeletedAt:       &deletedAt,
+	}, nil)
+
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	profile, err := svc.GetProfile(context.Background(), userID)
+	require.NoError(t, err)
+	require.Equal(t, userID.String(), profile.UserID)
+	require.Equal(t, "deleted", profile.AccountStatus)
+	require.Empty(t, profile.DisplayName)
+	require.Nil(t, profile.AvatarURL)
+	require.Nil(t, profile.Preferences.LearningGoal)
+	require.NotNil(t, profile.DeletedAt)
+}
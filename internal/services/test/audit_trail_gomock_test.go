@@ -0,0 +1,165 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/metadata"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/bionicotaku/lingo-services-profile/internal/services"
+	"github.com/bionicotaku/lingo-services-profile/internal/services/mocks"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestProfileService_UpdatePreferences_RecordsAuditDiff(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	auditRepo := mocks.NewMockAuditTrailRepository(ctrl)
+
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), userID).Return(&po.ProfileUser{
+		UserID:          userID,
+		DisplayName:     "Alice",
+		ProfileVersion:  2,
+		PreferencesJSON: map[string]any{"learning_goal": "travel"},
+	}, nil)
+	repo.EXPECT().Upsert(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.UpsertProfileUserInput{})).
+		DoAndReturn(func(_ context.Context, _ any, input repositories.UpsertProfileUserInput) (*po.ProfileUser, error) {
+			return &po.ProfileUser{
+				UserID:          userID,
+				DisplayName:     input.DisplayName,
+				ProfileVersion:  input.ProfileVersion,
+				PreferencesJSON: input.Preferences,
+			}, nil
+		})
+
+	traceID := trace.TraceID{0x0a, 0x0b, 0x0c, 0x0d, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c}
+	spanCtx := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: trace.SpanID{1}})
+	ctx := trace.ContextWithSpanContext(context.Background(), spanCtx)
+	ctx = metadata.Inject(ctx, metadata.HandlerMetadata{UserID: userID.String(), ServiceAccount: "api-gateway@proj.iam.gserviceaccount.com"})
+
+	auditRepo.EXPECT().Append(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.AppendAuditEntryInput{})).
+		DoAndReturn(func(_ context.Context, _ any, input repositories.AppendAuditEntryInput) error {
+			require.Equal(t, userID, input.UserID)
+			require.Equal(t, services.AuditActorUser, input.ActorType)
+			require.Equal(t, userID.String(), input.ActorID)
+			require.Equal(t, services.AuditActionPreferencesUpdate, input.Action)
+			require.Equal(t, "profile", input.ResourceType)
+			require.Equal(t, userID.String(), input.ResourceID)
+			require.Equal(t, traceID.String(), input.TraceID)
+			require.Equal(t, map[string]any{
+				"preferences":     map[string]any{"learning_goal": "travel"},
+				"profile_version": int64(2),
+			}, input.Before)
+			require.Equal(t, map[string]any{
+				"preferences":     map[string]any{"learning_goal": "fluency"},
+				"profile_version": int64(3),
+			}, input.After)
+			return nil
+		})
+
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard),
+		services.WithAuditTrail(services.NewAuditRecorder(auditRepo)))
+
+	_, err := svc.UpdatePreferences(ctx, services.UpdatePreferencesInput{
+		UserID:       userID,
+		LearningGoal: ptrString("fluency"),
+	})
+	require.NoError(t, err)
+}
+
+func TestProfileService_TransitionAccountStatus_AuditFailureAborts(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	auditRepo := mocks.NewMockAuditTrailRepository(ctrl)
+
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), userID).Return(&po.ProfileUser{
+		UserID:         userID,
+		ProfileVersion: 1,
+		AccountStatus:  string(services.AccountStatusActive),
+	}, nil)
+	repo.EXPECT().UpdateAccountStatus(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.UpdateAccountStatusInput{})).
+		Return(&po.ProfileUser{
+			UserID:         userID,
+			ProfileVersion: 2,
+			AccountStatus:  string(services.AccountStatusSuspended),
+		}, nil)
+
+	ctx := metadata.Inject(context.Background(), metadata.HandlerMetadata{ServiceAccount: "ops-console@proj.iam.gserviceaccount.com"})
+	auditRepo.EXPECT().Append(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.AppendAuditEntryInput{})).
+		DoAndReturn(func(_ context.Context, _ any, input repositories.AppendAuditEntryInput) error {
+			require.Equal(t, services.AuditActorService, input.ActorType)
+			require.Equal(t, "ops-console@proj.iam.gserviceaccount.com", input.ActorID)
+			require.Equal(t, "active", input.Before["account_status"])
+			require.Equal(t, "suspended", input.After["account_status"])
+			require.Equal(t, "abuse", input.After["reason"])
+			return errors.New("insert failed")
+		})
+
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard),
+		services.WithAuditTrail(services.NewAuditRecorder(auditRepo)))
+
+	_, err := svc.TransitionAccountStatus(ctx, services.TransitionAccountStatusInput{
+		UserID: userID,
+		Target: services.AccountStatusSuspended,
+		Reason: "abuse",
+	})
+	require.ErrorContains(t, err, "insert failed")
+}
+
+func TestEngagementService_Mutate_RecordsAudit(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	videoID := uuid.New()
+	engRepo := mocks.NewMockEngagementsRepository(ctrl)
+	auditRepo := mocks.NewMockAuditTrailRepository(ctrl)
+
+	deletedAt := time.Now().UTC()
+	engRepo.EXPECT().Get(gomock.Any(), gomock.Any(), userID, videoID, "bookmark").
+		Return(&po.ProfileEngagement{UserID: userID, VideoID: videoID, EngagementType: "bookmark", DeletedAt: &deletedAt}, nil)
+	engRepo.EXPECT().Upsert(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.UpsertProfileEngagementInput{})).Return(nil)
+	auditRepo.EXPECT().Append(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.AppendAuditEntryInput{})).
+		DoAndReturn(func(_ context.Context, _ any, input repositories.AppendAuditEntryInput) error {
+			require.Equal(t, services.AuditActionEngagementAdd, input.Action)
+			require.Equal(t, "engagement:bookmark", input.ResourceType)
+			require.Equal(t, videoID.String(), input.ResourceID)
+			require.Equal(t, services.AuditActorService, input.ActorType)
+			require.Equal(t, "unknown", input.ActorID)
+			require.Empty(t, input.TraceID)
+			require.Equal(t, map[string]any{"active": false}, input.Before)
+			require.Equal(t, map[string]any{"active": true}, input.After)
+			return nil
+		})
+
+	svc := services.NewEngagementService(engRepo, nil, nil, &fakeTxManager{}, log.NewStdLogger(io.Discard),
+		services.WithEngagementAudit(services.NewAuditRecorder(auditRepo)))
+
+	err := svc.Mutate(context.Background(), services.MutateEngagementInput{
+		UserID:         userID,
+		VideoID:        videoID,
+		EngagementType: "bookmark",
+		Action:         services.EngagementActionAdd,
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,168 @@
+package services_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	profilev1 "github.com/bionicotaku/lingo-services-profile/api/profile/v1"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/bionicotaku/lingo-services-profile/internal/services"
+	"github.com/bionicotaku/lingo-services-profile/internal/services/mocks"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCollectionService_AddItem_EmitsAddedEvent(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockCollectionsRepository(ctrl)
+	outbox := mocks.NewMockOutboxEnqueuer(ctrl)
+	svc := services.NewCollectionService(repo, outbox, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	userID := uuid.New()
+	collectionID := uuid.New()
+	videoID := uuid.New()
+
+	repo.EXPECT().Lock(gomock.Any(), gomock.Any(), collectionID).Return(userID, nil)
+	repo.EXPECT().GetItem(gomock.Any(), gomock.Any(), collectionID, videoID).Return(nil, repositories.ErrCollectionItemNotFound)
+	repo.EXPECT().ListItemVideoIDs(gomock.Any(), gomock.Any(), collectionID).Return([]uuid.UUID{uuid.New()}, nil)
+	repo.EXPECT().AddItem(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.AddCollectionItemInput{})).
+		Return(&po.CollectionItem{CollectionID: collectionID, VideoID: videoID, UserID: userID, Position: 2, AddedAt: time.Now()}, true, nil)
+	outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.OutboxMessage{})).
+		DoAndReturn(func(_ context.Context, _ any, msg repositories.OutboxMessage) error {
+			require.Equal(t, "profile.collection.item_added", msg.EventType)
+			require.Equal(t, collectionID, msg.AggregateID)
+			var evt profilev1.CollectionItemAddedEvent
+			require.NoError(t, proto.Unmarshal(msg.Payload, &evt))
+			require.Equal(t, videoID.String(), evt.GetVideoId())
+			require.Equal(t, int32(2), evt.GetPosition())
+			return nil
+		})
+
+	item, err := svc.AddItem(context.Background(), services.AddCollectionItemInput{
+		UserID:       userID,
+		CollectionID: collectionID,
+		VideoID:      videoID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), item.Position)
+}
+
+func TestCollectionService_AddItem_ExistingIsIdempotent(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockCollectionsRepository(ctrl)
+	outbox := mocks.NewMockOutboxEnqueuer(ctrl)
+	svc := services.NewCollectionService(repo, outbox, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	userID := uuid.New()
+	collectionID := uuid.New()
+	videoID := uuid.New()
+
+	repo.EXPECT().Lock(gomock.Any(), gomock.Any(), collectionID).Return(userID, nil)
+	repo.EXPECT().GetItem(gomock.Any(), gomock.Any(), collectionID, videoID).
+		Return(&po.CollectionItem{CollectionID: collectionID, VideoID: videoID, Position: 1}, nil)
+
+	item, err := svc.AddItem(context.Background(), services.AddCollectionItemInput{
+		UserID:       userID,
+		CollectionID: collectionID,
+		VideoID:      videoID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), item.Position)
+}
+
+func TestCollectionService_ForeignCollectionIsNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockCollectionsRepository(ctrl)
+	svc := services.NewCollectionService(repo, nil, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	collectionID := uuid.New()
+	repo.EXPECT().Lock(gomock.Any(), gomock.Any(), collectionID).Return(uuid.New(), nil)
+
+	err := svc.RemoveItem(context.Background(), uuid.New(), collectionID, uuid.New())
+	require.ErrorIs(t, err, services.ErrCollectionNotFound)
+}
+
+func TestCollectionService_ReorderItems_RejectsPartialOrder(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockCollectionsRepository(ctrl)
+	svc := services.NewCollectionService(repo, nil, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	userID := uuid.New()
+	collectionID := uuid.New()
+	a, b := uuid.New(), uuid.New()
+
+	repo.EXPECT().Lock(gomock.Any(), gomock.Any(), collectionID).Return(userID, nil)
+	repo.EXPECT().ListItemVideoIDs(gomock.Any(), gomock.Any(), collectionID).Return([]uuid.UUID{a, b}, nil)
+
+	err := svc.ReorderItems(context.Background(), userID, collectionID, []uuid.UUID{b})
+	require.ErrorIs(t, err, services.ErrCollectionOrderMismatch)
+}
+
+func TestCollectionService_MoveItem_EmitsRemovedAndAdded(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockCollectionsRepository(ctrl)
+	outbox := mocks.NewMockOutboxEnqueuer(ctrl)
+	svc := services.NewCollectionService(repo, outbox, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	userID := uuid.New()
+	source := uuid.New()
+	target := uuid.New()
+	videoID := uuid.New()
+
+	repo.EXPECT().Lock(gomock.Any(), gomock.Any(), source).Return(userID, nil)
+	repo.EXPECT().Lock(gomock.Any(), gomock.Any(), target).Return(userID, nil)
+	repo.EXPECT().RemoveItem(gomock.Any(), gomock.Any(), source, videoID).Return(true, nil)
+	repo.EXPECT().GetItem(gomock.Any(), gomock.Any(), target, videoID).Return(nil, repositories.ErrCollectionItemNotFound)
+	repo.EXPECT().ListItemVideoIDs(gomock.Any(), gomock.Any(), target).Return(nil, nil)
+	repo.EXPECT().AddItem(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.AddCollectionItemInput{})).
+		Return(&po.CollectionItem{CollectionID: target, VideoID: videoID, UserID: userID, Position: 1}, true, nil)
+
+	var eventTypes []string
+	var removed profilev1.CollectionItemRemovedEvent
+	outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.OutboxMessage{})).
+		DoAndReturn(func(_ context.Context, _ any, msg repositories.OutboxMessage) error {
+			eventTypes = append(eventTypes, msg.EventType)
+			if msg.EventType == "profile.collection.item_removed" {
+				require.NoError(t, proto.Unmarshal(msg.Payload, &removed))
+			}
+			return nil
+		}).Times(2)
+
+	item, err := svc.MoveItem(context.Background(), services.MoveItemInput{
+		UserID:             userID,
+		SourceCollectionID: source,
+		TargetCollectionID: target,
+		VideoID:            videoID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, target, item.CollectionID)
+	require.Equal(t, []string{"profile.collection.item_removed", "profile.collection.item_added"}, eventTypes)
+	require.Equal(t, "moved", removed.GetReason())
+	require.Equal(t, source.String(), removed.GetCollectionId())
+}
@@ -0,0 +1,127 @@
+package services_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/bionicotaku/lingo-services-profile/internal/services"
+	"github.com/bionicotaku/lingo-services-profile/internal/services/mocks"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngagementNoteService_AddNote_TrimsAndInserts(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notesRepo := mocks.NewMockEngagementNotesRepository(ctrl)
+	engRepo := mocks.NewMockEngagementsRepository(ctrl)
+	svc := services.NewEngagementNoteService(notesRepo, engRepo, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	userID := uuid.New()
+	videoID := uuid.New()
+	position := int32(192)
+
+	engRepo.EXPECT().Get(gomock.Any(), gomock.Any(), userID, videoID, "bookmark").
+		Return(&po.ProfileEngagement{UserID: userID, VideoID: videoID, EngagementType: "bookmark"}, nil)
+	notesRepo.EXPECT().Count(gomock.Any(), gomock.Any(), userID, videoID, "bookmark").Return(int64(1), nil)
+	notesRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.InsertEngagementNoteInput{})).
+		DoAndReturn(func(_ context.Context, _ any, input repositories.InsertEngagementNoteInput) (*po.EngagementNote, error) {
+			require.Equal(t, "idiom at 03:12", input.Body)
+			require.Equal(t, "bookmark", input.EngagementType)
+			require.Equal(t, &position, input.PositionSeconds)
+			return &po.EngagementNote{NoteID: uuid.New(), UserID: userID, VideoID: videoID, Body: input.Body, PositionSeconds: input.PositionSeconds}, nil
+		})
+
+	note, err := svc.AddNote(context.Background(), services.AddEngagementNoteInput{
+		UserID:          userID,
+		VideoID:         videoID,
+		Body:            "  idiom at 03:12 ",
+		PositionSeconds: &position,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "idiom at 03:12", note.Body)
+}
+
+func TestEngagementNoteService_AddNote_RemovedBookmarkRejected(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notesRepo := mocks.NewMockEngagementNotesRepository(ctrl)
+	engRepo := mocks.NewMockEngagementsRepository(ctrl)
+	svc := services.NewEngagementNoteService(notesRepo, engRepo, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	userID := uuid.New()
+	videoID := uuid.New()
+	deletedAt := time.Now()
+	engRepo.EXPECT().Get(gomock.Any(), gomock.Any(), userID, videoID, "bookmark").
+		Return(&po.ProfileEngagement{UserID: userID, VideoID: videoID, EngagementType: "bookmark", DeletedAt: &deletedAt}, nil)
+
+	_, err := svc.AddNote(context.Background(), services.AddEngagementNoteInput{UserID: userID, VideoID: videoID, Body: "note"})
+	require.ErrorIs(t, err, services.ErrNoteRequiresBookmark)
+}
+
+func TestEngagementNoteService_UpdateNote_ForeignNoteIsNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notesRepo := mocks.NewMockEngagementNotesRepository(ctrl)
+	svc := services.NewEngagementNoteService(notesRepo, nil, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	noteID := uuid.New()
+	notesRepo.EXPECT().Get(gomock.Any(), gomock.Any(), noteID).
+		Return(&po.EngagementNote{NoteID: noteID, UserID: uuid.New(), VideoID: uuid.New(), Body: "theirs"}, nil)
+
+	_, err := svc.UpdateNote(context.Background(), services.UpdateEngagementNoteInput{
+		UserID: uuid.New(),
+		NoteID: noteID,
+		Body:   "mine",
+	})
+	require.ErrorIs(t, err, services.ErrEngagementNoteNotFound)
+}
+
+func TestEngagementNoteService_UpdateNote_AuditOmitsBody(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notesRepo := mocks.NewMockEngagementNotesRepository(ctrl)
+	auditRepo := mocks.NewMockAuditTrailRepository(ctrl)
+	svc := services.NewEngagementNoteService(notesRepo, nil, &fakeTxManager{}, log.NewStdLogger(io.Discard),
+		services.WithEngagementNoteAudit(services.NewAuditRecorder(auditRepo)))
+
+	userID := uuid.New()
+	noteID := uuid.New()
+	videoID := uuid.New()
+	notesRepo.EXPECT().Get(gomock.Any(), gomock.Any(), noteID).
+		Return(&po.EngagementNote{NoteID: noteID, UserID: userID, VideoID: videoID, Body: "private"}, nil)
+	notesRepo.EXPECT().Update(gomock.Any(), gomock.Any(), noteID, "private thoughts", gomock.Nil()).
+		Return(&po.EngagementNote{NoteID: noteID, UserID: userID, VideoID: videoID, Body: "private thoughts"}, nil)
+	auditRepo.EXPECT().Append(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ any, input repositories.AppendAuditEntryInput) error {
+			require.Equal(t, services.AuditActionNoteUpdate, input.Action)
+			require.Equal(t, map[string]any{"body_length": 7}, input.Before)
+			require.Equal(t, map[string]any{"body_length": 16}, input.After)
+			return nil
+		})
+
+	_, err := svc.UpdateNote(context.Background(), services.UpdateEngagementNoteInput{
+		UserID: userID,
+		NoteID: noteID,
+		Body:   "private thoughts",
+	})
+	require.NoError(t, err)
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	profilev1 "github.com/bionicotaku/lingo-services-profile/api/profile/v1"
 	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
 	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
 	"github.com/bionicotaku/lingo-services-profile/internal/services"
@@ -15,6 +16,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestEngagementService_Mutate_StatsError(t *testing.T) {
@@ -31,8 +33,9 @@ func TestEngagementService_Mutate_StatsError(t *testing.T) {
 	userID := uuid.New()
 	videoID := uuid.New()
 
+	engRepo.EXPECT().Get(gomock.Any(), gomock.Any(), userID, videoID, "dislike").Return(nil, repositories.ErrProfileEngagementNotFound)
 	engRepo.EXPECT().Upsert(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.UpsertProfileEngagementInput{})).Return(nil)
-	statsRepo.EXPECT().Increment(gomock.Any(), gomock.Any(), videoID, int64(1), int64(0), int64(0), int64(0)).Return(errors.New("stats failure"))
+	statsRepo.EXPECT().Increment(gomock.Any(), gomock.Any(), videoID, repositories.VideoStatsDelta{Likes: 1}).Return(errors.New("stats failure"))
 
 	err := svc.Mutate(context.Background(), services.MutateEngagementInput{
 		UserID:         userID,
@@ -59,7 +62,7 @@ func TestEngagementService_Mutate_RemoveOutboxError(t *testing.T) {
 	deletedAt := time.Now().UTC()
 
 	engRepo.EXPECT().SoftDelete(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.SoftDeleteProfileEngagementInput{})).Return(nil)
-	statsRepo.EXPECT().Increment(gomock.Any(), gomock.Any(), videoID, int64(-1), int64(0), int64(0), int64(0)).Return(nil)
+	statsRepo.EXPECT().Increment(gomock.Any(), gomock.Any(), videoID, repositories.VideoStatsDelta{Likes: -1}).Return(nil)
 	statsRepo.EXPECT().Get(gomock.Any(), gomock.Any(), videoID).Return(&po.ProfileVideoStats{}, nil)
 	outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("outbox failure"))
 
@@ -84,13 +87,224 @@ func TestEngagementService_GetFavoriteState_NotFound(t *testing.T) {
 
 	userID := uuid.New()
 	videoID := uuid.New()
+	engRepo.EXPECT().ListByUserVideo(gomock.Any(), gomock.Any(), userID, videoID).Return([]*po.ProfileEngagement{}, nil)
+
+	state, err := svc.GetFavoriteState(context.Background(), userID, videoID)
+	require.NoError(t, err)
+	require.Equal(t, services.FavoriteState{}, state)
+}
+
+func TestEngagementService_GetFavoriteState_FoldsActiveTypes(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engRepo := mocks.NewMockEngagementsRepository(ctrl)
+	svc := services.NewEngagementService(engRepo, nil, nil, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	userID := uuid.New()
+	videoID := uuid.New()
+	deletedAt := time.Now().UTC()
+	engRepo.EXPECT().ListByUserVideo(gomock.Any(), gomock.Any(), userID, videoID).Return([]*po.ProfileEngagement{
+		{EngagementType: "bookmark"},
+		{EngagementType: "dislike"},
+		{EngagementType: "like", DeletedAt: &deletedAt},
+		{EngagementType: "not_interested"},
+		{EngagementType: "share"},
+	}, nil)
+
+	state, err := svc.GetFavoriteState(context.Background(), userID, videoID)
+	require.NoError(t, err)
+	require.Equal(t, services.FavoriteState{
+		HasBookmarked: true,
+		HasDisliked:   true,
+		HasShared:     true,
+		NotInterested: true,
+	}, state)
+}
+
+func TestEngagementService_Mutate_DislikeRevokesActiveLike(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engRepo := mocks.NewMockEngagementsRepository(ctrl)
+	statsRepo := mocks.NewMockEngagementStatsRepository(ctrl)
+	outbox := mocks.NewMockOutboxEnqueuer(ctrl)
+	svc := services.NewEngagementService(engRepo, statsRepo, outbox, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	userID := uuid.New()
+	videoID := uuid.New()
+
+	engRepo.EXPECT().Get(gomock.Any(), gomock.Any(), userID, videoID, "like").
+		Return(&po.ProfileEngagement{UserID: userID, VideoID: videoID, EngagementType: "like"}, nil)
+	var eventTypes []string
 	gomock.InOrder(
-		engRepo.EXPECT().Get(gomock.Any(), gomock.Any(), userID, videoID, "like").Return(nil, repositories.ErrProfileEngagementNotFound),
-		engRepo.EXPECT().Get(gomock.Any(), gomock.Any(), userID, videoID, "bookmark").Return(nil, repositories.ErrProfileEngagementNotFound),
+		engRepo.EXPECT().SoftDelete(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.SoftDeleteProfileEngagementInput{})).
+			DoAndReturn(func(_ context.Context, _ any, input repositories.SoftDeleteProfileEngagementInput) error {
+				require.Equal(t, "like", input.EngagementType)
+				return nil
+			}),
+		statsRepo.EXPECT().Increment(gomock.Any(), gomock.Any(), videoID, repositories.VideoStatsDelta{Likes: -1}).Return(nil),
+		engRepo.EXPECT().Upsert(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.UpsertProfileEngagementInput{})).
+			DoAndReturn(func(_ context.Context, _ any, input repositories.UpsertProfileEngagementInput) error {
+				require.Equal(t, "dislike", input.EngagementType)
+				return nil
+			}),
+		statsRepo.EXPECT().Increment(gomock.Any(), gomock.Any(), videoID, repositories.VideoStatsDelta{Dislikes: 1}).Return(nil),
 	)
+	statsRepo.EXPECT().Get(gomock.Any(), gomock.Any(), videoID).Return(&po.ProfileVideoStats{VideoID: videoID}, nil).Times(2)
+	outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.OutboxMessage{})).
+		DoAndReturn(func(_ context.Context, _ any, msg repositories.OutboxMessage) error {
+			eventTypes = append(eventTypes, msg.EventType)
+			return nil
+		}).Times(2)
 
-	state, err := svc.GetFavoriteState(context.Background(), userID, videoID)
+	err := svc.Mutate(context.Background(), services.MutateEngagementInput{
+		UserID:         userID,
+		VideoID:        videoID,
+		EngagementType: "dislike",
+		Action:         services.EngagementActionAdd,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"profile.engagement.removed", "profile.engagement.added"}, eventTypes)
+}
+
+func TestEngagementService_Mutate_NotInterestedSkipsStats(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engRepo := mocks.NewMockEngagementsRepository(ctrl)
+	statsRepo := mocks.NewMockEngagementStatsRepository(ctrl)
+	outbox := mocks.NewMockOutboxEnqueuer(ctrl)
+	svc := services.NewEngagementService(engRepo, statsRepo, outbox, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	videoID := uuid.New()
+	engRepo.EXPECT().Upsert(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.UpsertProfileEngagementInput{})).Return(nil)
+	statsRepo.EXPECT().Get(gomock.Any(), gomock.Any(), videoID).Return(&po.ProfileVideoStats{VideoID: videoID}, nil)
+	outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.OutboxMessage{})).
+		DoAndReturn(func(_ context.Context, _ any, msg repositories.OutboxMessage) error {
+			var evt profilev1.EngagementAddedEvent
+			require.NoError(t, proto.Unmarshal(msg.Payload, &evt))
+			require.Equal(t, profilev1.FavoriteType_FAVORITE_TYPE_NOT_INTERESTED, evt.GetFavoriteType())
+			return nil
+		})
+
+	err := svc.Mutate(context.Background(), services.MutateEngagementInput{
+		UserID:         uuid.New(),
+		VideoID:        videoID,
+		EngagementType: "not_interested",
+		Action:         services.EngagementActionAdd,
+	})
+	require.NoError(t, err)
+}
+
+func TestEngagementService_Mutate_ShareNotRemovable(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engRepo := mocks.NewMockEngagementsRepository(ctrl)
+	svc := services.NewEngagementService(engRepo, nil, nil, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	err := svc.Mutate(context.Background(), services.MutateEngagementInput{
+		UserID:         uuid.New(),
+		VideoID:        uuid.New(),
+		EngagementType: "share",
+		Action:         services.EngagementActionRemove,
+	})
+	require.ErrorIs(t, err, services.ErrEngagementNotRemovable)
+}
+
+func TestEngagementService_Mutate_PersistsSourceAndMetadata(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engRepo := mocks.NewMockEngagementsRepository(ctrl)
+	outbox := mocks.NewMockOutboxEnqueuer(ctrl)
+	svc := services.NewEngagementService(engRepo, nil, outbox, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	userID := uuid.New()
+	videoID := uuid.New()
+	metadata := map[string]any{"entry_point": "feed", "device": "android"}
+
+	engRepo.EXPECT().Get(gomock.Any(), gomock.Any(), userID, videoID, "dislike").Return(nil, repositories.ErrProfileEngagementNotFound)
+	engRepo.EXPECT().Upsert(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.UpsertProfileEngagementInput{})).
+		DoAndReturn(func(_ context.Context, _ any, input repositories.UpsertProfileEngagementInput) error {
+			require.Equal(t, services.EngagementSourceRecommendation, input.Source)
+			require.Equal(t, metadata, input.Metadata)
+			return nil
+		})
+	outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.OutboxMessage{})).
+		DoAndReturn(func(_ context.Context, _ any, msg repositories.OutboxMessage) error {
+			var evt profilev1.EngagementAddedEvent
+			require.NoError(t, proto.Unmarshal(msg.Payload, &evt))
+			require.Equal(t, services.EngagementSourceRecommendation, evt.GetSource())
+			require.Equal(t, "feed", evt.GetContext().GetEntryPoint())
+			require.Equal(t, "android", evt.GetContext().GetDevice())
+			return nil
+		})
+
+	err := svc.Mutate(context.Background(), services.MutateEngagementInput{
+		UserID:         userID,
+		VideoID:        videoID,
+		EngagementType: "like",
+		Action:         services.EngagementActionAdd,
+		Source:         ptrString(services.EngagementSourceRecommendation),
+		Metadata:       metadata,
+	})
+	require.NoError(t, err)
+}
+
+func TestEngagementService_Mutate_RejectsUnknownSource(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engRepo := mocks.NewMockEngagementsRepository(ctrl)
+	svc := services.NewEngagementService(engRepo, nil, nil, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	err := svc.Mutate(context.Background(), services.MutateEngagementInput{
+		UserID:         uuid.New(),
+		VideoID:        uuid.New(),
+		EngagementType: "like",
+		Action:         services.EngagementActionAdd,
+		Source:         ptrString("crawler"),
+	})
+	require.ErrorIs(t, err, services.ErrUnsupportedEngagementSource)
+}
+
+func TestEngagementService_ListFavorites_DefaultsToLikeAndBookmark(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engRepo := mocks.NewMockEngagementsRepository(ctrl)
+	svc := services.NewEngagementService(engRepo, nil, nil, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	userID := uuid.New()
+	engRepo.EXPECT().ListByUser(gomock.Any(), gomock.Any(), userID, []string{"like", "bookmark"}, false, int32(21), int32(0)).
+		Return([]*po.ProfileEngagement{{UserID: userID, VideoID: uuid.New(), EngagementType: "like"}}, nil)
+	engRepo.EXPECT().ListByUser(gomock.Any(), gomock.Any(), userID, []string{"bookmark"}, false, int32(21), int32(0)).
+		Return([]*po.ProfileEngagement{}, nil)
+
+	items, err := svc.ListFavorites(context.Background(), services.ListFavoritesInput{UserID: userID, Limit: 21})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+
+	_, err = svc.ListFavorites(context.Background(), services.ListFavoritesInput{
+		UserID:          userID,
+		EngagementTypes: []string{"bookmark"},
+		Limit:           21,
+	})
 	require.NoError(t, err)
-	require.False(t, state.HasLiked)
-	require.False(t, state.HasBookmarked)
 }
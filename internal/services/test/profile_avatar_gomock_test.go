@@ -0,0 +1,206 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/objectstore"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/bionicotaku/lingo-services-profile/internal/services"
+	"github.com/bionicotaku/lingo-services-profile/internal/services/mocks"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func testAvatarPolicy() services.AvatarPolicy {
+	return services.AvatarPolicy{
+		AllowedHosts:        []string{"cdn.example.com", "*.googleusercontent.com"},
+		AllowedContentTypes: []string{"image/png", "image/jpeg"},
+		MaxBytes:            1024,
+		UploadTTL:           10 * time.Minute,
+	}
+}
+
+func TestAvatarPolicy_ValidateURL(t *testing.T) {
+	t.Parallel()
+
+	policy := testAvatarPolicy()
+	cases := map[string]bool{
+		"":                                     true,
+		"https://cdn.example.com/a.png":        true,
+		"https://lh3.googleusercontent.com/x":  true,
+		"https://googleusercontent.com.evil/x": false,
+		"https://evil.com/a.png":               false,
+		"javascript:alert(1)":                  false,
+		"ftp://cdn.example.com/a.png":          false,
+		"not a url":                            false,
+	}
+	for raw, ok := range cases {
+		err := policy.ValidateURL(raw)
+		if ok {
+			require.NoError(t, err, raw)
+		} else {
+			require.ErrorIs(t, err, services.ErrAvatarURLNotAllowed, raw)
+		}
+	}
+
+	require.NoError(t, services.AvatarPolicy{}.ValidateURL("https://any.host/a.png"))
+}
+
+func TestProfileService_UpdateProfile_RejectsDisallowedAvatarHost(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard),
+		services.WithAvatarUploads(nil, testAvatarPolicy()))
+
+	avatar := "https://evil.com/a.png"
+	_, err := svc.UpdateProfile(context.Background(), services.UpdateProfileInput{
+		UserID:    uuid.New(),
+		AvatarURL: &avatar,
+	})
+	require.ErrorIs(t, err, services.ErrAvatarURLNotAllowed)
+}
+
+func TestProfileService_CreateAvatarUpload_SignsUserScopedKey(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), userID).Return(&po.ProfileUser{UserID: userID, AccountStatus: "active"}, nil)
+
+	store := mocks.NewMockAvatarObjectStore(ctrl)
+	store.EXPECT().SignUpload(gomock.Any(), gomock.AssignableToTypeOf(objectstore.SignUploadInput{})).
+		DoAndReturn(func(_ context.Context, input objectstore.SignUploadInput) (*objectstore.UploadTarget, error) {
+			require.True(t, strings.HasPrefix(input.Key, "avatars/"+userID.String()+"/"))
+			require.True(t, strings.HasSuffix(input.Key, ".png"))
+			require.Equal(t, "image/png", input.ContentType)
+			require.Equal(t, int64(1024), input.MaxBytes)
+			return &objectstore.UploadTarget{URL: "http://upload/" + input.Key, Method: "PUT", ExpiresAt: input.ExpiresAt}, nil
+		})
+
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard),
+		services.WithAvatarUploads(store, testAvatarPolicy()))
+
+	upload, err := svc.CreateAvatarUpload(context.Background(), services.CreateAvatarUploadInput{
+		UserID:        userID,
+		ContentType:   "IMAGE/PNG",
+		ContentLength: 512,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "PUT", upload.Method)
+	require.Equal(t, int64(1024), upload.MaxBytes)
+	require.Equal(t, "http://upload/"+upload.Key, upload.URL)
+}
+
+func TestProfileService_CreateAvatarUpload_RejectsInvalidRequests(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	store := mocks.NewMockAvatarObjectStore(ctrl)
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard),
+		services.WithAvatarUploads(store, testAvatarPolicy()))
+
+	_, err := svc.CreateAvatarUpload(context.Background(), services.CreateAvatarUploadInput{UserID: uuid.New(), ContentType: "image/gif"})
+	require.ErrorIs(t, err, services.ErrAvatarContentTypeNotAllowed)
+
+	_, err = svc.CreateAvatarUpload(context.Background(), services.CreateAvatarUploadInput{UserID: uuid.New(), ContentType: "image/png", ContentLength: 4096})
+	require.ErrorIs(t, err, services.ErrAvatarTooLarge)
+
+	disabled := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+	_, err = disabled.CreateAvatarUpload(context.Background(), services.CreateAvatarUploadInput{UserID: uuid.New(), ContentType: "image/png"})
+	require.ErrorIs(t, err, services.ErrAvatarUploadUnavailable)
+}
+
+func TestProfileService_ConfirmAvatarUpload_CommitsPublicURL(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	key := "avatars/" + userID.String() + "/a.png"
+
+	store := mocks.NewMockAvatarObjectStore(ctrl)
+	store.EXPECT().Stat(gomock.Any(), key).Return(&objectstore.ObjectInfo{Key: key, Size: 512, ContentType: "image/png"}, nil)
+	store.EXPECT().PublicURL(key).Return("https://cdn.example.com/" + key)
+
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), userID).Return(&po.ProfileUser{
+		UserID:         userID,
+		DisplayName:    "Alice",
+		ProfileVersion: 2,
+		AccountStatus:  "active",
+	}, nil)
+	repo.EXPECT().Upsert(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.UpsertProfileUserInput{})).
+		DoAndReturn(func(_ context.Context, _ any, input repositories.UpsertProfileUserInput) (*po.ProfileUser, error) {
+			require.Equal(t, "Alice", input.DisplayName)
+			require.Equal(t, int64(3), input.ProfileVersion)
+			return &po.ProfileUser{
+				UserID:         userID,
+				DisplayName:    input.DisplayName,
+				AvatarURL:      input.AvatarURL,
+				ProfileVersion: input.ProfileVersion,
+			}, nil
+		})
+
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard),
+		services.WithAvatarUploads(store, testAvatarPolicy()))
+
+	expected := int64(2)
+	profile, err := svc.ConfirmAvatarUpload(context.Background(), services.ConfirmAvatarUploadInput{
+		UserID:          userID,
+		Key:             key,
+		ExpectedVersion: &expected,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "https://cdn.example.com/"+key, *profile.AvatarURL)
+}
+
+func TestProfileService_ConfirmAvatarUpload_ValidatesObject(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	key := "avatars/" + userID.String() + "/a.png"
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	store := mocks.NewMockAvatarObjectStore(ctrl)
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard),
+		services.WithAvatarUploads(store, testAvatarPolicy()))
+
+	_, err := svc.ConfirmAvatarUpload(context.Background(), services.ConfirmAvatarUploadInput{
+		UserID: userID,
+		Key:    "avatars/" + uuid.NewString() + "/a.png",
+	})
+	require.ErrorIs(t, err, services.ErrAvatarUploadNotFound)
+
+	store.EXPECT().Stat(gomock.Any(), key).Return(nil, objectstore.ErrObjectNotFound)
+	_, err = svc.ConfirmAvatarUpload(context.Background(), services.ConfirmAvatarUploadInput{UserID: userID, Key: key})
+	require.ErrorIs(t, err, services.ErrAvatarUploadNotFound)
+
+	store.EXPECT().Stat(gomock.Any(), key).Return(&objectstore.ObjectInfo{Key: key, Size: 100, ContentType: "text/html; charset=utf-8"}, nil)
+	_, err = svc.ConfirmAvatarUpload(context.Background(), services.ConfirmAvatarUploadInput{UserID: userID, Key: key})
+	require.ErrorIs(t, err, services.ErrAvatarContentTypeNotAllowed)
+
+	store.EXPECT().Stat(gomock.Any(), key).Return(&objectstore.ObjectInfo{Key: key, Size: 4096, ContentType: "image/png"}, nil)
+	_, err = svc.ConfirmAvatarUpload(context.Background(), services.ConfirmAvatarUploadInput{UserID: userID, Key: key})
+	require.True(t, errors.Is(err, services.ErrAvatarTooLarge))
+}
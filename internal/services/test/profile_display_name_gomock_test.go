@@ -0,0 +1,171 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/bionicotaku/lingo-services-profile/internal/services"
+	"github.com/bionicotaku/lingo-services-profile/internal/services/mocks"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisplayNamePolicy_Normalize(t *testing.T) {
+	t.Parallel()
+
+	policy := services.DisplayNamePolicy{MinLength: 2, MaxLength: 12}
+	valid := map[string]string{
+		"  Alice   Prime ": "Alice Prime",
+		"李小龙":              "李小龙",
+		"o'neil_99":        "o'neil_99",
+		"Zoe\u0301":        "Zo\u00e9",
+	}
+	for raw, want := range valid {
+		got, err := policy.Normalize(raw)
+		require.NoError(t, err, raw)
+		require.Equal(t, want, got)
+	}
+
+	invalid := map[string]error{
+		"A":               services.ErrDisplayNameTooShort,
+		"abcdefghijklmn":  services.ErrDisplayNameTooLong,
+		"bad<script>":     services.ErrDisplayNameInvalidCharacters,
+		"emoji 😀":         services.ErrDisplayNameInvalidCharacters,
+		"tab\tname\x00":   services.ErrDisplayNameInvalidCharacters,
+		"---":             services.ErrDisplayNameInvalidCharacters,
+		"zero\u200bwidth": services.ErrDisplayNameInvalidCharacters,
+	}
+	for raw, want := range invalid {
+		_, err := policy.Normalize(raw)
+		require.ErrorIs(t, err, want, raw)
+	}
+}
+
+func TestBlocklistModerator_MatchesObfuscatedTerms(t *testing.T) {
+	t.Parallel()
+
+	moderator := services.NewBlocklistModerator([]string{"Admin", "  "})
+	ctx := context.Background()
+
+	require.ErrorIs(t, moderator.ModerateDisplayName(ctx, uuid.New(), "the a.d-m_i n"), services.ErrDisplayNameBlocked)
+	require.ErrorIs(t, moderator.ModerateDisplayName(ctx, uuid.New(), "ＡＤＭＩＮ"), services.ErrDisplayNameBlocked)
+	require.NoError(t, moderator.ModerateDisplayName(ctx, uuid.New(), "Alice"))
+}
+
+func TestBlocklistModerator_AllowsInnocentWordsContainingTerms(t *testing.T) {
+	t.Parallel()
+
+	moderator := services.NewBlocklistModerator([]string{"admin", "official", "bad word", "管理员"})
+	ctx := context.Background()
+
+	for _, name := range []string{"Badminton", "BADMINTON", "Unofficial", "Hadminh", "Bad Wordsmith"} {
+		require.NoError(t, moderator.ModerateDisplayName(ctx, uuid.New(), name), name)
+	}
+	for _, name := range []string{"Admin", "super admin", "SuperAdmin", "admin_2", "Official-Team", "bad.word", "B a d w o r d", "超级管理员"} {
+		require.ErrorIs(t, moderator.ModerateDisplayName(ctx, uuid.New(), name), services.ErrDisplayNameBlocked, name)
+	}
+}
+
+type rejectingModerator struct{}
+
+func (rejectingModerator) ModerateDisplayName(context.Context, uuid.UUID, string) error {
+	return services.ErrDisplayNameRejected
+}
+
+func TestProfileService_UpdateProfile_ModeratesDisplayName(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard),
+		services.WithDisplayNameModeration(
+			services.DisplayNamePolicy{MinLength: 2, MaxLength: 32},
+			services.ChainDisplayNameModerators(services.NewBlocklistModerator([]string{"admin"}), nil, rejectingModerator{}),
+		))
+
+	userID := uuid.New()
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), userID).Return(nil, repositories.ErrProfileUserNotFound).Times(2)
+
+	name := "SiteAdmin"
+	_, err := svc.UpdateProfile(context.Background(), services.UpdateProfileInput{UserID: userID, DisplayName: &name})
+	require.ErrorIs(t, err, services.ErrDisplayNameBlocked)
+
+	name = "Alice"
+	_, err = svc.UpdateProfile(context.Background(), services.UpdateProfileInput{UserID: userID, DisplayName: &name})
+	require.True(t, errors.Is(err, services.ErrDisplayNameRejected))
+}
+
+func TestProfileService_UpdateProfile_KeepsGrandfatheredDisplayName(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), userID).Return(&po.ProfileUser{
+		UserID:         userID,
+		DisplayName:    "<legacy>",
+		ProfileVersion: 1,
+	}, nil)
+	repo.EXPECT().Upsert(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.UpsertProfileUserInput{})).
+		DoAndReturn(func(_ context.Context, _ any, input repositories.UpsertProfileUserInput) (*po.ProfileUser, error) {
+			require.Equal(t, "<legacy>", input.DisplayName)
+			return &po.ProfileUser{UserID: userID, DisplayName: input.DisplayName, ProfileVersion: input.ProfileVersion}, nil
+		})
+
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard),
+		services.WithDisplayNameModeration(services.DisplayNamePolicy{MinLength: 2, MaxLength: 32, RequireUnique: true}, nil))
+
+	name := "<legacy>"
+	avatar := "https://cdn.example.com/a.png"
+	profile, err := svc.UpdateProfile(context.Background(), services.UpdateProfileInput{UserID: userID, DisplayName: &name, AvatarURL: &avatar})
+	require.NoError(t, err)
+	require.Equal(t, "<legacy>", profile.DisplayName)
+}
+
+func TestProfileService_UpdateProfile_EnforcesUniqueDisplayName(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), userID).Return(&po.ProfileUser{
+		UserID:         userID,
+		DisplayName:    "alice",
+		ProfileVersion: 1,
+	}, nil).Times(3)
+
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard),
+		services.WithDisplayNameModeration(services.DisplayNamePolicy{MinLength: 2, MaxLength: 32, RequireUnique: true}, nil))
+
+	repo.EXPECT().IsDisplayNameTaken(gomock.Any(), gomock.Any(), userID, "Bob").Return(true, nil)
+	name := "  Bob "
+	_, err := svc.UpdateProfile(context.Background(), services.UpdateProfileInput{UserID: userID, DisplayName: &name})
+	require.ErrorIs(t, err, services.ErrDisplayNameTaken)
+
+	// 仅修改大小写时不需要唯一性检查。
+	repo.EXPECT().Upsert(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ any, input repositories.UpsertProfileUserInput) (*po.ProfileUser, error) {
+			return &po.ProfileUser{UserID: userID, DisplayName: input.DisplayName, ProfileVersion: input.ProfileVersion}, nil
+		}).Times(2)
+	name = "Alice"
+	profile, err := svc.UpdateProfile(context.Background(), services.UpdateProfileInput{UserID: userID, DisplayName: &name})
+	require.NoError(t, err)
+	require.Equal(t, "Alice", profile.DisplayName)
+
+	repo.EXPECT().IsDisplayNameTaken(gomock.Any(), gomock.Any(), userID, "Carol").Return(false, nil)
+	name = "Carol"
+	_, err = svc.UpdateProfile(context.Background(), services.UpdateProfileInput{UserID: userID, DisplayName: &name})
+	require.NoError(t, err)
+}
@@ -0,0 +1,173 @@
+package services_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/metadata"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/bionicotaku/lingo-services-profile/internal/services"
+	"github.com/bionicotaku/lingo-services-profile/internal/services/mocks"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeLocale(t *testing.T) {
+	t.Parallel()
+
+	value, err := services.NormalizeLocale("en-us")
+	require.NoError(t, err)
+	require.Equal(t, "en-US", value)
+
+	value, err = services.NormalizeLocale(" zh-Hans-CN ")
+	require.NoError(t, err)
+	require.Equal(t, "zh-Hans-CN", value)
+
+	for _, invalid := range []string{"", "und", "english!", "en_US_POSIX_x"} {
+		_, err := services.NormalizeLocale(invalid)
+		require.ErrorIsf(t, err, services.ErrInvalidLocale, "locale %q", invalid)
+	}
+}
+
+func TestNormalizeTimezone(t *testing.T) {
+	t.Parallel()
+
+	value, err := services.NormalizeTimezone("Asia/Shanghai")
+	require.NoError(t, err)
+	require.Equal(t, "Asia/Shanghai", value)
+
+	for _, invalid := range []string{"", "Local", "Mars/Olympus", "../etc/passwd"} {
+		_, err := services.NormalizeTimezone(invalid)
+		require.ErrorIsf(t, err, services.ErrInvalidTimezone, "timezone %q", invalid)
+	}
+}
+
+func TestProfileService_UpdateProfile_SeedsLocaleFromUserInfo(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), userID).Return(nil, repositories.ErrProfileUserNotFound)
+	repo.EXPECT().Upsert(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.UpsertProfileUserInput{})).
+		DoAndReturn(func(_ context.Context, _ any, input repositories.UpsertProfileUserInput) (*po.ProfileUser, error) {
+			require.NotNil(t, input.PreferredLocale)
+			require.Equal(t, "ja-JP", *input.PreferredLocale)
+			require.NotNil(t, input.PreferredTimezone)
+			require.Equal(t, "Asia/Tokyo", *input.PreferredTimezone)
+			return &po.ProfileUser{
+				UserID:            userID,
+				DisplayName:       input.DisplayName,
+				ProfileVersion:    input.ProfileVersion,
+				PreferredLocale:   input.PreferredLocale,
+				PreferredTimezone: input.PreferredTimezone,
+			}, nil
+		})
+
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	ctx := metadata.Inject(context.Background(), metadata.HandlerMetadata{UserID: userID.String(), Locale: "ja-jp", Timezone: "Asia/Tokyo"})
+	profile, err := svc.UpdateProfile(ctx, services.UpdateProfileInput{
+		UserID:      userID,
+		DisplayName: ptrString("Alice"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "ja-JP", *profile.PreferredLocale)
+}
+
+func TestProfileService_UpdateProfile_RejectsInvalidTimezone(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&po.ProfileUser{ProfileVersion: 1, DisplayName: "Alice"}, nil)
+
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	_, err := svc.UpdateProfile(context.Background(), services.UpdateProfileInput{
+		UserID:            uuid.New(),
+		PreferredTimezone: ptrString("Not/AZone"),
+	})
+	require.ErrorIs(t, err, services.ErrInvalidTimezone)
+}
+
+func TestProfileService_GetProfile_FallsBackToUserInfoLocale(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	stored := "Europe/Paris"
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), userID).Return(&po.ProfileUser{
+		UserID:            userID,
+		DisplayName:       "Alice",
+		PreferredTimezone: &stored,
+	}, nil)
+
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	ctx := metadata.Inject(context.Background(), metadata.HandlerMetadata{UserID: userID.String(), Locale: "fr-FR", Timezone: "America/New_York"})
+	profile, err := svc.GetProfile(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, "fr-FR", *profile.PreferredLocale)
+	require.Equal(t, "Europe/Paris", *profile.PreferredTimezone)
+}
+
+func TestProfileService_GetProfile_IgnoresCallerLocaleForOtherUsers(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	targetID := uuid.New()
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), targetID).Return(&po.ProfileUser{
+		UserID:      targetID,
+		DisplayName: "Bob",
+	}, nil)
+
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	// 调用者读取他人档案时，其 userinfo 中的 locale/zoneinfo 不得出现在对方档案中。
+	ctx := metadata.Inject(context.Background(), metadata.HandlerMetadata{UserID: uuid.NewString(), Locale: "fr-FR", Timezone: "America/New_York"})
+	profile, err := svc.GetProfile(ctx, targetID)
+	require.NoError(t, err)
+	require.Nil(t, profile.PreferredLocale)
+	require.Nil(t, profile.PreferredTimezone)
+}
+
+func TestProfileService_UpdateProfile_DoesNotSeedLocaleForOtherUsers(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	targetID := uuid.New()
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), targetID).Return(nil, repositories.ErrProfileUserNotFound)
+	repo.EXPECT().Upsert(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.UpsertProfileUserInput{})).
+		DoAndReturn(func(_ context.Context, _ any, input repositories.UpsertProfileUserInput) (*po.ProfileUser, error) {
+			require.Nil(t, input.PreferredLocale)
+			require.Nil(t, input.PreferredTimezone)
+			return &po.ProfileUser{UserID: targetID, DisplayName: input.DisplayName, ProfileVersion: input.ProfileVersion}, nil
+		})
+
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	ctx := metadata.Inject(context.Background(), metadata.HandlerMetadata{UserID: uuid.NewString(), Locale: "ja-jp", Timezone: "Asia/Tokyo"})
+	_, err := svc.UpdateProfile(ctx, services.UpdateProfileInput{
+		UserID:      targetID,
+		DisplayName: ptrString("Bob"),
+	})
+	require.NoError(t, err)
+}
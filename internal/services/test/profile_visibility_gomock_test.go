@@ -0,0 +1,142 @@
+package services_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/bionicotaku/lingo-services-profile/internal/services"
+	"github.com/bionicotaku/lingo-services-profile/internal/services/mocks"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileService_UpdateVisibility_PatchesFields(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), userID).Return(&po.ProfileUser{
+		UserID:            userID,
+		ProfileVersion:    4,
+		PublicDisplayName: true,
+		PublicAvatar:      true,
+	}, nil)
+	repo.EXPECT().UpdateVisibility(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.UpdateVisibilityInput{})).
+		DoAndReturn(func(_ context.Context, _ any, input repositories.UpdateVisibilityInput) (*po.ProfileUser, error) {
+			require.True(t, input.PublicDisplayName)
+			require.False(t, input.PublicAvatar)
+			require.True(t, input.PublicBookmarks)
+			require.False(t, input.PublicStats)
+			require.Equal(t, int64(5), input.ProfileVersion)
+			return &po.ProfileUser{
+				UserID:            userID,
+				ProfileVersion:    input.ProfileVersion,
+				PublicDisplayName: input.PublicDisplayName,
+				PublicAvatar:      input.PublicAvatar,
+				PublicBookmarks:   input.PublicBookmarks,
+				PublicStats:       input.PublicStats,
+			}, nil
+		})
+
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	hide, show := false, true
+	profile, err := svc.UpdateVisibility(context.Background(), services.UpdateVisibilityInput{
+		UserID:          userID,
+		PublicAvatar:    &hide,
+		PublicBookmarks: &show,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), profile.ProfileVersion)
+	require.True(t, profile.Visibility.PublicBookmarks)
+	require.False(t, profile.Visibility.PublicAvatar)
+}
+
+func TestProfileService_UpdateVisibility_RequiresFields(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := services.NewProfileService(mocks.NewMockProfileUsersRepository(ctrl), &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	_, err := svc.UpdateVisibility(context.Background(), services.UpdateVisibilityInput{UserID: uuid.New()})
+	require.ErrorIs(t, err, services.ErrNoVisibilityFields)
+}
+
+func TestProfileService_GetPublicProfile_HidesPrivateFields(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	avatar := "https://cdn/avatar.png"
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), userID).Return(&po.ProfileUser{
+		UserID:            userID,
+		DisplayName:       "Alice",
+		AvatarURL:         &avatar,
+		PublicDisplayName: true,
+		PublicStats:       true,
+	}, nil)
+	repo.EXPECT().GetPublicStats(gomock.Any(), gomock.Any(), userID).Return(&po.ProfilePublicStats{
+		LikeCount:         7,
+		WatchedVideoCount: 3,
+	}, nil)
+
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	profile, err := svc.GetPublicProfile(context.Background(), userID)
+	require.NoError(t, err)
+	require.NotNil(t, profile.DisplayName)
+	require.Equal(t, "Alice", *profile.DisplayName)
+	require.Nil(t, profile.AvatarURL)
+	require.False(t, profile.BookmarksPublic)
+	require.NotNil(t, profile.Stats)
+	require.Equal(t, int64(7), profile.Stats.LikeCount)
+	require.Equal(t, int64(3), profile.Stats.WatchedVideoCount)
+}
+
+func TestProfileService_GetPublicProfile_InactiveAccountNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&po.ProfileUser{
+		DisplayName:       "Alice",
+		AccountStatus:     "suspended",
+		PublicDisplayName: true,
+	}, nil)
+
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	_, err := svc.GetPublicProfile(context.Background(), uuid.New())
+	require.ErrorIs(t, err, services.ErrProfileNotFound)
+}
+
+func TestProfileService_EnsureBookmarksPublic(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockProfileUsersRepository(ctrl)
+	svc := services.NewProfileService(repo, &fakeTxManager{}, log.NewStdLogger(io.Discard))
+
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&po.ProfileUser{}, nil)
+	require.ErrorIs(t, svc.EnsureBookmarksPublic(context.Background(), uuid.New()), services.ErrProfileFieldNotPublic)
+
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&po.ProfileUser{PublicBookmarks: true}, nil)
+	require.NoError(t, svc.EnsureBookmarksPublic(context.Background(), uuid.New()))
+}
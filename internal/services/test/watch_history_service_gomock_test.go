@@ -43,7 +43,7 @@ func TestWatchHistoryService_UpsertProgress_EmitsEvent(t *testing.T) {
 		FirstWatchedAt:    firstWatched,
 		LastWatchedAt:     lastWatched,
 	}, nil)
-	stats.EXPECT().Increment(gomock.Any(), gomock.Any(), videoID, int64(0), int64(0), int64(1), int64(180)).Return(nil)
+	stats.EXPECT().Increment(gomock.Any(), gomock.Any(), videoID, repositories.VideoStatsDelta{Watchers: 1, WatchSeconds: 180}).Return(nil)
 	outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 	result, err := svc.UpsertProgress(context.Background(), services.UpsertWatchProgressInput{
@@ -83,7 +83,7 @@ func TestWatchHistoryService_UpsertProgress_StatsError(t *testing.T) {
 		FirstWatchedAt:    time.Now().UTC(),
 		LastWatchedAt:     time.Now().UTC(),
 	}, nil)
-	stats.EXPECT().Increment(gomock.Any(), gomock.Any(), videoID, int64(0), int64(0), int64(1), int64(240)).Return(errors.New("stats failure"))
+	stats.EXPECT().Increment(gomock.Any(), gomock.Any(), videoID, repositories.VideoStatsDelta{Watchers: 1, WatchSeconds: 240}).Return(errors.New("stats failure"))
 
 	_, err := svc.UpsertProgress(context.Background(), services.UpsertWatchProgressInput{
 		UserID:            userID,
@@ -128,7 +128,7 @@ func TestWatchHistoryService_UpsertProgress_NoEventWhenDeltaBelowThreshold(t *te
 	logs.EXPECT().Get(gomock.Any(), gomock.Any(), userID, videoID).Return(existing, nil)
 	logs.EXPECT().Upsert(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.UpsertWatchLogInput{})).Return(nil)
 	logs.EXPECT().Get(gomock.Any(), gomock.Any(), userID, videoID).Return(updated, nil)
-	stats.EXPECT().Increment(gomock.Any(), gomock.Any(), videoID, int64(0), int64(0), int64(0), int64(20)).Return(nil)
+	stats.EXPECT().Increment(gomock.Any(), gomock.Any(), videoID, repositories.VideoStatsDelta{WatchSeconds: 20}).Return(nil)
 	// No Outbox enqueue expected
 
 	_, err := svc.UpsertProgress(context.Background(), services.UpsertWatchProgressInput{
@@ -174,7 +174,7 @@ func TestWatchHistoryService_UpsertProgress_OutboxError(t *testing.T) {
 	logs.EXPECT().Get(gomock.Any(), gomock.Any(), userID, videoID).Return(existing, nil)
 	logs.EXPECT().Upsert(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(repositories.UpsertWatchLogInput{})).Return(nil)
 	logs.EXPECT().Get(gomock.Any(), gomock.Any(), userID, videoID).Return(updated, nil)
-	stats.EXPECT().Increment(gomock.Any(), gomock.Any(), videoID, int64(0), int64(0), int64(0), int64(60)).Return(nil)
+	stats.EXPECT().Increment(gomock.Any(), gomock.Any(), videoID, repositories.VideoStatsDelta{WatchSeconds: 60}).Return(nil)
 	outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("outbox failure"))
 
 	_, err := svc.UpsertProgress(context.Background(), services.UpsertWatchProgressInput{
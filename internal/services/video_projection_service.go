@@ -10,6 +10,9 @@ import (
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	noopmetric "go.opentelemetry.io/otel/metric/noop"
 )
 
 // VideoProjectionRepository 定义投影仓储接口，便于测试替换。
@@ -21,15 +24,17 @@ type VideoProjectionRepository interface {
 
 // VideoProjectionService 负责维护和查询视频投影。
 type VideoProjectionService struct {
-	repo VideoProjectionRepository
-	log  *log.Helper
+	repo    VideoProjectionRepository
+	log     *log.Helper
+	metrics *projectionMissMetrics
 }
 
 // NewVideoProjectionService 构造 VideoProjectionService。
 func NewVideoProjectionService(repo VideoProjectionRepository, logger log.Logger) *VideoProjectionService {
 	return &VideoProjectionService{
-		repo: repo,
-		log:  log.NewHelper(logger),
+		repo:    repo,
+		log:     log.NewHelper(logger),
+		metrics: newProjectionMissMetrics(),
 	}
 }
 
@@ -56,7 +61,7 @@ func (s *VideoProjectionService) GetProjection(ctx context.Context, videoID uuid
 	return record, nil
 }
 
-// ListProjections 批量查询投影。
+// ListProjections 批量查询投影，投影中缺失的视频不出现在结果中，缺失数量计入 profile_projection_miss_total。
 func (s *VideoProjectionService) ListProjections(ctx context.Context, videoIDs []uuid.UUID) ([]*po.ProfileVideoProjection, error) {
 	if len(videoIDs) == 0 {
 		return nil, nil
@@ -65,5 +70,48 @@ func (s *VideoProjectionService) ListProjections(ctx context.Context, videoIDs [
 	if err != nil {
 		return nil, fmt.Errorf("list projections: %w", err)
 	}
+	s.metrics.record(ctx, countMissingVideos(videoIDs, items))
 	return items, nil
 }
+
+func countMissingVideos(requested []uuid.UUID, found []*po.ProfileVideoProjection) int {
+	present := make(map[uuid.UUID]struct{}, len(found))
+	for _, item := range found {
+		present[item.VideoID] = struct{}{}
+	}
+	missing := 0
+	for _, id := range requested {
+		if _, ok := present[id]; ok {
+			continue
+		}
+		present[id] = struct{}{}
+		missing++
+	}
+	return missing
+}
+
+type projectionMissMetrics struct {
+	misses  metric.Int64Counter
+	enabled bool
+}
+
+func newProjectionMissMetrics() *projectionMissMetrics {
+	provider := otel.GetMeterProvider()
+	if provider == nil {
+		provider = noopmetric.NewMeterProvider()
+	}
+	meter := provider.Meter("lingo-services-profile.services.video_projection")
+	misses, err := meter.Int64Counter("profile_projection_miss_total",
+		metric.WithDescription("Number of videos missing from videos_projection on read paths"))
+	if err != nil {
+		return &projectionMissMetrics{}
+	}
+	return &projectionMissMetrics{misses: misses, enabled: true}
+}
+
+func (m *projectionMissMetrics) record(ctx context.Context, count int) {
+	if m == nil || !m.enabled || count <= 0 {
+		return
+	}
+	m.misses.Add(ctx, int64(count))
+}
@@ -24,7 +24,7 @@ type WatchLogsRepository interface {
 
 // WatchStatsRepository 抽象视频统计仓储行为。
 type WatchStatsRepository interface {
-	Increment(ctx context.Context, sess txmanager.Session, videoID uuid.UUID, likeDelta, bookmarkDelta, watcherDelta, secondsDelta int64) error
+	Increment(ctx context.Context, sess txmanager.Session, videoID uuid.UUID, delta repositories.VideoStatsDelta) error
 }
 
 // OutboxEnqueuer 抽象 Outbox 写入行为，供服务层与测试复用。
@@ -117,7 +117,7 @@ func (s *WatchHistoryService) UpsertProgress(ctx context.Context, input UpsertWa
 			watcherDelta := computeWatcherDelta(existing, updated)
 			secondsDelta := int64(math.Round(deltaSeconds))
 			if secondsDelta != 0 || watcherDelta != 0 {
-				if err := s.stats.Increment(txCtx, sess, input.VideoID, 0, 0, watcherDelta, secondsDelta); err != nil {
+				if err := s.stats.Increment(txCtx, sess, input.VideoID, repositories.VideoStatsDelta{Watchers: watcherDelta, WatchSeconds: secondsDelta}); err != nil {
 					return err
 				}
 			}
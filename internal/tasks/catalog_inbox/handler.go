@@ -14,18 +14,25 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/proto"
 )
 
+// eventHandler 将 Catalog 视频事件落到 profile.videos_projection。
+// 投影尚未创建时到达的 updated/deleted 事件会暂存到 pending 表，待 created 写入后按版本回放；
+// pending 为 nil 时退化为直接跳过；offsets 非空时在同一事务内推进消费组高水位。
 type eventHandler struct {
 	projections *repositories.ProfileVideoProjectionRepository
+	pending     *repositories.PendingVideoEventsRepository
+	offsets     *offsetRecorder
 	log         *log.Helper
 	metrics     *inboxMetrics
 	clock       func() time.Time
 }
 
-func newEventHandler(repo *repositories.ProfileVideoProjectionRepository, logger log.Logger, metrics *inboxMetrics) *eventHandler {
+func newEventHandler(repo *repositories.ProfileVideoProjectionRepository, pending *repositories.PendingVideoEventsRepository, logger log.Logger, metrics *inboxMetrics) *eventHandler {
 	return &eventHandler{
 		projections: repo,
+		pending:     pending,
 		log:         log.NewHelper(logger),
 		metrics:     metrics,
 		clock:       time.Now,
@@ -67,9 +74,12 @@ func (h *eventHandler) Handle(ctx context.Context, sess txmanager.Session, evt *
 		handleErr = h.handleDeleted(ctx, sess, evt, videoID, occurredAt)
 	default:
 		h.log.WithContext(ctx).Debugw("msg", "catalog inbox: skip unsupported event", "event_type", evt.GetEventType().String(), "event_id", evt.GetEventId())
-		return nil
+		return h.offsets.record(ctx, sess, evt, occurredAt, h.clock())
 	}
 
+	if handleErr == nil {
+		handleErr = h.offsets.record(ctx, sess, evt, occurredAt, h.clock())
+	}
 	if handleErr != nil {
 		if h.metrics != nil {
 			h.metrics.recordFailure(ctx, evt.GetEventType().String(), handleErr)
@@ -90,6 +100,15 @@ func (h *eventHandler) handleCreated(ctx context.Context, sess txmanager.Session
 	}
 
 	version := eventVersion(evt.GetVersion(), payload.GetVersion())
+	current, err := h.loadCurrent(ctx, sess, videoID)
+	if err != nil {
+		return err
+	}
+	if current != nil && !shouldApply(version, current.Version) {
+		h.log.WithContext(ctx).Debugw("msg", "catalog inbox: skip stale create", "video_id", videoID, "event_version", version, "current_version", current.Version)
+		return nil
+	}
+
 	input := repositories.UpsertVideoProjectionInput{
 		VideoID:           videoID,
 		Title:             defaultTitle(payload.GetTitle()),
@@ -107,7 +126,7 @@ func (h *eventHandler) handleCreated(ctx context.Context, sess txmanager.Session
 	if err := h.projections.Upsert(ctx, sess, input); err != nil {
 		return fmt.Errorf("catalog inbox: upsert created: %w", err)
 	}
-	return nil
+	return h.replayParked(ctx, sess, videoID)
 }
 
 func (h *eventHandler) handleUpdated(ctx context.Context, sess txmanager.Session, evt *videov1.Event, videoID uuid.UUID, occurredAt time.Time) error {
@@ -120,12 +139,11 @@ func (h *eventHandler) handleUpdated(ctx context.Context, sess txmanager.Session
 	if err != nil {
 		return err
 	}
+	version := eventVersion(evt.GetVersion(), payload.GetVersion())
 	if current == nil {
-		h.log.WithContext(ctx).Debugw("msg", "catalog inbox: skip update without projection", "video_id", videoID)
-		return nil
+		return h.park(ctx, sess, evt, videoID, version, occurredAt)
 	}
 
-	version := eventVersion(evt.GetVersion(), payload.GetVersion())
 	if !shouldApply(version, current.Version) {
 		h.log.WithContext(ctx).Debugw("msg", "catalog inbox: skip stale update", "video_id", videoID, "event_version", version, "current_version", current.Version)
 		return nil
@@ -161,14 +179,13 @@ func (h *eventHandler) handleDeleted(ctx context.Context, sess txmanager.Session
 	if err != nil {
 		return err
 	}
-	if current == nil {
-		return nil
-	}
-
 	version := evt.GetVersion()
 	if payload := evt.GetDeleted(); payload != nil && payload.GetVersion() > 0 {
 		version = payload.GetVersion()
 	}
+	if current == nil {
+		return h.park(ctx, sess, evt, videoID, version, occurredAt)
+	}
 	if !shouldApply(version, current.Version) {
 		h.log.WithContext(ctx).Debugw("msg", "catalog inbox: skip stale delete", "video_id", videoID, "event_version", version, "current_version", current.Version)
 		return nil
@@ -195,6 +212,88 @@ func (h *eventHandler) handleDeleted(ctx context.Context, sess txmanager.Session
 	return nil
 }
 
+// park 暂存投影尚未创建时到达的事件，等待 created 到达后回放。
+func (h *eventHandler) park(ctx context.Context, sess txmanager.Session, evt *videov1.Event, videoID uuid.UUID, version int64, occurredAt time.Time) error {
+	eventType := evt.GetEventType().String()
+	if h.pending == nil {
+		h.log.WithContext(ctx).Debugw("msg", "catalog inbox: skip event without projection", "video_id", videoID, "event_type", eventType)
+		return nil
+	}
+	eventID, err := uuid.Parse(evt.GetEventId())
+	if err != nil {
+		return fmt.Errorf("catalog inbox: parse event_id: %w", err)
+	}
+	payload, err := proto.Marshal(evt)
+	if err != nil {
+		return fmt.Errorf("catalog inbox: marshal parked event: %w", err)
+	}
+	inserted, err := h.pending.Park(ctx, sess, repositories.ParkVideoEventInput{
+		VideoID:    videoID,
+		Version:    version,
+		EventID:    eventID,
+		EventType:  eventType,
+		Payload:    payload,
+		OccurredAt: &occurredAt,
+	})
+	if err != nil {
+		return fmt.Errorf("catalog inbox: park event: %w", err)
+	}
+	if !inserted {
+		h.log.WithContext(ctx).Debugw("msg", "catalog inbox: parked event already exists", "video_id", videoID, "event_id", eventID, "event_version", version)
+		return nil
+	}
+	h.log.WithContext(ctx).Infow("msg", "catalog inbox: park event without projection", "video_id", videoID, "event_type", eventType, "event_version", version)
+	if h.metrics != nil {
+		h.metrics.recordParked(ctx, eventType)
+	}
+	return nil
+}
+
+// replayParked 在 created 写入投影后按 (version, occurred_at) 升序回放暂存事件，过期版本由 shouldApply 跳过。
+func (h *eventHandler) replayParked(ctx context.Context, sess txmanager.Session, videoID uuid.UUID) error {
+	if h.pending == nil {
+		return nil
+	}
+	parked, err := h.pending.ListByVideo(ctx, sess, videoID)
+	if err != nil {
+		return fmt.Errorf("catalog inbox: list parked events: %w", err)
+	}
+	if len(parked) == 0 {
+		return nil
+	}
+	for _, item := range parked {
+		var evt videov1.Event
+		if err := proto.Unmarshal(item.Payload, &evt); err != nil {
+			return fmt.Errorf("catalog inbox: decode parked event %s: %w", item.EventID, err)
+		}
+		occurredAt := item.ParkedAt
+		if item.OccurredAt != nil {
+			occurredAt = *item.OccurredAt
+		}
+		var replayErr error
+		switch evt.GetEventType() {
+		case videov1.EventType_EVENT_TYPE_VIDEO_UPDATED:
+			replayErr = h.handleUpdated(ctx, sess, &evt, videoID, occurredAt)
+		case videov1.EventType_EVENT_TYPE_VIDEO_DELETED:
+			replayErr = h.handleDeleted(ctx, sess, &evt, videoID, occurredAt)
+		default:
+			h.log.WithContext(ctx).Warnw("msg", "catalog inbox: drop unsupported parked event", "video_id", videoID, "event_type", item.EventType)
+			continue
+		}
+		if replayErr != nil {
+			return fmt.Errorf("catalog inbox: replay parked event %s: %w", item.EventID, replayErr)
+		}
+		if h.metrics != nil {
+			h.metrics.recordReplayed(ctx, item.EventType)
+		}
+	}
+	if _, err := h.pending.DeleteByVideo(ctx, sess, videoID); err != nil {
+		return fmt.Errorf("catalog inbox: clear parked events: %w", err)
+	}
+	h.log.WithContext(ctx).Infow("msg", "catalog inbox: replayed parked events", "video_id", videoID, "count", len(parked))
+	return nil
+}
+
 func (h *eventHandler) loadCurrent(ctx context.Context, sess txmanager.Session, videoID uuid.UUID) (*po.ProfileVideoProjection, error) {
 	record, err := h.projections.Get(ctx, sess, videoID)
 	if err != nil {
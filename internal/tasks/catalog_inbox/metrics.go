@@ -11,10 +11,14 @@ import (
 )
 
 type inboxMetrics struct {
-	success metric.Int64Counter
-	failure metric.Int64Counter
-	lag     metric.Float64Histogram
-	enabled bool
+	success     metric.Int64Counter
+	failure     metric.Int64Counter
+	lag         metric.Float64Histogram
+	parked      metric.Int64Counter
+	replayed    metric.Int64Counter
+	quarantined metric.Int64Counter
+	acked       metric.Int64Counter
+	enabled     bool
 }
 
 func newInboxMetrics() *inboxMetrics {
@@ -36,12 +40,32 @@ func newInboxMetrics() *inboxMetrics {
 	if err != nil {
 		return &inboxMetrics{}
 	}
+	parked, err := meter.Int64Counter("catalog_inbox_parked_total", metric.WithDescription("Number of catalog events parked because the projection did not exist yet"))
+	if err != nil {
+		return &inboxMetrics{}
+	}
+	replayed, err := meter.Int64Counter("catalog_inbox_replayed_total", metric.WithDescription("Number of parked catalog events replayed after the create event"))
+	if err != nil {
+		return &inboxMetrics{}
+	}
+	quarantined, err := meter.Int64Counter("catalog_inbox_quarantined_total", metric.WithDescription("Number of catalog events moved to quarantine after reaching max attempts"))
+	if err != nil {
+		return &inboxMetrics{}
+	}
+	acked, err := meter.Int64Counter("catalog_inbox_quarantine_acked_total", metric.WithDescription("Number of redeliveries acknowledged without processing because the event is quarantined"))
+	if err != nil {
+		return &inboxMetrics{}
+	}
 
 	return &inboxMetrics{
-		success: success,
-		failure: failure,
-		lag:     lag,
-		enabled: true,
+		success:     success,
+		failure:     failure,
+		lag:         lag,
+		parked:      parked,
+		replayed:    replayed,
+		quarantined: quarantined,
+		acked:       acked,
+		enabled:     true,
 	}
 }
 
@@ -67,3 +91,31 @@ func (m *inboxMetrics) recordFailure(ctx context.Context, eventType string, _ er
 	attrs := metric.WithAttributes(attribute.String("event_type", eventType))
 	m.failure.Add(ctx, 1, attrs)
 }
+
+func (m *inboxMetrics) recordParked(ctx context.Context, eventType string) {
+	if m == nil || !m.enabled {
+		return
+	}
+	m.parked.Add(ctx, 1, metric.WithAttributes(attribute.String("event_type", eventType)))
+}
+
+func (m *inboxMetrics) recordReplayed(ctx context.Context, eventType string) {
+	if m == nil || !m.enabled {
+		return
+	}
+	m.replayed.Add(ctx, 1, metric.WithAttributes(attribute.String("event_type", eventType)))
+}
+
+func (m *inboxMetrics) recordQuarantined(ctx context.Context, eventType string) {
+	if m == nil || !m.enabled {
+		return
+	}
+	m.quarantined.Add(ctx, 1, metric.WithAttributes(attribute.String("event_type", eventType)))
+}
+
+func (m *inboxMetrics) recordQuarantineAcked(ctx context.Context, eventType string) {
+	if m == nil || !m.enabled {
+		return
+	}
+	m.acked.Add(ctx, 1, metric.WithAttributes(attribute.String("event_type", eventType)))
+}
@@ -0,0 +1,98 @@
+package cataloginbox
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	videov1 "github.com/bionicotaku/lingo-services-catalog/api/video/v1"
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/eventbus"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/bionicotaku/lingo-utils/txmanager"
+	"github.com/google/uuid"
+)
+
+// ConsumerGroup 为 Catalog Inbox 在 profile.subscription_offsets 中的消费组标识。
+const ConsumerGroup = "catalog_inbox"
+
+// messageMeta 记录 Pub/Sub 消息层面的元数据；Inbox Runner 只向 handler 传递解码后的事件，
+// 因此由 offsetSubscriber 在 Receive 时注入 ctx，供 handler 在同一事务内推进位点。
+type messageMeta struct {
+	ID          string
+	PublishTime time.Time
+}
+
+type messageMetaKey struct{}
+
+func withMessageMeta(ctx context.Context, meta messageMeta) context.Context {
+	return context.WithValue(ctx, messageMetaKey{}, meta)
+}
+
+func messageMetaFromContext(ctx context.Context) (messageMeta, bool) {
+	meta, ok := ctx.Value(messageMetaKey{}).(messageMeta)
+	return meta, ok
+}
+
+// offsetSubscriber 装饰 eventbus.Subscriber，把消息 ID 与 publish_time 透传到处理上下文。
+type offsetSubscriber struct {
+	eventbus.Subscriber
+}
+
+func (s offsetSubscriber) Receive(ctx context.Context, handler func(context.Context, *eventbus.Message) error) error {
+	return s.Subscriber.Receive(ctx, func(msgCtx context.Context, msg *eventbus.Message) error {
+		if msg != nil {
+			msgCtx = withMessageMeta(msgCtx, messageMeta{ID: msg.ID, PublishTime: msg.PublishTime})
+		}
+		return handler(msgCtx, msg)
+	})
+}
+
+// offsetRecorder 在事件处理事务内推进消费组高水位。
+type offsetRecorder struct {
+	repo         *repositories.SubscriptionOffsetsRepository
+	subscription string
+	updatedBy    string
+}
+
+func newOffsetRecorder(repo *repositories.SubscriptionOffsetsRepository, subscription string) *offsetRecorder {
+	if repo == nil {
+		return nil
+	}
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "unknown"
+	}
+	return &offsetRecorder{repo: repo, subscription: subscription, updatedBy: host}
+}
+
+func (r *offsetRecorder) record(ctx context.Context, sess txmanager.Session, evt *videov1.Event, occurredAt, now time.Time) error {
+	if r == nil {
+		return nil
+	}
+	input := repositories.AdvanceSubscriptionOffsetInput{
+		ConsumerGroup:  ConsumerGroup,
+		Subscription:   r.subscription,
+		LastOccurredAt: &occurredAt,
+		Lag:            now.Sub(occurredAt),
+		UpdatedBy:      r.updatedBy,
+	}
+	if eventID, err := uuid.Parse(evt.GetEventId()); err == nil {
+		input.LastEventID = &eventID
+	}
+	if meta, ok := messageMetaFromContext(ctx); ok {
+		if meta.ID != "" {
+			id := meta.ID
+			input.MessageID = &id
+		}
+		if !meta.PublishTime.IsZero() {
+			publishTime := meta.PublishTime.UTC()
+			input.PublishTime = &publishTime
+			input.Lag = now.Sub(publishTime)
+		}
+	}
+	if err := r.repo.Advance(ctx, sess, input); err != nil {
+		return fmt.Errorf("catalog inbox: advance offset: %w", err)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package cataloginbox
 
 import (
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/eventbus"
 	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
 	"github.com/bionicotaku/lingo-utils/gcpubsub"
 	outboxcfg "github.com/bionicotaku/lingo-utils/outbox/config"
@@ -10,11 +11,16 @@ import (
 
 // ProvideTask 根据配置和依赖构造 Catalog Inbox 任务。
 func ProvideTask(
-	subscriber gcpubsub.Subscriber,
+	subscriber eventbus.Subscriber,
 	inboxRepo *repositories.InboxRepository,
 	projectionRepo *repositories.ProfileVideoProjectionRepository,
+	pendingRepo *repositories.PendingVideoEventsRepository,
+	offsetsRepo *repositories.SubscriptionOffsetsRepository,
+	quarantineRepo *repositories.InboxQuarantineRepository,
 	tx txmanager.Manager,
 	cfg outboxcfg.Config,
+	pubsubCfg gcpubsub.Config,
+	policy QuarantinePolicy,
 	logger log.Logger,
 ) *Task {
 	normalized := cfg.Normalize()
@@ -22,5 +28,20 @@ func ProvideTask(
 		log.NewHelper(logger).Warn("catalog inbox: skip initialization, source_service not configured")
 		return nil
 	}
-	return NewTask(subscriber, inboxRepo, projectionRepo, tx, logger, normalized.Inbox)
+	return NewTask(subscriber, inboxRepo, projectionRepo, pendingRepo, tx, logger, normalized.Inbox,
+		WithSubscriptionOffsets(offsetsRepo, pubsubCfg.SubscriptionID),
+		WithQuarantine(quarantineRepo, policy))
+}
+
+// ProvideQuarantineAdmin 供隔离区管理命令使用。
+func ProvideQuarantineAdmin(
+	quarantineRepo *repositories.InboxQuarantineRepository,
+	inboxRepo *repositories.InboxRepository,
+	projectionRepo *repositories.ProfileVideoProjectionRepository,
+	pendingRepo *repositories.PendingVideoEventsRepository,
+	tx txmanager.Manager,
+	policy QuarantinePolicy,
+	logger log.Logger,
+) *QuarantineAdmin {
+	return NewQuarantineAdmin(quarantineRepo, inboxRepo, projectionRepo, pendingRepo, tx, policy, logger)
 }
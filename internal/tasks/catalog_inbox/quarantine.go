@@ -0,0 +1,215 @@
+package cataloginbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	videov1 "github.com/bionicotaku/lingo-services-catalog/api/video/v1"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/bionicotaku/lingo-utils/outbox/store"
+	"github.com/bionicotaku/lingo-utils/txmanager"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultMaxAttempts 为未配置 max_attempts 时单个事件的最大处理次数。
+const DefaultMaxAttempts = 10
+
+// QuarantinePolicy 配置 Inbox 处理失败后的隔离策略。
+type QuarantinePolicy struct {
+	// MaxAttempts 为单个事件的最大处理次数，达到后进入隔离区不再重试；<=0 时使用 DefaultMaxAttempts。
+	MaxAttempts int
+}
+
+func (p QuarantinePolicy) maxAttempts() int {
+	if p.MaxAttempts <= 0 {
+		return DefaultMaxAttempts
+	}
+	return p.MaxAttempts
+}
+
+// quarantineHandler 装饰 eventHandler：失败次数在 handler 事务之外累计，达到上限后事件转为 quarantined；
+// 之后的重投直接 Ack，避免毒消息被无限重投。
+type quarantineHandler struct {
+	next          *eventHandler
+	repo          *repositories.InboxQuarantineRepository
+	sourceService string
+	policy        QuarantinePolicy
+	log           *log.Helper
+	metrics       *inboxMetrics
+}
+
+func (h *quarantineHandler) Handle(ctx context.Context, sess txmanager.Session, evt *videov1.Event, inboxEvt *store.InboxEvent) error {
+	if inboxEvt == nil {
+		return h.next.Handle(ctx, sess, evt, inboxEvt)
+	}
+
+	entry, err := h.repo.Get(ctx, sess, inboxEvt.EventID)
+	switch {
+	case errors.Is(err, repositories.ErrInboxQuarantineNotFound):
+		entry = nil
+	case err != nil:
+		return fmt.Errorf("catalog inbox: load quarantine state: %w", err)
+	case entry.Quarantined():
+		h.log.WithContext(ctx).Warnw("msg", "catalog inbox: ack quarantined event", "event_id", inboxEvt.EventID, "status", entry.Status, "attempts", entry.Attempts)
+		h.metrics.recordQuarantineAcked(ctx, evt.GetEventType().String())
+		return nil
+	}
+
+	handleErr := h.next.Handle(ctx, sess, evt, inboxEvt)
+	if handleErr == nil {
+		if entry != nil {
+			if err := h.repo.Resolve(ctx, sess, inboxEvt.EventID, po.InboxQuarantineResolved, nil); err != nil && !errors.Is(err, repositories.ErrInboxQuarantineNotFound) {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// 计数使用独立连接写入，不随本次事务回滚。
+	failure, err := h.repo.RecordFailure(ctx, nil, failureInput(inboxEvt, evt, h.sourceService, h.policy.maxAttempts(), handleErr))
+	if err != nil {
+		h.log.WithContext(ctx).Errorw("msg", "catalog inbox: record failure failed", "event_id", inboxEvt.EventID, "error", err)
+		return handleErr
+	}
+	if failure.Status == po.InboxQuarantineQuarantined && failure.Attempts == failure.MaxAttempts {
+		h.log.WithContext(ctx).Warnw("msg", "catalog inbox: event quarantined", "event_id", inboxEvt.EventID, "attempts", failure.Attempts, "error", handleErr)
+		h.metrics.recordQuarantined(ctx, evt.GetEventType().String())
+	}
+	return handleErr
+}
+
+func failureInput(inboxEvt *store.InboxEvent, evt *videov1.Event, sourceService string, maxAttempts int, cause error) repositories.RecordInboxFailureInput {
+	payload := inboxEvt.Payload
+	if len(payload) == 0 && evt != nil {
+		payload, _ = proto.Marshal(evt)
+	}
+	source := inboxEvt.SourceService
+	if source == "" {
+		source = sourceService
+	}
+	eventType := inboxEvt.EventType
+	if eventType == "" && evt != nil {
+		eventType = evt.GetEventType().String()
+	}
+	return repositories.RecordInboxFailureInput{
+		EventID:       inboxEvt.EventID,
+		ConsumerGroup: ConsumerGroup,
+		SourceService: source,
+		EventType:     eventType,
+		AggregateID:   inboxEvt.AggregateID,
+		Payload:       payload,
+		MaxAttempts:   maxAttempts,
+		LastError:     cause.Error(),
+	}
+}
+
+// QuarantineAdmin 提供隔离区的查询、重试与丢弃操作。
+type QuarantineAdmin struct {
+	quarantine *repositories.InboxQuarantineRepository
+	inbox      *repositories.InboxRepository
+	tx         txmanager.Manager
+	handler    *eventHandler
+	decoder    *decoder
+	policy     QuarantinePolicy
+	log        *log.Helper
+	clock      func() time.Time
+}
+
+// NewQuarantineAdmin 构造隔离区管理器；依赖缺失时返回 nil。
+func NewQuarantineAdmin(
+	quarantine *repositories.InboxQuarantineRepository,
+	inboxRepo *repositories.InboxRepository,
+	projection *repositories.ProfileVideoProjectionRepository,
+	pending *repositories.PendingVideoEventsRepository,
+	tx txmanager.Manager,
+	policy QuarantinePolicy,
+	logger log.Logger,
+) *QuarantineAdmin {
+	if quarantine == nil || inboxRepo == nil || projection == nil || tx == nil {
+		return nil
+	}
+	return &QuarantineAdmin{
+		quarantine: quarantine,
+		inbox:      inboxRepo,
+		tx:         tx,
+		handler:    newEventHandler(projection, pending, logger, newInboxMetrics()),
+		decoder:    newDecoder(),
+		policy:     policy,
+		log:        log.NewHelper(logger),
+		clock:      time.Now,
+	}
+}
+
+// List 按最近失败时间倒序列出本消费组的隔离区事件，status 为空时返回全部状态。
+func (a *QuarantineAdmin) List(ctx context.Context, status string, limit int32) ([]*po.InboxQuarantine, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	return a.quarantine.List(ctx, nil, repositories.ListInboxQuarantineInput{
+		ConsumerGroup: ConsumerGroup,
+		Status:        status,
+		Limit:         limit,
+	})
+}
+
+// Get 返回单个隔离区事件。
+func (a *QuarantineAdmin) Get(ctx context.Context, eventID uuid.UUID) (*po.InboxQuarantine, error) {
+	return a.quarantine.Get(ctx, nil, eventID)
+}
+
+// Retry 使用保存的载荷重新执行 handler；成功后标记 resolved 与 Inbox processed，失败则累加次数并保持隔离。
+func (a *QuarantineAdmin) Retry(ctx context.Context, eventID uuid.UUID, operator string) error {
+	entry, err := a.quarantine.Get(ctx, nil, eventID)
+	if err != nil {
+		return err
+	}
+	if entry.Status != po.InboxQuarantineQuarantined && entry.Status != po.InboxQuarantineFailing {
+		return fmt.Errorf("catalog inbox: event %s is %s: %w", eventID, entry.Status, repositories.ErrInboxQuarantineNotFound)
+	}
+	evt, err := a.decoder.Decode(entry.Payload)
+	if err != nil {
+		return err
+	}
+
+	inboxEvt := &store.InboxEvent{
+		EventID:       entry.EventID,
+		SourceService: entry.SourceService,
+		EventType:     entry.EventType,
+		AggregateID:   entry.AggregateID,
+		Payload:       entry.Payload,
+		ReceivedAt:    entry.FirstFailedAt,
+	}
+	resolvedBy := operator
+	err = a.tx.WithinTx(ctx, txmanager.TxOptions{}, func(txCtx context.Context, sess txmanager.Session) error {
+		if err := a.handler.Handle(txCtx, sess, evt, inboxEvt); err != nil {
+			return err
+		}
+		if err := a.inbox.MarkProcessed(txCtx, sess, eventID, a.clock().UTC()); err != nil {
+			return err
+		}
+		return a.quarantine.Resolve(txCtx, sess, eventID, po.InboxQuarantineResolved, &resolvedBy)
+	})
+	if err != nil {
+		if _, recordErr := a.quarantine.RecordFailure(ctx, nil, failureInput(inboxEvt, evt, entry.SourceService, a.policy.maxAttempts(), err)); recordErr != nil {
+			a.log.WithContext(ctx).Errorw("msg", "catalog inbox: record retry failure failed", "event_id", eventID, "error", recordErr)
+		}
+		return fmt.Errorf("catalog inbox: retry %s: %w", eventID, err)
+	}
+	a.log.WithContext(ctx).Infow("msg", "catalog inbox: quarantined event retried", "event_id", eventID, "operator", operator)
+	return nil
+}
+
+// Discard 将事件标记为 discarded，之后的重投都会被直接 Ack。
+func (a *QuarantineAdmin) Discard(ctx context.Context, eventID uuid.UUID, operator string) error {
+	resolvedBy := operator
+	if err := a.quarantine.Resolve(ctx, nil, eventID, po.InboxQuarantineDiscarded, &resolvedBy); err != nil {
+		return err
+	}
+	a.log.WithContext(ctx).Infow("msg", "catalog inbox: quarantined event discarded", "event_id", eventID, "operator", operator)
+	return nil
+}
@@ -0,0 +1,163 @@
+package cataloginbox_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	videov1 "github.com/bionicotaku/lingo-services-catalog/api/video/v1"
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/bionicotaku/lingo-services-profile/internal/tasks/catalog_inbox"
+	"github.com/bionicotaku/lingo-utils/gcpubsub"
+	outboxcfg "github.com/bionicotaku/lingo-utils/outbox/config"
+	"github.com/bionicotaku/lingo-utils/txmanager"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCatalogInboxTask_QuarantinesPoisonEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dsn, terminate := startPostgres(ctx, t)
+	defer terminate()
+
+	pool, err := pgxpool.New(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { pool.Close() })
+
+	applyMigrations(ctx, t, pool)
+
+	logger := log.NewStdLogger(io.Discard)
+	inboxRepo := repositories.NewInboxRepository(pool, logger, outboxcfg.Config{Schema: "profile"})
+	projectionRepo := repositories.NewProfileVideoProjectionRepository(pool, logger)
+	quarantineRepo := repositories.NewInboxQuarantineRepository(pool, logger)
+	manager, err := txmanager.NewManager(pool, txmanager.Config{}, txmanager.Dependencies{Logger: logger})
+	require.NoError(t, err)
+
+	// created 事件缺少 payload，handler 每次都会失败。
+	poison := &videov1.Event{
+		EventId:       uuid.NewString(),
+		EventType:     videov1.EventType_EVENT_TYPE_VIDEO_CREATED,
+		AggregateId:   uuid.NewString(),
+		AggregateType: "video",
+		Version:       1,
+		OccurredAt:    time.Now().UTC().Format(time.RFC3339Nano),
+	}
+	eventID := uuid.MustParse(poison.GetEventId())
+
+	policy := cataloginbox.QuarantinePolicy{MaxAttempts: 2}
+	stub := &stubSubscriber{messages: []*gcpubsub.Message{buildMessage(t, poison)}}
+	cfg := outboxcfg.Config{Schema: "profile", Inbox: outboxcfg.InboxConfig{SourceService: "catalog", MaxConcurrency: 1}}
+	task := cataloginbox.NewTask(stub, inboxRepo, projectionRepo, nil, manager, logger, cfg.Inbox,
+		cataloginbox.WithQuarantine(quarantineRepo, policy))
+	require.NotNil(t, task)
+
+	require.Error(t, task.Run(ctx))
+	entry, err := quarantineRepo.Get(ctx, nil, eventID)
+	require.NoError(t, err)
+	require.Equal(t, po.InboxQuarantineFailing, entry.Status)
+	require.Equal(t, int32(1), entry.Attempts)
+
+	require.Error(t, task.Run(ctx))
+	entry, err = quarantineRepo.Get(ctx, nil, eventID)
+	require.NoError(t, err)
+	require.Equal(t, po.InboxQuarantineQuarantined, entry.Status)
+	require.Equal(t, int32(2), entry.Attempts)
+	require.NotNil(t, entry.QuarantinedAt)
+	require.NotNil(t, entry.LastError)
+
+	// 隔离后的重投直接 Ack，不再计数。
+	require.NoError(t, task.Run(ctx))
+	entry, err = quarantineRepo.Get(ctx, nil, eventID)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), entry.Attempts)
+
+	admin := cataloginbox.NewQuarantineAdmin(quarantineRepo, inboxRepo, projectionRepo, nil, manager, policy, logger)
+	require.NotNil(t, admin)
+
+	listed, err := admin.List(ctx, po.InboxQuarantineQuarantined, 10)
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	require.Equal(t, eventID, listed[0].EventID)
+
+	require.Error(t, admin.Retry(ctx, eventID, "oncall"))
+	entry, err = quarantineRepo.Get(ctx, nil, eventID)
+	require.NoError(t, err)
+	require.Equal(t, po.InboxQuarantineQuarantined, entry.Status)
+	require.Equal(t, int32(3), entry.Attempts)
+
+	require.NoError(t, admin.Discard(ctx, eventID, "oncall"))
+	entry, err = quarantineRepo.Get(ctx, nil, eventID)
+	require.NoError(t, err)
+	require.Equal(t, po.InboxQuarantineDiscarded, entry.Status)
+	require.Equal(t, "oncall", deref(entry.ResolvedBy))
+	require.ErrorIs(t, admin.Discard(ctx, eventID, "oncall"), repositories.ErrInboxQuarantineNotFound)
+}
+
+func TestCatalogInboxQuarantineAdmin_RetryAppliesStoredPayload(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dsn, terminate := startPostgres(ctx, t)
+	defer terminate()
+
+	pool, err := pgxpool.New(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { pool.Close() })
+
+	applyMigrations(ctx, t, pool)
+
+	logger := log.NewStdLogger(io.Discard)
+	inboxRepo := repositories.NewInboxRepository(pool, logger, outboxcfg.Config{Schema: "profile"})
+	projectionRepo := repositories.NewProfileVideoProjectionRepository(pool, logger)
+	quarantineRepo := repositories.NewInboxQuarantineRepository(pool, logger)
+	manager, err := txmanager.NewManager(pool, txmanager.Config{}, txmanager.Dependencies{Logger: logger})
+	require.NoError(t, err)
+
+	videoID := uuid.New()
+	created := &videov1.Event{
+		EventId:       uuid.NewString(),
+		EventType:     videov1.EventType_EVENT_TYPE_VIDEO_CREATED,
+		AggregateId:   videoID.String(),
+		AggregateType: "video",
+		Version:       1,
+		OccurredAt:    time.Now().UTC().Format(time.RFC3339Nano),
+		Payload: &videov1.Event_Created{Created: &videov1.Event_VideoCreated{
+			VideoId: videoID.String(),
+			Title:   "Recovered Title",
+			Version: 1,
+		}},
+	}
+	payload, err := proto.Marshal(created)
+	require.NoError(t, err)
+	eventID := uuid.MustParse(created.GetEventId())
+
+	_, err = quarantineRepo.RecordFailure(ctx, nil, repositories.RecordInboxFailureInput{
+		EventID:       eventID,
+		ConsumerGroup: cataloginbox.ConsumerGroup,
+		SourceService: "catalog",
+		EventType:     created.GetEventType().String(),
+		Payload:       payload,
+		MaxAttempts:   1,
+		LastError:     "transient database error",
+	})
+	require.NoError(t, err)
+
+	admin := cataloginbox.NewQuarantineAdmin(quarantineRepo, inboxRepo, projectionRepo, nil, manager, cataloginbox.QuarantinePolicy{MaxAttempts: 1}, logger)
+	require.NoError(t, admin.Retry(ctx, eventID, "oncall"))
+
+	record, err := projectionRepo.Get(ctx, nil, videoID)
+	require.NoError(t, err)
+	require.Equal(t, "Recovered Title", record.Title)
+
+	entry, err := quarantineRepo.Get(ctx, nil, eventID)
+	require.NoError(t, err)
+	require.Equal(t, po.InboxQuarantineResolved, entry.Status)
+	require.NotNil(t, entry.ResolvedAt)
+}
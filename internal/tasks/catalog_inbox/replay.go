@@ -0,0 +1,234 @@
+package cataloginbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	outboxcfg "github.com/bionicotaku/lingo-utils/outbox/config"
+	"github.com/bionicotaku/lingo-utils/txmanager"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// DefaultReplayBatchSize 为回放时单次从 Inbox 读取的事件数。
+const DefaultReplayBatchSize int32 = 100
+
+var maxEventID = uuid.UUID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+// ReplayOptions 控制一次 Inbox 回放的区间与行为。区间按 (received_at, event_id) 全序计算，两端均包含。
+type ReplayOptions struct {
+	// From / To 为 received_at 时间边界，零值表示不限（To 不限时截止到回放开始时刻）。
+	From time.Time
+	To   time.Time
+	// FromEventID / ToEventID 以指定事件的位置作为边界，设置后优先于 From / To。
+	FromEventID *uuid.UUID
+	ToEventID   *uuid.UUID
+	// BatchSize 为单次读取的事件数，<=0 时使用 DefaultReplayBatchSize。
+	BatchSize int32
+	// IncludeProcessed 为 true 时绕过去重，已处理的事件也重新执行；默认仅回放未处理成功的事件。
+	IncludeProcessed bool
+	// DryRun 为 true 时只统计将回放的事件，不执行 handler。
+	DryRun bool
+}
+
+// ReplayResult 汇总一次回放的结果；DryRun 时 Replayed 表示将被回放的事件数。
+type ReplayResult struct {
+	Scanned  int
+	Replayed int
+	Skipped  int
+	Failed   int
+	Last     repositories.InboxCursor
+}
+
+// Replayer 使用 profile.inbox_events 中保存的原始载荷，经与在线消费相同的 eventHandler 重新处理事件。
+// 回放不推进 profile.subscription_offsets，高水位只反映在线消费进度。
+type Replayer struct {
+	inbox         *repositories.InboxRepository
+	tx            txmanager.Manager
+	handler       *eventHandler
+	decoder       *decoder
+	sourceService string
+	log           *log.Helper
+	clock         func() time.Time
+}
+
+// NewReplayer 构造回放器；依赖缺失时返回 nil。
+func NewReplayer(
+	inboxRepo *repositories.InboxRepository,
+	projection *repositories.ProfileVideoProjectionRepository,
+	pending *repositories.PendingVideoEventsRepository,
+	tx txmanager.Manager,
+	logger log.Logger,
+	sourceService string,
+) *Replayer {
+	if inboxRepo == nil || projection == nil || tx == nil || sourceService == "" {
+		return nil
+	}
+	return &Replayer{
+		inbox:         inboxRepo,
+		tx:            tx,
+		handler:       newEventHandler(projection, pending, logger, newInboxMetrics()),
+		decoder:       newDecoder(),
+		sourceService: sourceService,
+		log:           log.NewHelper(logger),
+		clock:         time.Now,
+	}
+}
+
+// ProvideReplayer 供 Wire 使用，来源服务取自 messaging.inbox.source_service。
+func ProvideReplayer(
+	inboxRepo *repositories.InboxRepository,
+	projectionRepo *repositories.ProfileVideoProjectionRepository,
+	pendingRepo *repositories.PendingVideoEventsRepository,
+	tx txmanager.Manager,
+	cfg outboxcfg.Config,
+	logger log.Logger,
+) *Replayer {
+	normalized := cfg.Normalize()
+	if normalized.Inbox.SourceService == "" {
+		log.NewHelper(logger).Warn("catalog inbox replay: skip initialization, source_service not configured")
+		return nil
+	}
+	return NewReplayer(inboxRepo, projectionRepo, pendingRepo, tx, logger, normalized.Inbox.SourceService)
+}
+
+// WithClock 提供测试替换时间。
+func (r *Replayer) WithClock(fn func() time.Time) {
+	if r == nil || fn == nil {
+		return
+	}
+	r.clock = fn
+	r.handler.clock = fn
+}
+
+// Replay 按区间顺序回放 Inbox 事件。单个事件失败会记录 last_error 并继续，读取失败或 ctx 取消时返回错误。
+func (r *Replayer) Replay(ctx context.Context, opts ReplayOptions) (ReplayResult, error) {
+	var result ReplayResult
+	if r == nil {
+		return result, errors.New("catalog inbox replay: replayer not initialized")
+	}
+	after, until, err := r.resolveRange(ctx, opts)
+	if err != nil {
+		return result, err
+	}
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultReplayBatchSize
+	}
+
+	for {
+		events, err := r.inbox.ListForReplay(ctx, repositories.ListInboxForReplayInput{
+			SourceService: r.sourceService,
+			After:         after,
+			Until:         until,
+			Limit:         batchSize,
+		})
+		if err != nil {
+			return result, fmt.Errorf("catalog inbox replay: %w", err)
+		}
+		for _, event := range events {
+			if err := ctx.Err(); err != nil {
+				return result, err
+			}
+			result.Scanned++
+			after = repositories.InboxCursor{ReceivedAt: event.ReceivedAt, EventID: event.EventID}
+			result.Last = after
+
+			if event.ProcessedAt != nil && !opts.IncludeProcessed {
+				result.Skipped++
+				continue
+			}
+			if opts.DryRun {
+				result.Replayed++
+				continue
+			}
+			if err := r.replayOne(ctx, event); err != nil {
+				result.Failed++
+				r.log.WithContext(ctx).Warnw("msg", "catalog inbox replay: event failed", "event_id", event.EventID, "error", err)
+				if recordErr := r.inbox.RecordError(ctx, nil, event.EventID, err.Error()); recordErr != nil {
+					r.log.WithContext(ctx).Errorw("msg", "catalog inbox replay: record error failed", "event_id", event.EventID, "error", recordErr)
+				}
+				continue
+			}
+			result.Replayed++
+		}
+		if int32(len(events)) < batchSize {
+			return result, nil
+		}
+	}
+}
+
+func (r *Replayer) replayOne(ctx context.Context, event *repositories.InboxEvent) error {
+	evt, err := r.decoder.Decode(event.Payload)
+	if err != nil {
+		return err
+	}
+	return r.tx.WithinTx(ctx, txmanager.TxOptions{}, func(txCtx context.Context, sess txmanager.Session) error {
+		if err := r.handler.Handle(txCtx, sess, evt, event); err != nil {
+			return err
+		}
+		return r.inbox.MarkProcessed(txCtx, sess, event.EventID, r.clock().UTC())
+	})
+}
+
+// resolveRange 将选项换算为 ListForReplay 使用的 (after, until] 区间。
+func (r *Replayer) resolveRange(ctx context.Context, opts ReplayOptions) (repositories.InboxCursor, repositories.InboxCursor, error) {
+	after := repositories.InboxCursor{ReceivedAt: time.Unix(0, 0).UTC()}
+	until := repositories.InboxCursor{ReceivedAt: r.clock().UTC(), EventID: maxEventID}
+
+	switch {
+	case opts.FromEventID != nil:
+		event, err := r.lookup(ctx, *opts.FromEventID)
+		if err != nil {
+			return after, until, err
+		}
+		after = cursorBefore(event.ReceivedAt, event.EventID)
+	case !opts.From.IsZero():
+		after = repositories.InboxCursor{ReceivedAt: opts.From.UTC().Add(-time.Microsecond), EventID: maxEventID}
+	}
+
+	switch {
+	case opts.ToEventID != nil:
+		event, err := r.lookup(ctx, *opts.ToEventID)
+		if err != nil {
+			return after, until, err
+		}
+		until = repositories.InboxCursor{ReceivedAt: event.ReceivedAt, EventID: event.EventID}
+	case !opts.To.IsZero():
+		until = repositories.InboxCursor{ReceivedAt: opts.To.UTC(), EventID: maxEventID}
+	}
+
+	if until.ReceivedAt.Before(after.ReceivedAt) {
+		return after, until, errors.New("catalog inbox replay: range end is before range start")
+	}
+	return after, until, nil
+}
+
+func (r *Replayer) lookup(ctx context.Context, eventID uuid.UUID) (*repositories.InboxEvent, error) {
+	event, err := r.inbox.Get(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("catalog inbox replay: resolve event %s: %w", eventID, err)
+	}
+	if event.SourceService != r.sourceService {
+		return nil, fmt.Errorf("catalog inbox replay: event %s belongs to source %q", eventID, event.SourceService)
+	}
+	return event, nil
+}
+
+// cursorBefore 返回紧邻 (receivedAt, eventID) 之前的位置，使区间起点包含该事件。
+func cursorBefore(receivedAt time.Time, eventID uuid.UUID) repositories.InboxCursor {
+	if eventID == uuid.Nil {
+		return repositories.InboxCursor{ReceivedAt: receivedAt.Add(-time.Microsecond), EventID: maxEventID}
+	}
+	prev := eventID
+	for i := len(prev) - 1; i >= 0; i-- {
+		prev[i]--
+		if prev[i] != 0xff {
+			break
+		}
+	}
+	return repositories.InboxCursor{ReceivedAt: receivedAt, EventID: prev}
+}
@@ -0,0 +1,110 @@
+package cataloginbox_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	videov1 "github.com/bionicotaku/lingo-services-catalog/api/video/v1"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/bionicotaku/lingo-services-profile/internal/tasks/catalog_inbox"
+	"github.com/bionicotaku/lingo-utils/gcpubsub"
+	outboxcfg "github.com/bionicotaku/lingo-utils/outbox/config"
+	"github.com/bionicotaku/lingo-utils/txmanager"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalogInboxReplayer_ReplaysStoredPayloads(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dsn, terminate := startPostgres(ctx, t)
+	defer terminate()
+
+	pool, err := pgxpool.New(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { pool.Close() })
+
+	applyMigrations(ctx, t, pool)
+
+	logger := log.NewStdLogger(io.Discard)
+	inboxRepo := repositories.NewInboxRepository(pool, logger, outboxcfg.Config{Schema: "profile"})
+	projectionRepo := repositories.NewProfileVideoProjectionRepository(pool, logger)
+	manager, err := txmanager.NewManager(pool, txmanager.Config{}, txmanager.Dependencies{Logger: logger})
+	require.NoError(t, err)
+
+	videoID := uuid.New()
+	occurredAt := time.Now().UTC().Truncate(time.Millisecond)
+	created := &videov1.Event{
+		EventId:       uuid.NewString(),
+		EventType:     videov1.EventType_EVENT_TYPE_VIDEO_CREATED,
+		AggregateId:   videoID.String(),
+		AggregateType: "video",
+		Version:       1,
+		OccurredAt:    occurredAt.Format(time.RFC3339Nano),
+		Payload: &videov1.Event_Created{Created: &videov1.Event_VideoCreated{
+			VideoId: videoID.String(),
+			Title:   "Original Title",
+			Version: 1,
+		}},
+	}
+	updated := &videov1.Event{
+		EventId:       uuid.NewString(),
+		EventType:     videov1.EventType_EVENT_TYPE_VIDEO_UPDATED,
+		AggregateId:   videoID.String(),
+		AggregateType: "video",
+		Version:       2,
+		OccurredAt:    occurredAt.Add(time.Minute).Format(time.RFC3339Nano),
+		Payload: &videov1.Event_Updated{Updated: &videov1.Event_VideoUpdated{
+			VideoId: videoID.String(),
+			Title:   optionalString("Updated Title"),
+			Version: 2,
+		}},
+	}
+
+	stub := &stubSubscriber{messages: []*gcpubsub.Message{buildMessage(t, created), buildMessage(t, updated)}}
+	cfg := outboxcfg.Config{Schema: "profile", Inbox: outboxcfg.InboxConfig{SourceService: "catalog", MaxConcurrency: 1}}
+	task := cataloginbox.NewTask(stub, inboxRepo, projectionRepo, nil, manager, logger, cfg.Inbox)
+	require.NotNil(t, task)
+	require.NoError(t, task.Run(ctx))
+
+	// 模拟投影丢失：删除投影行后重放。
+	_, err = pool.Exec(ctx, `DELETE FROM profile.videos_projection WHERE video_id = $1`, videoID)
+	require.NoError(t, err)
+
+	replayer := cataloginbox.NewReplayer(inboxRepo, projectionRepo, nil, manager, logger, "catalog")
+	require.NotNil(t, replayer)
+
+	result, err := replayer.Replay(ctx, cataloginbox.ReplayOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Scanned)
+	require.Equal(t, 2, result.Skipped)
+	require.Zero(t, result.Replayed)
+	_, err = projectionRepo.Get(ctx, nil, videoID)
+	require.Error(t, err)
+
+	createdID := uuid.MustParse(created.GetEventId())
+	result, err = replayer.Replay(ctx, cataloginbox.ReplayOptions{FromEventID: &createdID, IncludeProcessed: true, DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Replayed)
+	_, err = projectionRepo.Get(ctx, nil, videoID)
+	require.Error(t, err)
+
+	result, err = replayer.Replay(ctx, cataloginbox.ReplayOptions{FromEventID: &createdID, IncludeProcessed: true, BatchSize: 1})
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Replayed)
+	require.Zero(t, result.Failed)
+
+	record, err := projectionRepo.Get(ctx, nil, videoID)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), record.Version)
+	require.Equal(t, "Updated Title", record.Title)
+
+	result, err = replayer.Replay(ctx, cataloginbox.ReplayOptions{To: occurredAt.Add(-time.Hour), IncludeProcessed: true})
+	require.NoError(t, err)
+	require.Zero(t, result.Scanned)
+}
@@ -5,8 +5,8 @@ import (
 	"time"
 
 	videov1 "github.com/bionicotaku/lingo-services-catalog/api/video/v1"
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/eventbus"
 	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
-	"github.com/bionicotaku/lingo-utils/gcpubsub"
 	outboxcfg "github.com/bionicotaku/lingo-utils/outbox/config"
 	"github.com/bionicotaku/lingo-utils/outbox/inbox"
 	"github.com/bionicotaku/lingo-utils/txmanager"
@@ -18,21 +18,69 @@ type Task struct {
 	runner *inbox.Runner[videov1.Event]
 }
 
-// NewTask 构造 Inbox Runner。
+// TaskOption 定制 Catalog Inbox 任务的可选依赖。
+type TaskOption func(*taskOptions)
+
+type taskOptions struct {
+	offsets      *repositories.SubscriptionOffsetsRepository
+	subscription string
+	quarantine   *repositories.InboxQuarantineRepository
+	policy       QuarantinePolicy
+}
+
+// WithSubscriptionOffsets 启用消费组高水位记录，subscription 为写入位点表的订阅 ID。
+func WithSubscriptionOffsets(repo *repositories.SubscriptionOffsetsRepository, subscription string) TaskOption {
+	return func(o *taskOptions) {
+		o.offsets = repo
+		o.subscription = subscription
+	}
+}
+
+// WithQuarantine 启用失败计数与隔离：同一事件失败达到 policy.MaxAttempts 次后不再重试。
+func WithQuarantine(repo *repositories.InboxQuarantineRepository, policy QuarantinePolicy) TaskOption {
+	return func(o *taskOptions) {
+		o.quarantine = repo
+		o.policy = policy
+	}
+}
+
+// NewTask 构造 Inbox Runner；pending 为 nil 时不暂存乱序事件。
 func NewTask(
-	subscriber gcpubsub.Subscriber,
+	subscriber eventbus.Subscriber,
 	inboxRepo *repositories.InboxRepository,
 	projection *repositories.ProfileVideoProjectionRepository,
+	pending *repositories.PendingVideoEventsRepository,
 	tx txmanager.Manager,
 	logger log.Logger,
 	cfg outboxcfg.InboxConfig,
+	opts ...TaskOption,
 ) *Task {
 	if subscriber == nil || inboxRepo == nil || projection == nil || tx == nil {
 		return nil
 	}
 
+	var options taskOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	metrics := newInboxMetrics()
-	handler := newEventHandler(projection, logger, metrics)
+	handler := newEventHandler(projection, pending, logger, metrics)
+	if options.offsets != nil {
+		handler.offsets = newOffsetRecorder(options.offsets, options.subscription)
+		subscriber = offsetSubscriber{Subscriber: subscriber}
+	}
+	var runnerHandler inbox.Handler[videov1.Event] = handler
+	if options.quarantine != nil {
+		runnerHandler = &quarantineHandler{
+			next:          handler,
+			repo:          options.quarantine,
+			sourceService: cfg.SourceService,
+			policy:        options.policy,
+			log:           log.NewHelper(logger),
+			metrics:       metrics,
+		}
+	}
 	dec := newDecoder()
 
 	runner, err := inbox.NewRunner[videov1.Event](inbox.RunnerParams[videov1.Event]{
@@ -40,7 +88,7 @@ func NewTask(
 		Subscriber: subscriber,
 		TxManager:  tx,
 		Decoder:    dec,
-		Handler:    handler,
+		Handler:    runnerHandler,
 		Config:     cfg.Normalize(),
 		Logger:     logger,
 	})
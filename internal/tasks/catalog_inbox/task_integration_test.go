@@ -68,7 +68,7 @@ func TestCatalogInboxTask_UpsertsProjection(t *testing.T) {
 	stub := &stubSubscriber{messages: []*gcpubsub.Message{msg}}
 
 	cfg := outboxcfg.Config{Schema: "profile", Inbox: outboxcfg.InboxConfig{SourceService: "catalog", MaxConcurrency: 1}}
-	task := cataloginbox.NewTask(stub, inboxRepo, projectionRepo, manager, logger, cfg.Inbox)
+	task := cataloginbox.NewTask(stub, inboxRepo, projectionRepo, nil, manager, logger, cfg.Inbox)
 	require.NotNil(t, task)
 
 	require.NoError(t, task.Run(ctx))
@@ -120,6 +120,226 @@ func TestCatalogInboxTask_UpsertsProjection(t *testing.T) {
 	require.Equal(t, "Fresh Description", deref(record.Description))
 }
 
+func TestCatalogInboxTask_ReplaysEventsParkedBeforeCreate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dsn, terminate := startPostgres(ctx, t)
+	defer terminate()
+
+	pool, err := pgxpool.New(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { pool.Close() })
+
+	applyMigrations(ctx, t, pool)
+
+	logger := log.NewStdLogger(io.Discard)
+	inboxRepo := repositories.NewInboxRepository(pool, logger, outboxcfg.Config{Schema: "profile"})
+	projectionRepo := repositories.NewProfileVideoProjectionRepository(pool, logger)
+	pendingRepo := repositories.NewPendingVideoEventsRepository(pool, logger)
+	manager, err := txmanager.NewManager(pool, txmanager.Config{}, txmanager.Dependencies{Logger: logger})
+	require.NoError(t, err)
+
+	videoID := uuid.New()
+	occurredAt := time.Now().UTC().Truncate(time.Millisecond)
+	updated := &videov1.Event{
+		EventId:       uuid.NewString(),
+		EventType:     videov1.EventType_EVENT_TYPE_VIDEO_UPDATED,
+		AggregateId:   videoID.String(),
+		AggregateType: "video",
+		Version:       2,
+		OccurredAt:    occurredAt.Add(time.Minute).Format(time.RFC3339Nano),
+		Payload: &videov1.Event_Updated{Updated: &videov1.Event_VideoUpdated{
+			VideoId: videoID.String(),
+			Title:   optionalString("Updated Title"),
+			Version: 2,
+		}},
+	}
+	created := &videov1.Event{
+		EventId:       uuid.NewString(),
+		EventType:     videov1.EventType_EVENT_TYPE_VIDEO_CREATED,
+		AggregateId:   videoID.String(),
+		AggregateType: "video",
+		Version:       1,
+		OccurredAt:    occurredAt.Format(time.RFC3339Nano),
+		Payload: &videov1.Event_Created{Created: &videov1.Event_VideoCreated{
+			VideoId:     videoID.String(),
+			Title:       "Original Title",
+			Description: optionalString("Original Description"),
+			Version:     1,
+			Status:      "published",
+		}},
+	}
+
+	stub := &stubSubscriber{messages: []*gcpubsub.Message{buildMessage(t, updated)}}
+	cfg := outboxcfg.Config{Schema: "profile", Inbox: outboxcfg.InboxConfig{SourceService: "catalog", MaxConcurrency: 1}}
+	task := cataloginbox.NewTask(stub, inboxRepo, projectionRepo, pendingRepo, manager, logger, cfg.Inbox)
+	require.NotNil(t, task)
+	require.NoError(t, task.Run(ctx))
+
+	_, err = projectionRepo.Get(ctx, nil, videoID)
+	require.Error(t, err)
+	parked, err := pendingRepo.ListByVideo(ctx, nil, videoID)
+	require.NoError(t, err)
+	require.Len(t, parked, 1)
+	require.Equal(t, int64(2), parked[0].Version)
+
+	stub.messages = []*gcpubsub.Message{buildMessage(t, created)}
+	require.NoError(t, task.Run(ctx))
+
+	record, err := projectionRepo.Get(ctx, nil, videoID)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), record.Version)
+	require.Equal(t, "Updated Title", record.Title)
+	require.Equal(t, "Original Description", deref(record.Description))
+	require.Equal(t, "published", deref(record.Status))
+
+	parked, err = pendingRepo.ListByVideo(ctx, nil, videoID)
+	require.NoError(t, err)
+	require.Empty(t, parked)
+}
+
+func TestCatalogInboxTask_ParksUnversionedEventsByEventID(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dsn, terminate := startPostgres(ctx, t)
+	defer terminate()
+
+	pool, err := pgxpool.New(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { pool.Close() })
+
+	applyMigrations(ctx, t, pool)
+
+	logger := log.NewStdLogger(io.Discard)
+	inboxRepo := repositories.NewInboxRepository(pool, logger, outboxcfg.Config{Schema: "profile"})
+	projectionRepo := repositories.NewProfileVideoProjectionRepository(pool, logger)
+	pendingRepo := repositories.NewPendingVideoEventsRepository(pool, logger)
+	manager, err := txmanager.NewManager(pool, txmanager.Config{}, txmanager.Dependencies{Logger: logger})
+	require.NoError(t, err)
+
+	videoID := uuid.New()
+	occurredAt := time.Now().UTC().Truncate(time.Millisecond)
+	unversioned := func(title string, at time.Time) *videov1.Event {
+		return &videov1.Event{
+			EventId:       uuid.NewString(),
+			EventType:     videov1.EventType_EVENT_TYPE_VIDEO_UPDATED,
+			AggregateId:   videoID.String(),
+			AggregateType: "video",
+			OccurredAt:    at.Format(time.RFC3339Nano),
+			Payload: &videov1.Event_Updated{Updated: &videov1.Event_VideoUpdated{
+				VideoId: videoID.String(),
+				Title:   optionalString(title),
+			}},
+		}
+	}
+	created := &videov1.Event{
+		EventId:       uuid.NewString(),
+		EventType:     videov1.EventType_EVENT_TYPE_VIDEO_CREATED,
+		AggregateId:   videoID.String(),
+		AggregateType: "video",
+		Version:       1,
+		OccurredAt:    occurredAt.Format(time.RFC3339Nano),
+		Payload: &videov1.Event_Created{Created: &videov1.Event_VideoCreated{
+			VideoId: videoID.String(),
+			Title:   "Original Title",
+			Version: 1,
+			Status:  "published",
+		}},
+	}
+
+	// 后发生的事件先到达，回放时仍按 occurred_at 顺序应用。
+	stub := &stubSubscriber{messages: []*gcpubsub.Message{
+		buildMessage(t, unversioned("Second Title", occurredAt.Add(2*time.Minute))),
+		buildMessage(t, unversioned("First Title", occurredAt.Add(time.Minute))),
+	}}
+	cfg := outboxcfg.Config{Schema: "profile", Inbox: outboxcfg.InboxConfig{SourceService: "catalog", MaxConcurrency: 1}}
+	task := cataloginbox.NewTask(stub, inboxRepo, projectionRepo, pendingRepo, manager, logger, cfg.Inbox)
+	require.NotNil(t, task)
+	require.NoError(t, task.Run(ctx))
+
+	parked, err := pendingRepo.ListByVideo(ctx, nil, videoID)
+	require.NoError(t, err)
+	require.Len(t, parked, 2, "unversioned events must not collide on version 0")
+	require.True(t, parked[0].OccurredAt.Before(*parked[1].OccurredAt))
+
+	stub.messages = []*gcpubsub.Message{buildMessage(t, created)}
+	require.NoError(t, task.Run(ctx))
+
+	record, err := projectionRepo.Get(ctx, nil, videoID)
+	require.NoError(t, err)
+	require.Equal(t, "Second Title", record.Title)
+
+	parked, err = pendingRepo.ListByVideo(ctx, nil, videoID)
+	require.NoError(t, err)
+	require.Empty(t, parked)
+}
+
+func TestCatalogInboxTask_AdvancesSubscriptionOffsets(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dsn, terminate := startPostgres(ctx, t)
+	defer terminate()
+
+	pool, err := pgxpool.New(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { pool.Close() })
+
+	applyMigrations(ctx, t, pool)
+
+	logger := log.NewStdLogger(io.Discard)
+	inboxRepo := repositories.NewInboxRepository(pool, logger, outboxcfg.Config{Schema: "profile"})
+	projectionRepo := repositories.NewProfileVideoProjectionRepository(pool, logger)
+	offsetsRepo := repositories.NewSubscriptionOffsetsRepository(pool, logger)
+	manager, err := txmanager.NewManager(pool, txmanager.Config{}, txmanager.Dependencies{Logger: logger})
+	require.NoError(t, err)
+
+	base := time.Now().UTC().Truncate(time.Millisecond)
+	newer := &videov1.Event{
+		EventId:       uuid.NewString(),
+		EventType:     videov1.EventType_EVENT_TYPE_VIDEO_CREATED,
+		AggregateId:   uuid.NewString(),
+		AggregateType: "video",
+		Version:       1,
+		OccurredAt:    base.Format(time.RFC3339Nano),
+		Payload:       &videov1.Event_Created{Created: &videov1.Event_VideoCreated{Title: "Newer", Version: 1}},
+	}
+	older := &videov1.Event{
+		EventId:       uuid.NewString(),
+		EventType:     videov1.EventType_EVENT_TYPE_VIDEO_CREATED,
+		AggregateId:   uuid.NewString(),
+		AggregateType: "video",
+		Version:       1,
+		OccurredAt:    base.Add(-time.Hour).Format(time.RFC3339Nano),
+		Payload:       &videov1.Event_Created{Created: &videov1.Event_VideoCreated{Title: "Older", Version: 1}},
+	}
+
+	newerMsg := buildMessage(t, newer)
+	newerMsg.PublishTime = base.Add(time.Second)
+	olderMsg := buildMessage(t, older)
+	olderMsg.PublishTime = base.Add(-time.Hour)
+
+	stub := &stubSubscriber{messages: []*gcpubsub.Message{newerMsg, olderMsg}}
+	cfg := outboxcfg.Config{Schema: "profile", Inbox: outboxcfg.InboxConfig{SourceService: "catalog", MaxConcurrency: 1}}
+	task := cataloginbox.NewTask(stub, inboxRepo, projectionRepo, nil, manager, logger, cfg.Inbox,
+		cataloginbox.WithSubscriptionOffsets(offsetsRepo, "profile.catalog-video-events"))
+	require.NotNil(t, task)
+	require.NoError(t, task.Run(ctx))
+
+	offset, err := offsetsRepo.Get(ctx, nil, cataloginbox.ConsumerGroup)
+	require.NoError(t, err)
+	require.Equal(t, "profile.catalog-video-events", offset.Subscription)
+	require.NotNil(t, offset.PublishTime)
+	require.True(t, newerMsg.PublishTime.Equal(*offset.PublishTime))
+	require.Equal(t, newerMsg.ID, deref(offset.MessageID))
+	require.NotNil(t, offset.LastOccurredAt)
+	require.True(t, base.Equal(*offset.LastOccurredAt))
+	require.NotNil(t, offset.LastEventID)
+	require.Equal(t, newer.GetEventId(), offset.LastEventID.String())
+}
+
 // stubSubscriber delivers queued messages synchronously.
 type stubSubscriber struct {
 	messages []*gcpubsub.Message
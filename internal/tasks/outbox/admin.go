@@ -0,0 +1,148 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// DefaultAdminListLimit 为运维列表的默认返回条数。
+const DefaultAdminListLimit int32 = 50
+
+// AdminListOptions 描述运维列表的过滤条件。
+type AdminListOptions struct {
+	// Status 取 repositories.OutboxStatus* 常量，空值表示全部。
+	Status string
+	// MinAttempts 仅返回投递次数不少于该值的事件。
+	MinAttempts int32
+	// Limit <=0 时使用 DefaultAdminListLimit。
+	Limit int32
+}
+
+// EventDetail 为单个事件的详情；事件位于发布队列时 Event 非空，已移入死信时 DeadLetter 非空。
+type EventDetail struct {
+	Event      *repositories.OutboxEvent
+	DeadLetter *po.OutboxDeadLetter
+}
+
+// Admin 为运维 CLI 提供 Outbox 事件的查看、重试、死信与清理操作。
+type Admin struct {
+	repo      *repositories.OutboxRepository
+	retention RetentionPolicy
+	log       *log.Helper
+}
+
+// NewAdmin 构造运维入口；仓储缺失时返回 nil。
+func NewAdmin(repo *repositories.OutboxRepository, retention RetentionPolicy, logger log.Logger) *Admin {
+	if repo == nil {
+		return nil
+	}
+	return &Admin{repo: repo, retention: retention, log: log.NewHelper(logger)}
+}
+
+// Retention 返回配置中的保留期策略，供 CLI 作为默认值。
+func (a *Admin) Retention() RetentionPolicy {
+	return a.retention
+}
+
+// List 列出 outbox_events 中的事件。
+func (a *Admin) List(ctx context.Context, opts AdminListOptions) ([]*repositories.OutboxEvent, error) {
+	return a.repo.List(ctx, nil, repositories.ListOutboxEventsInput{
+		Status:      opts.Status,
+		MinAttempts: opts.MinAttempts,
+		Limit:       listLimit(opts.Limit),
+	})
+}
+
+// ListDeadLetters 列出死信事件。
+func (a *Admin) ListDeadLetters(ctx context.Context, limit int32) ([]*po.OutboxDeadLetter, error) {
+	return a.repo.ListDeadLetters(ctx, nil, listLimit(limit))
+}
+
+// Show 依次在发布队列与死信表中查找事件；均不存在时返回 repositories.ErrOutboxEventNotFound。
+func (a *Admin) Show(ctx context.Context, eventID uuid.UUID) (*EventDetail, error) {
+	event, err := a.repo.Get(ctx, nil, eventID)
+	if err == nil {
+		return &EventDetail{Event: event}, nil
+	}
+	if !errors.Is(err, repositories.ErrOutboxEventNotFound) {
+		return nil, err
+	}
+	deadLetter, err := a.repo.GetDeadLetter(ctx, nil, eventID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrOutboxDeadLetterNotFound) {
+			return nil, repositories.ErrOutboxEventNotFound
+		}
+		return nil, err
+	}
+	return &EventDetail{DeadLetter: deadLetter}, nil
+}
+
+// Retry 让事件在下一轮发布时立即投递：未发布事件释放租约并重置 available_at，死信事件移回发布队列。
+// requeued 表示事件来自死信表。事件不存在或已发布时返回 repositories.ErrOutboxEventNotFound。
+func (a *Admin) Retry(ctx context.Context, eventID uuid.UUID, resetAttempts bool) (requeued bool, err error) {
+	err = a.repo.Retry(ctx, nil, eventID, resetAttempts)
+	if err == nil {
+		a.log.WithContext(ctx).Infow("msg", "outbox event retried", "event_id", eventID, "reset_attempts", resetAttempts)
+		return false, nil
+	}
+	if !errors.Is(err, repositories.ErrOutboxEventNotFound) {
+		return false, err
+	}
+	if err := a.repo.RequeueDeadLetter(ctx, nil, eventID, resetAttempts); err != nil {
+		if errors.Is(err, repositories.ErrOutboxDeadLetterNotFound) {
+			return false, repositories.ErrOutboxEventNotFound
+		}
+		return false, err
+	}
+	a.log.WithContext(ctx).Infow("msg", "outbox dead letter requeued", "event_id", eventID, "reset_attempts", resetAttempts)
+	return true, nil
+}
+
+// DeadLetter 将未发布事件移入死信表，发布器不再重试。
+func (a *Admin) DeadLetter(ctx context.Context, eventID uuid.UUID, reason, operator string) error {
+	if err := a.repo.DeadLetter(ctx, nil, eventID, optionalString(reason), optionalString(operator)); err != nil {
+		return err
+	}
+	a.log.WithContext(ctx).Infow("msg", "outbox event dead-lettered", "event_id", eventID, "reason", reason, "operator", operator)
+	return nil
+}
+
+// PurgePublishedBefore 删除或归档 published_at 早于 before 的事件；mode 为空时使用配置中的清理方式。
+func (a *Admin) PurgePublishedBefore(ctx context.Context, before time.Time, mode string, batchSize int32) (int64, error) {
+	if mode == "" {
+		mode = a.retention.Mode
+	}
+	if mode == "" {
+		mode = RetentionModeDelete
+	}
+	if batchSize <= 0 {
+		batchSize = a.retention.BatchSize
+	}
+	total, err := PurgePublishedBefore(ctx, a.repo, before.UTC(), mode, batchSize)
+	if err != nil {
+		return total, fmt.Errorf("purge published outbox events: %w", err)
+	}
+	a.log.WithContext(ctx).Infow("msg", "outbox published events purged", "mode", mode, "rows", total, "published_before", before.UTC())
+	return total, nil
+}
+
+func listLimit(limit int32) int32 {
+	if limit <= 0 {
+		return DefaultAdminListLimit
+	}
+	return limit
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
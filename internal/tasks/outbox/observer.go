@@ -0,0 +1,177 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	outboxcfg "github.com/bionicotaku/lingo-utils/outbox/config"
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	noopmetric "go.opentelemetry.io/otel/metric/noop"
+)
+
+// DefaultObserverInterval 为两次积压统计之间的默认间隔。
+const DefaultObserverInterval = 15 * time.Second
+
+// ObserverOptions 控制积压统计的判定阈值。
+type ObserverOptions struct {
+	// Interval 为统计间隔，<=0 时使用 DefaultObserverInterval。
+	Interval time.Duration
+	// MaxAttempts 为发布器的最大投递次数，投递次数达到该值的事件计入超限；<=0 表示不限次数。
+	MaxAttempts int
+	// LockTTL 为发布器租约时长，locked_at 早于 now-LockTTL 的未发布事件计入租约过期。
+	LockTTL time.Duration
+}
+
+// BacklogReport 为单次统计的结果。
+type BacklogReport struct {
+	ObservedAt time.Time
+	repositories.OutboxBacklogStats
+	// Lag 为最早一条未发布事件自 available_at 起的等待时长，无积压或尚未到期时为 0。
+	Lag time.Duration
+}
+
+// Observer 周期性统计 outbox_events 的积压并以 OTel gauge 导出，补足发布器自身只有发布计数的不足。
+type Observer struct {
+	repo    *repositories.OutboxRepository
+	opts    ObserverOptions
+	log     *log.Helper
+	metrics *observerMetrics
+	clock   func() time.Time
+}
+
+// NewObserver 构造积压观察器；仓储缺失时返回 nil。
+func NewObserver(repo *repositories.OutboxRepository, opts ObserverOptions, logger log.Logger) *Observer {
+	if repo == nil {
+		return nil
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultObserverInterval
+	}
+	return &Observer{
+		repo:    repo,
+		opts:    opts,
+		log:     log.NewHelper(logger),
+		metrics: newObserverMetrics(),
+		clock:   time.Now,
+	}
+}
+
+// ProvideObserver 供 Wire 使用，阈值取自 messaging.outbox；metrics_enabled=false 时返回 nil。
+func ProvideObserver(repo *repositories.OutboxRepository, cfg outboxcfg.Config, logger log.Logger) *Observer {
+	publisher := cfg.Normalize().Publisher
+	if !boolValue(publisher.MetricsEnabled, true) {
+		return nil
+	}
+	return NewObserver(repo, ObserverOptions{
+		MaxAttempts: publisher.MaxAttempts,
+		LockTTL:     publisher.LockTTL,
+	}, logger)
+}
+
+// WithClock 提供测试替换时间。
+func (o *Observer) WithClock(fn func() time.Time) {
+	if o == nil || fn == nil {
+		return
+	}
+	o.clock = fn
+}
+
+// Run 按间隔循环统计直至 ctx 取消；单次统计失败只记录日志，不中断循环。
+func (o *Observer) Run(ctx context.Context) error {
+	if o == nil {
+		return nil
+	}
+	ticker := time.NewTicker(o.opts.Interval)
+	defer ticker.Stop()
+	for {
+		if _, err := o.Observe(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			o.log.WithContext(ctx).Warnw("msg", "outbox backlog observe failed", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Observe 执行一次统计并刷新指标。
+func (o *Observer) Observe(ctx context.Context) (BacklogReport, error) {
+	now := o.clock().UTC()
+	report := BacklogReport{ObservedAt: now}
+
+	maxAttempts := int32(math.MaxInt32)
+	if o.opts.MaxAttempts > 0 && o.opts.MaxAttempts < math.MaxInt32 {
+		maxAttempts = int32(o.opts.MaxAttempts)
+	}
+	stats, err := o.repo.BacklogStats(ctx, maxAttempts, now.Add(-o.opts.LockTTL))
+	if err != nil {
+		return report, err
+	}
+	report.OutboxBacklogStats = stats
+	if stats.OldestAvailableAt != nil && now.After(*stats.OldestAvailableAt) {
+		report.Lag = now.Sub(*stats.OldestAvailableAt)
+	}
+
+	o.metrics.record(ctx, report)
+	return report, nil
+}
+
+type observerMetrics struct {
+	pending         metric.Int64Gauge
+	lag             metric.Float64Gauge
+	overMaxAttempts metric.Int64Gauge
+	leaseExpired    metric.Int64Gauge
+	enabled         bool
+}
+
+func newObserverMetrics() *observerMetrics {
+	provider := otel.GetMeterProvider()
+	if provider == nil {
+		provider = noopmetric.NewMeterProvider()
+	}
+	meter := provider.Meter("lingo-services-profile.outbox")
+
+	pending, err := meter.Int64Gauge("profile_outbox_pending_events",
+		metric.WithDescription("Number of unpublished outbox events"))
+	if err != nil {
+		return &observerMetrics{}
+	}
+	lag, err := meter.Float64Gauge("profile_outbox_lag_seconds",
+		metric.WithDescription("Age of the oldest unpublished outbox event since available_at"), metric.WithUnit("s"))
+	if err != nil {
+		return &observerMetrics{}
+	}
+	overMaxAttempts, err := meter.Int64Gauge("profile_outbox_over_max_attempts_events",
+		metric.WithDescription("Number of unpublished outbox events whose delivery attempts reached max_attempts"))
+	if err != nil {
+		return &observerMetrics{}
+	}
+	leaseExpired, err := meter.Int64Gauge("profile_outbox_lease_expired_events",
+		metric.WithDescription("Number of unpublished outbox events holding a lease older than lock_ttl"))
+	if err != nil {
+		return &observerMetrics{}
+	}
+	return &observerMetrics{
+		pending:         pending,
+		lag:             lag,
+		overMaxAttempts: overMaxAttempts,
+		leaseExpired:    leaseExpired,
+		enabled:         true,
+	}
+}
+
+func (m *observerMetrics) record(ctx context.Context, report BacklogReport) {
+	if m == nil || !m.enabled {
+		return
+	}
+	m.pending.Record(ctx, report.Pending)
+	m.lag.Record(ctx, report.Lag.Seconds())
+	m.overMaxAttempts.Record(ctx, report.OverMaxAttempts)
+	m.leaseExpired.Record(ctx, report.LeaseExpired)
+}
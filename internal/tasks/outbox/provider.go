@@ -13,15 +13,15 @@ import (
 	noopmetric "go.opentelemetry.io/otel/metric/noop"
 )
 
-// ProvideRunner 将共享仓储与 Pub/Sub 发布器包装为 Outbox Runner。
+// ProvideRunner 将共享仓储与按 event_type 分发的路由发布器包装为 Outbox Runner。
 func ProvideRunner(
 	repo *repositories.OutboxRepository,
-	publisher gcpubsub.Publisher,
+	router *Router,
 	pubCfg gcpubsub.Config,
 	cfg outboxcfg.Config,
 	logger log.Logger,
 ) *outboxpublisher.Runner {
-	if repo == nil || logger == nil {
+	if repo == nil || router == nil || logger == nil {
 		return nil
 	}
 	helper := log.NewHelper(logger)
@@ -47,7 +47,7 @@ func ProvideRunner(
 
 	runner, err := outboxpublisher.NewRunner(outboxpublisher.RunnerParams{
 		Store:     repo.Shared(),
-		Publisher: publisher,
+		Publisher: router,
 		Config:    pubCfgNormalized,
 		Logger:    logger,
 		Meter:     meterProvider.Meter("lingo-services-profile.outbox"),
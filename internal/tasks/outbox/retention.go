@@ -0,0 +1,189 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	noopmetric "go.opentelemetry.io/otel/metric/noop"
+)
+
+// 已发布事件的清理方式。
+const (
+	// RetentionModeDelete 直接删除过期的已发布事件。
+	RetentionModeDelete = "delete"
+	// RetentionModeArchive 将过期的已发布事件移入 profile.outbox_events_archive。
+	RetentionModeArchive = "archive"
+)
+
+const (
+	// DefaultRetentionInterval 为两次清理之间的默认间隔。
+	DefaultRetentionInterval = 10 * time.Minute
+	// DefaultRetentionBatchSize 为单批删除或归档的默认行数。
+	DefaultRetentionBatchSize int32 = 1000
+)
+
+// RetentionPolicy 控制已发布 Outbox 事件的保留期清理。
+type RetentionPolicy struct {
+	// MaxAge 为已发布事件的保留时长，<=0 时不启动清理。
+	MaxAge time.Duration
+	// Mode 取 RetentionModeDelete 或 RetentionModeArchive，空值按 delete 处理。
+	Mode string
+	// Interval 为清理周期，<=0 时使用 DefaultRetentionInterval。
+	Interval time.Duration
+	// BatchSize 为单批处理行数，<=0 时使用 DefaultRetentionBatchSize。
+	BatchSize int32
+}
+
+// RetentionWorker 周期性删除或归档 published_at 早于保留期的 Outbox 事件，避免 outbox_events 无限增长。
+type RetentionWorker struct {
+	repo    *repositories.OutboxRepository
+	policy  RetentionPolicy
+	log     *log.Helper
+	metrics *retentionMetrics
+	clock   func() time.Time
+}
+
+// NewRetentionWorker 构造清理任务；仓储缺失或未配置保留期时返回 nil。
+func NewRetentionWorker(repo *repositories.OutboxRepository, policy RetentionPolicy, logger log.Logger) *RetentionWorker {
+	if repo == nil || policy.MaxAge <= 0 {
+		return nil
+	}
+	if policy.Interval <= 0 {
+		policy.Interval = DefaultRetentionInterval
+	}
+	if policy.BatchSize <= 0 {
+		policy.BatchSize = DefaultRetentionBatchSize
+	}
+	if policy.Mode == "" {
+		policy.Mode = RetentionModeDelete
+	}
+	return &RetentionWorker{
+		repo:    repo,
+		policy:  policy,
+		log:     log.NewHelper(logger),
+		metrics: newRetentionMetrics(),
+		clock:   time.Now,
+	}
+}
+
+// ProvideRetentionWorker 供 Wire 使用，未配置 messaging.outbox.retention.max_age 时返回 nil。
+func ProvideRetentionWorker(repo *repositories.OutboxRepository, policy RetentionPolicy, logger log.Logger) *RetentionWorker {
+	worker := NewRetentionWorker(repo, policy, logger)
+	if worker == nil && logger != nil {
+		log.NewHelper(logger).Info("outbox retention disabled (messaging.outbox.retention.max_age not configured)")
+	}
+	return worker
+}
+
+// WithClock 提供测试替换时间。
+func (w *RetentionWorker) WithClock(fn func() time.Time) {
+	if w == nil || fn == nil {
+		return
+	}
+	w.clock = fn
+}
+
+// Run 按间隔循环清理直至 ctx 取消；单次清理失败只记录日志，不中断循环。
+func (w *RetentionWorker) Run(ctx context.Context) error {
+	if w == nil {
+		return nil
+	}
+	if err := validateRetentionMode(w.policy.Mode); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(w.policy.Interval)
+	defer ticker.Stop()
+	for {
+		if _, err := w.Sweep(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			w.log.WithContext(ctx).Warnw("msg", "outbox retention sweep failed", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sweep 执行一次清理，返回删除或归档的行数。
+func (w *RetentionWorker) Sweep(ctx context.Context) (int64, error) {
+	cutoff := w.clock().UTC().Add(-w.policy.MaxAge)
+	total, err := PurgePublishedBefore(ctx, w.repo, cutoff, w.policy.Mode, w.policy.BatchSize)
+	w.metrics.record(ctx, w.policy.Mode, total)
+	if total > 0 {
+		w.log.WithContext(ctx).Infow("msg", "outbox retention sweep completed", "mode", w.policy.Mode, "rows", total, "published_before", cutoff)
+	}
+	return total, err
+}
+
+// PurgePublishedBefore 分批删除或归档 published_at 早于 before 的事件，直至没有剩余行；返回已处理的行数。
+// 每批在单条语句内完成，中途失败时已处理的批次不会回滚。
+func PurgePublishedBefore(ctx context.Context, repo *repositories.OutboxRepository, before time.Time, mode string, batchSize int32) (int64, error) {
+	if err := validateRetentionMode(mode); err != nil {
+		return 0, err
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultRetentionBatchSize
+	}
+	purge := repo.DeletePublishedBefore
+	if mode == RetentionModeArchive {
+		purge = repo.ArchivePublishedBefore
+	}
+
+	var total int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+		affected, err := purge(ctx, nil, before, batchSize)
+		if err != nil {
+			return total, err
+		}
+		total += affected
+		if affected < int64(batchSize) {
+			return total, nil
+		}
+	}
+}
+
+func validateRetentionMode(mode string) error {
+	switch mode {
+	case RetentionModeDelete, RetentionModeArchive:
+		return nil
+	default:
+		return fmt.Errorf("outbox retention: unsupported mode %q", mode)
+	}
+}
+
+type retentionMetrics struct {
+	purged  metric.Int64Counter
+	enabled bool
+}
+
+func newRetentionMetrics() *retentionMetrics {
+	provider := otel.GetMeterProvider()
+	if provider == nil {
+		provider = noopmetric.NewMeterProvider()
+	}
+	meter := provider.Meter("lingo-services-profile.outbox")
+	purged, err := meter.Int64Counter("profile_outbox_retention_rows_total",
+		metric.WithDescription("Number of published outbox events removed by retention, by mode"))
+	if err != nil {
+		return &retentionMetrics{}
+	}
+	return &retentionMetrics{purged: purged, enabled: true}
+}
+
+func (m *retentionMetrics) record(ctx context.Context, mode string, rows int64) {
+	if m == nil || !m.enabled || rows <= 0 {
+		return
+	}
+	m.purged.Add(ctx, rows, metric.WithAttributes(attribute.String("mode", mode)))
+}
@@ -0,0 +1,250 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/eventbus"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	"github.com/bionicotaku/lingo-utils/gcpubsub"
+	"github.com/bionicotaku/lingo-utils/txmanager"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	noopmetric "go.opentelemetry.io/otel/metric/noop"
+)
+
+// DefaultTopic 为未命中任何路由规则时使用的 topic key。
+const DefaultTopic = "default"
+
+// eventTypeAttribute 为消息属性中的事件类型键，写入 Outbox 时随 headers 保存。
+const eventTypeAttribute = "event_type"
+
+// Route 将匹配 EventType 模式的事件发布到 Topic。模式语法同 path.Match，* 匹配任意字符。
+type Route struct {
+	EventType string
+	Topic     string
+}
+
+// RoutingConfig 描述 Outbox 事件的 topic 路由。
+type RoutingConfig struct {
+	// DefaultTopic 为未命中规则时的 topic key，对应 Wire 注入的默认发布器。
+	DefaultTopic string
+	// Routes 按顺序匹配，首条命中的规则生效。
+	Routes []Route
+	// Topics 为 messaging.topics 中各 topic key 的 Pub/Sub 配置。
+	Topics map[string]gcpubsub.Config
+}
+
+// EventLookup 按 event_id 读取 Outbox 事件，用于消息属性中缺少 event_type 时确定路由。
+type EventLookup interface {
+	Get(ctx context.Context, sess txmanager.Session, eventID uuid.UUID) (*repositories.OutboxEvent, error)
+}
+
+// Router 实现 eventbus.Publisher，按 event_type 将共享 Runner 发出的消息分发到各 topic 的发布器。
+type Router struct {
+	routes       []Route
+	defaultTopic string
+	publishers   map[string]eventbus.Publisher
+	lookup       EventLookup
+	log          *log.Helper
+	metrics      *routerMetrics
+}
+
+// NewRouter 构造路由发布器；规则引用的 topic 缺少发布器或模式非法时返回错误。
+func NewRouter(cfg RoutingConfig, publishers map[string]eventbus.Publisher, lookup EventLookup, logger log.Logger) (*Router, error) {
+	defaultTopic := cfg.DefaultTopic
+	if defaultTopic == "" {
+		defaultTopic = DefaultTopic
+	}
+	for _, route := range cfg.Routes {
+		if route.EventType == "" || route.Topic == "" {
+			return nil, fmt.Errorf("outbox router: route requires event_type and topic, got %+v", route)
+		}
+		if _, err := path.Match(route.EventType, ""); err != nil {
+			return nil, fmt.Errorf("outbox router: invalid event_type pattern %q: %w", route.EventType, err)
+		}
+		if publishers[route.Topic] == nil {
+			return nil, fmt.Errorf("outbox router: no publisher for topic %q", route.Topic)
+		}
+	}
+	return &Router{
+		routes:       append([]Route(nil), cfg.Routes...),
+		defaultTopic: defaultTopic,
+		publishers:   publishers,
+		lookup:       lookup,
+		log:          log.NewHelper(logger),
+		metrics:      newRouterMetrics(),
+	}, nil
+}
+
+// ProvideRouter 供 Wire 使用：默认 topic 复用已注入的发布器，规则引用的其他 topic 经事件总线按当前驱动创建发布器。
+func ProvideRouter(
+	ctx context.Context,
+	cfg RoutingConfig,
+	defaultPublisher eventbus.Publisher,
+	bus *eventbus.Bus,
+	repo *repositories.OutboxRepository,
+	logger log.Logger,
+) (*Router, func(), error) {
+	if cfg.DefaultTopic == "" {
+		cfg.DefaultTopic = DefaultTopic
+	}
+	publishers := map[string]eventbus.Publisher{}
+	if defaultPublisher != nil {
+		publishers[cfg.DefaultTopic] = defaultPublisher
+	}
+
+	var cleanups []func()
+	cleanup := func() {
+		for i := len(cleanups) - 1; i >= 0; i-- {
+			cleanups[i]()
+		}
+	}
+	for _, topic := range routedTopics(cfg.Routes) {
+		if _, ok := publishers[topic]; ok {
+			continue
+		}
+		topicCfg, ok := cfg.Topics[topic]
+		if !ok || topicCfg.TopicID == "" {
+			cleanup()
+			return nil, nil, fmt.Errorf("outbox router: topic %q not configured in messaging.topics", topic)
+		}
+		publisher, publisherCleanup, err := bus.NewPublisher(ctx, topicCfg)
+		if err != nil {
+			cleanup()
+			return nil, nil, fmt.Errorf("outbox router: init topic %q: %w", topic, err)
+		}
+		cleanups = append(cleanups, publisherCleanup)
+		publishers[topic] = publisher
+	}
+
+	var lookup EventLookup
+	if repo != nil {
+		lookup = repo
+	}
+	router, err := NewRouter(cfg, publishers, lookup, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	if len(cfg.Routes) > 0 {
+		log.NewHelper(logger).Infof("init outbox router: routes=%d, topics=%v", len(cfg.Routes), router.Topics())
+	}
+	return router, cleanup, nil
+}
+
+// Resolve 返回事件类型对应的 topic key。
+func (r *Router) Resolve(eventType string) string {
+	for _, route := range r.routes {
+		if matched, _ := path.Match(route.EventType, eventType); matched {
+			return route.Topic
+		}
+	}
+	return r.defaultTopic
+}
+
+// Topics 返回已配置发布器的 topic key，按字典序排列。
+func (r *Router) Topics() []string {
+	topics := make([]string, 0, len(r.publishers))
+	for topic := range r.publishers {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+	return topics
+}
+
+// Publish 按 event_type 选择 topic 并发布；事件类型无法确定或 topic 缺少发布器时返回错误，由 Runner 重新调度。
+func (r *Router) Publish(ctx context.Context, msg eventbus.Message) (string, error) {
+	eventType, err := r.eventType(ctx, msg)
+	if err != nil {
+		return "", err
+	}
+	topic := r.Resolve(eventType)
+	publisher := r.publishers[topic]
+	if publisher == nil {
+		r.metrics.record(ctx, topic, 0, errNoPublisher)
+		return "", fmt.Errorf("outbox router: no publisher for topic %q (event_type %q)", topic, eventType)
+	}
+
+	start := time.Now()
+	id, err := publisher.Publish(ctx, msg)
+	r.metrics.record(ctx, topic, time.Since(start), err)
+	return id, err
+}
+
+var errNoPublisher = errors.New("no publisher")
+
+// eventType 优先读取消息属性；早于路由上线写入的事件没有该属性，回查 outbox_events。
+func (r *Router) eventType(ctx context.Context, msg eventbus.Message) (string, error) {
+	if eventType := msg.Attributes[eventTypeAttribute]; eventType != "" {
+		return eventType, nil
+	}
+	eventID := uuid.UUID(msg.EventID)
+	if r.lookup == nil || eventID == uuid.Nil {
+		return "", nil
+	}
+	event, err := r.lookup.Get(ctx, nil, eventID)
+	if err != nil {
+		return "", fmt.Errorf("outbox router: resolve event_type for %s: %w", eventID, err)
+	}
+	return event.EventType, nil
+}
+
+func routedTopics(routes []Route) []string {
+	seen := make(map[string]struct{}, len(routes))
+	topics := make([]string, 0, len(routes))
+	for _, route := range routes {
+		if _, ok := seen[route.Topic]; ok {
+			continue
+		}
+		seen[route.Topic] = struct{}{}
+		topics = append(topics, route.Topic)
+	}
+	return topics
+}
+
+type routerMetrics struct {
+	published metric.Int64Counter
+	latency   metric.Float64Histogram
+	enabled   bool
+}
+
+func newRouterMetrics() *routerMetrics {
+	provider := otel.GetMeterProvider()
+	if provider == nil {
+		provider = noopmetric.NewMeterProvider()
+	}
+	meter := provider.Meter("lingo-services-profile.outbox")
+	published, err := meter.Int64Counter("profile_outbox_topic_published_total",
+		metric.WithDescription("Number of outbox publish attempts per topic, by result"))
+	if err != nil {
+		return &routerMetrics{}
+	}
+	latency, err := meter.Float64Histogram("profile_outbox_topic_publish_latency_ms",
+		metric.WithDescription("Outbox publish latency per topic"), metric.WithUnit("ms"))
+	if err != nil {
+		return &routerMetrics{}
+	}
+	return &routerMetrics{published: published, latency: latency, enabled: true}
+}
+
+func (m *routerMetrics) record(ctx context.Context, topic string, elapsed time.Duration, err error) {
+	if m == nil || !m.enabled {
+		return
+	}
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	m.published.Add(ctx, 1, metric.WithAttributes(attribute.String("topic", topic), attribute.String("result", result)))
+	if !errors.Is(err, errNoPublisher) {
+		m.latency.Record(ctx, float64(elapsed.Microseconds())/1000, metric.WithAttributes(attribute.String("topic", topic)))
+	}
+}
@@ -0,0 +1,174 @@
+package outbox_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	outboxtasks "github.com/bionicotaku/lingo-services-profile/internal/tasks/outbox"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxAdmin_RetryAndDeadLetter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dsn, terminate := startPostgres(ctx, t)
+	defer terminate()
+
+	pool, err := pgxpool.New(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { pool.Close() })
+	applyMigrations(ctx, t, pool)
+
+	logger := log.NewStdLogger(io.Discard)
+	repo := repositories.NewOutboxRepository(pool, logger, defaultOutboxConfig)
+	admin := outboxtasks.NewAdmin(repo, outboxtasks.RetentionPolicy{}, logger)
+
+	eventID := enqueueTestEvent(ctx, t, repo)
+	_, err = pool.Exec(ctx, `
+		UPDATE profile.outbox_events
+		SET delivery_attempts = 5, last_error = 'topic not found', available_at = now() + interval '1 hour',
+		    lock_token = 'stale', locked_at = now()
+		WHERE event_id = $1`, eventID)
+	require.NoError(t, err)
+
+	failing, err := admin.List(ctx, outboxtasks.AdminListOptions{Status: repositories.OutboxStatusFailing, MinAttempts: 5})
+	require.NoError(t, err)
+	require.Len(t, failing, 1)
+	require.Equal(t, "topic not found", *failing[0].LastError)
+
+	requeued, err := admin.Retry(ctx, eventID, true)
+	require.NoError(t, err)
+	require.False(t, requeued)
+	detail, err := admin.Show(ctx, eventID)
+	require.NoError(t, err)
+	require.NotNil(t, detail.Event)
+	require.Zero(t, detail.Event.DeliveryAttempts)
+	require.Nil(t, detail.Event.LockToken)
+	require.False(t, detail.Event.AvailableAt.After(time.Now().Add(time.Minute)))
+	require.Equal(t, "v1", detail.Event.Headers["schema_version"])
+
+	require.NoError(t, admin.DeadLetter(ctx, eventID, "poison payload", "tester"))
+	pending, err := repo.CountPending(ctx)
+	require.NoError(t, err)
+	require.Zero(t, pending)
+	detail, err = admin.Show(ctx, eventID)
+	require.NoError(t, err)
+	require.Nil(t, detail.Event)
+	require.Equal(t, "poison payload", *detail.DeadLetter.Reason)
+	require.Equal(t, "tester", *detail.DeadLetter.DeadLetteredBy)
+
+	requeued, err = admin.Retry(ctx, eventID, false)
+	require.NoError(t, err)
+	require.True(t, requeued)
+	pending, err = repo.CountPending(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), pending)
+
+	_, err = admin.Retry(ctx, uuid.New(), false)
+	require.ErrorIs(t, err, repositories.ErrOutboxEventNotFound)
+}
+
+func TestOutboxRetention_PurgesPublishedEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dsn, terminate := startPostgres(ctx, t)
+	defer terminate()
+
+	pool, err := pgxpool.New(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { pool.Close() })
+	applyMigrations(ctx, t, pool)
+
+	logger := log.NewStdLogger(io.Discard)
+	repo := repositories.NewOutboxRepository(pool, logger, defaultOutboxConfig)
+
+	now := time.Now().UTC()
+	publish := func(eventID uuid.UUID, publishedAt time.Time) {
+		_, err := pool.Exec(ctx, `UPDATE profile.outbox_events SET published_at = $2 WHERE event_id = $1`, eventID, publishedAt)
+		require.NoError(t, err)
+	}
+	var expired []uuid.UUID
+	for i := 0; i < 3; i++ {
+		id := enqueueTestEvent(ctx, t, repo)
+		publish(id, now.Add(-48*time.Hour))
+		expired = append(expired, id)
+	}
+	recent := enqueueTestEvent(ctx, t, repo)
+	publish(recent, now.Add(-time.Hour))
+	unpublished := enqueueTestEvent(ctx, t, repo)
+
+	// 模拟此前已归档但未从 outbox 删除的事件：归档写入被忽略，仍应计入移出数量，避免分批循环提前结束。
+	_, err = pool.Exec(ctx, `
+		INSERT INTO profile.outbox_events_archive (
+			event_id, aggregate_type, aggregate_id, event_type, payload, headers,
+			occurred_at, published_at, delivery_attempts
+		)
+		SELECT event_id, aggregate_type, aggregate_id, event_type, payload, headers,
+		       occurred_at, published_at, delivery_attempts
+		FROM profile.outbox_events
+		WHERE event_id = $1`, expired[0])
+	require.NoError(t, err)
+
+	worker := outboxtasks.NewRetentionWorker(repo, outboxtasks.RetentionPolicy{
+		MaxAge:    24 * time.Hour,
+		Mode:      outboxtasks.RetentionModeArchive,
+		BatchSize: 2,
+	}, logger)
+	require.NotNil(t, worker)
+	worker.WithClock(func() time.Time { return now })
+
+	rows, err := worker.Sweep(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), rows)
+
+	var archived int
+	require.NoError(t, pool.QueryRow(ctx,
+		`SELECT count(*) FROM profile.outbox_events_archive WHERE event_id = ANY($1)`, expired).Scan(&archived))
+	require.Equal(t, 3, archived)
+
+	remaining, err := repo.List(ctx, nil, repositories.ListOutboxEventsInput{Limit: 10})
+	require.NoError(t, err)
+	ids := make([]uuid.UUID, 0, len(remaining))
+	for _, event := range remaining {
+		ids = append(ids, event.EventID)
+	}
+	require.ElementsMatch(t, []uuid.UUID{recent, unpublished}, ids)
+
+	admin := outboxtasks.NewAdmin(repo, outboxtasks.RetentionPolicy{Mode: outboxtasks.RetentionModeDelete}, logger)
+	rows, err = admin.PurgePublishedBefore(ctx, now, "", 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+	_, err = repo.Get(ctx, nil, recent)
+	require.ErrorIs(t, err, repositories.ErrOutboxEventNotFound)
+	_, err = repo.Get(ctx, nil, unpublished)
+	require.NoError(t, err)
+}
+
+func TestNewRetentionWorker_DisabledWithoutMaxAge(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, outboxtasks.NewRetentionWorker(&repositories.OutboxRepository{}, outboxtasks.RetentionPolicy{}, log.NewStdLogger(io.Discard)))
+}
+
+func enqueueTestEvent(ctx context.Context, t *testing.T, repo *repositories.OutboxRepository) uuid.UUID {
+	t.Helper()
+	eventID := uuid.New()
+	require.NoError(t, repo.Enqueue(ctx, nil, repositories.OutboxMessage{
+		EventID:       eventID,
+		AggregateType: "profile",
+		AggregateID:   uuid.New(),
+		EventType:     "profile.engagement.added",
+		Payload:       []byte(`{}`),
+		Headers:       map[string]string{"schema_version": "v1"},
+		AvailableAt:   time.Now().UTC(),
+	}))
+	return eventID
+}
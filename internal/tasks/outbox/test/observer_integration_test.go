@@ -0,0 +1,94 @@
+package outbox_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	outboxtasks "github.com/bionicotaku/lingo-services-profile/internal/tasks/outbox"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// 该测试替换全局 MeterProvider，不能并行执行。
+func TestObserver_ExportsBacklogGauges(t *testing.T) {
+	ctx := context.Background()
+	dsn, terminate := startPostgres(ctx, t)
+	defer terminate()
+
+	pool, err := pgxpool.New(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { pool.Close() })
+	applyMigrations(ctx, t, pool)
+
+	reader := sdkmetric.NewManualReader()
+	previous := otel.GetMeterProvider()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	t.Cleanup(func() { otel.SetMeterProvider(previous) })
+
+	logger := log.NewStdLogger(io.Discard)
+	repo := repositories.NewOutboxRepository(pool, logger, defaultOutboxConfig)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	oldest := enqueueTestEvent(ctx, t, repo)
+	exhausted := enqueueTestEvent(ctx, t, repo)
+	leased := enqueueTestEvent(ctx, t, repo)
+	published := enqueueTestEvent(ctx, t, repo)
+	_, err = pool.Exec(ctx, `UPDATE profile.outbox_events SET available_at = $2 WHERE event_id = $1`, oldest, now.Add(-90*time.Second))
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx, `UPDATE profile.outbox_events SET delivery_attempts = 20 WHERE event_id = $1`, exhausted)
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx, `UPDATE profile.outbox_events SET lock_token = 'stale', locked_at = $2 WHERE event_id = $1`, leased, now.Add(-10*time.Minute))
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx, `UPDATE profile.outbox_events SET published_at = $2, delivery_attempts = 30 WHERE event_id = $1`, published, now)
+	require.NoError(t, err)
+
+	observer := outboxtasks.NewObserver(repo, outboxtasks.ObserverOptions{MaxAttempts: 20, LockTTL: 2 * time.Minute}, logger)
+	require.NotNil(t, observer)
+	observer.WithClock(func() time.Time { return now })
+
+	report, err := observer.Observe(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), report.Pending)
+	require.Equal(t, int64(1), report.OverMaxAttempts)
+	require.Equal(t, int64(1), report.LeaseExpired)
+	require.Equal(t, 90*time.Second, report.Lag)
+
+	var data metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(ctx, &data))
+	require.Equal(t, []int64{3}, gaugeValues(gaugeInt64DataPoints(data, "profile_outbox_pending_events")))
+	require.Equal(t, []int64{1}, gaugeValues(gaugeInt64DataPoints(data, "profile_outbox_over_max_attempts_events")))
+	require.Equal(t, []int64{1}, gaugeValues(gaugeInt64DataPoints(data, "profile_outbox_lease_expired_events")))
+	lag := gaugeFloat64DataPoints(data, "profile_outbox_lag_seconds")
+	require.Len(t, lag, 1)
+	require.InDelta(t, 90, lag[0].Value, 0.001)
+}
+
+func gaugeFloat64DataPoints(data metricdata.ResourceMetrics, name string) []metricdata.DataPoint[float64] {
+	var out []metricdata.DataPoint[float64]
+	for _, scope := range data.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if m.Name != name {
+				continue
+			}
+			if gauge, ok := m.Data.(metricdata.Gauge[float64]); ok {
+				out = append(out, gauge.DataPoints...)
+			}
+		}
+	}
+	return out
+}
+
+func gaugeValues(points []metricdata.DataPoint[int64]) []int64 {
+	out := make([]int64, 0, len(points))
+	for _, dp := range points {
+		out = append(out, dp.Value)
+	}
+	return out
+}
@@ -0,0 +1,126 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/infrastructure/eventbus"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	outboxtasks "github.com/bionicotaku/lingo-services-profile/internal/tasks/outbox"
+	"github.com/bionicotaku/lingo-utils/txmanager"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePublisher struct {
+	mu       sync.Mutex
+	messages []eventbus.Message
+	err      error
+}
+
+func (p *fakePublisher) Publish(_ context.Context, msg eventbus.Message) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		return "", p.err
+	}
+	p.messages = append(p.messages, msg)
+	return msg.ID, nil
+}
+
+func (p *fakePublisher) eventTypes() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	out := make([]string, 0, len(p.messages))
+	for _, msg := range p.messages {
+		out = append(out, msg.Attributes["event_type"])
+	}
+	return out
+}
+
+type eventLookupStub map[uuid.UUID]string
+
+func (s eventLookupStub) Get(_ context.Context, _ txmanager.Session, eventID uuid.UUID) (*repositories.OutboxEvent, error) {
+	eventType, ok := s[eventID]
+	if !ok {
+		return nil, repositories.ErrOutboxEventNotFound
+	}
+	return &repositories.OutboxEvent{EventID: eventID, EventType: eventType}, nil
+}
+
+func newTestRouter(t *testing.T, lookup outboxtasks.EventLookup) (*outboxtasks.Router, map[string]*fakePublisher) {
+	t.Helper()
+	fakes := map[string]*fakePublisher{"default": {}, "engagement": {}, "watch": {}}
+	publishers := make(map[string]eventbus.Publisher, len(fakes))
+	for topic, fake := range fakes {
+		publishers[topic] = fake
+	}
+	router, err := outboxtasks.NewRouter(outboxtasks.RoutingConfig{
+		DefaultTopic: "default",
+		Routes: []outboxtasks.Route{
+			{EventType: "profile.engagement.*", Topic: "engagement"},
+			{EventType: "profile.watch.progressed", Topic: "watch"},
+		},
+	}, publishers, lookup, log.NewStdLogger(io.Discard))
+	require.NoError(t, err)
+	return router, fakes
+}
+
+func TestRouter_PublishesByEventType(t *testing.T) {
+	t.Parallel()
+
+	router, fakes := newTestRouter(t, nil)
+	ctx := context.Background()
+	for _, eventType := range []string{
+		"profile.engagement.added",
+		"profile.watch.progressed",
+		"profile.engagement.removed",
+		"profile.collection.item_added",
+	} {
+		_, err := router.Publish(ctx, eventbus.Message{Attributes: map[string]string{"event_type": eventType}})
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, []string{"profile.engagement.added", "profile.engagement.removed"}, fakes["engagement"].eventTypes())
+	require.Equal(t, []string{"profile.watch.progressed"}, fakes["watch"].eventTypes())
+	require.Equal(t, []string{"profile.collection.item_added"}, fakes["default"].eventTypes())
+}
+
+func TestRouter_LooksUpEventTypeWithoutAttribute(t *testing.T) {
+	t.Parallel()
+
+	eventID := uuid.New()
+	router, fakes := newTestRouter(t, eventLookupStub{eventID: "profile.watch.progressed"})
+
+	_, err := router.Publish(context.Background(), eventbus.Message{EventID: eventID})
+	require.NoError(t, err)
+	require.Len(t, fakes["watch"].messages, 1)
+
+	_, err = router.Publish(context.Background(), eventbus.Message{EventID: uuid.New()})
+	require.ErrorIs(t, err, repositories.ErrOutboxEventNotFound)
+}
+
+func TestRouter_PropagatesTopicFailure(t *testing.T) {
+	t.Parallel()
+
+	router, fakes := newTestRouter(t, nil)
+	fakes["watch"].err = errors.New("topic unavailable")
+
+	_, err := router.Publish(context.Background(), eventbus.Message{Attributes: map[string]string{"event_type": "profile.watch.progressed"}})
+	require.ErrorContains(t, err, "topic unavailable")
+	_, err = router.Publish(context.Background(), eventbus.Message{Attributes: map[string]string{"event_type": "profile.engagement.added"}})
+	require.NoError(t, err)
+}
+
+func TestNewRouter_RejectsUnknownTopic(t *testing.T) {
+	t.Parallel()
+
+	_, err := outboxtasks.NewRouter(outboxtasks.RoutingConfig{
+		Routes: []outboxtasks.Route{{EventType: "profile.watch.*", Topic: "report"}},
+	}, map[string]eventbus.Publisher{"default": &fakePublisher{}}, nil, log.NewStdLogger(io.Discard))
+	require.ErrorContains(t, err, `no publisher for topic "report"`)
+}
@@ -0,0 +1,86 @@
+package projectionstaleness
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	noopmetric "go.opentelemetry.io/otel/metric/noop"
+)
+
+// 高水位的种类，作为 profile_subscription_high_water_age_seconds 的 mark 标签。
+const (
+	markPublishTime = "publish_time"
+	markOccurredAt  = "occurred_at"
+)
+
+type stalenessMetrics struct {
+	inboxLag     metric.Float64Gauge
+	staleRows    metric.Int64Gauge
+	highWaterAge metric.Float64Gauge
+	enabled      bool
+}
+
+func newStalenessMetrics() *stalenessMetrics {
+	provider := otel.GetMeterProvider()
+	if provider == nil {
+		provider = noopmetric.NewMeterProvider()
+	}
+	meter := provider.Meter("lingo-services-profile.projection_staleness")
+
+	inboxLag, err := meter.Float64Gauge("profile_inbox_lag_seconds",
+		metric.WithDescription("Age of the oldest unprocessed inbox event"), metric.WithUnit("s"))
+	if err != nil {
+		return &stalenessMetrics{}
+	}
+	staleRows, err := meter.Int64Gauge("profile_videos_projection_stale_rows",
+		metric.WithDescription("Number of videos_projection rows not updated within the staleness threshold"))
+	if err != nil {
+		return &stalenessMetrics{}
+	}
+	highWaterAge, err := meter.Float64Gauge("profile_subscription_high_water_age_seconds",
+		metric.WithDescription("Age of the newest processed message per consumer group"), metric.WithUnit("s"))
+	if err != nil {
+		return &stalenessMetrics{}
+	}
+	return &stalenessMetrics{
+		inboxLag:     inboxLag,
+		staleRows:    staleRows,
+		highWaterAge: highWaterAge,
+		enabled:      true,
+	}
+}
+
+func (m *stalenessMetrics) record(ctx context.Context, sourceService string, report Report) {
+	if m == nil || !m.enabled {
+		return
+	}
+	m.inboxLag.Record(ctx, report.InboxLag.Seconds(), metric.WithAttributes(attribute.String("source_service", sourceService)))
+	m.staleRows.Record(ctx, report.StaleRows)
+	for _, offset := range report.Offsets {
+		if offset == nil {
+			continue
+		}
+		base := []attribute.KeyValue{
+			attribute.String("consumer_group", offset.ConsumerGroup),
+			attribute.String("subscription", offset.Subscription),
+		}
+		if offset.PublishTime != nil {
+			m.highWaterAge.Record(ctx, ageSeconds(report.CheckedAt, *offset.PublishTime),
+				metric.WithAttributes(append(base, attribute.String("mark", markPublishTime))...))
+		}
+		if offset.LastOccurredAt != nil {
+			m.highWaterAge.Record(ctx, ageSeconds(report.CheckedAt, *offset.LastOccurredAt),
+				metric.WithAttributes(append(base, attribute.String("mark", markOccurredAt))...))
+		}
+	}
+}
+
+func ageSeconds(now, mark time.Time) float64 {
+	if mark.After(now) {
+		return 0
+	}
+	return now.Sub(mark).Seconds()
+}
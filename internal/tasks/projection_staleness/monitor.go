@@ -0,0 +1,146 @@
+// Package projectionstaleness 周期性检查 videos_projection 与 Inbox 消费的新鲜度，
+// 以 OTel gauge 暴露滞后、陈旧行数与订阅高水位，供告警规则使用。
+package projectionstaleness
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/models/po"
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	outboxcfg "github.com/bionicotaku/lingo-utils/outbox/config"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// DefaultInterval 为两次检查之间的默认间隔。
+	DefaultInterval = time.Minute
+	// DefaultStaleAfter 为投影被视为陈旧的默认未更新时长。
+	DefaultStaleAfter = 7 * 24 * time.Hour
+)
+
+// Options 控制一次 Run 的行为。
+type Options struct {
+	// Interval 为检查间隔，<=0 时使用 DefaultInterval。
+	Interval time.Duration
+	// StaleAfter 为投影陈旧阈值，<=0 时使用 DefaultStaleAfter。
+	StaleAfter time.Duration
+}
+
+// Report 为单次检查的结果。
+type Report struct {
+	CheckedAt time.Time
+	// InboxLag 为最早一条未处理 Inbox 事件的等待时长，无积压时为 0。
+	InboxLag time.Duration
+	// StaleRows 为 updated_at 早于 CheckedAt-StaleAfter 的投影行数。
+	StaleRows int64
+	// Offsets 为各消费组的高水位。
+	Offsets []*po.SubscriptionOffset
+}
+
+// Monitor 读取 Inbox、投影与订阅位点并刷新新鲜度指标。
+type Monitor struct {
+	inbox         *repositories.InboxRepository
+	projections   *repositories.ProfileVideoProjectionRepository
+	offsets       *repositories.SubscriptionOffsetsRepository
+	sourceService string
+	log           *log.Helper
+	metrics       *stalenessMetrics
+	clock         func() time.Time
+}
+
+// NewMonitor 构造 Monitor；任一依赖缺失时返回 nil。
+func NewMonitor(
+	inbox *repositories.InboxRepository,
+	projections *repositories.ProfileVideoProjectionRepository,
+	offsets *repositories.SubscriptionOffsetsRepository,
+	sourceService string,
+	logger log.Logger,
+) *Monitor {
+	if inbox == nil || projections == nil || offsets == nil {
+		return nil
+	}
+	return &Monitor{
+		inbox:         inbox,
+		projections:   projections,
+		offsets:       offsets,
+		sourceService: sourceService,
+		log:           log.NewHelper(logger),
+		metrics:       newStalenessMetrics(),
+		clock:         time.Now,
+	}
+}
+
+// ProvideMonitor 供 Wire 使用，Inbox 来源服务取自 messaging.inbox.source_service。
+func ProvideMonitor(
+	inbox *repositories.InboxRepository,
+	projections *repositories.ProfileVideoProjectionRepository,
+	offsets *repositories.SubscriptionOffsetsRepository,
+	cfg outboxcfg.Config,
+	logger log.Logger,
+) *Monitor {
+	normalized := cfg.Normalize()
+	return NewMonitor(inbox, projections, offsets, normalized.Inbox.SourceService, logger)
+}
+
+// WithClock 提供测试替换时间。
+func (m *Monitor) WithClock(fn func() time.Time) {
+	if m == nil || fn == nil {
+		return
+	}
+	m.clock = fn
+}
+
+// Run 按间隔循环检查直至 ctx 取消；单次检查失败只记录日志，不中断循环。
+func (m *Monitor) Run(ctx context.Context, opts Options) error {
+	if m == nil {
+		return nil
+	}
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := m.Check(ctx, opts.StaleAfter); err != nil && !errors.Is(err, context.Canceled) {
+			m.log.WithContext(ctx).Warnw("msg", "projection staleness check failed", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check 执行一次检查并刷新指标。
+func (m *Monitor) Check(ctx context.Context, staleAfter time.Duration) (Report, error) {
+	if staleAfter <= 0 {
+		staleAfter = DefaultStaleAfter
+	}
+	now := m.clock().UTC()
+	report := Report{CheckedAt: now}
+
+	oldest, err := m.inbox.OldestPendingReceivedAt(ctx, m.sourceService)
+	if err != nil {
+		return report, err
+	}
+	if oldest != nil && now.After(*oldest) {
+		report.InboxLag = now.Sub(*oldest)
+	}
+
+	report.StaleRows, err = m.projections.CountStale(ctx, nil, now.Add(-staleAfter))
+	if err != nil {
+		return report, err
+	}
+
+	report.Offsets, err = m.offsets.List(ctx, nil)
+	if err != nil {
+		return report, err
+	}
+
+	m.metrics.record(ctx, m.sourceService, report)
+	return report, nil
+}
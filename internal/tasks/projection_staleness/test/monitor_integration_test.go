@@ -0,0 +1,186 @@
+package projectionstaleness_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/bionicotaku/lingo-services-profile/internal/repositories"
+	projectionstaleness "github.com/bionicotaku/lingo-services-profile/internal/tasks/projection_staleness"
+	outboxcfg "github.com/bionicotaku/lingo-utils/outbox/config"
+	"github.com/docker/go-connections/nat"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// 该测试替换全局 MeterProvider，不能并行执行。
+func TestMonitor_CheckReportsLagStaleRowsAndHighWaterMarks(t *testing.T) {
+	ctx := context.Background()
+	dsn, terminate := startPostgres(ctx, t)
+	defer terminate()
+
+	pool, err := pgxpool.New(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { pool.Close() })
+
+	applyMigrations(ctx, t, pool)
+
+	reader := sdkmetric.NewManualReader()
+	previous := otel.GetMeterProvider()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	t.Cleanup(func() { otel.SetMeterProvider(previous) })
+
+	logger := log.NewStdLogger(io.Discard)
+	inboxRepo := repositories.NewInboxRepository(pool, logger, outboxcfg.Config{Schema: "profile"})
+	projectionRepo := repositories.NewProfileVideoProjectionRepository(pool, logger)
+	offsetsRepo := repositories.NewSubscriptionOffsetsRepository(pool, logger)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	staleAt := now.Add(-48 * time.Hour)
+	freshAt := now.Add(-time.Minute)
+	for _, updatedAt := range []time.Time{staleAt, staleAt, freshAt} {
+		ts := updatedAt
+		require.NoError(t, projectionRepo.Upsert(ctx, nil, repositories.UpsertVideoProjectionInput{
+			VideoID:   uuid.New(),
+			Title:     "Video",
+			Version:   1,
+			UpdatedAt: &ts,
+		}))
+	}
+
+	_, err = pool.Exec(ctx, `INSERT INTO profile.inbox_events (event_id, source_service, event_type, payload, received_at)
+		VALUES ($1, 'catalog', 'catalog.video.updated', '\x00', $2), ($3, 'catalog', 'catalog.video.updated', '\x00', $4)`,
+		uuid.New(), now.Add(-90*time.Second), uuid.New(), now.Add(-10*time.Second))
+	require.NoError(t, err)
+
+	publishTime := now.Add(-30 * time.Second)
+	occurredAt := now.Add(-45 * time.Second)
+	require.NoError(t, offsetsRepo.Advance(ctx, nil, repositories.AdvanceSubscriptionOffsetInput{
+		ConsumerGroup:  "catalog_inbox",
+		Subscription:   "profile.catalog-video-events",
+		PublishTime:    &publishTime,
+		LastOccurredAt: &occurredAt,
+		UpdatedBy:      "test",
+	}))
+
+	monitor := projectionstaleness.NewMonitor(inboxRepo, projectionRepo, offsetsRepo, "catalog", logger)
+	require.NotNil(t, monitor)
+	monitor.WithClock(func() time.Time { return now })
+
+	report, err := monitor.Check(ctx, 24*time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 90*time.Second, report.InboxLag)
+	require.Equal(t, int64(2), report.StaleRows)
+	require.Len(t, report.Offsets, 1)
+
+	var data metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(ctx, &data))
+
+	lag := floatGauge(data, "profile_inbox_lag_seconds")
+	require.Len(t, lag, 1)
+	require.InDelta(t, 90, lag[0].Value, 0.001)
+
+	stale := intGauge(data, "profile_videos_projection_stale_rows")
+	require.Len(t, stale, 1)
+	require.Equal(t, int64(2), stale[0].Value)
+
+	marks := map[string]float64{}
+	for _, dp := range floatGauge(data, "profile_subscription_high_water_age_seconds") {
+		mark, _ := dp.Attributes.Value(attribute.Key("mark"))
+		marks[mark.AsString()] = dp.Value
+	}
+	require.InDelta(t, 30, marks["publish_time"], 0.001)
+	require.InDelta(t, 45, marks["occurred_at"], 0.001)
+}
+
+func floatGauge(data metricdata.ResourceMetrics, name string) []metricdata.DataPoint[float64] {
+	var out []metricdata.DataPoint[float64]
+	for _, scope := range data.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if gauge, ok := m.Data.(metricdata.Gauge[float64]); ok && m.Name == name {
+				out = append(out, gauge.DataPoints...)
+			}
+		}
+	}
+	return out
+}
+
+func intGauge(data metricdata.ResourceMetrics, name string) []metricdata.DataPoint[int64] {
+	var out []metricdata.DataPoint[int64]
+	for _, scope := range data.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if gauge, ok := m.Data.(metricdata.Gauge[int64]); ok && m.Name == name {
+				out = append(out, gauge.DataPoints...)
+			}
+		}
+	}
+	return out
+}
+
+func startPostgres(ctx context.Context, t *testing.T) (string, func()) {
+	t.Helper()
+
+	req := testcontainers.ContainerRequest{
+		Image:        "postgres:16-alpine",
+		ExposedPorts: []string{"5432/tcp"},
+		Env: map[string]string{
+			"POSTGRES_PASSWORD": "postgres",
+			"POSTGRES_USER":     "postgres",
+			"POSTGRES_DB":       "profile",
+		},
+		WaitingFor: wait.ForSQL("5432/tcp", "postgres", func(host string, port nat.Port) string {
+			return fmt.Sprintf("postgres://postgres:postgres@%s:%s/profile?sslmode=disable", host, port.Port())
+		}).WithStartupTimeout(60 * time.Second),
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		t.Skipf("skip projection staleness tests: cannot start postgres container: %v", err)
+	}
+
+	host, err := container.Host(ctx)
+	require.NoError(t, err)
+
+	port, err := container.MappedPort(ctx, "5432")
+	require.NoError(t, err)
+
+	dsn := fmt.Sprintf("postgres://postgres:postgres@%s:%s/profile?sslmode=disable", host, port.Port())
+	cleanup := func() {
+		termCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = container.Terminate(termCtx)
+	}
+	return dsn, cleanup
+}
+
+func applyMigrations(ctx context.Context, t *testing.T, pool *pgxpool.Pool) {
+	t.Helper()
+
+	migrationsDir := filepath.Join("..", "..", "..", "..", "migrations")
+	entries, err := filepath.Glob(filepath.Join(migrationsDir, "*.sql"))
+	require.NoError(t, err)
+	sort.Strings(entries)
+
+	for _, path := range entries {
+		content, readErr := os.ReadFile(path)
+		require.NoError(t, readErr)
+		_, execErr := pool.Exec(ctx, string(content))
+		require.NoErrorf(t, execErr, "apply migration %s", filepath.Base(path))
+	}
+}
@@ -0,0 +1,14 @@
+-- ============================================
+-- Profile 公开主页字段可见性：public_display_name / public_avatar / public_bookmarks / public_stats
+-- ============================================
+
+alter table profile.users
+  add column if not exists public_display_name boolean not null default false,  -- 昵称是否公开
+  add column if not exists public_avatar boolean not null default false,        -- 头像是否公开
+  add column if not exists public_bookmarks boolean not null default false,     -- 收藏列表是否公开
+  add column if not exists public_stats boolean not null default false;         -- 聚合统计是否公开
+
+comment on column profile.users.public_display_name is '公开主页是否展示昵称，默认不公开';
+comment on column profile.users.public_avatar is '公开主页是否展示头像，默认不公开';
+comment on column profile.users.public_bookmarks is '是否允许匿名访问收藏列表（ListPublicBookmarks），默认关闭';
+comment on column profile.users.public_stats is '公开主页是否展示点赞/收藏/观看聚合统计，默认关闭';
@@ -0,0 +1,14 @@
+-- ============================================
+-- Profile 昵称唯一性：大小写不敏感的函数索引
+-- ============================================
+
+-- 服务端在 moderation.display_name.require_unique 开启时，于事务内先按
+-- lower(display_name) 获取 advisory 锁，再借助该索引检查是否已被其他活跃账户占用。
+-- 索引本身不带 unique，便于按部署开关启用唯一性而无需清洗历史重名数据；
+-- 因此唯一性是应用层的尽力保证：开关开启前的重名、未改名的历史昵称以及绕过服务的写入不受约束，
+-- 需要数据库强制唯一时应先清洗重名，再改为 unique 部分索引。
+create index if not exists users_display_name_lower_idx
+  on profile.users (lower(display_name))
+  where deleted_at is null;
+
+comment on index profile.users_display_name_lower_idx is '昵称大小写不敏感查找索引（非 unique），支撑可选的应用层昵称唯一性校验';
@@ -0,0 +1,44 @@
+-- ============================================
+-- Profile 互动类型注册表：engagement_types
+-- 以引用表替代 engagements.engagement_type 的硬编码 check 约束，
+-- 并为 video_stats 增加 dislike / share 计数列。
+-- ============================================
+
+create table if not exists profile.engagement_types (
+  engagement_type text primary key,                    -- 互动类型标识
+  description     text not null default '',            -- 类型说明
+  created_at      timestamptz not null default now()
+);
+
+comment on table profile.engagement_types is '互动类型注册表，新增类型需同步服务端注册表（services.engagementTypeRegistry）';
+
+insert into profile.engagement_types (engagement_type, description) values
+  ('like', '点赞，计入 video_stats.like_count，与 dislike 互斥'),
+  ('bookmark', '收藏，计入 video_stats.bookmark_count'),
+  ('dislike', '点踩，计入 video_stats.dislike_count，与 like 互斥'),
+  ('not_interested', '不感兴趣，推荐负反馈信号，不计入统计'),
+  ('share', '分享，计入 video_stats.share_count，不可撤销')
+on conflict (engagement_type) do nothing;
+
+alter table profile.engagements
+  drop constraint if exists engagements_engagement_type_check;
+
+do $$
+begin
+  if not exists (
+    select 1 from pg_constraint
+    where conname = 'engagements_engagement_type_fkey'
+      and conrelid = 'profile.engagements'::regclass
+  ) then
+    alter table profile.engagements
+      add constraint engagements_engagement_type_fkey
+      foreign key (engagement_type) references profile.engagement_types(engagement_type);
+  end if;
+end$$;
+
+alter table profile.video_stats
+  add column if not exists dislike_count bigint not null default 0,  -- 点踩数
+  add column if not exists share_count bigint not null default 0;    -- 分享数
+
+comment on column profile.video_stats.dislike_count is '点踩数，由 dislike 互动增减';
+comment on column profile.video_stats.share_count is '分享数，由 share 互动累加';
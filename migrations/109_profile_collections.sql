@@ -0,0 +1,48 @@
+-- ============================================
+-- Profile 收藏夹：collections / collection_items
+-- ============================================
+
+-- 用户自定义的收藏夹（如“语法”“听力练习”），名称在同一用户下大小写不敏感唯一。
+create table if not exists profile.collections (
+  collection_id  uuid primary key default gen_random_uuid(),   -- 收藏夹 ID
+  user_id        uuid not null,                                -- 所属用户
+  name           text not null,                                -- 收藏夹名称
+  created_at     timestamptz not null default now(),
+  updated_at     timestamptz not null default now(),
+  constraint collections_name_length_check check (char_length(name) between 1 and 64)
+);
+
+create unique index if not exists collections_user_name_uidx
+  on profile.collections (user_id, lower(name));
+
+comment on table profile.collections is '用户自定义收藏夹，按 created_at 升序展示';
+comment on column profile.collections.name is '收藏夹名称，1-64 个字符，同一用户内大小写不敏感唯一';
+
+do $$
+begin
+  if not exists (
+    select 1 from pg_trigger where tgname = 'set_updated_at_on_profile_collections'
+  ) then
+    create trigger set_updated_at_on_profile_collections
+      before update on profile.collections
+      for each row execute function profile.tg_set_updated_at();
+  end if;
+end$$;
+
+-- 收藏夹内的视频，position 越小越靠前；新加入的视频追加到末尾。
+create table if not exists profile.collection_items (
+  collection_id  uuid not null references profile.collections(collection_id) on delete cascade, -- 所属收藏夹
+  video_id       uuid not null,                                -- 视频 ID
+  user_id        uuid not null,                                -- 冗余所属用户，便于按用户清理
+  position       integer not null,                             -- 排序位置（从 1 开始）
+  added_at       timestamptz not null default now(),           -- 加入时间
+  primary key (collection_id, video_id)
+);
+
+create index if not exists collection_items_order_idx
+  on profile.collection_items (collection_id, position, added_at);
+create index if not exists collection_items_user_video_idx
+  on profile.collection_items (user_id, video_id);
+
+comment on table profile.collection_items is '收藏夹成员，视频元数据通过 profile.videos_projection 补水';
+comment on column profile.collection_items.position is '排序位置，重排时按请求顺序重写为 1..n';
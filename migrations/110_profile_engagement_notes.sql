@@ -0,0 +1,38 @@
+-- ============================================
+-- Profile 收藏笔记：engagement_notes
+-- ============================================
+
+-- 用户附加在收藏（bookmark 互动）上的私有学习笔记，可选记录视频内时间点。
+-- 互动行物理删除（用户数据清理）时笔记随外键级联删除；软删除（取消收藏）时保留，重新收藏后恢复可见。
+create table if not exists profile.engagement_notes (
+  note_id           uuid primary key default gen_random_uuid(), -- 笔记 ID
+  user_id           uuid not null,                              -- 所属用户
+  video_id          uuid not null,                              -- 视频 ID
+  engagement_type   text not null default 'bookmark',           -- 关联的互动类型
+  body              text not null,                              -- 笔记正文
+  position_seconds  integer,                                    -- 视频内时间点（秒），可空
+  created_at        timestamptz not null default now(),
+  updated_at        timestamptz not null default now(),
+  constraint engagement_notes_engagement_fkey
+    foreign key (user_id, video_id, engagement_type)
+    references profile.engagements (user_id, video_id, engagement_type) on delete cascade,
+  constraint engagement_notes_body_length_check check (char_length(body) between 1 and 2000),
+  constraint engagement_notes_position_check check (position_seconds is null or position_seconds >= 0)
+);
+
+create index if not exists engagement_notes_user_video_idx
+  on profile.engagement_notes (user_id, video_id, created_at);
+
+comment on table profile.engagement_notes is '收藏上的私有笔记，仅本人可见，不出现在公开收藏列表';
+comment on column profile.engagement_notes.position_seconds is '笔记对应的视频时间点（秒），为空表示不关联时间点';
+
+do $$
+begin
+  if not exists (
+    select 1 from pg_trigger where tgname = 'set_updated_at_on_profile_engagement_notes'
+  ) then
+    create trigger set_updated_at_on_profile_engagement_notes
+      before update on profile.engagement_notes
+      for each row execute function profile.tg_set_updated_at();
+  end if;
+end$$;
@@ -0,0 +1,20 @@
+-- ============================================
+-- Profile 订阅位点：subscription_offsets
+-- ============================================
+
+-- 每个消费组（Inbox Runner）一行，记录已处理消息的高水位：最新 Pub/Sub publish_time 与事件 occurred_at。
+-- 由 Inbox handler 在处理事件的同一事务内推进，只前进不回退；供滞后监控与按时间点回放使用。
+create table if not exists profile.subscription_offsets (
+  consumer_group    text primary key,                   -- 消费组，如 catalog_inbox
+  subscription      text not null default '',           -- Pub/Sub 订阅 ID
+  message_id        text,                               -- publish_time 高水位对应的 Pub/Sub 消息 ID
+  publish_time      timestamptz,                        -- 已处理消息的最大 publish_time
+  last_event_id     uuid,                               -- occurred_at 高水位对应的事件 ID
+  last_occurred_at  timestamptz,                        -- 已处理事件的最大 occurred_at
+  lag_millis        bigint not null default 0,          -- 最近一次处理时 publish_time 至处理完成的滞后
+  updated_by        text not null default '',           -- 最近推进位点的进程标识
+  updated_at        timestamptz not null default now()
+);
+
+comment on table profile.subscription_offsets is 'Inbox 消费组高水位：最大 publish_time / occurred_at，只前进不回退';
+comment on column profile.subscription_offsets.lag_millis is '最近一次处理的消息从发布到处理完成的毫秒数';
@@ -0,0 +1,32 @@
+-- ============================================
+-- Profile Inbox 隔离区：inbox_quarantine
+-- ============================================
+
+-- 记录 Inbox handler 处理失败的事件及失败次数；达到消费组 max_attempts 后转为 quarantined，
+-- Runner 不再重试（直接 Ack），由运维通过 catalog_inbox_quarantine 命令重试或丢弃。
+-- 失败计数在 handler 事务之外写入，事务回滚不会丢失；payload 复制一份以便在 Inbox 行缺失时仍可重试。
+create table if not exists profile.inbox_quarantine (
+  event_id         uuid primary key,                    -- Inbox 事件 ID
+  consumer_group   text not null,                       -- 消费组，如 catalog_inbox
+  source_service   text not null,                       -- 事件来源服务
+  event_type       text not null,                       -- 事件类型
+  aggregate_id     text,                                -- 来源聚合根主键
+  payload          bytea not null,                      -- 原始事件载荷
+  status           text not null default 'failing',     -- failing / quarantined / resolved / discarded
+  attempts         integer not null default 0,          -- 累计失败次数
+  max_attempts     integer not null,                    -- 记录失败时生效的最大尝试次数
+  last_error       text,                                -- 最近一次失败信息
+  first_failed_at  timestamptz not null default now(),
+  last_failed_at   timestamptz not null default now(),
+  quarantined_at   timestamptz,                         -- 进入隔离区时间
+  resolved_at      timestamptz,                         -- 重试成功或丢弃时间
+  resolved_by      text,                                -- 处理隔离事件的操作人
+  constraint profile_inbox_quarantine_status_check
+    check (status in ('failing', 'quarantined', 'resolved', 'discarded'))
+);
+
+create index if not exists profile_inbox_quarantine_status_idx
+  on profile.inbox_quarantine (consumer_group, status, last_failed_at desc);
+
+comment on table profile.inbox_quarantine is 'Inbox 失败事件与隔离区：failing 仍会重试，quarantined 停止重试待人工处理';
+comment on column profile.inbox_quarantine.attempts is '累计处理失败次数，达到 max_attempts 时转为 quarantined';
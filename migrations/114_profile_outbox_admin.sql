@@ -0,0 +1,41 @@
+-- ============================================
+-- Profile Outbox 运维：死信与归档
+-- ============================================
+
+-- 死信表：运维通过 outbox_admin dead-letter 将无法投递的事件移出 outbox_events，发布器不再扫描；
+-- outbox_admin retry 可将其移回 outbox_events 重新投递。
+create table if not exists profile.outbox_dead_letters (
+  event_id            uuid primary key,
+  aggregate_type      text not null,
+  aggregate_id        uuid not null,
+  event_type          text not null,
+  payload             bytea not null,
+  headers             jsonb not null default '{}'::jsonb,
+  occurred_at         timestamptz not null,
+  delivery_attempts   integer not null default 0,
+  last_error          text,
+  dead_lettered_at    timestamptz not null default now(),  -- 移入死信的时间
+  reason              text,                                -- 移入死信的原因
+  dead_lettered_by    text                                 -- 操作人
+);
+
+comment on table profile.outbox_dead_letters is 'Outbox 死信：人工移出发布队列的事件，可重新入队';
+
+-- 归档表：保留期清理以 archive 模式运行时，已发布事件移入此表而非直接删除。
+create table if not exists profile.outbox_events_archive (
+  event_id            uuid primary key,
+  aggregate_type      text not null,
+  aggregate_id        uuid not null,
+  event_type          text not null,
+  payload             bytea not null,
+  headers             jsonb not null default '{}'::jsonb,
+  occurred_at         timestamptz not null,
+  published_at        timestamptz not null,
+  delivery_attempts   integer not null default 0,
+  archived_at         timestamptz not null default now()
+);
+
+comment on table profile.outbox_events_archive is 'Outbox 归档：超过保留期的已发布事件';
+
+create index if not exists profile_outbox_events_archive_published_idx
+  on profile.outbox_events_archive (published_at);
@@ -0,0 +1,27 @@
+-- ============================================
+-- Profile 账户生命周期：account_status / pending_deletion_at / deleted_at
+-- ============================================
+
+alter table profile.users
+  add column if not exists account_status text not null default 'active',   -- 账户状态
+  add column if not exists pending_deletion_at timestamptz,                  -- 申请删除时间
+  add column if not exists deleted_at timestamptz;                           -- 账号删除时间
+
+do $$
+begin
+  if not exists (
+    select 1 from pg_constraint where conname = 'profile_users_account_status_check'
+  ) then
+    alter table profile.users
+      add constraint profile_users_account_status_check
+      check (account_status in ('active', 'suspended', 'pending_deletion', 'deleted'));
+  end if;
+end$$;
+
+comment on column profile.users.account_status is '账户状态：active/suspended/pending_deletion/deleted';
+comment on column profile.users.pending_deletion_at is '进入待删除状态的时间，撤销删除后清空';
+comment on column profile.users.deleted_at is '账号删除完成时间，删除后仅保留公开字段';
+
+create index if not exists profile_users_account_status_idx
+  on profile.users (account_status, user_id);
+comment on index profile.profile_users_account_status_idx is '按账户状态筛选用户（运营/清理任务）';
@@ -0,0 +1,10 @@
+-- ============================================
+-- Profile 区域设置：preferred_locale / preferred_timezone
+-- ============================================
+
+alter table profile.users
+  add column if not exists preferred_locale text,     -- 首选语言（BCP47）
+  add column if not exists preferred_timezone text;   -- 首选时区（IANA TZ）
+
+comment on column profile.users.preferred_locale is '首选语言，BCP47 规范化标签（如 en-US、zh-Hans-CN），为空时回落到网关 userinfo 的 locale';
+comment on column profile.users.preferred_timezone is '首选时区，IANA TZ 名称（如 Asia/Shanghai），为空时回落到网关 userinfo 的 zoneinfo';
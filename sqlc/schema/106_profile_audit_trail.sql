@@ -0,0 +1,36 @@
+-- ============================================
+-- Profile 审计轨迹：记录每次写操作的操作者、前后差异与 trace_id
+-- ============================================
+
+-- 审计记录与业务写入在同一事务内追加，业务回滚时审计一并回滚，保证“有写入必有审计”。
+-- before/after 仅包含发生变化的字段；互动类写操作记录互动的激活状态。
+create table if not exists profile.audit_trail (
+  audit_id       uuid primary key default gen_random_uuid(),   -- 审计记录 ID
+  user_id        uuid not null,                                -- 被操作的账户
+  actor_type     text not null,                                -- 操作者类型：user / service
+  actor_id       text not null,                                -- 操作者标识：用户 UUID 或服务身份
+  action         text not null,                                -- 动作，如 profile.update、engagement.add
+  resource_type  text not null,                                -- 资源类型：profile / engagement
+  resource_id    text not null,                                -- 资源标识
+  before         jsonb not null default '{}'::jsonb,           -- 变更前的字段值（仅变化字段）
+  after          jsonb not null default '{}'::jsonb,           -- 变更后的字段值（仅变化字段）
+  trace_id       text,                                         -- OpenTelemetry trace_id，便于关联日志/链路
+  created_at     timestamptz not null default now(),
+  constraint audit_trail_actor_type_check check (actor_type in ('user', 'service'))
+);
+
+create index if not exists audit_trail_user_created_idx
+  on profile.audit_trail (user_id, created_at desc, audit_id desc);
+create index if not exists audit_trail_actor_created_idx
+  on profile.audit_trail (actor_id, created_at desc);
+create index if not exists audit_trail_action_created_idx
+  on profile.audit_trail (action, created_at desc);
+create index if not exists audit_trail_trace_idx
+  on profile.audit_trail (trace_id)
+  where trace_id is not null;
+
+comment on table profile.audit_trail is '写操作审计轨迹，与业务写入同事务追加，只增不改';
+comment on column profile.audit_trail.actor_type is '操作者类型：user（终端用户）或 service（运营/内部服务身份）';
+comment on column profile.audit_trail.before is '变更前的字段快照，仅包含发生变化的字段';
+comment on column profile.audit_trail.after is '变更后的字段快照，仅包含发生变化的字段';
+comment on column profile.audit_trail.trace_id is '写入时的 OpenTelemetry trace_id';
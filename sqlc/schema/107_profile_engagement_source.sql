@@ -0,0 +1,23 @@
+-- ============================================
+-- Profile 互动来源与上下文：source / metadata
+-- ============================================
+
+alter table profile.engagements
+  add column if not exists source text not null default 'manual',        -- 互动来源
+  add column if not exists metadata jsonb not null default '{}'::jsonb;  -- 互动上下文（入口、终端等）
+
+do $$
+begin
+  if not exists (
+    select 1 from pg_constraint
+    where conname = 'engagements_source_check'
+      and conrelid = 'profile.engagements'::regclass
+  ) then
+    alter table profile.engagements
+      add constraint engagements_source_check
+      check (source in ('manual', 'recommendation', 'system'));
+  end if;
+end$$;
+
+comment on column profile.engagements.source is '互动来源：manual（用户主动）/recommendation（推荐位）/system（系统代操作），默认 manual';
+comment on column profile.engagements.metadata is '互动上下文，如 entry_point（入口）、device（终端），最近一次写入覆盖';
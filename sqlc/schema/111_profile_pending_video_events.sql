@@ -0,0 +1,25 @@
+-- ============================================
+-- Profile 乱序 Catalog 事件暂存：pending_video_events
+-- ============================================
+
+-- Pub/Sub 不保证顺序：video.updated / video.deleted 可能早于 video.created 到达。
+-- 投影缺失时事件暂存于此，待 created 写入投影后按 (version, occurred_at) 顺序回放并删除。
+-- 以 event_id 为主键：未携带版本号的事件 version 均为 0，不能以 (video_id, version) 去重。
+create table if not exists profile.pending_video_events (
+  event_id     uuid primary key,                   -- 原始事件 ID，重复投递时去重
+  video_id     uuid not null,                      -- 视频 ID（聚合 ID）
+  version      bigint not null,                    -- 事件版本号，未携带时为 0
+  event_type   text not null,                      -- 原始事件类型
+  payload      bytea not null,                     -- 原始事件（videov1.Event protobuf 编码）
+  occurred_at  timestamptz,                        -- 事件发生时间
+  parked_at    timestamptz not null default now()  -- 暂存时间
+);
+
+create index if not exists pending_video_events_video_version_idx
+  on profile.pending_video_events (video_id, version, occurred_at);
+
+create index if not exists pending_video_events_parked_at_idx
+  on profile.pending_video_events (parked_at);
+
+comment on table profile.pending_video_events is '投影尚未创建时到达的 Catalog 事件，created 到达后按版本回放';
+comment on column profile.pending_video_events.payload is 'videov1.Event 的 protobuf 编码，回放时重新解码';
@@ -0,0 +1,29 @@
+-- ============================================
+-- Profile 事件总线 Postgres 驱动：eventbus_messages / eventbus_subscriptions
+-- ============================================
+
+-- messaging.driver = postgres 时替代 Pub/Sub：发布端追加消息并 NOTIFY profile_eventbus，
+-- 订阅端 LISTEN 后按 id 顺序读取，处理成功后推进订阅游标，实现单库离线运行的至少一次投递。
+create table if not exists profile.eventbus_messages (
+  id            bigserial primary key,                    -- 追加顺序；同一 topic 内由事务级 advisory lock 保证按提交顺序递增
+  topic         text not null,                            -- 对应 messaging.topics.*.topic_id
+  event_id      uuid,                                     -- Outbox 事件 ID，便于排障
+  ordering_key  text not null default '',
+  data          bytea not null,
+  attributes    jsonb not null default '{}'::jsonb,
+  published_at  timestamptz not null default now()
+);
+
+create index if not exists eventbus_messages_topic_id_idx
+  on profile.eventbus_messages (topic, id);
+
+comment on table profile.eventbus_messages is 'Postgres 事件总线消息日志，按 topic 追加、按 id 顺序消费';
+
+create table if not exists profile.eventbus_subscriptions (
+  subscription  text primary key,                         -- 对应 messaging.topics.*.subscription_id
+  topic         text not null,
+  last_id       bigint not null default 0,                -- 已确认处理的最大消息 id
+  updated_at    timestamptz not null default now()
+);
+
+comment on table profile.eventbus_subscriptions is 'Postgres 事件总线订阅游标，同一订阅同一时刻仅一个消费者持有 advisory lock';